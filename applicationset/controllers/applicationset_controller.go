@@ -248,6 +248,8 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 
 	// appSyncMap tracks which apps will be synced during this reconciliation.
 	appSyncMap := map[string]bool{}
+	// rolloutRequeueAfter is the delay after which the rollout step gates must be evaluated again.
+	var rolloutRequeueAfter time.Duration
 
 	if r.EnableProgressiveSyncs {
 		if !progressivesync.IsRollingSyncStrategy(&applicationSetInfo) && len(applicationSetInfo.Status.ApplicationStatus) > 0 {
@@ -258,6 +260,10 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 			if err != nil {
 				return ctrl.Result{}, fmt.Errorf("failed to clear previous AppSet application statuses for %v: %w", applicationSetInfo.Name, err)
 			}
+			err = r.SetAppSetRolloutStepStatus(ctx, logCtx, &applicationSetInfo, []argov1alpha1.ApplicationSetRolloutStepStatus{})
+			if err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to clear previous AppSet rollout step statuses for %v: %w", applicationSetInfo.Name, err)
+			}
 		} else if progressivesync.IsRollingSyncStrategy(&applicationSetInfo) {
 			// before starting progressive sync, checks if steps
 			if progressivesync.IsStepsEmpty(&applicationSetInfo) {
@@ -274,7 +280,7 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 				)
 				return ctrl.Result{RequeueAfter: ReconcileRequeueOnValidationError}, nil
 			}
-			appSyncMap, err = r.ProgressiveSyncManager.PerformProgressiveSyncs(ctx, logCtx, applicationSetInfo, currentApplications, generatedApplications)
+			appSyncMap, rolloutRequeueAfter, err = r.ProgressiveSyncManager.PerformProgressiveSyncs(ctx, logCtx, applicationSetInfo, currentApplications, generatedApplications)
 			if err != nil {
 				return ctrl.Result{}, fmt.Errorf("failed to perform progressive sync reconciliation for application set: %w", err)
 			}
@@ -288,6 +294,10 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 			if err != nil {
 				return ctrl.Result{}, fmt.Errorf("failed to clear AppSet application statuses when Progressive Sync is disabled for %v: %w", applicationSetInfo.Name, err)
 			}
+			err = r.SetAppSetRolloutStepStatus(ctx, logCtx, &applicationSetInfo, []argov1alpha1.ApplicationSetRolloutStepStatus{})
+			if err != nil {
+				return ctrl.Result{}, fmt.Errorf("failed to clear AppSet rollout step statuses when Progressive Sync is disabled for %v: %w", applicationSetInfo.Name, err)
+			}
 		}
 	}
 
@@ -331,6 +341,9 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		// trigger appropriate application syncs if RollingSync strategy is enabled
 		if progressivesync.RollingSyncStrategyEnabled(&applicationSetInfo) {
 			validApps = r.ProgressiveSyncManager.SyncDesiredApplications(logCtx, &applicationSetInfo, appSyncMap, validApps)
+			if progressivesync.IsRollbackOnFailure(&applicationSetInfo) {
+				validApps = r.ProgressiveSyncManager.RollbackDesiredApplications(logCtx, &applicationSetInfo, currentApplications, validApps)
+			}
 		}
 	}
 
@@ -402,8 +415,12 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		return ctrl.Result{}, fmt.Errorf("failed to update resources status for application set: %w", err)
 	}
 
-	if applicationSetInfo.RefreshRequired() {
+	_, rolloutStepApproved := applicationSetInfo.Annotations[common.AnnotationApplicationSetRolloutApproveStep]
+	_, rolloutStepRejected := applicationSetInfo.Annotations[common.AnnotationApplicationSetRolloutRejectStep]
+	if applicationSetInfo.RefreshRequired() || rolloutStepApproved || rolloutStepRejected {
 		delete(applicationSetInfo.Annotations, common.AnnotationApplicationSetRefresh)
+		delete(applicationSetInfo.Annotations, common.AnnotationApplicationSetRolloutApproveStep)
+		delete(applicationSetInfo.Annotations, common.AnnotationApplicationSetRolloutRejectStep)
 		err := r.Update(ctx, &applicationSetInfo)
 		if err != nil {
 			logCtx.Warnf("error occurred while updating ApplicationSet: %v", err)
@@ -423,6 +440,9 @@ func (r *ApplicationSetReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 	}
 
 	requeueAfter := r.getMinRequeueAfter(&applicationSetInfo)
+	if rolloutRequeueAfter > 0 && (requeueAfter == 0 || rolloutRequeueAfter < requeueAfter) {
+		requeueAfter = rolloutRequeueAfter
+	}
 
 	if len(validateErrors) == 0 {
 		if err := r.setApplicationSetStatusCondition(ctx,
@@ -1216,6 +1236,39 @@ func (r *ApplicationSetReconciler) setAppSetApplicationStatus(ctx context.Contex
 	return nil
 }
 
+// setAppSetRolloutStepStatus updates the RollingSync step gate statuses of the ApplicationSet, if they changed
+func (r *ApplicationSetReconciler) setAppSetRolloutStepStatus(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, stepStatuses []argov1alpha1.ApplicationSetRolloutStepStatus) error {
+	if cmp.Equal(applicationSet.Status.RolloutSteps, stepStatuses, cmpopts.EquateEmpty()) {
+		return nil
+	}
+
+	// DefaultRetry will retry 5 times with a backoff factor of 1, jitter of 0.1 and a duration of 10ms
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		updatedAppset := &argov1alpha1.ApplicationSet{}
+		if err := r.Get(ctx, types.NamespacedName{Namespace: applicationSet.Namespace, Name: applicationSet.Name}, updatedAppset); err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				return nil
+			}
+			return fmt.Errorf("error fetching updated application set: %w", err)
+		}
+
+		updatedAppset.Status.RolloutSteps = stepStatuses
+
+		err := r.Client.Status().Update(ctx, updatedAppset)
+		if err != nil {
+			return err
+		}
+		updatedAppset.DeepCopyInto(applicationSet)
+		return nil
+	})
+	if err != nil {
+		logCtx.Errorf("unable to set application set rollout step status: %v", err)
+		return fmt.Errorf("unable to set application set rollout step status: %w", err)
+	}
+
+	return nil
+}
+
 func getApplicationOwnsHandler(enableProgressiveSyncs bool) predicate.Funcs {
 	return predicate.Funcs{
 		CreateFunc: func(e event.CreateEvent) bool {
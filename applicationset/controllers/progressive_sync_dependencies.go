@@ -36,3 +36,13 @@ func (r *ApplicationSetReconciler) SetApplicationSetStatusCondition(
 	// Delegate to existing controller method
 	return r.setApplicationSetStatusCondition(ctx, applicationSet, conditions, parametersGenerated)
 }
+
+func (r *ApplicationSetReconciler) SetAppSetRolloutStepStatus(
+	ctx context.Context,
+	logCtx *log.Entry,
+	applicationSet *argov1alpha1.ApplicationSet,
+	stepStatuses []argov1alpha1.ApplicationSetRolloutStepStatus,
+) error {
+	// Delegate to existing controller method
+	return r.setAppSetRolloutStepStatus(ctx, logCtx, applicationSet, stepStatuses)
+}
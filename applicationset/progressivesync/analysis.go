@@ -0,0 +1,151 @@
+package progressivesync
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AnalysisResult is the outcome of a single evaluation of a rollout step analysis
+type AnalysisResult string
+
+const (
+	AnalysisSuccessful   AnalysisResult = "Successful"
+	AnalysisFailed       AnalysisResult = "Failed"
+	AnalysisInconclusive AnalysisResult = "Inconclusive"
+)
+
+const prometheusQueryTimeout = 30 * time.Second
+
+// PrometheusClient runs instant PromQL queries on behalf of the analysis gate of RollingSync steps
+type PrometheusClient interface {
+	// Query evaluates the query at the current time and returns the value of every sample of the result
+	Query(ctx context.Context, query string) ([]float64, error)
+}
+
+type prometheusHTTPClient struct {
+	address string
+	client  *http.Client
+}
+
+// NewPrometheusClient returns a PrometheusClient querying the HTTP API of the Prometheus server at address
+func NewPrometheusClient(address string) PrometheusClient {
+	return &prometheusHTTPClient{
+		address: strings.TrimSuffix(address, "/"),
+		client:  &http.Client{Timeout: prometheusQueryTimeout},
+	}
+}
+
+type prometheusQueryResponse struct {
+	Status    string `json:"status"`
+	ErrorType string `json:"errorType"`
+	Error     string `json:"error"`
+	Data      struct {
+		ResultType string          `json:"resultType"`
+		Result     json.RawMessage `json:"result"`
+	} `json:"data"`
+}
+
+type prometheusSample struct {
+	Value [2]any `json:"value"`
+}
+
+func (c *prometheusHTTPClient) Query(ctx context.Context, query string) ([]float64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.address+"/api/v1/query?"+url.Values{"query": []string{query}}.Encode(), http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Prometheus query request: %w", err)
+	}
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query Prometheus: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read Prometheus response: %w", err)
+	}
+	var queryResp prometheusQueryResponse
+	if err := json.Unmarshal(body, &queryResp); err != nil {
+		return nil, fmt.Errorf("failed to parse Prometheus response (HTTP %d): %w", resp.StatusCode, err)
+	}
+	if queryResp.Status != "success" {
+		return nil, fmt.Errorf("prometheus query failed: %s: %s", queryResp.ErrorType, queryResp.Error)
+	}
+
+	switch queryResp.Data.ResultType {
+	case "vector":
+		var samples []prometheusSample
+		if err := json.Unmarshal(queryResp.Data.Result, &samples); err != nil {
+			return nil, fmt.Errorf("failed to parse Prometheus vector result: %w", err)
+		}
+		values := make([]float64, 0, len(samples))
+		for _, sample := range samples {
+			value, err := parsePrometheusValue(sample.Value)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	case "scalar":
+		var scalar [2]any
+		if err := json.Unmarshal(queryResp.Data.Result, &scalar); err != nil {
+			return nil, fmt.Errorf("failed to parse Prometheus scalar result: %w", err)
+		}
+		value, err := parsePrometheusValue(scalar)
+		if err != nil {
+			return nil, err
+		}
+		return []float64{value}, nil
+	default:
+		return nil, fmt.Errorf("unsupported Prometheus result type %q, the query must return a vector or a scalar", queryResp.Data.ResultType)
+	}
+}
+
+// parsePrometheusValue parses a [<timestamp>, "<value>"] pair of the Prometheus HTTP API
+func parsePrometheusValue(pair [2]any) (float64, error) {
+	raw, ok := pair[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("unexpected Prometheus sample value %v", pair[1])
+	}
+	value, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse Prometheus sample value %q: %w", raw, err)
+	}
+	return value, nil
+}
+
+// evaluatePrometheusAnalysis runs the query once. The evaluation succeeds when every returned sample is non-zero, fails
+// when any sample is zero, and is inconclusive when the query returns no sample, a NaN sample or cannot be run.
+func evaluatePrometheusAnalysis(ctx context.Context, client PrometheusClient, query string) (AnalysisResult, string) {
+	if client == nil {
+		return AnalysisFailed, "no Prometheus server is configured on the ApplicationSet controller"
+	}
+	if query == "" {
+		return AnalysisFailed, "the Prometheus analysis query is empty"
+	}
+	values, err := client.Query(ctx, query)
+	if err != nil {
+		return AnalysisInconclusive, err.Error()
+	}
+	if len(values) == 0 {
+		return AnalysisInconclusive, "the Prometheus query returned no result"
+	}
+	for _, value := range values {
+		if math.IsNaN(value) {
+			return AnalysisInconclusive, "the Prometheus query returned NaN"
+		}
+		if value == 0 {
+			return AnalysisFailed, "the Prometheus query returned a zero value"
+		}
+	}
+	return AnalysisSuccessful, fmt.Sprintf("the Prometheus query returned %d non-zero value(s)", len(values))
+}
@@ -0,0 +1,89 @@
+package progressivesync
+
+import (
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrometheusClientQuery(t *testing.T) {
+	t.Parallel()
+
+	for _, cc := range []struct {
+		name           string
+		response       string
+		expectedValues []float64
+		expectedErr    string
+	}{
+		{
+			name:           "vector result",
+			response:       `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"job":"a"},"value":[1700000000.1,"1"]},{"metric":{"job":"b"},"value":[1700000000.1,"0.25"]}]}}`,
+			expectedValues: []float64{1, 0.25},
+		},
+		{
+			name:           "empty vector result",
+			response:       `{"status":"success","data":{"resultType":"vector","result":[]}}`,
+			expectedValues: []float64{},
+		},
+		{
+			name:           "scalar result",
+			response:       `{"status":"success","data":{"resultType":"scalar","result":[1700000000.1,"0"]}}`,
+			expectedValues: []float64{0},
+		},
+		{
+			name:        "matrix result",
+			response:    `{"status":"success","data":{"resultType":"matrix","result":[]}}`,
+			expectedErr: `unsupported Prometheus result type "matrix"`,
+		},
+		{
+			name:        "query error",
+			response:    `{"status":"error","errorType":"bad_data","error":"parse error"}`,
+			expectedErr: "prometheus query failed: bad_data: parse error",
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			t.Parallel()
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, "/api/v1/query", r.URL.Path)
+				assert.Equal(t, `sum(up{job="a"})`, r.URL.Query().Get("query"))
+				_, _ = w.Write([]byte(cc.response))
+			}))
+			defer server.Close()
+
+			values, err := NewPrometheusClient(server.URL+"/").Query(t.Context(), `sum(up{job="a"})`)
+			if cc.expectedErr != "" {
+				require.ErrorContains(t, err, cc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, cc.expectedValues, values)
+		})
+	}
+}
+
+func TestEvaluatePrometheusAnalysis(t *testing.T) {
+	t.Parallel()
+
+	result, _ := evaluatePrometheusAnalysis(t.Context(), &fakePrometheusClient{values: []float64{1, 2}}, "up")
+	assert.Equal(t, AnalysisSuccessful, result)
+
+	result, _ = evaluatePrometheusAnalysis(t.Context(), &fakePrometheusClient{values: []float64{1, 0}}, "up")
+	assert.Equal(t, AnalysisFailed, result)
+
+	result, _ = evaluatePrometheusAnalysis(t.Context(), &fakePrometheusClient{values: []float64{}}, "up")
+	assert.Equal(t, AnalysisInconclusive, result)
+
+	result, _ = evaluatePrometheusAnalysis(t.Context(), &fakePrometheusClient{values: []float64{math.NaN()}}, "up")
+	assert.Equal(t, AnalysisInconclusive, result)
+
+	result, _ = evaluatePrometheusAnalysis(t.Context(), &fakePrometheusClient{values: []float64{1}}, "")
+	assert.Equal(t, AnalysisFailed, result)
+
+	result, message := evaluatePrometheusAnalysis(t.Context(), nil, "up")
+	assert.Equal(t, AnalysisFailed, result)
+	assert.Equal(t, "no Prometheus server is configured on the ApplicationSet controller", message)
+}
@@ -29,6 +29,7 @@ import (
 const (
 	ReverseDeletionOrder      = "Reverse"
 	AllAtOnceDeletionOrder    = "AllAtOnce"
+	RollbackOnFailure         = "Rollback"
 	revisionAndSpecChangedMsg = "Application has pending changes (revision and spec differ), setting status to Waiting"
 	revisionChangedMsg        = "Application has pending changes, setting status to Waiting"
 	specChangedMsg            = "Application has pending changes (spec differs), setting status to Waiting"
@@ -58,10 +59,20 @@ type Dependencies interface {
 		conditions []argov1alpha1.ApplicationSetCondition,
 		parametersGenerated bool,
 	) error
+
+	// SetAppSetRolloutStepStatus persists ApplicationSet status.RolloutSteps field
+	SetAppSetRolloutStepStatus(
+		ctx context.Context,
+		logCtx *log.Entry,
+		applicationSet *argov1alpha1.ApplicationSet,
+		stepStatuses []argov1alpha1.ApplicationSetRolloutStepStatus,
+	) error
 }
 
 type Manager struct {
-	Client           client.Client
+	Client client.Client
+	// Prometheus is used to evaluate the Prometheus analysis of rollout steps, it is nil if no server is configured
+	Prometheus       PrometheusClient
 	dependencies     Dependencies
 	validationIssues *ValidationIssues // collected during progressive sync execution
 }
@@ -74,7 +85,9 @@ func NewManager(client client.Client, dependencies Dependencies) *Manager {
 	}
 }
 
-func (m *Manager) PerformProgressiveSyncs(ctx context.Context, logCtx *log.Entry, appset argov1alpha1.ApplicationSet, applications []argov1alpha1.Application, desiredApplications []argov1alpha1.Application) (map[string]bool, error) {
+// PerformProgressiveSyncs updates the progressive sync status of the ApplicationSet and returns the Applications which are
+// allowed to sync, along with the delay after which the rollout step gates must be evaluated again, if any.
+func (m *Manager) PerformProgressiveSyncs(ctx context.Context, logCtx *log.Entry, appset argov1alpha1.ApplicationSet, applications []argov1alpha1.Application, desiredApplications []argov1alpha1.Application) (map[string]bool, time.Duration, error) {
 	// Initialize validation tracking
 	m.validationIssues = &ValidationIssues{}
 
@@ -87,7 +100,12 @@ func (m *Manager) PerformProgressiveSyncs(ctx context.Context, logCtx *log.Entry
 
 	_, err := m.UpdateApplicationSetApplicationStatus(ctx, logCtx, &appset, applications, desiredApplications, appStepMap)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update applicationset app status: %w", err)
+		return nil, 0, fmt.Errorf("failed to update applicationset app status: %w", err)
+	}
+
+	requeueAfter, err := m.UpdateApplicationSetRolloutStepStatus(ctx, logCtx, &appset, applications, appDependencyList)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to update applicationset rollout step status: %w", err)
 	}
 
 	logCtx.Infof("ApplicationSet %v step list:", appset.Name)
@@ -100,7 +118,7 @@ func (m *Manager) PerformProgressiveSyncs(ctx context.Context, logCtx *log.Entry
 
 	_, err = m.UpdateApplicationSetApplicationStatusProgress(ctx, logCtx, &appset, appsToSync, appStepMap)
 	if err != nil {
-		return nil, fmt.Errorf("failed to update applicationset application status progress: %w", err)
+		return nil, 0, fmt.Errorf("failed to update applicationset application status progress: %w", err)
 	}
 
 	progressingCondition := m.getProgressingCondition(&appset)
@@ -108,7 +126,7 @@ func (m *Manager) PerformProgressiveSyncs(ctx context.Context, logCtx *log.Entry
 	conditions := []*argov1alpha1.ApplicationSetCondition{invalidConfigCondition, progressingCondition}
 	_ = m.updateApplicationSetApplicationStatusConditions(ctx, &appset, conditions)
 
-	return appsToSync, nil
+	return appsToSync, requeueAfter, nil
 }
 
 func (m *Manager) PerformReverseDeletion(ctx context.Context, logCtx *log.Entry, appset argov1alpha1.ApplicationSet, currentApps []argov1alpha1.Application) (time.Duration, error) {
@@ -395,6 +413,22 @@ func (m *Manager) UpdateApplicationSetApplicationStatus(ctx context.Context, log
 					newAppStatus.LastTransitionTime = &now
 					newAppStatus.Status = argov1alpha1.ProgressiveSyncHealthy
 					newAppStatus.Message = "Application resource became Healthy, updating status from Progressing to Healthy"
+				} else if IsRollbackOnFailure(applicationSet) {
+					// With the Rollback failure policy, a Degraded Application or a failed sync fails the step
+					if failureMessage, failed := getApplicationFailure(app); failed {
+						newAppStatus.LastTransitionTime = &now
+						newAppStatus.Status = argov1alpha1.ProgressiveSyncFailed
+						newAppStatus.Message = failureMessage + ", updating status from Progressing to Failed"
+					}
+				}
+			}
+
+			if currentAppStatus.Status == argov1alpha1.ProgressiveSyncRollingBack {
+				// Validate that the rollback operation was started after the rollback was requested
+				if app.Status.OperationState != nil && currentAppStatus.LastTransitionTime != nil && app.Status.OperationState.StartedAt.After(currentAppStatus.LastTransitionTime.Time) {
+					newAppStatus.LastTransitionTime = &now
+					newAppStatus.Status = argov1alpha1.ProgressiveSyncRolledBack
+					newAppStatus.Message = "Application resource started its rollback, updating status from RollingBack to RolledBack"
 				}
 			}
 		}
@@ -457,6 +491,10 @@ func getAppsToSync(applicationSet argov1alpha1.ApplicationSet, appDependencyList
 				break
 			}
 		}
+		if syncNextWave && !rolloutStepGatesPassed(applicationSet, stepIndex) {
+			// Every application of this wave is healthy, but the pause, analysis or approval of the step is not completed
+			syncNextWave = false
+		}
 		if !syncNextWave {
 			break
 		}
@@ -484,6 +522,22 @@ func IsDeletionOrderReversed(appset *argov1alpha1.ApplicationSet) bool {
 	return RollingSyncStrategyEnabled(appset) && strings.EqualFold(appset.Spec.Strategy.DeletionOrder, ReverseDeletionOrder)
 }
 
+// IsRollbackOnFailure returns true if the Applications of a failed RollingSync step are rolled back
+func IsRollbackOnFailure(appset *argov1alpha1.ApplicationSet) bool {
+	return RollingSyncStrategyEnabled(appset) && strings.EqualFold(appset.Spec.Strategy.RollingSync.OnFailure, RollbackOnFailure)
+}
+
+// getApplicationFailure returns whether the Application is Degraded or its last sync operation failed
+func getApplicationFailure(app argov1alpha1.Application) (string, bool) {
+	if app.Status.Health.Status == health.HealthStatusDegraded {
+		return "Application resource became Degraded", true
+	}
+	if app.Status.OperationState != nil && app.Status.OperationState.Phase.Completed() && !app.Status.OperationState.Phase.Successful() {
+		return fmt.Sprintf("Application resource sync %s: %s", strings.ToLower(string(app.Status.OperationState.Phase)), app.Status.OperationState.Message), true
+	}
+	return "", false
+}
+
 func isApplicationWithError(app argov1alpha1.Application) bool {
 	for _, condition := range app.Status.Conditions {
 		if condition.Type == argov1alpha1.ApplicationConditionInvalidSpecError {
@@ -624,6 +678,32 @@ func (m *Manager) getProgressingCondition(applicationSet *argov1alpha1.Applicati
 			progressingStep = step
 			break
 		}
+		if !rolloutStepGatesPassed(*applicationSet, i) {
+			isProgressing = true
+			progressingStep = step
+			break
+		}
+	}
+
+	if isProgressing {
+		if stepStatus := findRolloutStepStatus(applicationSet.Status.RolloutSteps, progressingStep); stepStatus != nil {
+			if stepStatus.Phase == argov1alpha1.ApplicationSetRolloutStepFailed {
+				return &argov1alpha1.ApplicationSetCondition{
+					Type:    argov1alpha1.ApplicationSetConditionRolloutProgressing,
+					Status:  argov1alpha1.ApplicationSetConditionStatusFalse,
+					Message: fmt.Sprintf("ApplicationSet Rollout is halted, step %s failed: %s", progressingStep, stepStatus.Message),
+					Reason:  argov1alpha1.ApplicationSetReasonApplicationSetRolloutError,
+				}
+			}
+			if stepStatus.Phase != argov1alpha1.ApplicationSetRolloutStepSucceeded {
+				return &argov1alpha1.ApplicationSetCondition{
+					Type:    argov1alpha1.ApplicationSetConditionRolloutProgressing,
+					Status:  argov1alpha1.ApplicationSetConditionStatusTrue,
+					Message: fmt.Sprintf("ApplicationSet is performing rollout of step %s: %s", progressingStep, stepStatus.Message),
+					Reason:  argov1alpha1.ApplicationSetReasonApplicationSetModified,
+				}
+			}
+		}
 	}
 
 	if isProgressing {
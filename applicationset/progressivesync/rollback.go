@@ -0,0 +1,165 @@
+package progressivesync
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+)
+
+// failStepApplications returns the Application statuses of the ApplicationSet, updated for the newly failed steps.
+// By default, the Applications of a failed step are moved to the Failed status. With the Rollback failure policy, the
+// Applications of a failed step which were already updated, and optionally those of the preceding steps, are moved to
+// the RollingBack status instead, while the Applications which were not updated yet are moved to the Failed status.
+func failStepApplications(logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, applications []argov1alpha1.Application, failedSteps map[int]string, now metav1.Time) []argov1alpha1.ApplicationSetApplicationStatus {
+	rollback := IsRollbackOnFailure(applicationSet)
+	// rollbackUpToStep is the 1-based index of the last step whose preceding steps are rolled back as well
+	rollbackUpToStep := 0
+	if rollback && applicationSet.Spec.Strategy.RollingSync.RollbackPreviousSteps {
+		for stepIndex := range failedSteps {
+			rollbackUpToStep = max(rollbackUpToStep, stepIndex+1)
+		}
+	}
+
+	appMap := make(map[string]*argov1alpha1.Application, len(applications))
+	for i := range applications {
+		appMap[applications[i].Name] = &applications[i]
+	}
+
+	appStatuses := make([]argov1alpha1.ApplicationSetApplicationStatus, 0, len(applicationSet.Status.ApplicationStatus))
+	for _, appStatus := range applicationSet.Status.ApplicationStatus {
+		step, err := strconv.Atoi(appStatus.Step)
+		if err != nil || step < 1 {
+			appStatuses = append(appStatuses, appStatus)
+			continue
+		}
+
+		message, stepFailed := failedSteps[step-1]
+		rollbackPreviousStep := !stepFailed && step < rollbackUpToStep
+		if rollbackPreviousStep {
+			message = fmt.Sprintf("rollout step %d failed", rollbackUpToStep)
+		}
+		if (!stepFailed && !rollbackPreviousStep) || appStatus.Status == argov1alpha1.ProgressiveSyncRollingBack || appStatus.Status == argov1alpha1.ProgressiveSyncRolledBack {
+			appStatuses = append(appStatuses, appStatus)
+			continue
+		}
+
+		updated := appStatus.Status != argov1alpha1.ProgressiveSyncWaiting
+		switch {
+		case rollback && updated:
+			app, ok := appMap[appStatus.Application]
+			if !ok {
+				appStatus.Status = argov1alpha1.ProgressiveSyncFailed
+				appStatus.Message = fmt.Sprintf("Rollout step %s failed, halting the rollout: %s", appStatus.Step, message)
+				break
+			}
+			deployment := previousDeployment(app, appStatus.TargetRevisions)
+			if deployment == nil {
+				appStatus.Status = argov1alpha1.ProgressiveSyncFailed
+				appStatus.Message = fmt.Sprintf("Rollout step %s failed, but the Application has no previous deployment to roll back to: %s", appStatus.Step, message)
+				break
+			}
+			appStatus.Status = argov1alpha1.ProgressiveSyncRollingBack
+			appStatus.Message = fmt.Sprintf("Rollout step %s failed, rolling back the Application to deployment %d: %s", appStatus.Step, deployment.ID, message)
+		case rollback:
+			appStatus.Status = argov1alpha1.ProgressiveSyncFailed
+			appStatus.Message = fmt.Sprintf("Rollout step %s failed before the Application was updated: %s", appStatus.Step, message)
+		default:
+			appStatus.Status = argov1alpha1.ProgressiveSyncFailed
+			appStatus.Message = fmt.Sprintf("Rollout step %s failed, halting the rollout: %s", appStatus.Step, message)
+		}
+		appStatus.LastTransitionTime = &now
+
+		logCtx.WithFields(log.Fields{
+			"app.name":           appStatus.Application,
+			"new_status.status":  appStatus.Status,
+			"new_status.message": appStatus.Message,
+			"new_status.step":    appStatus.Step,
+		}).Info("Progressive sync application changed status")
+		appStatuses = append(appStatuses, appStatus)
+	}
+	return appStatuses
+}
+
+// previousDeployment returns the most recent deployment of the Application history which differs from the revisions
+// and sources targeted by the rollout, or nil if there is none
+func previousDeployment(app *argov1alpha1.Application, targetRevisions []string) *argov1alpha1.RevisionHistory {
+	for i := len(app.Status.History) - 1; i >= 0; i-- {
+		deployment := app.Status.History[i]
+		if deployment.Source.IsZero() && deployment.Sources.IsZero() {
+			// deployments without a source cannot be rolled back to
+			continue
+		}
+		revisions := deployment.Revisions
+		if len(revisions) == 0 && deployment.Revision != "" {
+			revisions = []string{deployment.Revision}
+		}
+		if !slices.Equal(revisions, targetRevisions) {
+			return &deployment
+		}
+		if app.Spec.HasMultipleSources() {
+			if !cmp.Equal(deployment.Sources, app.Spec.Sources, cmpopts.EquateEmpty()) {
+				return &deployment
+			}
+		} else if app.Spec.Source != nil && !cmp.Equal(deployment.Source, *app.Spec.Source, cmpopts.EquateEmpty()) {
+			return &deployment
+		}
+	}
+	return nil
+}
+
+// RollbackDesiredApplications sets a rollback operation on the desired Applications which are in the RollingBack status,
+// using the same sync operation as a manual rollback to the previous deployment of the Application.
+func (m *Manager) RollbackDesiredApplications(logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, currentApplications []argov1alpha1.Application, desiredApplications []argov1alpha1.Application) []argov1alpha1.Application {
+	currentAppsMap := make(map[string]*argov1alpha1.Application, len(currentApplications))
+	for i := range currentApplications {
+		currentAppsMap[currentApplications[i].Name] = &currentApplications[i]
+	}
+
+	for i := range desiredApplications {
+		idx := utils.FindApplicationStatusIndex(applicationSet.Status.ApplicationStatus, desiredApplications[i].Name)
+		if idx == -1 || applicationSet.Status.ApplicationStatus[idx].Status != argov1alpha1.ProgressiveSyncRollingBack {
+			continue
+		}
+		currentApp, ok := currentAppsMap[desiredApplications[i].Name]
+		if !ok {
+			continue
+		}
+		deployment := previousDeployment(currentApp, applicationSet.Status.ApplicationStatus[idx].TargetRevisions)
+		if deployment == nil {
+			logCtx.Warnf("application %v has no previous deployment to roll back to", desiredApplications[i].Name)
+			continue
+		}
+
+		prune := false
+		if desiredApplications[i].Spec.SyncPolicy != nil && desiredApplications[i].Spec.SyncPolicy.Automated != nil {
+			prune = desiredApplications[i].Spec.SyncPolicy.Automated.GetPrune()
+		}
+		logCtx.Infof("triggering rollback of application: %v to deployment %d, prune enabled: %v", desiredApplications[i].Name, deployment.ID, prune)
+		op := argo.NewRollbackOperation(currentApp, deployment, prune, false, argov1alpha1.OperationInitiator{
+			Username:  "applicationset-controller",
+			Automated: true,
+		})
+		op.Info = []*argov1alpha1.Info{
+			{
+				Name:  "Reason",
+				Value: fmt.Sprintf("ApplicationSet RollingSync step %s failed, rolling back this Application resource", applicationSet.Status.ApplicationStatus[idx].Step),
+			},
+		}
+		if desiredApplications[i].Spec.SyncPolicy != nil && desiredApplications[i].Spec.SyncPolicy.Retry != nil {
+			op.Retry = *desiredApplications[i].Spec.SyncPolicy.Retry
+		} else {
+			op.Retry = argov1alpha1.RetryStrategy{Limit: 5}
+		}
+		desiredApplications[i].Operation = op
+	}
+	return desiredApplications
+}
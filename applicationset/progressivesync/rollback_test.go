@@ -0,0 +1,260 @@
+package progressivesync
+
+import (
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func newRollbackApp(name string, revision string, history ...string) v1alpha1.Application {
+	app := v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSpec{
+			Source: &v1alpha1.ApplicationSource{RepoURL: "https://example.com/repo.git", Path: "app", TargetRevision: "main"},
+		},
+		Status: v1alpha1.ApplicationStatus{
+			Sync: v1alpha1.SyncStatus{Status: v1alpha1.SyncStatusCodeSynced, Revision: revision},
+		},
+	}
+	for i, historyRevision := range history {
+		app.Status.History = append(app.Status.History, v1alpha1.RevisionHistory{
+			ID:       int64(i),
+			Revision: historyRevision,
+			Source:   *app.Spec.Source,
+		})
+	}
+	return app
+}
+
+func newRollbackAppSet(rollbackPreviousSteps bool, appStatuses ...v1alpha1.ApplicationSetApplicationStatus) *v1alpha1.ApplicationSet {
+	return &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "name", Namespace: "argocd"},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Strategy: &v1alpha1.ApplicationSetStrategy{
+				Type: "RollingSync",
+				RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+					Steps:                 []v1alpha1.ApplicationSetRolloutStep{{}, {}, {}},
+					OnFailure:             "rollback",
+					RollbackPreviousSteps: rollbackPreviousSteps,
+				},
+			},
+		},
+		Status: v1alpha1.ApplicationSetStatus{ApplicationStatus: appStatuses},
+	}
+}
+
+func TestUpdateApplicationSetApplicationStatusDetectsFailures(t *testing.T) {
+	t.Parallel()
+	logCtx := log.NewEntry(log.StandardLogger())
+	transitionTime := metav1.NewTime(time.Now().Add(-time.Minute))
+
+	for _, cc := range []struct {
+		name            string
+		onFailure       string
+		appHealth       health.HealthStatusCode
+		operationState  *v1alpha1.OperationState
+		currentStatus   v1alpha1.ProgressiveSyncStatusCode
+		expectedStatus  v1alpha1.ProgressiveSyncStatusCode
+		expectedMessage string
+	}{
+		{
+			name:            "a degraded application fails with the rollback policy",
+			onFailure:       "Rollback",
+			appHealth:       health.HealthStatusDegraded,
+			currentStatus:   v1alpha1.ProgressiveSyncProgressing,
+			expectedStatus:  v1alpha1.ProgressiveSyncFailed,
+			expectedMessage: "Application resource became Degraded, updating status from Progressing to Failed",
+		},
+		{
+			name:      "a failed sync fails with the rollback policy",
+			onFailure: "Rollback",
+			appHealth: health.HealthStatusProgressing,
+			operationState: &v1alpha1.OperationState{
+				Phase:     synccommon.OperationFailed,
+				Message:   "one or more objects failed to apply",
+				StartedAt: metav1.Now(),
+			},
+			currentStatus:   v1alpha1.ProgressiveSyncProgressing,
+			expectedStatus:  v1alpha1.ProgressiveSyncFailed,
+			expectedMessage: "Application resource sync failed: one or more objects failed to apply, updating status from Progressing to Failed",
+		},
+		{
+			name:           "a degraded application keeps progressing without the rollback policy",
+			appHealth:      health.HealthStatusDegraded,
+			currentStatus:  v1alpha1.ProgressiveSyncProgressing,
+			expectedStatus: v1alpha1.ProgressiveSyncProgressing,
+		},
+		{
+			name:      "a rolling back application is rolled back once the rollback started",
+			onFailure: "Rollback",
+			appHealth: health.HealthStatusProgressing,
+			operationState: &v1alpha1.OperationState{
+				Phase:     synccommon.OperationRunning,
+				StartedAt: metav1.Now(),
+			},
+			currentStatus:   v1alpha1.ProgressiveSyncRollingBack,
+			expectedStatus:  v1alpha1.ProgressiveSyncRolledBack,
+			expectedMessage: "Application resource started its rollback, updating status from RollingBack to RolledBack",
+		},
+		{
+			name:           "a rolling back application waits for the rollback to start",
+			onFailure:      "Rollback",
+			appHealth:      health.HealthStatusDegraded,
+			currentStatus:  v1alpha1.ProgressiveSyncRollingBack,
+			expectedStatus: v1alpha1.ProgressiveSyncRollingBack,
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			t.Parallel()
+			app := newRollbackApp("app1", "new")
+			app.Status.Health.Status = cc.appHealth
+			app.Status.OperationState = cc.operationState
+
+			appSet := newRollbackAppSet(false, v1alpha1.ApplicationSetApplicationStatus{
+				Application:        "app1",
+				Status:             cc.currentStatus,
+				Step:               "1",
+				TargetRevisions:    []string{"new"},
+				LastTransitionTime: &transitionTime,
+			})
+			appSet.Spec.Strategy.RollingSync.OnFailure = cc.onFailure
+
+			m := NewManager(nil, fakeDependencies{})
+			appStatuses, err := m.UpdateApplicationSetApplicationStatus(t.Context(), logCtx, appSet, []v1alpha1.Application{app}, nil, map[string]int{"app1": 0})
+			require.NoError(t, err)
+			require.Len(t, appStatuses, 1)
+			assert.Equal(t, cc.expectedStatus, appStatuses[0].Status)
+			if cc.expectedMessage != "" {
+				assert.Equal(t, cc.expectedMessage, appStatuses[0].Message)
+			}
+		})
+	}
+}
+
+func TestUpdateApplicationSetRolloutStepStatusRollback(t *testing.T) {
+	t.Parallel()
+	logCtx := log.NewEntry(log.StandardLogger())
+	applications := []v1alpha1.Application{
+		newRollbackApp("app1", "new", "old", "new"),
+		newRollbackApp("app2", "new", "old"),
+		newRollbackApp("app3", "new"),
+		newRollbackApp("app4", "old", "old"),
+		newRollbackApp("app5", "old", "old"),
+	}
+	appDependencyList := [][]string{{"app1"}, {"app2", "app3", "app4"}, {"app5"}}
+
+	for _, cc := range []struct {
+		name                  string
+		rollbackPreviousSteps bool
+		expectedStatuses      map[string]v1alpha1.ProgressiveSyncStatusCode
+		expectedAppsToSync    map[string]bool
+	}{
+		{
+			name: "rolls back the updated applications of the failed step",
+			expectedStatuses: map[string]v1alpha1.ProgressiveSyncStatusCode{
+				"app1": v1alpha1.ProgressiveSyncHealthy,
+				"app2": v1alpha1.ProgressiveSyncRollingBack,
+				"app3": v1alpha1.ProgressiveSyncFailed,
+				"app4": v1alpha1.ProgressiveSyncFailed,
+				"app5": v1alpha1.ProgressiveSyncWaiting,
+			},
+			expectedAppsToSync: map[string]bool{"app1": true, "app2": true, "app3": true, "app4": true},
+		},
+		{
+			name:                  "rolls back the applications of the previous steps",
+			rollbackPreviousSteps: true,
+			expectedStatuses: map[string]v1alpha1.ProgressiveSyncStatusCode{
+				"app1": v1alpha1.ProgressiveSyncRollingBack,
+				"app2": v1alpha1.ProgressiveSyncRollingBack,
+				"app3": v1alpha1.ProgressiveSyncFailed,
+				"app4": v1alpha1.ProgressiveSyncFailed,
+				"app5": v1alpha1.ProgressiveSyncWaiting,
+			},
+			expectedAppsToSync: map[string]bool{"app1": true},
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			t.Parallel()
+			appSet := newRollbackAppSet(cc.rollbackPreviousSteps,
+				v1alpha1.ApplicationSetApplicationStatus{Application: "app1", Status: v1alpha1.ProgressiveSyncHealthy, Step: "1", TargetRevisions: []string{"new"}},
+				// app2 failed, app3 has no previous deployment and app4 was not updated yet
+				v1alpha1.ApplicationSetApplicationStatus{Application: "app2", Status: v1alpha1.ProgressiveSyncFailed, Step: "2", TargetRevisions: []string{"new"}, Message: "Application resource became Degraded"},
+				v1alpha1.ApplicationSetApplicationStatus{Application: "app3", Status: v1alpha1.ProgressiveSyncHealthy, Step: "2", TargetRevisions: []string{"new"}},
+				v1alpha1.ApplicationSetApplicationStatus{Application: "app4", Status: v1alpha1.ProgressiveSyncWaiting, Step: "2", TargetRevisions: []string{"old"}},
+				v1alpha1.ApplicationSetApplicationStatus{Application: "app5", Status: v1alpha1.ProgressiveSyncWaiting, Step: "3", TargetRevisions: []string{"old"}},
+			)
+
+			m := NewManager(nil, fakeDependencies{})
+			_, err := m.UpdateApplicationSetRolloutStepStatus(t.Context(), logCtx, appSet, applications, appDependencyList)
+			require.NoError(t, err)
+
+			statuses := map[string]v1alpha1.ProgressiveSyncStatusCode{}
+			for _, appStatus := range appSet.Status.ApplicationStatus {
+				statuses[appStatus.Application] = appStatus.Status
+			}
+			assert.Equal(t, cc.expectedStatuses, statuses)
+
+			stepStatus := findRolloutStepStatus(appSet.Status.RolloutSteps, "2")
+			require.NotNil(t, stepStatus)
+			assert.Equal(t, v1alpha1.ApplicationSetRolloutStepFailed, stepStatus.Phase)
+			assert.Equal(t, "Application app2 is Failed: Application resource became Degraded", stepStatus.Message)
+
+			assert.Equal(t, cc.expectedAppsToSync, getAppsToSync(*appSet, appDependencyList, applications))
+		})
+	}
+}
+
+func TestPreviousDeployment(t *testing.T) {
+	t.Parallel()
+
+	app := newRollbackApp("app", "new", "older", "old", "new")
+	deployment := previousDeployment(&app, []string{"new"})
+	require.NotNil(t, deployment)
+	assert.Equal(t, int64(1), deployment.ID)
+
+	// a deployment of the same revision with a different source can be rolled back to
+	app = newRollbackApp("app", "new", "new", "new")
+	app.Status.History[0].Source.Path = "previous-path"
+	deployment = previousDeployment(&app, []string{"new"})
+	require.NotNil(t, deployment)
+	assert.Equal(t, int64(0), deployment.ID)
+
+	app = newRollbackApp("app", "new", "new")
+	assert.Nil(t, previousDeployment(&app, []string{"new"}))
+}
+
+func TestRollbackDesiredApplications(t *testing.T) {
+	t.Parallel()
+	appSet := newRollbackAppSet(false,
+		v1alpha1.ApplicationSetApplicationStatus{Application: "app1", Status: v1alpha1.ProgressiveSyncRollingBack, Step: "1", TargetRevisions: []string{"new"}},
+		v1alpha1.ApplicationSetApplicationStatus{Application: "app2", Status: v1alpha1.ProgressiveSyncFailed, Step: "1", TargetRevisions: []string{"new"}},
+	)
+	currentApps := []v1alpha1.Application{
+		newRollbackApp("app1", "new", "old", "new"),
+		newRollbackApp("app2", "new", "old", "new"),
+	}
+	desiredApps := []v1alpha1.Application{
+		{ObjectMeta: metav1.ObjectMeta{Name: "app1"}, Spec: currentApps[0].Spec},
+		{ObjectMeta: metav1.ObjectMeta{Name: "app2"}, Spec: currentApps[1].Spec},
+	}
+
+	m := NewManager(nil, fakeDependencies{})
+	rolloutApps := m.RollbackDesiredApplications(log.NewEntry(log.StandardLogger()), appSet, currentApps, desiredApps)
+	require.Len(t, rolloutApps, 2)
+
+	require.NotNil(t, rolloutApps[0].Operation)
+	require.NotNil(t, rolloutApps[0].Operation.Sync)
+	assert.Equal(t, "old", rolloutApps[0].Operation.Sync.Revision)
+	assert.Equal(t, "app", rolloutApps[0].Operation.Sync.Source.Path)
+	assert.Equal(t, "applicationset-controller", rolloutApps[0].Operation.InitiatedBy.Username)
+	assert.True(t, rolloutApps[0].Operation.InitiatedBy.Automated)
+	assert.Nil(t, rolloutApps[1].Operation)
+}
@@ -0,0 +1,259 @@
+package progressivesync
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/utils"
+	"github.com/argoproj/argo-cd/v3/common"
+	argov1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const defaultAnalysisInterval = 30 * time.Second
+
+// stepHasGates returns true if the step must pass a pause, analysis or approval gate before the rollout moves on
+func stepHasGates(step argov1alpha1.ApplicationSetRolloutStep) bool {
+	return step.Pause != nil || step.Analysis != nil || step.RequireApproval
+}
+
+func findRolloutStepStatus(stepStatuses []argov1alpha1.ApplicationSetRolloutStepStatus, step string) *argov1alpha1.ApplicationSetRolloutStepStatus {
+	for i := range stepStatuses {
+		if stepStatuses[i].Step == step {
+			return &stepStatuses[i]
+		}
+	}
+	return nil
+}
+
+// rolloutStepGatesPassed returns true if the step has no gate, or if all of its gates passed
+func rolloutStepGatesPassed(applicationSet argov1alpha1.ApplicationSet, stepIndex int) bool {
+	if !RollingSyncStrategyEnabled(&applicationSet) || stepIndex >= len(applicationSet.Spec.Strategy.RollingSync.Steps) {
+		return true
+	}
+	if !stepHasGates(applicationSet.Spec.Strategy.RollingSync.Steps[stepIndex]) {
+		return true
+	}
+	stepStatus := findRolloutStepStatus(applicationSet.Status.RolloutSteps, strconv.Itoa(stepIndex+1))
+	return stepStatus != nil && stepStatus.Phase == argov1alpha1.ApplicationSetRolloutStepSucceeded
+}
+
+// isFailedProgressiveSyncStatus returns true for the statuses of the Applications of a failed step
+func isFailedProgressiveSyncStatus(status argov1alpha1.ProgressiveSyncStatusCode) bool {
+	return status == argov1alpha1.ProgressiveSyncFailed ||
+		status == argov1alpha1.ProgressiveSyncRollingBack ||
+		status == argov1alpha1.ProgressiveSyncRolledBack
+}
+
+// stepApplicationsState returns whether every Application of the step is Healthy and, if any of them failed, the
+// message describing the first failure
+func stepApplicationsState(applicationSet *argov1alpha1.ApplicationSet, appNames []string) (allHealthy bool, failureMessage string, anyFailed bool) {
+	allHealthy = true
+	for _, appName := range appNames {
+		idx := utils.FindApplicationStatusIndex(applicationSet.Status.ApplicationStatus, appName)
+		if idx == -1 {
+			allHealthy = false
+			continue
+		}
+		appStatus := applicationSet.Status.ApplicationStatus[idx]
+		switch {
+		case appStatus.Status == argov1alpha1.ProgressiveSyncHealthy:
+		case isFailedProgressiveSyncStatus(appStatus.Status):
+			allHealthy = false
+			if !anyFailed {
+				anyFailed = true
+				failureMessage = fmt.Sprintf("Application %s is %s: %s", appName, appStatus.Status, appStatus.Message)
+			}
+		default:
+			allHealthy = false
+		}
+	}
+	return allHealthy, failureMessage, anyFailed
+}
+
+// UpdateApplicationSetRolloutStepStatus evaluates the pause, analysis and approval gates of the steps whose Applications
+// are all Healthy, and fails the steps whose gate failed or was rejected, or which have a failed Application. The Applications of a
+// failed step are moved to the Failed status, or rolled back with the Rollback failure policy, which halts the rollout
+// until their revision or spec changes. It returns the delay after which the gates must be evaluated again, if any.
+func (m *Manager) UpdateApplicationSetRolloutStepStatus(ctx context.Context, logCtx *log.Entry, applicationSet *argov1alpha1.ApplicationSet, applications []argov1alpha1.Application, appDependencyList [][]string) (time.Duration, error) {
+	now := metav1.Now()
+	stepStatuses := []argov1alpha1.ApplicationSetRolloutStepStatus{}
+	failedSteps := map[int]string{}
+	var requeueAfter time.Duration
+
+	if RollingSyncStrategyEnabled(applicationSet) {
+		approvedStep := applicationSet.Annotations[common.AnnotationApplicationSetRolloutApproveStep]
+		rejectedStep := applicationSet.Annotations[common.AnnotationApplicationSetRolloutRejectStep]
+
+		for stepIndex, step := range applicationSet.Spec.Strategy.RollingSync.Steps {
+			if stepIndex >= len(appDependencyList) {
+				continue
+			}
+			stepName := strconv.Itoa(stepIndex + 1)
+			stepLogCtx := logCtx.WithField("step", stepName)
+			currentStatus := findRolloutStepStatus(applicationSet.Status.RolloutSteps, stepName)
+
+			allHealthy, failureMessage, anyFailed := stepApplicationsState(applicationSet, appDependencyList[stepIndex])
+			if anyFailed {
+				if currentStatus != nil && currentStatus.Phase == argov1alpha1.ApplicationSetRolloutStepFailed {
+					// the step stays failed until its Applications are updated again
+					stepStatuses = append(stepStatuses, *currentStatus)
+					continue
+				}
+				stepLogCtx.WithField("message", failureMessage).Info("Progressive sync step failed")
+				stepStatuses = append(stepStatuses, argov1alpha1.ApplicationSetRolloutStepStatus{
+					Step:               stepName,
+					Phase:              argov1alpha1.ApplicationSetRolloutStepFailed,
+					Message:            failureMessage,
+					StartedAt:          &now,
+					LastTransitionTime: &now,
+				})
+				failedSteps[stepIndex] = failureMessage
+				continue
+			}
+			if !stepHasGates(step) || !allHealthy {
+				// the gates are evaluated again from the start once every Application of the step is Healthy
+				continue
+			}
+
+			var newStatus *argov1alpha1.ApplicationSetRolloutStepStatus
+			if currentStatus == nil || currentStatus.Phase == argov1alpha1.ApplicationSetRolloutStepFailed {
+				newStatus = &argov1alpha1.ApplicationSetRolloutStepStatus{
+					Step:               stepName,
+					StartedAt:          &now,
+					LastTransitionTime: &now,
+				}
+			} else {
+				newStatus = currentStatus.DeepCopy()
+			}
+
+			if approvedStep == stepName && !newStatus.Approved {
+				stepLogCtx.Info("Progressive sync step approved")
+				newStatus.Approved = true
+			}
+
+			var stepRequeueAfter time.Duration
+			if rejectedStep == stepName && newStatus.Phase != argov1alpha1.ApplicationSetRolloutStepSucceeded {
+				setRolloutStepPhase(newStatus, argov1alpha1.ApplicationSetRolloutStepFailed, "Step was rejected", now)
+			} else {
+				stepRequeueAfter = m.evaluateRolloutStepGates(ctx, step, newStatus, now)
+			}
+			if newStatus.Phase != "" && (currentStatus == nil || currentStatus.Phase != newStatus.Phase) {
+				stepLogCtx.WithFields(log.Fields{
+					"phase":   newStatus.Phase,
+					"message": newStatus.Message,
+				}).Info("Progressive sync step changed phase")
+			}
+			if newStatus.Phase == argov1alpha1.ApplicationSetRolloutStepFailed {
+				failedSteps[stepIndex] = newStatus.Message
+			}
+			if stepRequeueAfter > 0 && (requeueAfter == 0 || stepRequeueAfter < requeueAfter) {
+				requeueAfter = stepRequeueAfter
+			}
+			stepStatuses = append(stepStatuses, *newStatus)
+		}
+	}
+
+	if err := m.dependencies.SetAppSetRolloutStepStatus(ctx, logCtx, applicationSet, stepStatuses); err != nil {
+		return 0, fmt.Errorf("failed to set AppSet rollout step status: %w", err)
+	}
+
+	if len(failedSteps) == 0 {
+		return requeueAfter, nil
+	}
+
+	appStatuses := failStepApplications(logCtx, applicationSet, applications, failedSteps, now)
+	if err := m.dependencies.SetAppSetApplicationStatus(ctx, logCtx, applicationSet, appStatuses); err != nil {
+		return 0, fmt.Errorf("failed to set AppSet application status: %w", err)
+	}
+
+	return requeueAfter, nil
+}
+
+// evaluateRolloutStepGates moves the step status through the Paused, Analyzing and AwaitingApproval phases until it
+// reaches Succeeded or Failed. It returns the delay after which the gates must be evaluated again, if any.
+func (m *Manager) evaluateRolloutStepGates(ctx context.Context, step argov1alpha1.ApplicationSetRolloutStep, stepStatus *argov1alpha1.ApplicationSetRolloutStepStatus, now metav1.Time) time.Duration {
+	for {
+		switch stepStatus.Phase {
+		case "", argov1alpha1.ApplicationSetRolloutStepPaused:
+			if step.Pause != nil {
+				resumeAt := stepStatus.StartedAt.Add(step.Pause.Duration)
+				if remaining := resumeAt.Sub(now.Time); remaining > 0 {
+					setRolloutStepPhase(stepStatus, argov1alpha1.ApplicationSetRolloutStepPaused, "Step is paused until "+resumeAt.UTC().Format(time.RFC3339), now)
+					return remaining
+				}
+			}
+			setRolloutStepPhase(stepStatus, argov1alpha1.ApplicationSetRolloutStepAnalyzing, "Step analysis started", now)
+			stepStatus.AnalysisStartedAt = &now
+
+		case argov1alpha1.ApplicationSetRolloutStepAnalyzing:
+			if step.Analysis == nil || step.Analysis.Prometheus == nil {
+				setRolloutStepPhase(stepStatus, argov1alpha1.ApplicationSetRolloutStepAwaitingApproval, "Step has no analysis", now)
+				continue
+			}
+
+			interval := defaultAnalysisInterval
+			if step.Analysis.Interval != nil && step.Analysis.Interval.Duration > 0 {
+				interval = step.Analysis.Interval.Duration
+			}
+			if stepStatus.LastAnalysisTime != nil {
+				if wait := stepStatus.LastAnalysisTime.Add(interval).Sub(now.Time); wait > 0 {
+					return wait
+				}
+			}
+
+			var duration time.Duration
+			if step.Analysis.Duration != nil {
+				duration = step.Analysis.Duration.Duration
+			}
+			analysisStartedAt := now.Time
+			if stepStatus.AnalysisStartedAt != nil {
+				analysisStartedAt = stepStatus.AnalysisStartedAt.Time
+			}
+			elapsed := now.Sub(analysisStartedAt)
+
+			result, message := evaluatePrometheusAnalysis(ctx, m.Prometheus, step.Analysis.Prometheus.Query)
+			stepStatus.LastAnalysisTime = &now
+			switch {
+			case result == AnalysisFailed:
+				setRolloutStepPhase(stepStatus, argov1alpha1.ApplicationSetRolloutStepFailed, "Step analysis failed: "+message, now)
+				return 0
+			case result == AnalysisInconclusive && elapsed >= duration:
+				setRolloutStepPhase(stepStatus, argov1alpha1.ApplicationSetRolloutStepFailed, "Step analysis was inconclusive at the end of its duration: "+message, now)
+				return 0
+			case result == AnalysisInconclusive:
+				stepStatus.Message = "Step analysis was inconclusive, retrying: " + message
+				return interval
+			case elapsed >= duration:
+				setRolloutStepPhase(stepStatus, argov1alpha1.ApplicationSetRolloutStepAwaitingApproval, "Step analysis succeeded: "+message, now)
+			default:
+				stepStatus.Message = "Step analysis is running: " + message
+				return interval
+			}
+
+		case argov1alpha1.ApplicationSetRolloutStepAwaitingApproval:
+			if step.RequireApproval && !stepStatus.Approved {
+				setRolloutStepPhase(stepStatus, argov1alpha1.ApplicationSetRolloutStepAwaitingApproval, "Step is waiting for approval", now)
+				return 0
+			}
+			setRolloutStepPhase(stepStatus, argov1alpha1.ApplicationSetRolloutStepSucceeded, "All gates of the step passed", now)
+			return 0
+
+		default:
+			// Succeeded and Failed are final
+			return 0
+		}
+	}
+}
+
+func setRolloutStepPhase(stepStatus *argov1alpha1.ApplicationSetRolloutStepStatus, phase argov1alpha1.ApplicationSetRolloutStepPhase, message string, now metav1.Time) {
+	if stepStatus.Phase != phase {
+		stepStatus.LastTransitionTime = &now
+	}
+	stepStatus.Phase = phase
+	stepStatus.Message = message
+}
@@ -0,0 +1,269 @@
+package progressivesync
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// fakeDependencies stores the statuses in the ApplicationSet in memory
+type fakeDependencies struct{}
+
+func (fakeDependencies) SetAppSetApplicationStatus(_ context.Context, _ *log.Entry, applicationSet *v1alpha1.ApplicationSet, applicationStatuses []v1alpha1.ApplicationSetApplicationStatus) error {
+	applicationSet.Status.ApplicationStatus = applicationStatuses
+	return nil
+}
+
+func (fakeDependencies) SetApplicationSetStatusCondition(_ context.Context, applicationSet *v1alpha1.ApplicationSet, conditions []v1alpha1.ApplicationSetCondition, _ bool) error {
+	applicationSet.Status.Conditions = conditions
+	return nil
+}
+
+func (fakeDependencies) SetAppSetRolloutStepStatus(_ context.Context, _ *log.Entry, applicationSet *v1alpha1.ApplicationSet, stepStatuses []v1alpha1.ApplicationSetRolloutStepStatus) error {
+	applicationSet.Status.RolloutSteps = stepStatuses
+	return nil
+}
+
+type fakePrometheusClient struct {
+	values  []float64
+	err     error
+	queries int
+}
+
+func (c *fakePrometheusClient) Query(_ context.Context, _ string) ([]float64, error) {
+	c.queries++
+	return c.values, c.err
+}
+
+func newGatedAppSet(step v1alpha1.ApplicationSetRolloutStep, appStatus v1alpha1.ProgressiveSyncStatusCode, stepStatuses ...v1alpha1.ApplicationSetRolloutStepStatus) *v1alpha1.ApplicationSet {
+	return &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "name",
+			Namespace: "argocd",
+		},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Strategy: &v1alpha1.ApplicationSetStrategy{
+				Type: "RollingSync",
+				RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+					Steps: []v1alpha1.ApplicationSetRolloutStep{step, {}},
+				},
+			},
+		},
+		Status: v1alpha1.ApplicationSetStatus{
+			ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app1", Status: appStatus, Step: "1"},
+				{Application: "app2", Status: v1alpha1.ProgressiveSyncWaiting, Step: "2"},
+			},
+			RolloutSteps: stepStatuses,
+		},
+	}
+}
+
+func TestUpdateApplicationSetRolloutStepStatus(t *testing.T) {
+	t.Parallel()
+	appDependencyList := [][]string{{"app1"}, {"app2"}}
+	longAgo := metav1.NewTime(time.Now().Add(-time.Hour))
+	prometheusStep := v1alpha1.ApplicationSetRolloutStep{
+		Analysis: &v1alpha1.ApplicationSetRolloutStepAnalysis{
+			Prometheus: &v1alpha1.ApplicationSetRolloutPrometheusAnalysis{Query: "up"},
+		},
+	}
+
+	for _, cc := range []struct {
+		name               string
+		appSet             *v1alpha1.ApplicationSet
+		prometheus         *fakePrometheusClient
+		expectedPhase      v1alpha1.ApplicationSetRolloutStepPhase
+		expectedAppStatus  v1alpha1.ProgressiveSyncStatusCode
+		expectedRequeue    bool
+		expectedAppsToSync map[string]bool
+	}{
+		{
+			name:               "starts the pause once the step is healthy",
+			appSet:             newGatedAppSet(v1alpha1.ApplicationSetRolloutStep{Pause: &metav1.Duration{Duration: time.Hour}}, v1alpha1.ProgressiveSyncHealthy),
+			expectedPhase:      v1alpha1.ApplicationSetRolloutStepPaused,
+			expectedAppStatus:  v1alpha1.ProgressiveSyncHealthy,
+			expectedRequeue:    true,
+			expectedAppsToSync: map[string]bool{"app1": true},
+		},
+		{
+			name: "moves on once the pause elapsed",
+			appSet: newGatedAppSet(v1alpha1.ApplicationSetRolloutStep{Pause: &metav1.Duration{Duration: time.Minute}}, v1alpha1.ProgressiveSyncHealthy,
+				v1alpha1.ApplicationSetRolloutStepStatus{Step: "1", Phase: v1alpha1.ApplicationSetRolloutStepPaused, StartedAt: &longAgo}),
+			expectedPhase:      v1alpha1.ApplicationSetRolloutStepSucceeded,
+			expectedAppStatus:  v1alpha1.ProgressiveSyncHealthy,
+			expectedAppsToSync: map[string]bool{"app1": true, "app2": true},
+		},
+		{
+			name:               "does not start the gates before the step is healthy",
+			appSet:             newGatedAppSet(v1alpha1.ApplicationSetRolloutStep{Pause: &metav1.Duration{Duration: time.Hour}}, v1alpha1.ProgressiveSyncProgressing),
+			expectedAppStatus:  v1alpha1.ProgressiveSyncProgressing,
+			expectedAppsToSync: map[string]bool{"app1": true},
+		},
+		{
+			name:               "succeeds when the analysis succeeds",
+			appSet:             newGatedAppSet(prometheusStep, v1alpha1.ProgressiveSyncHealthy),
+			prometheus:         &fakePrometheusClient{values: []float64{1, 0.5}},
+			expectedPhase:      v1alpha1.ApplicationSetRolloutStepSucceeded,
+			expectedAppStatus:  v1alpha1.ProgressiveSyncHealthy,
+			expectedAppsToSync: map[string]bool{"app1": true, "app2": true},
+		},
+		{
+			name:               "fails the step and its applications when the analysis fails",
+			appSet:             newGatedAppSet(prometheusStep, v1alpha1.ProgressiveSyncHealthy),
+			prometheus:         &fakePrometheusClient{values: []float64{1, 0}},
+			expectedPhase:      v1alpha1.ApplicationSetRolloutStepFailed,
+			expectedAppStatus:  v1alpha1.ProgressiveSyncFailed,
+			expectedAppsToSync: map[string]bool{"app1": true},
+		},
+		{
+			name:               "fails the step when the analysis is inconclusive at the end of its duration",
+			appSet:             newGatedAppSet(prometheusStep, v1alpha1.ProgressiveSyncHealthy),
+			prometheus:         &fakePrometheusClient{err: errors.New("connection refused")},
+			expectedPhase:      v1alpha1.ApplicationSetRolloutStepFailed,
+			expectedAppStatus:  v1alpha1.ProgressiveSyncFailed,
+			expectedAppsToSync: map[string]bool{"app1": true},
+		},
+		{
+			name: "retries an inconclusive analysis within its duration",
+			appSet: newGatedAppSet(v1alpha1.ApplicationSetRolloutStep{
+				Analysis: &v1alpha1.ApplicationSetRolloutStepAnalysis{
+					Prometheus: &v1alpha1.ApplicationSetRolloutPrometheusAnalysis{Query: "up"},
+					Duration:   &metav1.Duration{Duration: time.Hour},
+				},
+			}, v1alpha1.ProgressiveSyncHealthy),
+			prometheus:         &fakePrometheusClient{},
+			expectedPhase:      v1alpha1.ApplicationSetRolloutStepAnalyzing,
+			expectedAppStatus:  v1alpha1.ProgressiveSyncHealthy,
+			expectedRequeue:    true,
+			expectedAppsToSync: map[string]bool{"app1": true},
+		},
+		{
+			name:               "fails the analysis when no Prometheus server is configured",
+			appSet:             newGatedAppSet(prometheusStep, v1alpha1.ProgressiveSyncHealthy),
+			expectedPhase:      v1alpha1.ApplicationSetRolloutStepFailed,
+			expectedAppStatus:  v1alpha1.ProgressiveSyncFailed,
+			expectedAppsToSync: map[string]bool{"app1": true},
+		},
+		{
+			name: "keeps a failed step failed",
+			appSet: newGatedAppSet(prometheusStep, v1alpha1.ProgressiveSyncFailed,
+				v1alpha1.ApplicationSetRolloutStepStatus{Step: "1", Phase: v1alpha1.ApplicationSetRolloutStepFailed}),
+			prometheus:         &fakePrometheusClient{values: []float64{1}},
+			expectedPhase:      v1alpha1.ApplicationSetRolloutStepFailed,
+			expectedAppStatus:  v1alpha1.ProgressiveSyncFailed,
+			expectedAppsToSync: map[string]bool{"app1": true},
+		},
+		{
+			name:               "waits for approval",
+			appSet:             newGatedAppSet(v1alpha1.ApplicationSetRolloutStep{RequireApproval: true}, v1alpha1.ProgressiveSyncHealthy),
+			expectedPhase:      v1alpha1.ApplicationSetRolloutStepAwaitingApproval,
+			expectedAppStatus:  v1alpha1.ProgressiveSyncHealthy,
+			expectedAppsToSync: map[string]bool{"app1": true},
+		},
+		{
+			name: "succeeds once approved",
+			appSet: func() *v1alpha1.ApplicationSet {
+				appSet := newGatedAppSet(v1alpha1.ApplicationSetRolloutStep{RequireApproval: true}, v1alpha1.ProgressiveSyncHealthy,
+					v1alpha1.ApplicationSetRolloutStepStatus{Step: "1", Phase: v1alpha1.ApplicationSetRolloutStepAwaitingApproval, StartedAt: &longAgo})
+				appSet.Annotations = map[string]string{common.AnnotationApplicationSetRolloutApproveStep: "1"}
+				return appSet
+			}(),
+			expectedPhase:      v1alpha1.ApplicationSetRolloutStepSucceeded,
+			expectedAppStatus:  v1alpha1.ProgressiveSyncHealthy,
+			expectedAppsToSync: map[string]bool{"app1": true, "app2": true},
+		},
+		{
+			name: "fails the step and its applications once rejected",
+			appSet: func() *v1alpha1.ApplicationSet {
+				appSet := newGatedAppSet(v1alpha1.ApplicationSetRolloutStep{RequireApproval: true}, v1alpha1.ProgressiveSyncHealthy,
+					v1alpha1.ApplicationSetRolloutStepStatus{Step: "1", Phase: v1alpha1.ApplicationSetRolloutStepAwaitingApproval, StartedAt: &longAgo})
+				appSet.Annotations = map[string]string{common.AnnotationApplicationSetRolloutRejectStep: "1"}
+				return appSet
+			}(),
+			expectedPhase:      v1alpha1.ApplicationSetRolloutStepFailed,
+			expectedAppStatus:  v1alpha1.ProgressiveSyncFailed,
+			expectedAppsToSync: map[string]bool{"app1": true},
+		},
+	} {
+		t.Run(cc.name, func(t *testing.T) {
+			t.Parallel()
+			m := NewManager(nil, fakeDependencies{})
+			if cc.prometheus != nil {
+				m.Prometheus = cc.prometheus
+			}
+
+			requeueAfter, err := m.UpdateApplicationSetRolloutStepStatus(t.Context(), log.NewEntry(log.StandardLogger()), cc.appSet, nil, appDependencyList)
+			require.NoError(t, err)
+
+			stepStatus := findRolloutStepStatus(cc.appSet.Status.RolloutSteps, "1")
+			if cc.expectedPhase == "" {
+				assert.Nil(t, stepStatus)
+			} else {
+				require.NotNil(t, stepStatus)
+				assert.Equal(t, cc.expectedPhase, stepStatus.Phase, stepStatus.Message)
+			}
+			assert.Equal(t, cc.expectedAppStatus, cc.appSet.Status.ApplicationStatus[0].Status)
+			assert.Equal(t, v1alpha1.ProgressiveSyncWaiting, cc.appSet.Status.ApplicationStatus[1].Status)
+			assert.Equal(t, cc.expectedRequeue, requeueAfter > 0)
+
+			apps := []v1alpha1.Application{{ObjectMeta: metav1.ObjectMeta{Name: "app1"}}, {ObjectMeta: metav1.ObjectMeta{Name: "app2"}}}
+			assert.Equal(t, cc.expectedAppsToSync, getAppsToSync(*cc.appSet, appDependencyList, apps))
+		})
+	}
+}
+
+func TestUpdateApplicationSetRolloutStepStatusAnalysisInterval(t *testing.T) {
+	t.Parallel()
+	appSet := newGatedAppSet(v1alpha1.ApplicationSetRolloutStep{
+		Analysis: &v1alpha1.ApplicationSetRolloutStepAnalysis{
+			Prometheus: &v1alpha1.ApplicationSetRolloutPrometheusAnalysis{Query: "up"},
+			Duration:   &metav1.Duration{Duration: time.Hour},
+			Interval:   &metav1.Duration{Duration: time.Minute},
+		},
+	}, v1alpha1.ProgressiveSyncHealthy)
+	prometheus := &fakePrometheusClient{values: []float64{1}}
+	m := NewManager(nil, fakeDependencies{})
+	m.Prometheus = prometheus
+	logCtx := log.NewEntry(log.StandardLogger())
+
+	requeueAfter, err := m.UpdateApplicationSetRolloutStepStatus(t.Context(), logCtx, appSet, nil, [][]string{{"app1"}, {"app2"}})
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, requeueAfter)
+	assert.Equal(t, 1, prometheus.queries)
+
+	// the analysis is not evaluated again before the interval elapsed
+	requeueAfter, err = m.UpdateApplicationSetRolloutStepStatus(t.Context(), logCtx, appSet, nil, [][]string{{"app1"}, {"app2"}})
+	require.NoError(t, err)
+	assert.Positive(t, requeueAfter)
+	assert.LessOrEqual(t, requeueAfter, time.Minute)
+	assert.Equal(t, 1, prometheus.queries)
+	assert.Equal(t, v1alpha1.ApplicationSetRolloutStepAnalyzing, appSet.Status.RolloutSteps[0].Phase)
+}
+
+func TestGetProgressingConditionRolloutStepGates(t *testing.T) {
+	t.Parallel()
+	m := NewManager(nil, fakeDependencies{})
+
+	appSet := newGatedAppSet(v1alpha1.ApplicationSetRolloutStep{RequireApproval: true}, v1alpha1.ProgressiveSyncHealthy,
+		v1alpha1.ApplicationSetRolloutStepStatus{Step: "1", Phase: v1alpha1.ApplicationSetRolloutStepAwaitingApproval, Message: "Step is waiting for approval"})
+	condition := m.getProgressingCondition(appSet)
+	assert.Equal(t, v1alpha1.ApplicationSetConditionStatusTrue, condition.Status)
+	assert.Equal(t, "ApplicationSet is performing rollout of step 1: Step is waiting for approval", condition.Message)
+
+	appSet = newGatedAppSet(v1alpha1.ApplicationSetRolloutStep{RequireApproval: true}, v1alpha1.ProgressiveSyncFailed,
+		v1alpha1.ApplicationSetRolloutStepStatus{Step: "1", Phase: v1alpha1.ApplicationSetRolloutStepFailed, Message: "Step analysis failed"})
+	condition = m.getProgressingCondition(appSet)
+	assert.Equal(t, v1alpha1.ApplicationSetConditionStatusFalse, condition.Status)
+	assert.Equal(t, v1alpha1.ApplicationSetReasonApplicationSetRolloutError, condition.Reason)
+	assert.Equal(t, "ApplicationSet Rollout is halted, step 1 failed: Step analysis failed", condition.Message)
+}
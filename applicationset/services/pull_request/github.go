@@ -18,7 +18,10 @@ type GithubService struct {
 	labels []string
 }
 
-var _ PullRequestService = (*GithubService)(nil)
+var (
+	_ PullRequestService = (*GithubService)(nil)
+	_ PullRequestCreator = (*GithubService)(nil)
+)
 
 func NewGithubService(token, url, owner, repo string, labels []string, optionalHTTPClient ...*http.Client) (PullRequestService, error) {
 	// Undocumented environment variable to set a default token, to be used in testing to dodge anonymous rate limits.
@@ -93,6 +96,49 @@ func (g *GithubService) List(ctx context.Context) ([]*PullRequest, error) {
 	return pullRequests, nil
 }
 
+func (g *GithubService) GetOpen(ctx context.Context, branch, targetBranch string) (*PullRequest, error) {
+	opts := &github.PullRequestListOptions{
+		State: "open",
+		Head:  g.owner + ":" + branch,
+		Base:  targetBranch,
+	}
+	pulls, _, err := g.client.PullRequests.List(ctx, g.owner, g.repo, opts)
+	if err != nil {
+		return nil, fmt.Errorf("error listing pull requests for %s/%s: %w", g.owner, g.repo, err)
+	}
+	if len(pulls) == 0 {
+		return nil, nil
+	}
+	return toGithubPullRequest(pulls[0]), nil
+}
+
+func (g *GithubService) Create(ctx context.Context, title, body, branch, targetBranch string) (*PullRequest, error) {
+	pull, _, err := g.client.PullRequests.Create(ctx, g.owner, g.repo, &github.NewPullRequest{
+		Title: &title,
+		Body:  &body,
+		Head:  &branch,
+		Base:  &targetBranch,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error creating pull request for %s/%s: %w", g.owner, g.repo, err)
+	}
+	return toGithubPullRequest(pull), nil
+}
+
+// Converts a GitHub pull request returned by the open and create calls, which carry the web URL.
+func toGithubPullRequest(pull *github.PullRequest) *PullRequest {
+	return &PullRequest{
+		Number:       int64(pull.GetNumber()),
+		Title:        pull.GetTitle(),
+		Branch:       pull.GetHead().GetRef(),
+		TargetBranch: pull.GetBase().GetRef(),
+		HeadSHA:      pull.GetHead().GetSHA(),
+		Labels:       getGithubPRLabelNames(pull.Labels),
+		Author:       pull.GetUser().GetLogin(),
+		URL:          pull.GetHTMLURL(),
+	}
+}
+
 // containLabels returns true if gotLabels contains expectedLabels
 func containLabels(expectedLabels []string, gotLabels []*github.Label) bool {
 	for _, expected := range expectedLabels {
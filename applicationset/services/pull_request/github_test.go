@@ -1,6 +1,7 @@
 package pull_request
 
 import (
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -116,3 +117,89 @@ func TestGitHubListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestGitHubCreate(t *testing.T) {
+	t.Parallel()
+	const path = "/api/v3/repos/argoproj/gitops/pulls"
+	const pull = `{"number": 7, "title": "Promote to env/prod", "html_url": "https://github.example.com/argoproj/gitops/pull/7",
+		"head": {"ref": "env/prod-next", "sha": "abc123"}, "base": {"ref": "env/prod"}, "user": {"login": "argocd"}}`
+
+	t.Run("opens a pull request", func(t *testing.T) {
+		t.Parallel()
+		mux := http.NewServeMux()
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"title": "Promote to env/prod", "body": "Promotes abc123", "head": "env/prod-next", "base": "env/prod"}`, string(body))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(pull))
+		})
+
+		svc, err := NewGithubService("", server.URL, "argoproj", "gitops", nil, nil)
+		require.NoError(t, err)
+
+		pr, err := svc.(PullRequestCreator).Create(t.Context(), "Promote to env/prod", "Promotes abc123", "env/prod-next", "env/prod")
+		require.NoError(t, err)
+		assert.Equal(t, &PullRequest{
+			Number:       7,
+			Title:        "Promote to env/prod",
+			Branch:       "env/prod-next",
+			TargetBranch: "env/prod",
+			HeadSHA:      "abc123",
+			Author:       "argocd",
+			URL:          "https://github.example.com/argoproj/gitops/pull/7",
+		}, pr)
+	})
+
+}
+
+func TestGitHubGetOpen(t *testing.T) {
+	t.Parallel()
+	const path = "/api/v3/repos/argoproj/gitops/pulls"
+
+	t.Run("returns the open pull request", func(t *testing.T) {
+		t.Parallel()
+		mux := http.NewServeMux()
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "argoproj:env/prod-next", r.URL.Query().Get("head"))
+			assert.Equal(t, "env/prod", r.URL.Query().Get("base"))
+			assert.Equal(t, "open", r.URL.Query().Get("state"))
+			_, _ = w.Write([]byte(`[{"number": 7, "html_url": "https://github.example.com/argoproj/gitops/pull/7"}]`))
+		})
+
+		svc, err := NewGithubService("", server.URL, "argoproj", "gitops", nil, nil)
+		require.NoError(t, err)
+
+		pr, err := svc.(PullRequestCreator).GetOpen(t.Context(), "env/prod-next", "env/prod")
+		require.NoError(t, err)
+		require.NotNil(t, pr)
+		assert.Equal(t, int64(7), pr.Number)
+		assert.Equal(t, "https://github.example.com/argoproj/gitops/pull/7", pr.URL)
+	})
+
+	t.Run("returns nil without an open pull request", func(t *testing.T) {
+		t.Parallel()
+		mux := http.NewServeMux()
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		mux.HandleFunc(path, func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`[]`))
+		})
+
+		svc, err := NewGithubService("", server.URL, "argoproj", "gitops", nil, nil)
+		require.NoError(t, err)
+
+		pr, err := svc.(PullRequestCreator).GetOpen(t.Context(), "env/prod-next", "env/prod")
+		require.NoError(t, err)
+		assert.Nil(t, pr)
+	})
+}
@@ -20,7 +20,10 @@ type GitLabService struct {
 	pullRequestState string
 }
 
-var _ PullRequestService = (*GitLabService)(nil)
+var (
+	_ PullRequestService = (*GitLabService)(nil)
+	_ PullRequestCreator = (*GitLabService)(nil)
+)
 
 func NewGitLabService(token, url, project string, labels []string, pullRequestState string, scmRootCAPath string, insecure bool, caCerts []byte, proxyURL, noProxy string) (PullRequestService, error) {
 	var clientOptionFns []gitlab.ClientOptionFunc
@@ -107,3 +110,49 @@ func (g *GitLabService) List(ctx context.Context) ([]*PullRequest, error) {
 	}
 	return pullRequests, nil
 }
+
+func (g *GitLabService) GetOpen(ctx context.Context, branch, targetBranch string) (*PullRequest, error) {
+	state := "opened"
+	mrs, _, err := g.client.MergeRequests.ListProjectMergeRequests(g.project, &gitlab.ListProjectMergeRequestsOptions{
+		State:        &state,
+		SourceBranch: &branch,
+		TargetBranch: &targetBranch,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error listing merge requests for project '%s': %w", g.project, err)
+	}
+	if len(mrs) == 0 {
+		return nil, nil
+	}
+	return toGitLabPullRequest(mrs[0]), nil
+}
+
+func (g *GitLabService) Create(ctx context.Context, title, body, branch, targetBranch string) (*PullRequest, error) {
+	created, _, err := g.client.MergeRequests.CreateMergeRequest(g.project, &gitlab.CreateMergeRequestOptions{
+		Title:        &title,
+		Description:  &body,
+		SourceBranch: &branch,
+		TargetBranch: &targetBranch,
+	}, gitlab.WithContext(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error creating merge request for project '%s': %w", g.project, err)
+	}
+	return toGitLabPullRequest(&created.BasicMergeRequest), nil
+}
+
+// Converts a GitLab merge request returned by the open and create calls, which carry the web URL.
+func toGitLabPullRequest(mr *gitlab.BasicMergeRequest) *PullRequest {
+	pullRequest := &PullRequest{
+		Number:       mr.IID,
+		Title:        mr.Title,
+		Branch:       mr.SourceBranch,
+		TargetBranch: mr.TargetBranch,
+		HeadSHA:      mr.SHA,
+		Labels:       mr.Labels,
+		URL:          mr.WebURL,
+	}
+	if mr.Author != nil {
+		pullRequest.Author = mr.Author.Username
+	}
+	return pullRequest
+}
@@ -224,3 +224,89 @@ func TestGitLabListReturnsRepositoryNotFoundError(t *testing.T) {
 	require.Error(t, err)
 	assert.True(t, IsRepositoryNotFoundError(err), "Expected RepositoryNotFoundError but got: %v", err)
 }
+
+func TestGitLabCreate(t *testing.T) {
+	t.Parallel()
+	const path = "/api/v4/projects/278964/merge_requests"
+	const mr = `{"iid": 12, "title": "Promote to env/prod", "source_branch": "env/prod-next", "target_branch": "env/prod",
+		"sha": "abc123", "web_url": "https://gitlab.example.com/argoproj/gitops/-/merge_requests/12", "author": {"username": "argocd"}}`
+
+	t.Run("opens a merge request", func(t *testing.T) {
+		t.Parallel()
+		mux := http.NewServeMux()
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			body, err := io.ReadAll(r.Body)
+			assert.NoError(t, err)
+			assert.JSONEq(t, `{"title": "Promote to env/prod", "description": "Promotes abc123", "source_branch": "env/prod-next", "target_branch": "env/prod"}`, string(body))
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(mr))
+		})
+
+		svc, err := NewGitLabService("", server.URL, "278964", nil, "", "", false, nil, "", "")
+		require.NoError(t, err)
+
+		pr, err := svc.(PullRequestCreator).Create(t.Context(), "Promote to env/prod", "Promotes abc123", "env/prod-next", "env/prod")
+		require.NoError(t, err)
+		assert.Equal(t, &PullRequest{
+			Number:       12,
+			Title:        "Promote to env/prod",
+			Branch:       "env/prod-next",
+			TargetBranch: "env/prod",
+			HeadSHA:      "abc123",
+			Author:       "argocd",
+			URL:          "https://gitlab.example.com/argoproj/gitops/-/merge_requests/12",
+		}, pr)
+	})
+
+}
+
+func TestGitLabGetOpen(t *testing.T) {
+	t.Parallel()
+	const path = "/api/v4/projects/278964/merge_requests"
+
+	t.Run("returns the open merge request", func(t *testing.T) {
+		t.Parallel()
+		mux := http.NewServeMux()
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodGet, r.Method)
+			assert.Equal(t, "env/prod-next", r.URL.Query().Get("source_branch"))
+			assert.Equal(t, "env/prod", r.URL.Query().Get("target_branch"))
+			assert.Equal(t, "opened", r.URL.Query().Get("state"))
+			_, _ = w.Write([]byte(`[{"iid": 12, "web_url": "https://gitlab.example.com/argoproj/gitops/-/merge_requests/12"}]`))
+		})
+
+		svc, err := NewGitLabService("", server.URL, "278964", nil, "", "", false, nil, "", "")
+		require.NoError(t, err)
+
+		pr, err := svc.(PullRequestCreator).GetOpen(t.Context(), "env/prod-next", "env/prod")
+		require.NoError(t, err)
+		require.NotNil(t, pr)
+		assert.Equal(t, int64(12), pr.Number)
+		assert.Equal(t, "https://gitlab.example.com/argoproj/gitops/-/merge_requests/12", pr.URL)
+	})
+
+	t.Run("returns nil without an open merge request", func(t *testing.T) {
+		t.Parallel()
+		mux := http.NewServeMux()
+		server := httptest.NewServer(mux)
+		defer server.Close()
+
+		mux.HandleFunc(path, func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`[]`))
+		})
+
+		svc, err := NewGitLabService("", server.URL, "278964", nil, "", "", false, nil, "", "")
+		require.NoError(t, err)
+
+		pr, err := svc.(PullRequestCreator).GetOpen(t.Context(), "env/prod-next", "env/prod")
+		require.NoError(t, err)
+		assert.Nil(t, pr)
+	})
+}
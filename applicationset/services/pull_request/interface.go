@@ -21,6 +21,8 @@ type PullRequest struct {
 	Labels []string
 	// Author is the author of the pull request.
 	Author string
+	// URL is the web URL of the pull request. Only set for pull requests returned by PullRequestCreator.
+	URL string
 }
 
 type PullRequestService interface {
@@ -28,6 +30,14 @@ type PullRequestService interface {
 	List(ctx context.Context) ([]*PullRequest, error)
 }
 
+// PullRequestCreator is implemented by the services which can open pull requests.
+type PullRequestCreator interface {
+	// GetOpen returns the open pull request from the branch to the target branch, or nil if there is none.
+	GetOpen(ctx context.Context, branch, targetBranch string) (*PullRequest, error)
+	// Create opens a pull request from the branch to the target branch.
+	Create(ctx context.Context, title, body, branch, targetBranch string) (*PullRequest, error)
+}
+
 type Filter struct {
 	BranchMatch       *regexp.Regexp
 	TargetBranchMatch *regexp.Regexp
@@ -56,6 +56,9 @@ func NewAppCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 # Compare results of two reconciliations and print diff
 argocd admin app diff-reconcile-results APPNAME [flags]
 
+# Compare the manifests of a local directory with the live state without an Argo CD server
+argocd admin app diff-offline APPLICATION_MANIFEST_PATH --local PATH
+
 # Generate declarative config for an application
 argocd admin app generate-spec APPNAME
 
@@ -70,6 +73,7 @@ argocd admin app get-reconcile-results APPNAME
 	command.AddCommand(NewGenAppSpecCommand())
 	command.AddCommand(NewReconcileCommand(clientOpts))
 	command.AddCommand(NewDiffReconcileResults())
+	command.AddCommand(NewDiffOfflineCommand())
 	return command
 }
 
@@ -480,5 +484,5 @@ func reconcileApplications(
 }
 
 func newLiveStateCache(argoDB db.ArgoDB, appInformer kubecache.SharedIndexInformer, settingsMgr *settings.SettingsManager, server *metrics.MetricsServer) cache.LiveStateCache {
-	return cache.NewLiveStateCache(argoDB, appInformer, settingsMgr, server, func(_ map[string]bool, _ corev1.ObjectReference) {}, &sharding.ClusterSharding{}, argo.NewResourceTracking())
+	return cache.NewLiveStateCache(argoDB, appInformer, settingsMgr, server, func(_ map[string]bool, _ corev1.ObjectReference) {}, &sharding.ClusterSharding{}, argo.NewResourceTracking(), nil)
 }
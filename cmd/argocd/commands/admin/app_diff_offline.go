@@ -0,0 +1,431 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	clustercache "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/cache"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/diff"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/hook"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/ignore"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8smanagedfields "k8s.io/apimachinery/pkg/util/managedfields"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/controller"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	repoapiclient "github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/reposerver/repository"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	argodiff "github.com/argoproj/argo-cd/v3/util/argo/diff"
+	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
+	"github.com/argoproj/argo-cd/v3/util/cli"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	logutils "github.com/argoproj/argo-cd/v3/util/log"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+// offlineDiffResult is the difference of a resource between its target and live state
+type offlineDiffResult struct {
+	key    kube.ResourceKey
+	live   *unstructured.Unstructured
+	target *unstructured.Unstructured
+}
+
+// NewDiffOfflineCommand returns a new instance of an `argocd admin app diff-offline` command
+func NewDiffOfflineCommand() *cobra.Command {
+	var (
+		opts                 settingsOpts
+		local                string
+		localRepoRoot        string
+		revision             string
+		repoURL              string
+		argocdNamespace      string
+		exitCode             bool
+		diffExitCode         int
+		ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts
+	)
+	command := &cobra.Command{
+		Use:   "diff-offline APPLICATION_MANIFEST_PATH",
+		Short: "Perform a diff of a local directory or Git revision against the live state without an Argo CD server",
+		Long: `Perform a diff of a local directory or Git revision against the live state without an Argo CD server.
+The manifests of the application are generated locally and the live state is loaded from the cluster of the current kubeconfig context,
+which must be the destination cluster of the application. The differences are computed the same way as the application controller does,
+applying the ignoreDifferences of the application and the resource overrides of the argocd-cm ConfigMap.
+Returns the following exit codes: 2 on general errors, 1 when a diff is found, and 0 when no diff is found.`,
+		Example: `
+# Compare the manifests of a local directory with the live state, using the settings of a local argocd-cm.yaml file
+argocd admin app diff-offline ./apps/guestbook.yaml --local ./guestbook --argocd-cm-path ./argocd-cm.yaml
+
+# Compare the manifests of a Git branch with the live state, using the settings of the cluster
+argocd admin app diff-offline ./apps/guestbook.yaml --revision my-feature-branch --load-cluster-settings
+`,
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(2)
+			}
+			if local != "" && revision != "" {
+				errors.Fatal(errors.ErrorGeneric, "Only one of --local and --revision can be specified.")
+			}
+
+			app, err := loadApplication(args[0])
+			errors.CheckError(err)
+			if app.Spec.HasMultipleSources() {
+				errors.Fatal(errors.ErrorGeneric, "Applications with multiple sources are not supported.")
+			}
+			if argocdNamespace == "" {
+				argocdNamespace = app.Namespace
+			}
+
+			settingsMgr, err := opts.createOfflineSettingsManager(ctx)
+			errors.CheckError(err)
+			restConfig, err := opts.clientConfig.ClientConfig()
+			errors.CheckError(err)
+			clusterCache, err := newOfflineClusterCache(app, argocdNamespace, settingsMgr, restConfig)
+			errors.CheckError(err)
+
+			source := app.Spec.GetSource()
+			appPath, repoRoot := local, localRepoRoot
+			if local == "" {
+				if revision == "" {
+					revision = source.TargetRevision
+				}
+				if repoURL == "" {
+					repoURL = source.RepoURL
+				}
+				root, err := os.MkdirTemp("", "argocd-diff-offline")
+				errors.CheckError(err)
+				err = checkoutRevision(ctx, repoURL, root, revision)
+				if err != nil {
+					_ = os.RemoveAll(root)
+					errors.CheckError(err)
+				}
+				appPath, repoRoot = filepath.Join(root, source.Path), root
+			}
+
+			targetObjs, err := generateOfflineManifests(ctx, app, argocdNamespace, appPath, repoRoot, revision, settingsMgr,
+				clusterCache, clusterCache.GetServerVersion(), argo.APIResourcesToStrings(clusterCache.GetAPIResources(), true))
+			if local == "" {
+				_ = os.RemoveAll(repoRoot)
+			}
+			errors.CheckError(err)
+
+			instanceName := app.InstanceName(argocdNamespace)
+			liveObjByKey, err := clusterCache.GetManagedLiveObjs(targetObjs, func(r *clustercache.Resource) bool {
+				appName, _ := r.Info.(string)
+				return appName == instanceName
+			})
+			errors.CheckError(err)
+
+			results, err := diffOffline(ctx, app, targetObjs, liveObjByKey, settingsMgr, clusterCache.GetGVKParser(), ignoreNormalizerOpts)
+			errors.CheckError(err)
+			for _, result := range results {
+				fmt.Printf("\n===== %s/%s %s/%s ======\n", result.key.Group, result.key.Kind, result.key.Namespace, result.key.Name)
+				_ = cli.PrintDiff(result.key.Name, result.live, result.target)
+			}
+			if len(results) > 0 && exitCode {
+				os.Exit(diffExitCode)
+			}
+		},
+	}
+	opts.clientConfig = cli.AddKubectlFlagsToCmd(command)
+	command.Flags().StringVar(&opts.argocdCMPath, "argocd-cm-path", "", "Path to local argocd-cm.yaml file")
+	command.Flags().StringVar(&opts.argocdSecretPath, "argocd-secret-path", "", "Path to local argocd-secret.yaml file")
+	command.Flags().BoolVar(&opts.loadClusterSettings, "load-cluster-settings", false,
+		"Indicates that config map and secret should be loaded from cluster unless local file path is provided")
+	command.Flags().StringVar(&local, "local", "", "Compare live app to the manifests of a local directory")
+	command.Flags().StringVar(&localRepoRoot, "local-repo-root", "/", "Path to the repository root. Used together with --local allows setting the repository root")
+	command.Flags().StringVar(&revision, "revision", "", "Compare live app to a particular revision of the repository. Defaults to the target revision of the application")
+	command.Flags().StringVar(&repoURL, "repo", "", "Repository to check out the revision from, e.g. the path of a local clone. Defaults to the repository of the application")
+	command.Flags().StringVar(&argocdNamespace, "argocd-namespace", "", "Namespace of the Argo CD control plane. Defaults to the namespace of the application")
+	command.Flags().BoolVar(&exitCode, "exit-code", true, "Return non-zero exit code when there is a diff")
+	command.Flags().IntVar(&diffExitCode, "diff-exit-code", 1, "Return specified exit code when there is a diff")
+	command.Flags().DurationVar(&ignoreNormalizerOpts.JQExecutionTimeout, "ignore-normalizer-jq-execution-timeout", normalizers.DefaultJQExecutionTimeout, "Set ignore normalizer JQ execution timeout")
+	return command
+}
+
+// createOfflineSettingsManager creates a settings manager from the local files or the cluster, or with the default
+// settings if neither is provided
+func (opts *settingsOpts) createOfflineSettingsManager(ctx context.Context) (*settings.SettingsManager, error) {
+	if opts.argocdCMPath != "" || opts.loadClusterSettings {
+		return opts.createSettingsManager(ctx)
+	}
+	argocdCM := &corev1.ConfigMap{}
+	argocdCM.SetName(common.ArgoCDConfigMapName)
+	setSettingsMeta(argocdCM)
+	manager := settings.NewSettingsManager(ctx, fake.NewClientset(argocdCM), "default")
+	if err := manager.ResyncInformers(); err != nil {
+		return nil, fmt.Errorf("error loading default settings: %w", err)
+	}
+	return manager, nil
+}
+
+func loadApplication(path string) (*v1alpha1.Application, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading application manifest: %w", err)
+	}
+	var app v1alpha1.Application
+	if err := yaml.Unmarshal(data, &app); err != nil {
+		return nil, fmt.Errorf("error unmarshaling application manifest: %w", err)
+	}
+	if app.Kind != application.ApplicationKind {
+		return nil, fmt.Errorf("%s is not an Application manifest", path)
+	}
+	return &app, nil
+}
+
+// newOfflineClusterCache creates a synced cache of the cluster of the given config, which tracks the resources
+// managed by the given application
+func newOfflineClusterCache(app *v1alpha1.Application, argocdNamespace string, settingsMgr *settings.SettingsManager, restConfig *rest.Config) (clustercache.ClusterCache, error) {
+	resourcesFilter, err := settingsMgr.GetResourcesFilter()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resources filter: %w", err)
+	}
+	appLabelKey, err := settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return nil, fmt.Errorf("error getting app instance label key: %w", err)
+	}
+	trackingMethod, err := settingsMgr.GetTrackingMethod()
+	if err != nil {
+		return nil, fmt.Errorf("error getting tracking method: %w", err)
+	}
+	installationID, err := settingsMgr.GetInstallationID()
+	if err != nil {
+		return nil, fmt.Errorf("error getting installation ID: %w", err)
+	}
+
+	resourceTracking := argo.NewResourceTracking()
+	clusterCache := clustercache.NewClusterCache(restConfig,
+		clustercache.SetSettings(clustercache.Settings{ResourcesFilter: resourcesFilter}),
+		clustercache.SetPopulateResourceInfoHandler(func(un *unstructured.Unstructured, isRoot bool) (any, bool) {
+			if !isRoot {
+				return "", false
+			}
+			return resourceTracking.GetAppName(un, appLabelKey, v1alpha1.TrackingMethod(trackingMethod), installationID), false
+		}),
+	)
+	log.Infof("Loading the live state of the application %s", app.InstanceName(argocdNamespace))
+	if err := clusterCache.EnsureSynced(); err != nil {
+		return nil, fmt.Errorf("error synchronizing cluster cache: %w", err)
+	}
+	return clusterCache, nil
+}
+
+// checkoutRevision checks out the given revision of the repository to the given directory
+func checkoutRevision(ctx context.Context, repoURL, root, revision string) error {
+	gitClient, err := git.NewClientExt(repoURL, root, git.NopCreds{}, false, false, "", "")
+	if err != nil {
+		return fmt.Errorf("error creating git client: %w", err)
+	}
+	if err := gitClient.Init(); err != nil {
+		return fmt.Errorf("error initializing git repository: %w", err)
+	}
+	if err := gitClient.Fetch(ctx, "", 0); err != nil {
+		return fmt.Errorf("error fetching %s: %w", git.SanitizeRepoURL(repoURL), err)
+	}
+	if _, err := gitClient.Checkout(ctx, revision, false, true); err != nil {
+		// Only the branches are fetched by default, so try fetching the revision explicitly
+		if err := gitClient.Fetch(ctx, revision, 0); err != nil {
+			return fmt.Errorf("error fetching revision %s: %w", revision, err)
+		}
+		if _, err := gitClient.Checkout(ctx, "FETCH_HEAD", false, true); err != nil {
+			return fmt.Errorf("error checking out revision %s: %w", revision, err)
+		}
+	}
+	return nil
+}
+
+// generateOfflineManifests generates the manifests of the application from the given directory, and normalizes them
+// the same way as the application controller
+func generateOfflineManifests(
+	ctx context.Context,
+	app *v1alpha1.Application,
+	argocdNamespace string,
+	appPath string,
+	repoRoot string,
+	revision string,
+	settingsMgr *settings.SettingsManager,
+	infoProvider kube.ResourceInfoProvider,
+	kubeVersion string,
+	apiVersions []string,
+) ([]*unstructured.Unstructured, error) {
+	appLabelKey, err := settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return nil, fmt.Errorf("error getting app instance label key: %w", err)
+	}
+	trackingMethod, err := settingsMgr.GetTrackingMethod()
+	if err != nil {
+		return nil, fmt.Errorf("error getting tracking method: %w", err)
+	}
+	installationID, err := settingsMgr.GetInstallationID()
+	if err != nil {
+		return nil, fmt.Errorf("error getting installation ID: %w", err)
+	}
+	kustomizeSettings, err := settingsMgr.GetKustomizeSettings()
+	if err != nil {
+		return nil, fmt.Errorf("error getting Kustomize settings: %w", err)
+	}
+	helmOptions, err := settingsMgr.GetHelmSettings()
+	if err != nil {
+		return nil, fmt.Errorf("error getting Helm settings: %w", err)
+	}
+	enabledSourceTypes, err := settingsMgr.GetEnabledSourceTypes()
+	if err != nil {
+		return nil, fmt.Errorf("error getting enabled source types: %w", err)
+	}
+
+	source := app.Spec.GetSource()
+	res, err := repository.GenerateManifests(ctx, appPath, repoRoot, revision, &repoapiclient.ManifestRequest{
+		Repo:                            &v1alpha1.Repository{Repo: source.RepoURL},
+		Revision:                        revision,
+		AppLabelKey:                     appLabelKey,
+		AppName:                         app.InstanceName(argocdNamespace),
+		Namespace:                       app.Spec.Destination.Namespace,
+		ApplicationSource:               &source,
+		KustomizeOptions:                kustomizeSettings,
+		KubeVersion:                     kubeVersion,
+		ApiVersions:                     apiVersions,
+		TrackingMethod:                  trackingMethod,
+		EnabledSourceTypes:              enabledSourceTypes,
+		HelmOptions:                     helmOptions,
+		ProjectName:                     app.Spec.Project,
+		AnnotationManifestGeneratePaths: app.GetAnnotation(v1alpha1.AnnotationKeyManifestGeneratePaths),
+		InstallationID:                  installationID,
+	}, true, &git.NoopCredsStore{}, resource.MustParse("0"), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error generating manifests: %w", err)
+	}
+
+	var targetObjs []*unstructured.Unstructured
+	for _, manifest := range res.Manifests {
+		obj, err := v1alpha1.UnmarshalToUnstructured(manifest)
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling manifest: %w", err)
+		}
+		if obj == nil || hook.IsHook(obj) || ignore.Ignore(obj) {
+			continue
+		}
+		targetObjs = append(targetObjs, obj)
+	}
+
+	resourceTracking := argo.NewResourceTracking()
+	targetObjs, conditions, err := controller.NormalizeTargetObjects(app.Spec.Destination.Namespace, targetObjs, infoProvider, func(u *unstructured.Unstructured) error {
+		return resourceTracking.SetAppInstance(u, appLabelKey, app.InstanceName(argocdNamespace), app.Spec.Destination.Namespace, v1alpha1.TrackingMethod(trackingMethod), installationID)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error normalizing target objects: %w", err)
+	}
+	for _, condition := range conditions {
+		log.Warnf("%s: %s", condition.Type, condition.Message)
+	}
+	return targetObjs, nil
+}
+
+// diffOffline compares the target objects with the live objects of the application, applying its ignored differences
+// and the resource overrides the same way as the application controller, and returns the modified, added and removed
+// resources sorted by their keys. The data of the secrets is hidden.
+func diffOffline(
+	ctx context.Context,
+	app *v1alpha1.Application,
+	targetObjs []*unstructured.Unstructured,
+	liveObjByKey map[kube.ResourceKey]*unstructured.Unstructured,
+	settingsMgr *settings.SettingsManager,
+	gvkParser *k8smanagedfields.GvkParser,
+	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts,
+) ([]offlineDiffResult, error) {
+	resourceOverrides, err := settingsMgr.GetResourceOverrides()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource overrides: %w", err)
+	}
+	compareOptions, err := settingsMgr.GetResourceCompareOptions()
+	if err != nil {
+		return nil, fmt.Errorf("error getting resource compare options: %w", err)
+	}
+	appLabelKey, err := settingsMgr.GetAppInstanceLabelKey()
+	if err != nil {
+		return nil, fmt.Errorf("error getting app instance label key: %w", err)
+	}
+	trackingMethod, err := settingsMgr.GetTrackingMethod()
+	if err != nil {
+		return nil, fmt.Errorf("error getting tracking method: %w", err)
+	}
+	diffConfig, err := argodiff.NewDiffConfigBuilder().
+		WithDiffSettings(app.Spec.IgnoreDifferences, resourceOverrides, compareOptions.IgnoreAggregatedRoles, ignoreNormalizerOpts).
+		WithTracking(appLabelKey, trackingMethod).
+		WithNoCache().
+		WithLogger(logutils.NewLogrusLogger(logutils.NewWithCurrentConfig())).
+		WithGVKParser(gvkParser).
+		WithManager(common.ArgoCDSSAManager).
+		Build()
+	if err != nil {
+		return nil, fmt.Errorf("error building diff config: %w", err)
+	}
+
+	targetByKey := make(map[kube.ResourceKey]*unstructured.Unstructured)
+	for _, obj := range targetObjs {
+		targetByKey[kube.GetResourceKey(obj)] = obj
+	}
+	keys := make([]kube.ResourceKey, 0, len(targetByKey)+len(liveObjByKey))
+	for key := range targetByKey {
+		keys = append(keys, key)
+	}
+	for key := range liveObjByKey {
+		if _, ok := targetByKey[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].String() < keys[j].String()
+	})
+
+	lives := make([]*unstructured.Unstructured, len(keys))
+	targets := make([]*unstructured.Unstructured, len(keys))
+	for i, key := range keys {
+		lives[i], targets[i] = liveObjByKey[key], targetByKey[key]
+	}
+	diffResults, err := argodiff.StateDiffs(ctx, lives, targets, diffConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error computing diff: %w", err)
+	}
+
+	var results []offlineDiffResult
+	for i, diffRes := range diffResults.Diffs {
+		// The resources which are only live or only targeted are never reported as modified
+		if !diffRes.Modified && lives[i] != nil && targets[i] != nil {
+			continue
+		}
+		live, err := v1alpha1.UnmarshalToUnstructured(string(diffRes.NormalizedLive))
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling normalized live state: %w", err)
+		}
+		target, err := v1alpha1.UnmarshalToUnstructured(string(diffRes.PredictedLive))
+		if err != nil {
+			return nil, fmt.Errorf("error unmarshaling predicted live state: %w", err)
+		}
+		if keys[i].Kind == kube.SecretKind && keys[i].Group == "" {
+			target, live, err = diff.HideSecretData(target, live, settingsMgr.GetSensitiveAnnotations())
+			if err != nil {
+				return nil, fmt.Errorf("error hiding secret data: %w", err)
+			}
+		}
+		results = append(results, offlineDiffResult{key: keys[i], live: live, target: target})
+	}
+	return results, nil
+}
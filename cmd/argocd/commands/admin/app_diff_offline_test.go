@@ -0,0 +1,145 @@
+package admin
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
+)
+
+type namespacedResourceInfoProvider struct{}
+
+func (p *namespacedResourceInfoProvider) IsNamespaced(_ schema.GroupKind) (bool, error) {
+	return true, nil
+}
+
+func TestLoadApplication(t *testing.T) {
+	app, err := loadApplication("./testdata/diff-offline/application.yaml")
+	require.NoError(t, err)
+	assert.Equal(t, "guestbook", app.Name)
+	assert.Equal(t, "guestbook", app.Spec.GetSource().Path)
+
+	_, err = loadApplication("./testdata/diff-offline/guestbook/guestbook.yaml")
+	require.ErrorContains(t, err, "is not an Application manifest")
+}
+
+func TestDiffOffline(t *testing.T) {
+	ctx := t.Context()
+	app, err := loadApplication("./testdata/diff-offline/application.yaml")
+	require.NoError(t, err)
+	opts := settingsOpts{}
+	settingsMgr, err := opts.createOfflineSettingsManager(ctx)
+	require.NoError(t, err)
+
+	repoRoot, err := filepath.Abs("./testdata/diff-offline")
+	require.NoError(t, err)
+	targetObjs, err := generateOfflineManifests(ctx, app, app.Namespace, filepath.Join(repoRoot, "guestbook"), repoRoot, "HEAD", settingsMgr, &namespacedResourceInfoProvider{}, "1.30", nil)
+	require.NoError(t, err)
+
+	t.Run("manifests are generated and normalized like the controller does", func(t *testing.T) {
+		require.Len(t, targetObjs, 2, "hooks are excluded")
+		for _, obj := range targetObjs {
+			assert.Equal(t, "default", obj.GetNamespace())
+			assert.Equal(t, "guestbook:"+obj.GroupVersionKind().Group+"/"+obj.GetKind()+":default/"+obj.GetName(), obj.GetAnnotations()["argocd.argoproj.io/tracking-id"])
+		}
+	})
+
+	t.Run("differences are computed with the ignored differences of the application", func(t *testing.T) {
+		liveObjByKey := map[kube.ResourceKey]*unstructured.Unstructured{}
+		for _, obj := range targetObjs {
+			live := obj.DeepCopy()
+			if live.GetKind() == kube.DeploymentKind {
+				require.NoError(t, unstructured.SetNestedField(live.Object, int64(3), "spec", "replicas"))
+				containers, _, _ := unstructured.NestedSlice(live.Object, "spec", "template", "spec", "containers")
+				containers[0].(map[string]any)["image"] = "guestbook:v1"
+				require.NoError(t, unstructured.SetNestedSlice(live.Object, containers, "spec", "template", "spec", "containers"))
+			}
+			liveObjByKey[kube.GetResourceKey(live)] = live
+		}
+		secret := &unstructured.Unstructured{Object: map[string]any{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata":   map[string]any{"name": "old", "namespace": "default"},
+			"data":       map[string]any{"password": "c2VjcmV0"},
+		}}
+		liveObjByKey[kube.GetResourceKey(secret)] = secret
+
+		results, err := diffOffline(ctx, app, targetObjs, liveObjByKey, settingsMgr, nil, normalizers.IgnoreNormalizerOpts{})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+
+		assert.Equal(t, "Secret", results[0].key.Kind)
+		assert.Nil(t, results[0].target)
+		password, _, _ := unstructured.NestedString(results[0].live.Object, "data", "password")
+		assert.NotEqual(t, "c2VjcmV0", password, "secret data is hidden")
+
+		assert.Equal(t, kube.DeploymentKind, results[1].key.Kind)
+		replicas, _, _ := unstructured.NestedInt64(results[1].live.Object, "spec", "replicas")
+		targetReplicas, _, _ := unstructured.NestedInt64(results[1].target.Object, "spec", "replicas")
+		assert.Equal(t, replicas, targetReplicas, "ignored differences are normalized")
+	})
+}
+
+func TestCreateOfflineSettingsManager(t *testing.T) {
+	cmPath := filepath.Join(t.TempDir(), "argocd-cm.yaml")
+	require.NoError(t, os.WriteFile(cmPath, []byte(`apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: argocd-cm
+data:
+  application.instanceLabelKey: my-label
+`), 0o644))
+
+	t.Run("default settings", func(t *testing.T) {
+		opts := settingsOpts{}
+		settingsMgr, err := opts.createOfflineSettingsManager(t.Context())
+		require.NoError(t, err)
+		labelKey, err := settingsMgr.GetAppInstanceLabelKey()
+		require.NoError(t, err)
+		assert.Equal(t, "app.kubernetes.io/instance", labelKey)
+	})
+
+	t.Run("local settings", func(t *testing.T) {
+		opts := settingsOpts{argocdCMPath: cmPath}
+		settingsMgr, err := opts.createOfflineSettingsManager(t.Context())
+		require.NoError(t, err)
+		labelKey, err := settingsMgr.GetAppInstanceLabelKey()
+		require.NoError(t, err)
+		assert.Equal(t, "my-label", labelKey)
+	})
+}
+
+func TestCheckoutRevision(t *testing.T) {
+	repoDir := t.TempDir()
+	runGit := func(args ...string) {
+		t.Helper()
+		cmd := exec.CommandContext(t.Context(), "git", args...)
+		cmd.Dir = repoDir
+		cmd.Env = append(os.Environ(), "GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com", "GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com")
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+	}
+	runGit("init", "--initial-branch", "main")
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "app.yaml"), []byte("v1"), 0o644))
+	runGit("add", ".")
+	runGit("commit", "-m", "v1")
+	runGit("checkout", "-b", "feature")
+	require.NoError(t, os.WriteFile(filepath.Join(repoDir, "app.yaml"), []byte("v2"), 0o644))
+	runGit("commit", "-am", "v2")
+
+	for revision, expected := range map[string]string{"main": "v1", "feature": "v2"} {
+		root := t.TempDir()
+		require.NoError(t, checkoutRevision(t.Context(), "file://"+repoDir, root, revision))
+		data, err := os.ReadFile(filepath.Join(root, "app.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, expected, string(data))
+	}
+}
@@ -2,17 +2,30 @@ package admin
 
 import (
 	"context"
+	"fmt"
+	"io"
 	"log"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/clientcmd"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/env"
+	"github.com/argoproj/argo-cd/v3/util/errors"
 	service "github.com/argoproj/argo-cd/v3/util/notification/argocd"
+	"github.com/argoproj/argo-cd/v3/util/notification/delivery"
 	"github.com/argoproj/argo-cd/v3/util/notification/settings"
 	"github.com/argoproj/argo-cd/v3/util/tls"
 
@@ -32,6 +45,7 @@ func NewNotificationsCommand() *cobra.Command {
 	)
 
 	var argocdService service.Service
+	var clientConfig clientcmd.ClientConfig
 
 	var repoServerClientTLSConfigSrc func() (tls.Configuration, error)
 
@@ -40,7 +54,8 @@ func NewNotificationsCommand() *cobra.Command {
 		"argocd admin notifications",
 		applications,
 		settings.GetFactorySettingsForCLI(func() service.Service { return argocdService }, "argocd-notifications-secret", "argocd-notifications-cm", false),
-		func(_ context.Context, clientConfig clientcmd.ClientConfig) {
+		func(_ context.Context, config clientcmd.ClientConfig) {
+			clientConfig = config
 			k8sCfg, err := clientConfig.ClientConfig()
 			if err != nil {
 				log.Fatalf("Failed to parse k8s config: %v", err)
@@ -82,5 +97,86 @@ func NewNotificationsCommand() *cobra.Command {
 		log.Fatal(err)
 	}
 	repoServerClientTLSConfigSrc = tls.AddClientTLSFlagsToCmd(toolsCommand)
+	toolsCommand.AddCommand(newNotificationsHistoryCommand(func() clientcmd.ClientConfig { return clientConfig }))
+	toolsCommand.AddCommand(newNotificationsRedeliverCommand(func() clientcmd.ClientConfig { return clientConfig }))
 	return toolsCommand
 }
+
+// getApplicationClient returns the client of the applications in the namespace of the given application
+func getApplicationClient(clientConfig clientcmd.ClientConfig, qualifiedAppName string) (appclientset.Interface, string, string) {
+	k8sCfg, err := clientConfig.ClientConfig()
+	errors.CheckError(err)
+	ns, _, err := clientConfig.Namespace()
+	errors.CheckError(err)
+	appName, appNs := argo.ParseFromQualifiedName(qualifiedAppName, ns)
+	return appclientset.NewForConfigOrDie(k8sCfg), appName, appNs
+}
+
+func newNotificationsHistoryCommand(getClientConfig func() clientcmd.ClientConfig) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "history APPNAME",
+		Short: "Print the history of the notifications delivered about an application",
+		Long: `Print the history of the notifications delivered about an application, including the failed and the pending deliveries.
+Failed deliveries can be sent again with the 'redeliver' command.`,
+		Example: `  # Print the notifications delivered about the guestbook application
+  argocd admin notifications history guestbook
+
+  # Print the notifications delivered about an application in another namespace in JSON
+  argocd admin notifications history apps/guestbook -o json`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			appClient, appName, appNs := getApplicationClient(getClientConfig(), args[0])
+			app, err := appClient.ArgoprojV1alpha1().Applications(appNs).Get(c.Context(), appName, metav1.GetOptions{})
+			errors.CheckError(err)
+			switch output {
+			case "wide", "":
+				printNotificationDeliveries(os.Stdout, app.Status.NotificationDeliveries)
+			default:
+				errors.CheckError(PrintResources(output, os.Stdout, app.Status.NotificationDeliveries))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+func newNotificationsRedeliverCommand(getClientConfig func() clientcmd.ClientConfig) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "redeliver APPNAME ID",
+		Short: "Send a notification about an application again",
+		Long: `Send a notification about an application again. The notification controller renders the notification
+with the current state of the application and delivers it, retrying if the delivery fails.`,
+		Example: `  # Send the notification of the delivery 3 of the guestbook application again
+  argocd admin notifications redeliver guestbook 3`,
+		Run: func(c *cobra.Command, args []string) {
+			if len(args) != 2 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			id, err := strconv.ParseInt(args[1], 10, 64)
+			errors.CheckError(err)
+			appClient, appName, appNs := getApplicationClient(getClientConfig(), args[0])
+			redelivery, err := delivery.RedeliverApplication(c.Context(), appClient.ArgoprojV1alpha1().Applications(appNs), appName, id)
+			errors.CheckError(err)
+			fmt.Printf("Notification delivery %d requested\n", redelivery.ID)
+		},
+	}
+	return command
+}
+
+func printNotificationDeliveries(out io.Writer, deliveries v1alpha1.NotificationDeliveries) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "ID\tTRIGGER\tSERVICE\tRECIPIENT\tPHASE\tATTEMPTS\tLAST ATTEMPT\tMESSAGE")
+	for _, d := range deliveries {
+		lastAttempt := ""
+		if d.LastAttemptAt != nil {
+			lastAttempt = d.LastAttemptAt.Format(time.RFC3339)
+		}
+		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%d\t%s\t%s\n", d.ID, d.Trigger, d.Service, d.Recipient, d.Phase, d.Attempts, lastAttempt, strings.ReplaceAll(d.Message, "\n", " "))
+	}
+	_ = w.Flush()
+}
@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestPrintNotificationDeliveries(t *testing.T) {
+	lastAttempt := metav1.NewTime(time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC))
+	out := &bytes.Buffer{}
+	printNotificationDeliveries(out, v1alpha1.NotificationDeliveries{{
+		ID:            0,
+		Trigger:       "on-sync-succeeded",
+		Service:       "slack",
+		Recipient:     "alerts",
+		Phase:         v1alpha1.NotificationDeliveryPhaseSucceeded,
+		Attempts:      1,
+		LastAttemptAt: &lastAttempt,
+	}, {
+		ID:        1,
+		Trigger:   "on-sync-failed",
+		Service:   "webhook",
+		Recipient: "github",
+		Phase:     v1alpha1.NotificationDeliveryPhasePending,
+		Attempts:  2,
+		Message:   "request failed:\nconnection refused",
+	}})
+	assert.Equal(t, `ID  TRIGGER            SERVICE  RECIPIENT  PHASE      ATTEMPTS  LAST ATTEMPT          MESSAGE
+0   on-sync-succeeded  slack    alerts     Succeeded  1         2025-01-01T10:00:00Z  
+1   on-sync-failed     webhook  github     Pending    2                               request failed: connection refused
+`, out.String())
+}
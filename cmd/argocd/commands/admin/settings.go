@@ -489,7 +489,7 @@ func NewResourceHealthCommand(cmdCtx commandContext) *cobra.Command {
 	command := &cobra.Command{
 		Use:   "health RESOURCE_YAML_PATH",
 		Short: "Assess resource health",
-		Long:  "Assess resource health using the lua script configured in the 'resource.customizations' field of 'argocd-cm' ConfigMap",
+		Long:  "Assess resource health using the lua script or CEL expression configured in the 'resource.customizations' field of 'argocd-cm' ConfigMap",
 		Example: `
 argocd admin settings resource-overrides health ./deploy.yaml --argocd-cm-path ./argocd-cm.yaml`,
 		Run: func(c *cobra.Command, args []string) {
@@ -522,7 +522,7 @@ func NewResourceActionListCommand(cmdCtx commandContext) *cobra.Command {
 	command := &cobra.Command{
 		Use:   "list-actions RESOURCE_YAML_PATH",
 		Short: "List available resource actions",
-		Long:  "List actions available for given resource action using the lua scripts configured in the 'resource.customizations' field of 'argocd-cm' ConfigMap and outputs updated fields",
+		Long:  "List actions available for given resource action using the lua scripts or CEL expressions configured in the 'resource.customizations' field of 'argocd-cm' ConfigMap and outputs updated fields",
 		Example: `
 argocd admin settings resource-overrides action list /tmp/deploy.yaml --argocd-cm-path ./argocd-cm.yaml`,
 		Run: func(c *cobra.Command, args []string) {
@@ -541,10 +541,7 @@ argocd admin settings resource-overrides action list /tmp/deploy.yaml --argocd-c
 				}
 
 				luaVM := lua.VM{ResourceOverrides: overrides}
-				discoveryScript, err := luaVM.GetResourceActionDiscovery(&res)
-				errors.CheckError(err)
-
-				availableActions, err := luaVM.ExecuteResourceActionDiscovery(&res, discoveryScript)
+				availableActions, err := luaVM.DiscoverResourceActions(&res)
 				errors.CheckError(err)
 				sort.Slice(availableActions, func(i, j int) bool {
 					return availableActions[i].Name < availableActions[j].Name
@@ -569,7 +566,7 @@ func NewResourceActionRunCommand(cmdCtx commandContext) *cobra.Command {
 		Use:     "run-action RESOURCE_YAML_PATH ACTION",
 		Aliases: []string{"action"},
 		Short:   "Executes resource action",
-		Long:    "Executes resource action using the lua script configured in the 'resource.customizations' field of 'argocd-cm' ConfigMap and outputs updated fields",
+		Long:    "Executes resource action using the lua script or CEL expression configured in the 'resource.customizations' field of 'argocd-cm' ConfigMap and outputs updated fields",
 		Example: `
 argocd admin settings resource-overrides action /tmp/deploy.yaml restart --argocd-cm-path ./argocd-cm.yaml`,
 		Run: func(c *cobra.Command, args []string) {
@@ -609,7 +606,7 @@ argocd admin settings resource-overrides action /tmp/deploy.yaml restart --argoc
 				action, err := luaVM.GetResourceAction(&res, action)
 				errors.CheckError(err)
 
-				modifiedRes, err := luaVM.ExecuteResourceAction(&res, action.ActionLua, parsedParams)
+				modifiedRes, err := luaVM.ExecuteResourceActionDefinition(&res, action, parsedParams)
 				errors.CheckError(err)
 
 				for _, impactedResource := range modifiedRes {
@@ -55,7 +55,7 @@ var resourceMap = map[string]string{
 var validRBACResourcesActions = map[string]actionTraitMap{
 	rbac.ResourceAccounts:        accountsActions,
 	rbac.ResourceApplications:    applicationsActions,
-	rbac.ResourceApplicationSets: defaultCRUDActions,
+	rbac.ResourceApplicationSets: applicationSetsActions,
 	rbac.ResourceCertificates:    defaultCRDActions,
 	rbac.ResourceClusters:        defaultCRUDActions,
 	rbac.ResourceExtensions:      extensionActions,
@@ -90,6 +90,14 @@ var applicationsActions = actionTraitMap{
 	rbac.ActionSync:     rbacTrait{},
 }
 
+var applicationSetsActions = actionTraitMap{
+	rbac.ActionCreate:  rbacTrait{},
+	rbac.ActionGet:     rbacTrait{},
+	rbac.ActionUpdate:  rbacTrait{},
+	rbac.ActionDelete:  rbacTrait{},
+	rbac.ActionApprove: rbacTrait{},
+}
+
 var accountsActions = actionTraitMap{
 	rbac.ActionCreate: rbacTrait{},
 	rbac.ActionUpdate: rbacTrait{},
@@ -347,6 +347,22 @@ func TestResourceOverrideHealth(t *testing.T) {
 		require.NoError(t, err)
 		assert.Contains(t, out, "Progressing")
 	})
+
+	t.Run("HealthAssessmentConfiguredWithCEL", func(t *testing.T) {
+		cmd := NewResourceOverridesCommand(newCmdContext(t.Context(), map[string]string{
+			"resource.customizations": `example.com/ExampleResource:
+  health.cel: |
+    obj.spec.replicas == 0 ? {"status": "Suspended", "message": "scaled down"} : {"status": "Healthy"}
+`,
+		}))
+		out, err := captureStdout(func() {
+			cmd.SetArgs([]string{"health", f})
+			err := cmd.Execute()
+			require.NoError(t, err)
+		})
+		require.NoError(t, err)
+		assert.Contains(t, out, "STATUS: Suspended\nMESSAGE: scaled down\n")
+	})
 }
 
 func TestResourceOverrideAction(t *testing.T) {
@@ -407,6 +423,38 @@ resume   false
 `)
 	})
 
+	t.Run("CELActionConfigured", func(t *testing.T) {
+		cmd := NewResourceOverridesCommand(newCmdContext(t.Context(), map[string]string{
+			"resource.customizations": `apps/Deployment:
+  actions: |
+    discovery.cel: |
+      {"scale": {"params": [{"name": "replicas"}]}, "pause": {"disabled": has(obj.spec.paused) && obj.spec.paused}}
+    definitions:
+    - name: scale
+      action.cel: |
+        {"spec": {"replicas": int(actionParams.replicas)}}
+`,
+		}))
+		out, err := captureStdout(func() {
+			cmd.SetArgs([]string{"run-action", f, "scale", "--param", "replicas=3"})
+			err := cmd.Execute()
+			require.NoError(t, err)
+		})
+		require.NoError(t, err)
+		assert.Contains(t, out, "replicas: 3")
+
+		out, err = captureStdout(func() {
+			cmd.SetArgs([]string{"list-actions", f})
+			err := cmd.Execute()
+			require.NoError(t, err)
+		})
+		require.NoError(t, err)
+		assert.Contains(t, out, `NAME   DISABLED
+pause  false
+scale  false
+`)
+	})
+
 	t.Run("NewStyleActionConfigured", func(t *testing.T) {
 		cmd := NewResourceOverridesCommand(newCmdContext(t.Context(), map[string]string{
 			"resource.customizations": `batch/CronJob:
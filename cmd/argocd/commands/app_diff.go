@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"slices"
 	"sort"
 
 	"golang.org/x/sync/errgroup"
@@ -51,11 +52,22 @@ type comparisonObject struct {
 	key    kube.ResourceKey
 	live   *unstructured.Unstructured
 	target *unstructured.Unstructured
+	// changes are the changed fields computed by the API server, if serverChanges is true
+	changes       []argoappv1.ResourceDiffChange
+	serverChanges bool
+}
+
+// diffResult is the result of the diff of a resource. The changed fields are set if the diff is computed by the API
+// server, which matches them with the schema of the cluster.
+type diffResult struct {
+	diff.DiffResult
+	changes       []argoappv1.ResourceDiffChange
+	serverChanges bool
 }
 
 // diffStrategy is a function that performs diff on a batch of resources
 // Returns DiffResult from the gitops-engine where NormalizedLive is the live state and PredictedLive is the target state
-type diffStrategy func(ctx context.Context, items []comparisonObject) ([]*diff.DiffResult, error)
+type diffStrategy func(ctx context.Context, items []comparisonObject) ([]*diffResult, error)
 
 type resourceInfoProvider struct {
 	namespacedByGk map[schema.GroupKind]bool
@@ -219,9 +231,9 @@ func newServerSideDiffStrategy(
 	concurrency int,
 	maxBatchKB int,
 ) diffStrategy {
-	return func(ctx context.Context, items []comparisonObject) ([]*diff.DiffResult, error) {
+	return func(ctx context.Context, items []comparisonObject) ([]*diffResult, error) {
 		if len(items) == 0 {
-			return []*diff.DiffResult{}, nil
+			return []*diffResult{}, nil
 		}
 
 		// For server-side diff, we need to create aligned arrays
@@ -301,13 +313,17 @@ func newServerSideDiffStrategy(
 			return nil, err
 		}
 
-		results := make([]*diff.DiffResult, 0)
+		results := make([]*diffResult, 0)
 		for _, batchItems := range batchResults {
 			for _, resultItem := range batchItems {
-				results = append(results, &diff.DiffResult{
-					Modified:       resultItem.Modified,
-					NormalizedLive: []byte(resultItem.LiveState),
-					PredictedLive:  []byte(resultItem.TargetState),
+				results = append(results, &diffResult{
+					DiffResult: diff.DiffResult{
+						Modified:       resultItem.Modified,
+						NormalizedLive: []byte(resultItem.LiveState),
+						PredictedLive:  []byte(resultItem.TargetState),
+					},
+					changes:       resultItem.Changes,
+					serverChanges: true,
 				})
 			}
 		}
@@ -340,8 +356,8 @@ func newClientSideDiffStrategy(
 		return nil, err
 	}
 
-	return func(ctx context.Context, items []comparisonObject) ([]*diff.DiffResult, error) {
-		results := make([]*diff.DiffResult, len(items))
+	return func(ctx context.Context, items []comparisonObject) ([]*diffResult, error) {
+		results := make([]*diffResult, len(items))
 
 		for i, item := range items {
 			diffRes, err := argodiff.StateDiff(ctx, item.live, item.target, diffConfig)
@@ -349,7 +365,7 @@ func newClientSideDiffStrategy(
 				return nil, err
 			}
 
-			results[i] = &diffRes
+			results[i] = &diffResult{DiffResult: diffRes}
 		}
 
 		return results, nil
@@ -611,15 +627,32 @@ func compareManifests(
 		}
 
 		results = append(results, comparisonObject{
-			key:    key,
-			live:   live,
-			target: target,
+			key:           key,
+			live:          live,
+			target:        target,
+			changes:       diffRes.changes,
+			serverChanges: diffRes.serverChanges,
 		})
 	}
 
 	return results, nil
 }
 
+// setManagedResourceChanges sets the changed fields computed by the application controller on the results which have
+// none. It only applies if the target state is the one compared by the application controller.
+func setManagedResourceChanges(results []comparisonObject, liveState *application.ManagedResourcesResponse) {
+	changesByKey := make(map[kube.ResourceKey][]argoappv1.ResourceDiffChange)
+	for _, res := range liveState.Items {
+		changesByKey[kube.NewResourceKey(res.Group, res.Kind, res.Namespace, res.Name)] = res.Changes
+	}
+	for i := range results {
+		if changes, ok := changesByKey[results[i].key]; ok && !results[i].serverChanges {
+			results[i].changes = changes
+			results[i].serverChanges = true
+		}
+	}
+}
+
 // NewApplicationDiffCommand returns a new instance of an `argocd app diff` command
 func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
@@ -640,6 +673,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 		sourcePositions           []int64
 		sourceNames               []string
 		ignoreNormalizerOpts      normalizers.IgnoreNormalizerOpts
+		output                    string
 	)
 	shortDesc := "Perform a diff against the target and live state."
 	command := &cobra.Command{
@@ -666,6 +700,10 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 				errors.Fatal(errors.ErrorGeneric, "While using --revisions and --source-names, length of values for both flags should be same.")
 			}
 
+			if !slices.Contains(diffOutputFormats, output) {
+				errors.Fatal(errors.ErrorGeneric, fmt.Sprintf("unknown output format: %s", output))
+			}
+
 			if serverSideDiffConcurrency == 0 {
 				errors.Fatal(errors.ErrorGeneric, "invalid value for --server-side-diff-concurrency: 0 is not allowed (use -1 for unlimited, or a positive number to limit concurrency)")
 			}
@@ -723,6 +761,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			// Create target manifest provider based on flags
 			var getTargetManifests manifestProvider
 			excludeSecret := false
+			useManagedResourceChanges := false
 
 			switch {
 			case app.Spec.HasMultipleSources() && len(revisions) > 0 && len(sourcePositions) > 0:
@@ -752,6 +791,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 
 			default:
 				getTargetManifests = newDefaultTargetProvider(liveState)
+				useManagedResourceChanges = true
 			}
 
 			// Wrap target manifest provider with normalization since the manifest are have not been applied to kubernetes
@@ -773,13 +813,14 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 			// Compute diff
 			results, err := compareManifests(ctx, getTargetManifests, getLiveManifests, diffHandler)
 			errors.CheckError(err)
+			if useManagedResourceChanges {
+				setManagedResourceChanges(results, liveState)
+			}
 
 			sort.Slice(results, func(i, j int) bool {
 				return results[i].key.String() < results[j].key.String()
 			})
-			for _, result := range results {
-				printResourceDiff(result.key.Group, result.key.Kind, result.key.Namespace, result.key.Name, result.live, result.target)
-			}
+			errors.CheckError(printResourceDiffs(results, output))
 
 			foundDiffs := len(results) > 0
 			if foundDiffs && exitCode {
@@ -803,6 +844,7 @@ func NewApplicationDiffCommand(clientOpts *argocdclient.ClientOptions) *cobra.Co
 	command.Flags().Int64SliceVar(&sourcePositions, "source-positions", []int64{}, "List of source positions. Default is empty array. Counting start at 1.")
 	command.Flags().StringArrayVar(&sourceNames, "source-names", []string{}, "List of source names. Default is an empty array.")
 	command.Flags().DurationVar(&ignoreNormalizerOpts.JQExecutionTimeout, "ignore-normalizer-jq-execution-timeout", normalizers.DefaultJQExecutionTimeout, "Set ignore normalizer JQ execution timeout")
+	command.Flags().StringVarP(&output, "output", "o", diffOutputText, "Output format. One of: diff|json|semantic")
 	return command
 }
 
@@ -817,3 +859,97 @@ func printResourceDiff(group, kind, namespace, name string, live, target *unstru
 	fmt.Printf("\n===== %s/%s %s/%s ======\n", group, kind, namespace, name)
 	_ = cli.PrintDiff(name, live, target)
 }
+
+const (
+	// diffOutputText prints the diff of the manifests of each resource
+	diffOutputText = "diff"
+	// diffOutputJSON prints the changed fields of each resource as JSON
+	diffOutputJSON = "json"
+	// diffOutputSemantic prints the changed fields of each resource in a human-readable form
+	diffOutputSemantic = "semantic"
+)
+
+var diffOutputFormats = []string{diffOutputText, diffOutputJSON, diffOutputSemantic}
+
+// resourceChanges are the changed fields of a resource, as printed by `argocd app diff --output json`
+type resourceChanges struct {
+	Group     string        `json:"group"`
+	Kind      string        `json:"kind"`
+	Namespace string        `json:"namespace,omitempty"`
+	Name      string        `json:"name"`
+	Changes   []diff.Change `json:"changes"`
+}
+
+// printResourceDiffs prints the differences of the compared resources in the given output format. The changed fields
+// are computed by the API server if available. Otherwise, e.g. for a local diff, they are matched using the schema of
+// the k8s built in types, since the CLI has no access to the cluster schema.
+func printResourceDiffs(results []comparisonObject, output string) error {
+	if output == diffOutputText {
+		for _, result := range results {
+			printResourceDiff(result.key.Group, result.key.Kind, result.key.Namespace, result.key.Name, result.live, result.target)
+		}
+		return nil
+	}
+
+	resources := make([]resourceChanges, 0, len(results))
+	for _, result := range results {
+		changes, err := getResourceChanges(result)
+		if err != nil {
+			return fmt.Errorf("error computing changes of %s: %w", result.key.String(), err)
+		}
+		resources = append(resources, resourceChanges{
+			Group:     result.key.Group,
+			Kind:      result.key.Kind,
+			Namespace: result.key.Namespace,
+			Name:      result.key.Name,
+			Changes:   changes,
+		})
+	}
+
+	switch output {
+	case diffOutputJSON:
+		data, err := json.MarshalIndent(resources, "", "  ")
+		if err != nil {
+			return fmt.Errorf("error marshaling changes: %w", err)
+		}
+		fmt.Println(string(data))
+	case diffOutputSemantic:
+		for _, res := range resources {
+			fmt.Printf("\n===== %s/%s %s/%s ======\n", res.Group, res.Kind, res.Namespace, res.Name)
+			for _, change := range res.Changes {
+				path := change.Path
+				if path == "" {
+					path = "."
+				}
+				switch change.Operation {
+				case diff.ChangeOperationAdd:
+					fmt.Printf("+ %s: %s\n", path, formatChangeValue(change.Target))
+				case diff.ChangeOperationRemove:
+					fmt.Printf("- %s: %s\n", path, formatChangeValue(change.Live))
+				default:
+					fmt.Printf("~ %s: %s -> %s\n", path, formatChangeValue(change.Live), formatChangeValue(change.Target))
+				}
+			}
+		}
+	default:
+		return fmt.Errorf("unknown output format: %s", output)
+	}
+	return nil
+}
+
+// getResourceChanges returns the changed fields of the compared resource
+func getResourceChanges(result comparisonObject) ([]diff.Change, error) {
+	if result.serverChanges {
+		return argodiff.FromResourceDiffChanges(result.changes)
+	}
+	return diff.SemanticDiff(result.live, result.target, nil)
+}
+
+// formatChangeValue formats the value of a changed field as compact JSON
+func formatChangeValue(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprint(v)
+	}
+	return string(data)
+}
@@ -72,16 +72,16 @@ func mockManifestProvider(manifests []*unstructured.Unstructured) manifestProvid
 
 // mockDiffStrategy creates a mock diffStrategy that marks all items as modified
 func mockDiffStrategyAllModified() diffStrategy {
-	return func(_ context.Context, items []comparisonObject) ([]*diff.DiffResult, error) {
-		results := make([]*diff.DiffResult, len(items))
+	return func(_ context.Context, items []comparisonObject) ([]*diffResult, error) {
+		results := make([]*diffResult, len(items))
 		for i, item := range items {
 			liveBytes, _ := json.Marshal(item.live)
 			targetBytes, _ := json.Marshal(item.target)
-			results[i] = &diff.DiffResult{
+			results[i] = &diffResult{DiffResult: diff.DiffResult{
 				Modified:       true,
 				NormalizedLive: liveBytes,
 				PredictedLive:  targetBytes,
-			}
+			}}
 		}
 		return results, nil
 	}
@@ -89,12 +89,12 @@ func mockDiffStrategyAllModified() diffStrategy {
 
 // mockDiffStrategyNoneModified creates a mock diffStrategy that marks no items as modified
 func mockDiffStrategyNoneModified() diffStrategy {
-	return func(_ context.Context, items []comparisonObject) ([]*diff.DiffResult, error) {
-		results := make([]*diff.DiffResult, len(items))
+	return func(_ context.Context, items []comparisonObject) ([]*diffResult, error) {
+		results := make([]*diffResult, len(items))
 		for i := range items {
-			results[i] = &diff.DiffResult{
+			results[i] = &diffResult{DiffResult: diff.DiffResult{
 				Modified: false,
-			}
+			}}
 		}
 		return results, nil
 	}
@@ -818,6 +818,7 @@ func TestNewServerSideDiffStrategy(t *testing.T) {
 					Modified:    true,
 					LiveState:   `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-deployment"}}`,
 					TargetState: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"test-deployment","labels":{"new":"label"}}}`,
+					Changes:     []v1alpha1.ResourceDiffChange{{Operation: "add", Path: ".metadata.labels", TargetValue: `{"new":"label"}`}},
 				},
 			},
 		}, nil)
@@ -838,6 +839,8 @@ func TestNewServerSideDiffStrategy(t *testing.T) {
 		assert.True(t, results[0].Modified)
 		assert.NotEmpty(t, results[0].NormalizedLive)
 		assert.NotEmpty(t, results[0].PredictedLive)
+		assert.True(t, results[0].serverChanges)
+		assert.Equal(t, []v1alpha1.ResourceDiffChange{{Operation: "add", Path: ".metadata.labels", TargetValue: `{"new":"label"}`}}, results[0].changes)
 		mockClient.AssertExpectations(t)
 	})
 
@@ -1522,3 +1525,109 @@ func TestNewNormalizeTargetManifestsProvider(t *testing.T) {
 		assert.Equal(t, "test-app", labels["app.kubernetes.io/instance"])
 	})
 }
+
+func TestPrintResourceDiffs(t *testing.T) {
+	live := createTestUnstructured(&appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(int32(1)),
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{
+				{Name: "guestbook", Image: "guestbook:v1"},
+				{Name: "sidecar", Image: "sidecar:v1"},
+			}}},
+		},
+	})
+	target := createTestUnstructured(&appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "default"},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(int32(1)),
+			Template: corev1.PodTemplateSpec{Spec: corev1.PodSpec{Containers: []corev1.Container{
+				{Name: "sidecar", Image: "sidecar:v1"},
+				{Name: "guestbook", Image: "guestbook:v2"},
+			}}},
+		},
+	})
+	configMap := createTestUnstructured(&corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "default"},
+	})
+	results := []comparisonObject{
+		{key: kube.GetResourceKey(live), live: live, target: target},
+		{key: kube.GetResourceKey(configMap), target: configMap},
+	}
+
+	t.Run("json", func(t *testing.T) {
+		out, err := captureOutput(func() error {
+			return printResourceDiffs(results, diffOutputJSON)
+		})
+		require.NoError(t, err)
+		var resources []map[string]any
+		require.NoError(t, json.Unmarshal([]byte(out), &resources))
+		require.Len(t, resources, 2)
+		assert.Equal(t, map[string]any{
+			"group":     "apps",
+			"kind":      "Deployment",
+			"namespace": "default",
+			"name":      "guestbook",
+			"changes": []any{map[string]any{
+				"op":     "replace",
+				"path":   `.spec.template.spec.containers[name="guestbook"].image`,
+				"live":   "guestbook:v1",
+				"target": "guestbook:v2",
+			}},
+		}, resources[0])
+		assert.Equal(t, "ConfigMap", resources[1]["kind"])
+		assert.Equal(t, []any{map[string]any{"op": "add", "path": "", "target": configMap.Object}}, resources[1]["changes"])
+	})
+
+	t.Run("semantic", func(t *testing.T) {
+		out, err := captureOutput(func() error {
+			return printResourceDiffs(results, diffOutputSemantic)
+		})
+		require.NoError(t, err)
+		assert.Contains(t, out, "===== apps/Deployment default/guestbook ======\n"+
+			`~ .spec.template.spec.containers[name="guestbook"].image: "guestbook:v1" -> "guestbook:v2"`+"\n")
+		assert.Contains(t, out, "===== /ConfigMap default/config ======\n+ .: {")
+	})
+
+	t.Run("changes computed by the server", func(t *testing.T) {
+		results := []comparisonObject{{
+			key:           kube.GetResourceKey(live),
+			live:          live,
+			target:        target,
+			changes:       []v1alpha1.ResourceDiffChange{{Operation: "replace", Path: ".spec.replicas", LiveValue: "1", TargetValue: "2"}},
+			serverChanges: true,
+		}}
+		out, err := captureOutput(func() error {
+			return printResourceDiffs(results, diffOutputSemantic)
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "\n===== apps/Deployment default/guestbook ======\n~ .spec.replicas: 1 -> 2\n", out)
+	})
+
+	t.Run("unknown output format", func(t *testing.T) {
+		err := printResourceDiffs(results, "yaml")
+		require.EqualError(t, err, "unknown output format: yaml")
+	})
+}
+
+func TestSetManagedResourceChanges(t *testing.T) {
+	changes := []v1alpha1.ResourceDiffChange{{Operation: "replace", Path: ".data.foo", LiveValue: `"bar"`, TargetValue: `"baz"`}}
+	serverSideChanges := []v1alpha1.ResourceDiffChange{{Operation: "remove", Path: ".data.foo", LiveValue: `"bar"`}}
+	results := []comparisonObject{
+		{key: kube.NewResourceKey("", "ConfigMap", "default", "managed")},
+		{key: kube.NewResourceKey("", "ConfigMap", "default", "server-side"), changes: serverSideChanges, serverChanges: true},
+		{key: kube.NewResourceKey("", "ConfigMap", "default", "unknown")},
+	}
+	setManagedResourceChanges(results, &applicationpkg.ManagedResourcesResponse{Items: []*v1alpha1.ResourceDiff{
+		{Kind: "ConfigMap", Namespace: "default", Name: "managed", Changes: changes},
+		{Kind: "ConfigMap", Namespace: "default", Name: "server-side", Changes: changes},
+	}})
+
+	assert.Equal(t, changes, results[0].changes)
+	assert.True(t, results[0].serverChanges)
+	assert.Equal(t, serverSideChanges, results[1].changes)
+	assert.False(t, results[2].serverChanges)
+}
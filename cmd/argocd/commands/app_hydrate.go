@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+// NewApplicationHydrateCommand returns a new instance of an `argocd app hydrate` command
+func NewApplicationHydrateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		appNamespace string
+		hard         bool
+		dryRun       bool
+		revision     string
+	)
+	command := &cobra.Command{
+		Use:   "hydrate APPNAME",
+		Short: "Request hydration of an application using the source hydrator, or preview the hydrated commit",
+		Example: templates.Examples(`
+	# Request hydration of an application, if its dry source changed
+	argocd app hydrate my-app
+
+	# Request hydration of an application, even if its dry source did not change
+	argocd app hydrate my-app --hard
+
+	# Print the changes the hydrator would commit, without committing them
+	argocd app hydrate my-app --dry-run
+
+	# Print the changes the hydrator would commit for a revision of the dry source
+	argocd app hydrate my-app --dry-run --revision my-feature-branch
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			if revision != "" && !dryRun {
+				errors.Fatal(errors.ErrorGeneric, "--revision can only be used with --dry-run")
+			}
+			appName, appNs := argo.ParseFromQualifiedName(args[0], appNamespace)
+			conn, appIf := headless.NewClientOrDie(clientOpts, c).NewApplicationClientOrDie()
+			defer utilio.Close(conn)
+
+			if dryRun {
+				resp, err := appIf.HydrateDryRun(ctx, &applicationpkg.ApplicationHydrateDryRunQuery{
+					Name:         &appName,
+					AppNamespace: &appNs,
+					Revision:     &revision,
+				})
+				errors.CheckError(err)
+				if resp.GetDiff() == "" {
+					fmt.Printf("Hydrating dry revision %s would not change branch %s at %s\n", resp.GetDrySha(), resp.GetTargetBranch(), resp.GetHydratedSha())
+					return
+				}
+				fmt.Println(resp.GetDiff())
+				return
+			}
+
+			refreshType := string(v1alpha1.RefreshTypeNormal)
+			if hard {
+				refreshType = string(v1alpha1.RefreshTypeHard)
+			}
+			_, err := appIf.Get(ctx, &applicationpkg.ApplicationQuery{
+				Name:         &appName,
+				AppNamespace: &appNs,
+				Refresh:      &refreshType,
+			})
+			errors.CheckError(err)
+			fmt.Printf("Application '%s' hydration requested\n", appName)
+		},
+	}
+	command.Flags().StringVarP(&appNamespace, "app-namespace", "N", "", "Only hydrate an application in namespace")
+	command.Flags().BoolVar(&hard, "hard", false, "Hydrate even if the dry source did not change since the last hydration")
+	command.Flags().BoolVar(&dryRun, "dry-run", false, "Print the changes the hydrator would commit, without committing them")
+	command.Flags().StringVar(&revision, "revision", "", "Revision of the dry source to preview the hydration of, defaults to the target revision of the dry source")
+	return command
+}
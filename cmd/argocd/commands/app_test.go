@@ -37,6 +37,7 @@ import (
 	applicationsetpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
 	certificatepkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/certificate"
 	clusterpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
+	cosignkeypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/cosignkey"
 	eventspkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/events"
 	gpgkeypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/gpgkey"
 	notificationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/notification"
@@ -45,6 +46,7 @@ import (
 	repositorypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
 	sessionpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/session"
 	settingspkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/settings"
+	sshsignerpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/sshsigner"
 	versionpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/version"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -2549,6 +2551,10 @@ func (c *fakeAppServiceClient) ServerSideDiff(_ context.Context, _ *applicationp
 	return nil, nil
 }
 
+func (c *fakeAppServiceClient) HydrateDryRun(_ context.Context, _ *applicationpkg.ApplicationHydrateDryRunQuery, _ ...grpc.CallOption) (*applicationpkg.ApplicationHydrateDryRunResponse, error) {
+	return nil, nil
+}
+
 type fakeAcdClient struct {
 	simulateTimeout uint
 }
@@ -2601,6 +2607,22 @@ func (c *fakeAcdClient) NewGPGKeyClientOrDie() (io.Closer, gpgkeypkg.GPGKeyServi
 	return nil, nil
 }
 
+func (c *fakeAcdClient) NewSSHSignerClient() (io.Closer, sshsignerpkg.SSHSignerServiceClient, error) {
+	return nil, nil, nil
+}
+
+func (c *fakeAcdClient) NewSSHSignerClientOrDie() (io.Closer, sshsignerpkg.SSHSignerServiceClient) {
+	return nil, nil
+}
+
+func (c *fakeAcdClient) NewCosignKeyClient() (io.Closer, cosignkeypkg.CosignKeyServiceClient, error) {
+	return nil, nil, nil
+}
+
+func (c *fakeAcdClient) NewCosignKeyClientOrDie() (io.Closer, cosignkeypkg.CosignKeyServiceClient) {
+	return nil, nil
+}
+
 func (c *fakeAcdClient) NewApplicationClient() (io.Closer, applicationpkg.ApplicationServiceClient, error) {
 	return nil, nil, nil
 }
@@ -41,6 +41,9 @@ var appSetExample = templates.Examples(`
 	# Delete an ApplicationSet
 	argocd appset delete APPSETNAME (APPSETNAME...)
 
+	# Approve a RollingSync step of an ApplicationSet which is waiting for approval
+	argocd appset rollout approve APPSETNAME STEP
+
 	# Namespace precedence for --appset-namespace (-N):
 	# - get/delete: if the argument is namespace/name, that namespace wins; -N is ignored.
 	# - create/generate: metadata.namespace in the YAML wins when set; -N applies only when the manifest omits namespace.
@@ -62,6 +65,7 @@ func NewAppSetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	command.AddCommand(NewApplicationSetListCommand(clientOpts))
 	command.AddCommand(NewApplicationSetDeleteCommand(clientOpts))
 	command.AddCommand(NewApplicationSetGenerateCommand(clientOpts))
+	command.AddCommand(NewApplicationSetRolloutCommand(clientOpts))
 	return command
 }
 
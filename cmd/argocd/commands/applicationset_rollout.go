@@ -0,0 +1,210 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
+	arogappsetv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+var appSetRolloutExample = templates.Examples(`
+	# Show the status of the RollingSync steps of an ApplicationSet
+	argocd appset rollout status APPSETNAME
+
+	# Approve a RollingSync step which is waiting for approval
+	argocd appset rollout approve APPSETNAME STEP
+
+	# Reject a gated RollingSync step, which fails the step
+	argocd appset rollout reject APPSETNAME STEP
+	`)
+
+// NewApplicationSetRolloutCommand returns a new instance of an `argocd appset rollout` command
+func NewApplicationSetRolloutCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:     "rollout",
+		Short:   "Manage the RollingSync rollout of an ApplicationSet",
+		Example: appSetRolloutExample,
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+	}
+	command.AddCommand(NewApplicationSetRolloutStatusCommand(clientOpts))
+	command.AddCommand(NewApplicationSetRolloutApproveCommand(clientOpts))
+	command.AddCommand(NewApplicationSetRolloutRejectCommand(clientOpts))
+	return command
+}
+
+// NewApplicationSetRolloutStatusCommand returns a new instance of an `argocd appset rollout status` command
+func NewApplicationSetRolloutStatusCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		output          string
+		appSetNamespace string
+	)
+	command := &cobra.Command{
+		Use:   "status APPSETNAME",
+		Short: "Show the status of the RollingSync steps of an ApplicationSet",
+		Example: templates.Examples(`
+	# Show the status of the RollingSync steps of an ApplicationSet
+	argocd appset rollout status APPSETNAME
+
+	# Show the status of the RollingSync steps as YAML
+	argocd appset rollout status APPSETNAME -o yaml
+		`),
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				c.HelpFunc()(c, args)
+				os.Exit(1)
+			}
+			acdClient := headless.NewClientOrDie(clientOpts, c)
+			conn, appIf := acdClient.NewApplicationSetClientOrDie()
+			defer utilio.Close(conn)
+
+			appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], appSetNamespace)
+
+			appSet, err := appIf.Get(ctx, &applicationset.ApplicationSetGetQuery{Name: appSetName, AppsetNamespace: appSetNs})
+			errors.CheckError(err)
+
+			switch output {
+			case "yaml", "json":
+				err := PrintResource(appSet.Status.RolloutSteps, output)
+				errors.CheckError(err)
+			case "wide", "":
+				w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+				printAppSetRolloutStatus(w, appSet)
+				_ = w.Flush()
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	command.Flags().StringVarP(&appSetNamespace, "appset-namespace", "N", "", "Only get ApplicationSet from a namespace (ignored when qualified name is provided)")
+	return command
+}
+
+// NewApplicationSetRolloutApproveCommand returns a new instance of an `argocd appset rollout approve` command
+func NewApplicationSetRolloutApproveCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	return newApplicationSetRolloutDecisionCommand(clientOpts, true)
+}
+
+// NewApplicationSetRolloutRejectCommand returns a new instance of an `argocd appset rollout reject` command
+func NewApplicationSetRolloutRejectCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	return newApplicationSetRolloutDecisionCommand(clientOpts, false)
+}
+
+func newApplicationSetRolloutDecisionCommand(clientOpts *argocdclient.ClientOptions, approve bool) *cobra.Command {
+	var appSetNamespace string
+	command := &cobra.Command{
+		Use:   "approve APPSETNAME STEP",
+		Short: "Approve a RollingSync step which is waiting for approval",
+		Example: templates.Examples(`
+	# Approve the second RollingSync step of an ApplicationSet
+	argocd appset rollout approve APPSETNAME 2
+		`),
+	}
+	if !approve {
+		command.Use = "reject APPSETNAME STEP"
+		command.Short = "Reject a gated RollingSync step, which fails the step"
+		command.Example = templates.Examples(`
+	# Reject the second RollingSync step of an ApplicationSet
+	argocd appset rollout reject APPSETNAME 2
+		`)
+	}
+	command.Run = func(c *cobra.Command, args []string) {
+		ctx := c.Context()
+
+		if len(args) != 2 {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		}
+		step, err := strconv.ParseInt(args[1], 10, 32)
+		if err != nil {
+			errors.CheckError(fmt.Errorf("invalid step %q: %w", args[1], err))
+		}
+
+		acdClient := headless.NewClientOrDie(clientOpts, c)
+		conn, appIf := acdClient.NewApplicationSetClientOrDie()
+		defer utilio.Close(conn)
+
+		appSetName, appSetNs := argo.ParseFromQualifiedName(args[0], appSetNamespace)
+		req := &applicationset.ApplicationSetRolloutStepRequest{
+			Name:            appSetName,
+			AppsetNamespace: appSetNs,
+			Step:            int32(step),
+		}
+		if approve {
+			_, err = appIf.ApproveStep(ctx, req)
+			errors.CheckError(err)
+			fmt.Printf("step %d of applicationset '%s' approved\n", step, args[0])
+		} else {
+			_, err = appIf.RejectStep(ctx, req)
+			errors.CheckError(err)
+			fmt.Printf("step %d of applicationset '%s' rejected\n", step, args[0])
+		}
+	}
+	command.Flags().StringVarP(&appSetNamespace, "appset-namespace", "N", "", "Namespace of the ApplicationSet (ignored when qualified name is provided)")
+	return command
+}
+
+// printAppSetRolloutStatus prints the gates of the RollingSync steps, followed by the status of their Applications
+func printAppSetRolloutStatus(w io.Writer, appSet *arogappsetv1.ApplicationSet) {
+	if appSet.Spec.Strategy == nil || appSet.Spec.Strategy.RollingSync == nil {
+		_, _ = fmt.Fprintf(w, "ApplicationSet '%s' does not use the RollingSync strategy\n", appSet.QualifiedName())
+		return
+	}
+
+	_, _ = fmt.Fprintf(w, "STEP\tGATES\tPHASE\tAPPROVED\tMESSAGE\n")
+	for i, step := range appSet.Spec.Strategy.RollingSync.Steps {
+		stepName := strconv.Itoa(i + 1)
+		phase, approved, message := "", false, ""
+		for _, stepStatus := range appSet.Status.RolloutSteps {
+			if stepStatus.Step == stepName {
+				phase, approved, message = string(stepStatus.Phase), stepStatus.Approved, stepStatus.Message
+			}
+		}
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%t\t%s\n", stepName, rolloutStepGates(step), phase, approved, message)
+	}
+
+	_, _ = fmt.Fprintf(w, "\nAPPLICATION\tSTEP\tSTATUS\tMESSAGE\n")
+	for _, appStatus := range appSet.Status.ApplicationStatus {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", appStatus.Application, appStatus.Step, appStatus.Status, appStatus.Message)
+	}
+}
+
+func rolloutStepGates(step arogappsetv1.ApplicationSetRolloutStep) string {
+	gates := ""
+	appendGate := func(gate string) {
+		if gates != "" {
+			gates += ","
+		}
+		gates += gate
+	}
+	if step.Pause != nil {
+		appendGate("pause=" + step.Pause.Duration.String())
+	}
+	if step.Analysis != nil {
+		appendGate("analysis")
+	}
+	if step.RequireApproval {
+		appendGate("approval")
+	}
+	if gates == "" {
+		return "-"
+	}
+	return gates
+}
@@ -0,0 +1,60 @@
+package commands
+
+import (
+	"bytes"
+	"testing"
+	"text/tabwriter"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestPrintAppSetRolloutStatus(t *testing.T) {
+	appSet := &v1alpha1.ApplicationSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-appset"},
+		Spec: v1alpha1.ApplicationSetSpec{
+			Strategy: &v1alpha1.ApplicationSetStrategy{
+				Type: "RollingSync",
+				RollingSync: &v1alpha1.ApplicationSetRolloutStrategy{
+					Steps: []v1alpha1.ApplicationSetRolloutStep{
+						{Pause: &metav1.Duration{Duration: 10 * time.Minute}, RequireApproval: true},
+						{},
+					},
+				},
+			},
+		},
+		Status: v1alpha1.ApplicationSetStatus{
+			RolloutSteps: []v1alpha1.ApplicationSetRolloutStepStatus{
+				{Step: "1", Phase: v1alpha1.ApplicationSetRolloutStepAwaitingApproval, Message: "Step is waiting for approval"},
+			},
+			ApplicationStatus: []v1alpha1.ApplicationSetApplicationStatus{
+				{Application: "app-dev", Step: "1", Status: v1alpha1.ProgressiveSyncHealthy, Message: "Application resource became Healthy"},
+				{Application: "app-prod", Step: "2", Status: v1alpha1.ProgressiveSyncWaiting, Message: "Application is waiting for rollout"},
+			},
+		},
+	}
+
+	var buf bytes.Buffer
+	w := tabwriter.NewWriter(&buf, 0, 0, 2, ' ', 0)
+	printAppSetRolloutStatus(w, appSet)
+	_ = w.Flush()
+
+	expectation := `STEP  GATES                 PHASE             APPROVED  MESSAGE
+1     pause=10m0s,approval  AwaitingApproval  false     Step is waiting for approval
+2     -                                       false     
+
+APPLICATION  STEP  STATUS   MESSAGE
+app-dev      1     Healthy  Application resource became Healthy
+app-prod     2     Waiting  Application is waiting for rollout
+`
+	assert.Equal(t, expectation, buf.String())
+}
+
+func TestPrintAppSetRolloutStatusWithoutRollingSync(t *testing.T) {
+	var buf bytes.Buffer
+	printAppSetRolloutStatus(&buf, &v1alpha1.ApplicationSet{ObjectMeta: metav1.ObjectMeta{Name: "team-appset"}})
+	assert.Equal(t, "ApplicationSet 'team-appset' does not use the RollingSync strategy\n", buf.String())
+}
@@ -0,0 +1,201 @@
+package commands
+
+import (
+	stderrors "errors"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	cosignkeypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/cosignkey"
+	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+// NewCosignKeyCommand returns a new instance of an `argocd cosign-key` command
+func NewCosignKeyCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "cosign-key",
+		Short: "Manage cosign public keys trusted to sign OCI artifacts",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+		Example: ``,
+	}
+	command.AddCommand(NewCosignKeyListCommand(clientOpts))
+	command.AddCommand(NewCosignKeyGetCommand(clientOpts))
+	command.AddCommand(NewCosignKeyAddCommand(clientOpts))
+	command.AddCommand(NewCosignKeyDeleteCommand(clientOpts))
+	return command
+}
+
+// NewCosignKeyListCommand lists all configured cosign public keys from the server
+func NewCosignKeyListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "List configured cosign public keys",
+		Example: templates.Examples(`
+  # List all configured cosign public keys in wide format (default).
+  argocd cosign-key list
+
+  # List all configured cosign public keys in JSON format.
+  argocd cosign-key list -o json
+  		`),
+
+		Run: func(c *cobra.Command, _ []string) {
+			ctx := c.Context()
+
+			conn, keyIf := headless.NewClientOrDie(clientOpts, c).NewCosignKeyClientOrDie()
+			defer utilio.Close(conn)
+			keys, err := keyIf.List(ctx, &cosignkeypkg.CosignKeyQuery{})
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(keys.Items, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printCosignKeyTable(keys.Items)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// NewCosignKeyGetCommand retrieves a single cosign public key from the server
+func NewCosignKeyGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "get NAME",
+		Short: "Get the cosign public key with name <NAME> from the server",
+		Example: templates.Examples(`
+  # Get a cosign public key with the specified NAME in wide format (default).
+  argocd cosign-key get NAME
+
+  # Get a cosign public key with the specified NAME in YAML format.
+  argocd cosign-key get NAME -o yaml
+  		`),
+
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				errors.Fatal(errors.ErrorGeneric, "Missing NAME argument")
+			}
+			conn, keyIf := headless.NewClientOrDie(clientOpts, c).NewCosignKeyClientOrDie()
+			defer utilio.Close(conn)
+			key, err := keyIf.Get(ctx, &cosignkeypkg.CosignKeyQuery{Name: args[0]})
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(key, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				fmt.Printf("Name:     %s\n", key.Name)
+				fmt.Printf("Key type: %s\n", key.KeyType)
+				fmt.Printf("Key data follows until EOF:\n%s\n", key.KeyData)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// NewCosignKeyAddCommand adds a cosign public key to the server's configuration
+func NewCosignKeyAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		fromFile string
+		upsert   bool
+	)
+	command := &cobra.Command{
+		Use:   "add NAME",
+		Short: "Adds a cosign public key to the server's configuration",
+		Example: templates.Examples(`
+  # Add a cosign public key generated by cosign generate-key-pair.
+  argocd cosign-key add release-team --from cosign.pub
+
+  # Replace an existing cosign public key.
+  argocd cosign-key add release-team --from /path/to/rotated/cosign.pub --upsert
+  		`),
+
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				errors.Fatal(errors.ErrorGeneric, "Missing NAME argument")
+			}
+			if fromFile == "" {
+				errors.CheckError(stderrors.New("--from is mandatory"))
+			}
+			keyData, err := os.ReadFile(fromFile)
+			if err != nil {
+				errors.CheckError(err)
+			}
+			conn, keyIf := headless.NewClientOrDie(clientOpts, c).NewCosignKeyClientOrDie()
+			defer utilio.Close(conn)
+			key, err := keyIf.Create(ctx, &cosignkeypkg.CosignKeyCreateRequest{
+				Publickey: &appsv1.CosignPublicKey{Name: args[0], KeyData: string(keyData)},
+				Upsert:    upsert,
+			})
+			errors.CheckError(err)
+			fmt.Printf("Cosign public key '%s' (%s) added\n", key.Name, key.KeyType)
+		},
+	}
+	command.Flags().StringVarP(&fromFile, "from", "f", "", "Path to the file that contains the PEM encoded cosign public key")
+	command.Flags().BoolVar(&upsert, "upsert", false, "Override the key if it already exists")
+	return command
+}
+
+// NewCosignKeyDeleteCommand removes a cosign public key from the server's configuration
+func NewCosignKeyDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "rm NAME",
+		Short: "Removes a cosign public key from the server's configuration",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				errors.Fatal(errors.ErrorGeneric, "Missing NAME argument")
+			}
+
+			name := args[0]
+
+			conn, keyIf := headless.NewClientOrDie(clientOpts, c).NewCosignKeyClientOrDie()
+			defer utilio.Close(conn)
+
+			promptUtil := utils.NewPrompt(clientOpts.PromptsEnabled)
+			canDelete := promptUtil.Confirm(fmt.Sprintf("Are you sure you want to remove '%s'? [y/n] ", name))
+			if canDelete {
+				_, err := keyIf.Delete(ctx, &cosignkeypkg.CosignKeyQuery{Name: name})
+				errors.CheckError(err)
+				fmt.Printf("Deleted cosign public key '%s'\n", name)
+			} else {
+				fmt.Printf("The command to delete cosign public key '%s' was cancelled.\n", name)
+			}
+		},
+	}
+	return command
+}
+
+// Print table of cosign public keys
+func printCosignKeyTable(keys []appsv1.CosignPublicKey) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprint(w, "NAME\tTYPE\n")
+
+	for _, k := range keys {
+		fmt.Fprintf(w, "%s\t%s\n", k.Name, k.KeyType)
+	}
+	_ = w.Flush()
+}
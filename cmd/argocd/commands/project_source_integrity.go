@@ -17,6 +17,7 @@ import (
 	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/gpgkey"
 	projectpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/sshsigner"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/cli"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
@@ -49,6 +50,9 @@ var (
 	newGpgKeyClient = func(clientOpts *argocdclient.ClientOptions, c *cobra.Command) (io.Closer, gpgkey.GPGKeyServiceClient) {
 		return headless.NewClientOrDie(clientOpts, c).NewGPGKeyClientOrDie()
 	}
+	newSSHSignerClient = func(clientOpts *argocdclient.ClientOptions, c *cobra.Command) (io.Closer, sshsigner.SSHSignerServiceClient) {
+		return headless.NewClientOrDie(clientOpts, c).NewSSHSignerClientOrDie()
+	}
 )
 
 // NewProjectSourceIntegrityCommand returns a new instance of an `argocd proj source-integrity` command
@@ -129,16 +133,25 @@ func NewProjectSourceIntegrityGitPoliciesListCommand(clientOpts *argocdclient.Cl
 				return fmt.Errorf(msgNoGitPolicies, projName)
 			}
 
-			listGitGpgPolicies(c.OutOrStdout(), proj)
+			listGitPolicies(c.OutOrStdout(), proj)
 			return nil
 		},
 	}
 	return command
 }
 
-func listGitGpgPolicies(out io.Writer, proj *v1alpha1.AppProject) {
+func listGitPolicies(out io.Writer, proj *v1alpha1.AppProject) {
+	// SSH columns are shown only when some of the policies use it
+	withSSH := slices.ContainsFunc(proj.Spec.SourceIntegrity.Git.Policies, func(policy *v1alpha1.SourceIntegrityGitPolicy) bool {
+		return policy.SSH != nil
+	})
+
 	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
-	_, _ = fmt.Fprintln(w, "ID\tGPG-MODE\tGPG-KEYS\tREPO-URLS")
+	if withSSH {
+		_, _ = fmt.Fprintln(w, "ID\tGPG-MODE\tGPG-KEYS\tSSH-MODE\tSSH-SIGNERS\tREPO-URLS")
+	} else {
+		_, _ = fmt.Fprintln(w, "ID\tGPG-MODE\tGPG-KEYS\tREPO-URLS")
+	}
 	for i, policy := range proj.Spec.SourceIntegrity.Git.Policies {
 		gpgMode := "<none>"
 		gpgKeys := "<none>"
@@ -158,7 +171,19 @@ func listGitGpgPolicies(out io.Writer, proj *v1alpha1.AppProject) {
 			repoURLs = strings.Join(urls, ", ")
 		}
 
-		_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", i, gpgMode, gpgKeys, repoURLs)
+		if withSSH {
+			sshMode := "<none>"
+			sshSigners := "<none>"
+			if policy.SSH != nil {
+				sshMode = string(policy.SSH.Mode)
+				if len(policy.SSH.Signers) > 0 {
+					sshSigners = strings.Join(policy.SSH.Signers, ", ")
+				}
+			}
+			_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\n", i, gpgMode, gpgKeys, sshMode, sshSigners, repoURLs)
+		} else {
+			_, _ = fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", i, gpgMode, gpgKeys, repoURLs)
+		}
 	}
 	_ = w.Flush()
 }
@@ -249,16 +274,20 @@ func NewProjectSourceIntegrityGitPoliciesDeleteCommand(clientOpts *argocdclient.
 func cleanupSourceIntegrityIfEmpty(proj *v1alpha1.AppProject) {
 	if proj.Spec.SourceIntegrity != nil && proj.Spec.SourceIntegrity.Git != nil && len(proj.Spec.SourceIntegrity.Git.Policies) == 0 {
 		proj.Spec.SourceIntegrity.Git = nil
-		proj.Spec.SourceIntegrity = nil
+		if proj.Spec.SourceIntegrity.OCI == nil && proj.Spec.SourceIntegrity.Helm == nil {
+			proj.Spec.SourceIntegrity = nil
+		}
 	}
 }
 
 // NewProjectSourceIntegrityGitPoliciesAddCommand returns a new instance of an `argocd proj source-integrity git policies add` command
 func NewProjectSourceIntegrityGitPoliciesAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
-		repoURLs []string
-		gpgMode  string
-		gpgKeys  []string
+		repoURLs   []string
+		gpgMode    string
+		gpgKeys    []string
+		sshMode    string
+		sshSigners []string
 	)
 	command := &cobra.Command{
 		Use:   "add PROJECT",
@@ -269,6 +298,14 @@ func NewProjectSourceIntegrityGitPoliciesAddCommand(clientOpts *argocdclient.Cli
 				--repo-url 'https://github.com/foo/*' \
 				--gpg-mode strict \
 				--gpg-key D56C4FCA57A46444
+
+			# Add a new git policy accepting commits signed either by the GPG key, or by SSH keys of the signer
+			argocd proj source-integrity git policies add PROJECT \
+				--repo-url 'https://github.com/foo/*' \
+				--gpg-mode head \
+				--gpg-key D56C4FCA57A46444 \
+				--ssh-mode head \
+				--ssh-signer alice
 		`),
 		RunE: func(c *cobra.Command, args []string) error {
 			ctx := c.Context()
@@ -286,25 +323,42 @@ func NewProjectSourceIntegrityGitPoliciesAddCommand(clientOpts *argocdclient.Cli
 				return fmt.Errorf("failed getting project %q: %w", projName, err)
 			}
 
-			mode, err := validateGpgMode(gpgMode)
-			if err != nil {
-				return err
-			}
-			newPolicy := v1alpha1.SourceIntegrityGitPolicy{GPG: &v1alpha1.SourceIntegrityGitPolicyGPG{Mode: mode}}
+			newPolicy := v1alpha1.SourceIntegrityGitPolicy{}
 			for _, url := range repoURLs {
 				newPolicy.Repos = append(newPolicy.Repos, v1alpha1.SourceIntegrityGitPolicyRepo{URL: url})
 			}
-			for _, key := range gpgKeys {
-				key, err := sourceintegrity.KeyID(key)
+
+			withSSH := sshMode != "" || len(sshSigners) > 0
+			// GPG is the default verification method, unless the policy is declared for SSH only
+			if !withSSH || gpgMode != "" || len(gpgKeys) > 0 {
+				mode, err := validateGpgMode(gpgMode)
 				if err != nil {
-					return fmt.Errorf("invalid GPG key ID '%s': %w", key, err)
+					return err
+				}
+				newPolicy.GPG = &v1alpha1.SourceIntegrityGitPolicyGPG{Mode: mode}
+				for _, key := range gpgKeys {
+					key, err := sourceintegrity.KeyID(key)
+					if err != nil {
+						return fmt.Errorf("invalid GPG key ID '%s': %w", key, err)
+					}
+					newPolicy.GPG.Keys = append(newPolicy.GPG.Keys, key)
+				}
+			}
+			if withSSH {
+				mode, err := validateSSHMode(sshMode)
+				if err != nil {
+					return err
+				}
+				newPolicy.SSH = &v1alpha1.SourceIntegrityGitPolicySSH{Mode: mode}
+				for _, signer := range sshSigners {
+					if err := sourceintegrity.ValidateSSHSignerName(signer); err != nil {
+						return err
+					}
+					newPolicy.SSH.Signers = append(newPolicy.SSH.Signers, signer)
 				}
-				newPolicy.GPG.Keys = append(newPolicy.GPG.Keys, key)
 			}
 
-			closer, gpgKeyClient := newGpgKeyClient(clientOpts, c)
-			defer utilio.Close(closer)
-			if err := warnOnProblems(c, &newPolicy, gpgKeyClient); err != nil {
+			if err := warnOnProblems(c, clientOpts, &newPolicy); err != nil {
 				return err
 			}
 
@@ -322,13 +376,15 @@ func NewProjectSourceIntegrityGitPoliciesAddCommand(clientOpts *argocdclient.Cli
 			}
 
 			// Print resulting policies out of convenience
-			listGitGpgPolicies(c.OutOrStdout(), proj)
+			listGitPolicies(c.OutOrStdout(), proj)
 			return nil
 		},
 	}
 	command.Flags().StringSliceVar(&repoURLs, "repo-url", []string{}, "Repository URL pattern (can be repeated)")
 	command.Flags().StringVar(&gpgMode, "gpg-mode", "", "GPG verification mode (strict, head, or none)")
 	command.Flags().StringSliceVar(&gpgKeys, "gpg-key", []string{}, "GPG key ID (can be repeated)")
+	command.Flags().StringVar(&sshMode, "ssh-mode", "", "SSH verification mode (strict, head, or none)")
+	command.Flags().StringSliceVar(&sshSigners, "ssh-signer", []string{}, "SSH signer name (can be repeated)")
 	return command
 }
 
@@ -345,6 +401,19 @@ func validateGpgMode(gpgMode string) (v1alpha1.SourceIntegrityGitPolicyGPGMode,
 	}
 }
 
+func validateSSHMode(sshMode string) (v1alpha1.SourceIntegrityGitPolicySSHMode, error) {
+	out := v1alpha1.SourceIntegrityGitPolicySSHMode(sshMode)
+
+	switch sshMode {
+	case "strict", "head", "none":
+		return out, nil
+	case "":
+		return out, errors.New("ssh-mode must be set")
+	default:
+		return out, errors.New("ssh-mode must be one of: strict, head, none")
+	}
+}
+
 // NewProjectSourceIntegrityGitPoliciesUpdateCommand returns a new instance of an `argocd proj source-integrity git policies update` command
 func NewProjectSourceIntegrityGitPoliciesUpdateCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
 	var (
@@ -355,6 +424,10 @@ func NewProjectSourceIntegrityGitPoliciesUpdateCommand(clientOpts *argocdclient.
 		gpgKeys        []string
 		deleteGPGKeys  []string
 		addGPGKeys     []string
+		sshMode        string
+		sshSigners     []string
+		deleteSigners  []string
+		addSigners     []string
 		yes            bool
 	)
 	command := &cobra.Command{
@@ -374,6 +447,11 @@ func NewProjectSourceIntegrityGitPoliciesUpdateCommand(clientOpts *argocdclient.
 			argocd proj source-integrity git policies update PROJECT POLICY_ID \
 				--gpg-mode strict \
 				--add-gpg-key D56C4FCA57A46444
+
+			# Update policy to also accept commits signed by SSH keys of a signer
+			argocd proj source-integrity git policies update PROJECT POLICY_ID \
+				--ssh-mode head \
+				--add-ssh-signer alice
 		`),
 		RunE: func(c *cobra.Command, args []string) error {
 			ctx := c.Context()
@@ -414,6 +492,10 @@ func NewProjectSourceIntegrityGitPoliciesUpdateCommand(clientOpts *argocdclient.
 				return errors.New("option --repo-url, cannot be combined with --add-repo-url or --delete-repo-url")
 			}
 
+			if len(sshSigners) > 0 && (len(deleteSigners) > 0 || len(addSigners) > 0) {
+				return errors.New("option --ssh-signer, cannot be combined with --add-ssh-signer or --delete-ssh-signer")
+			}
+
 			if len(repoURLs) > 0 {
 				var repos []v1alpha1.SourceIntegrityGitPolicyRepo
 				for _, url := range repoURLs {
@@ -442,66 +524,29 @@ func NewProjectSourceIntegrityGitPoliciesUpdateCommand(clientOpts *argocdclient.
 				}
 			}
 
-			// There are no other types for now, so turning GPG on is the only sensible thing
-			if policy.GPG == nil {
-				policy.GPG = &v1alpha1.SourceIntegrityGitPolicyGPG{}
-			}
-
-			// Update gpg mode
-			if gpgMode != "" {
-				mode, err := validateGpgMode(gpgMode)
-				if err != nil {
+			if sshMode != "" || len(sshSigners) > 0 || len(deleteSigners) > 0 || len(addSigners) > 0 {
+				if err := updateSSHPolicy(policy, sshMode, sshSigners, deleteSigners, addSigners); err != nil {
 					return err
 				}
-				policy.GPG.Mode = mode
-			} else if policy.GPG.Mode == "" {
-				// The policy is updated to a gpg one, but this mandatory field is unset
-				return errors.New("gpg-mode must be set")
 			}
 
-			// Reset keys to a new set
-			if len(gpgKeys) > 0 {
-				policy.GPG.Keys = make([]string, len(gpgKeys))
-				for i, key := range gpgKeys {
-					key, err := sourceintegrity.KeyID(key)
-					if err != nil {
-						return fmt.Errorf("invalid GPG key ID '%s': %w", key, err)
-					}
-					policy.GPG.Keys[i] = key
-				}
-			}
-			for _, key := range deleteGPGKeys {
-				key, err := sourceintegrity.KeyID(key)
-				if err != nil {
-					return fmt.Errorf("invalid GPG key ID '%s': %w", key, err)
-				}
-				policy.GPG.Keys = slices.DeleteFunc(policy.GPG.Keys, func(k string) bool {
-					k, err := sourceintegrity.KeyID(k)
-					return err == nil && k == key
-				})
+			// Turn GPG on unless the policy is an SSH one, and there is nothing to change about GPG
+			gpgChanged := gpgMode != "" || len(gpgKeys) > 0 || len(deleteGPGKeys) > 0 || len(addGPGKeys) > 0
+			if policy.GPG == nil && (gpgChanged || policy.SSH == nil) {
+				policy.GPG = &v1alpha1.SourceIntegrityGitPolicyGPG{}
 			}
-			for _, key := range addGPGKeys {
-				key, err := sourceintegrity.KeyID(key)
-				if err != nil {
-					return fmt.Errorf("invalid GPG key ID '%s': %w", key, err)
-				}
-				found := slices.ContainsFunc(policy.GPG.Keys, func(k string) bool {
-					k, err := sourceintegrity.KeyID(k)
-					return err == nil && k == key
-				})
-				if !found {
-					policy.GPG.Keys = append(policy.GPG.Keys, key)
+			if policy.GPG != nil {
+				if err := updateGPGPolicy(policy, gpgMode, gpgKeys, deleteGPGKeys, addGPGKeys); err != nil {
+					return err
 				}
 			}
 
-			closer, gpgKeyClient := newGpgKeyClient(clientOpts, c)
-			defer utilio.Close(closer)
-			if err := warnOnProblems(c, policy, gpgKeyClient); err != nil {
+			if err := warnOnProblems(c, clientOpts, policy); err != nil {
 				return err
 			}
 
 			// Print resulting policies out of convenience
-			listGitGpgPolicies(c.OutOrStdout(), proj)
+			listGitPolicies(c.OutOrStdout(), proj)
 
 			if !yes {
 				prompt := fmt.Sprintf("Are you sure you want to update policy %d from project %q? [y/N] ", index, projName)
@@ -525,12 +570,106 @@ func NewProjectSourceIntegrityGitPoliciesUpdateCommand(clientOpts *argocdclient.
 	command.Flags().StringSliceVar(&gpgKeys, "gpg-key", []string{}, "Set GPG key ID (replaces existing)")
 	command.Flags().StringSliceVar(&addGPGKeys, "add-gpg-key", []string{}, "Add GPG key ID")
 	command.Flags().StringSliceVar(&deleteGPGKeys, "delete-gpg-key", []string{}, "Delete GPG key ID")
+	command.Flags().StringVar(&sshMode, "ssh-mode", "", "Set SSH verification mode (strict, head, or none)")
+	command.Flags().StringSliceVar(&sshSigners, "ssh-signer", []string{}, "Set SSH signer name (replaces existing)")
+	command.Flags().StringSliceVar(&addSigners, "add-ssh-signer", []string{}, "Add SSH signer name")
+	command.Flags().StringSliceVar(&deleteSigners, "delete-ssh-signer", []string{}, "Delete SSH signer name")
 	command.Flags().BoolVarP(&yes, "yes", "y", false, "Skip explicit confirmation")
 	return command
 }
 
-// warnOnProblems checks if a policy has empty repo URLs or GPG keys and prints warnings
-func warnOnProblems(c *cobra.Command, policy *v1alpha1.SourceIntegrityGitPolicy, gpgKeyClient gpgkey.GPGKeyServiceClient) error {
+// updateGPGPolicy applies the changes of GPG mode and keys to the policy
+func updateGPGPolicy(policy *v1alpha1.SourceIntegrityGitPolicy, gpgMode string, gpgKeys, deleteGPGKeys, addGPGKeys []string) error {
+	// Update gpg mode
+	if gpgMode != "" {
+		mode, err := validateGpgMode(gpgMode)
+		if err != nil {
+			return err
+		}
+		policy.GPG.Mode = mode
+	} else if policy.GPG.Mode == "" {
+		// The policy is updated to a gpg one, but this mandatory field is unset
+		return errors.New("gpg-mode must be set")
+	}
+
+	// Reset keys to a new set
+	if len(gpgKeys) > 0 {
+		policy.GPG.Keys = make([]string, len(gpgKeys))
+		for i, key := range gpgKeys {
+			key, err := sourceintegrity.KeyID(key)
+			if err != nil {
+				return fmt.Errorf("invalid GPG key ID '%s': %w", key, err)
+			}
+			policy.GPG.Keys[i] = key
+		}
+	}
+	for _, key := range deleteGPGKeys {
+		key, err := sourceintegrity.KeyID(key)
+		if err != nil {
+			return fmt.Errorf("invalid GPG key ID '%s': %w", key, err)
+		}
+		policy.GPG.Keys = slices.DeleteFunc(policy.GPG.Keys, func(k string) bool {
+			k, err := sourceintegrity.KeyID(k)
+			return err == nil && k == key
+		})
+	}
+	for _, key := range addGPGKeys {
+		key, err := sourceintegrity.KeyID(key)
+		if err != nil {
+			return fmt.Errorf("invalid GPG key ID '%s': %w", key, err)
+		}
+		found := slices.ContainsFunc(policy.GPG.Keys, func(k string) bool {
+			k, err := sourceintegrity.KeyID(k)
+			return err == nil && k == key
+		})
+		if !found {
+			policy.GPG.Keys = append(policy.GPG.Keys, key)
+		}
+	}
+
+	return nil
+}
+
+// updateSSHPolicy applies the changes of SSH mode and signers to the policy
+func updateSSHPolicy(policy *v1alpha1.SourceIntegrityGitPolicy, sshMode string, sshSigners, deleteSigners, addSigners []string) error {
+	if policy.SSH == nil {
+		policy.SSH = &v1alpha1.SourceIntegrityGitPolicySSH{}
+	}
+
+	if sshMode != "" {
+		mode, err := validateSSHMode(sshMode)
+		if err != nil {
+			return err
+		}
+		policy.SSH.Mode = mode
+	} else if policy.SSH.Mode == "" {
+		// The policy is updated to an ssh one, but this mandatory field is unset
+		return errors.New("ssh-mode must be set")
+	}
+
+	for _, signer := range slices.Concat(sshSigners, addSigners) {
+		if err := sourceintegrity.ValidateSSHSignerName(signer); err != nil {
+			return err
+		}
+	}
+	if len(sshSigners) > 0 {
+		policy.SSH.Signers = slices.Clone(sshSigners)
+	}
+	for _, signer := range deleteSigners {
+		policy.SSH.Signers = slices.DeleteFunc(policy.SSH.Signers, func(s string) bool {
+			return s == signer
+		})
+	}
+	for _, signer := range addSigners {
+		if !slices.Contains(policy.SSH.Signers, signer) {
+			policy.SSH.Signers = append(policy.SSH.Signers, signer)
+		}
+	}
+	return nil
+}
+
+// warnOnProblems checks if a policy has empty repo URLs, GPG keys or SSH signers and prints warnings
+func warnOnProblems(c *cobra.Command, clientOpts *argocdclient.ClientOptions, policy *v1alpha1.SourceIntegrityGitPolicy) error {
 	stderr := c.ErrOrStderr()
 	if len(policy.Repos) == 0 {
 		_, _ = fmt.Fprintln(stderr, "Warning: Policy has no repository URLs and will never be used")
@@ -544,6 +683,8 @@ func warnOnProblems(c *cobra.Command, policy *v1alpha1.SourceIntegrityGitPolicy,
 				absent[key] = nil
 			}
 
+			closer, gpgKeyClient := newGpgKeyClient(clientOpts, c)
+			defer utilio.Close(closer)
 			keyring, err := gpgKeyClient.List(c.Context(), &gpgkey.GnuPGPublicKeyQuery{})
 			if err != nil {
 				return fmt.Errorf("failed listing GPG keys: %w", err)
@@ -562,6 +703,31 @@ func warnOnProblems(c *cobra.Command, policy *v1alpha1.SourceIntegrityGitPolicy,
 			}
 		}
 	}
+	if policy.SSH != nil {
+		if len(policy.SSH.Signers) == 0 {
+			_, _ = fmt.Fprintln(stderr, "Warning: Policy has no SSH signers and will never validate any revision")
+		} else {
+			closer, sshSignerClient := newSSHSignerClient(clientOpts, c)
+			defer utilio.Close(closer)
+			configured, err := sshSignerClient.List(c.Context(), &sshsigner.SSHSignerQuery{})
+			if err != nil {
+				return fmt.Errorf("failed listing SSH signers: %w", err)
+			}
+
+			var absentSigners []string
+			for _, signer := range policy.SSH.Signers {
+				if !slices.ContainsFunc(configured.Items, func(s v1alpha1.SSHSigner) bool { return s.Name == signer }) {
+					absentSigners = append(absentSigners, signer)
+				}
+			}
+			if len(absentSigners) != 0 {
+				_, _ = fmt.Fprintf(stderr,
+					"Warning: Following SSH signers are not configured: %s\n",
+					strings.Join(absentSigners, ", "),
+				)
+			}
+		}
+	}
 
 	return nil
 }
@@ -17,6 +17,8 @@ import (
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/gpgkey"
 	gpgkeymocks "github.com/argoproj/argo-cd/v3/pkg/apiclient/gpgkey/mocks"
 	projectmocks "github.com/argoproj/argo-cd/v3/pkg/apiclient/project/mocks"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/sshsigner"
+	sshsignermocks "github.com/argoproj/argo-cd/v3/pkg/apiclient/sshsigner/mocks"
 
 	projectpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
 	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -92,6 +94,20 @@ func mockKeyring(mockClient *gpgkeymocks.GPGKeyServiceClient, keys ...string) *m
 	return mockClient.On("List", mock.Anything, mock.Anything).Return(keyring, nil).Maybe()
 }
 
+// mockSSHSigners fakes that signers are added through `argocd ssh-signer add`, so those do not show up in warnings.
+func mockSSHSigners(t *testing.T, names ...string) {
+	t.Helper()
+	mockClient := sshsignermocks.NewSSHSignerServiceClient(t)
+	newSSHSignerClient = func(_ *argocdclient.ClientOptions, _ *cobra.Command) (io.Closer, sshsigner.SSHSignerServiceClient) {
+		return io.NopCloser(nil), mockClient
+	}
+	items := make([]appsv1.SSHSigner, 0, len(names))
+	for _, name := range names {
+		items = append(items, appsv1.SSHSigner{Name: name})
+	}
+	mockClient.On("List", mock.Anything, mock.Anything).Return(&appsv1.SSHSignerList{Items: items}, nil).Maybe()
+}
+
 func runCmd(t *testing.T, cmd *cobra.Command, args ...string) (stdout string, stderr string, e error) {
 	t.Helper()
 	cmd.SilenceErrors = true
@@ -621,3 +637,97 @@ func captureProjectUpdate(t *testing.T, projects *projectmocks.ProjectServiceCli
 	projects.AssertCalled(t, "Update", mock.Anything, capture)
 	return updatedProject
 }
+
+func TestProjectSourceIntegritySSH(t *testing.T) {
+	projectName := "test-project"
+
+	mockKeyring(mockGpgKeysClient(t), "ABCD1234ABCD1234")
+	mockSSHSigners(t, "alice")
+
+	t.Run("Add SSH only policy", func(t *testing.T) {
+		projects := mockProjectClient(t)
+		projects.On("Update", mock.Anything, mock.Anything).Return(nil, nil)
+		mockProjectGet(projects, projectName, dummyProject(projectName, nil), nil).Maybe()
+
+		cmd := NewProjectSourceIntegrityGitPoliciesAddCommand(&argocdclient.ClientOptions{})
+		out, stderr, err := runCmd(t, cmd, "--ssh-mode=strict", "--ssh-signer=alice", "--ssh-signer=bob", "--repo-url=*", projectName)
+		require.NoError(t, err)
+
+		expectedOut := `ID	GPG-MODE	GPG-KEYS	SSH-MODE	SSH-SIGNERS	REPO-URLS
+0	<none>	<none>	strict	alice, bob	*
+`
+		tabbedOut := regexp.MustCompile(" {2,}").ReplaceAllString(out, "\t")
+		assert.Equal(t, expectedOut, tabbedOut)
+		assert.Equal(t, "Warning: Following SSH signers are not configured: bob\n", stderr)
+
+		updatedProject := captureProjectUpdate(t, projects, projectName)
+		require.NotNil(t, updatedProject)
+		assert.Equal(t, []*appsv1.SourceIntegrityGitPolicy{{
+			Repos: []appsv1.SourceIntegrityGitPolicyRepo{{URL: "*"}},
+			SSH: &appsv1.SourceIntegrityGitPolicySSH{
+				Mode:    appsv1.SourceIntegrityGitPolicySSHModeStrict,
+				Signers: []string{"alice", "bob"},
+			},
+		}}, updatedProject.Spec.SourceIntegrity.Git.Policies)
+	})
+
+	t.Run("Add SSH policy without ssh-mode", func(t *testing.T) {
+		projects := mockProjectClient(t)
+		mockProjectGet(projects, projectName, dummyProject(projectName, nil), nil).Maybe()
+
+		cmd := NewProjectSourceIntegrityGitPoliciesAddCommand(&argocdclient.ClientOptions{})
+		_, stderr, err := runCmd(t, cmd, "--ssh-signer=alice", "--repo-url=*", projectName)
+		require.Error(t, err)
+		assert.Equal(t, "Error: ssh-mode must be set\n", stderr)
+	})
+
+	t.Run("Update GPG policy to accept SSH signatures", func(t *testing.T) {
+		projects := mockProjectClient(t)
+		projects.On("Update", mock.Anything, mock.Anything).Return(nil, nil)
+		mockProjectGet(projects, projectName, dummyProject(projectName, dummySourceIntegrity()), nil).Maybe()
+
+		cmd := NewProjectSourceIntegrityGitPoliciesUpdateCommand(&argocdclient.ClientOptions{})
+		out, stderr, err := runCmd(t, cmd, projectName, "0", "--ssh-mode=head", "--add-ssh-signer=alice", "--yes")
+		require.NoError(t, err)
+
+		expectedOut := `ID	GPG-MODE	GPG-KEYS	SSH-MODE	SSH-SIGNERS	REPO-URLS
+0	head	ABCD1234ABCD1234	head	alice	*, !https://github.com/argoproj/argo-cd.git
+1	strict	1234ABCD1234ABCD	<none>	<none>	https://github.com/argoproj/argo-cd.git
+`
+		tabbedOut := regexp.MustCompile(" {2,}").ReplaceAllString(out, "\t")
+		assert.Equal(t, expectedOut, tabbedOut)
+		assert.Empty(t, stderr)
+
+		updatedProject := captureProjectUpdate(t, projects, projectName)
+		require.NotNil(t, updatedProject)
+		policy := updatedProject.Spec.SourceIntegrity.Git.Policies[0]
+		assert.Equal(t, &appsv1.SourceIntegrityGitPolicyGPG{
+			Mode: appsv1.SourceIntegrityGitPolicyGPGModeHead,
+			Keys: []string{"ABCD1234ABCD1234"},
+		}, policy.GPG)
+		assert.Equal(t, &appsv1.SourceIntegrityGitPolicySSH{
+			Mode:    appsv1.SourceIntegrityGitPolicySSHModeHead,
+			Signers: []string{"alice"},
+		}, policy.SSH)
+	})
+
+	t.Run("Update SSH policy does not turn GPG on", func(t *testing.T) {
+		si := &appsv1.SourceIntegrity{Git: &appsv1.SourceIntegrityGit{Policies: []*appsv1.SourceIntegrityGitPolicy{{
+			Repos: []appsv1.SourceIntegrityGitPolicyRepo{{URL: "*"}},
+			SSH:   &appsv1.SourceIntegrityGitPolicySSH{Mode: appsv1.SourceIntegrityGitPolicySSHModeHead, Signers: []string{"alice", "bob"}},
+		}}}}
+		projects := mockProjectClient(t)
+		projects.On("Update", mock.Anything, mock.Anything).Return(nil, nil)
+		mockProjectGet(projects, projectName, dummyProject(projectName, si), nil).Maybe()
+
+		cmd := NewProjectSourceIntegrityGitPoliciesUpdateCommand(&argocdclient.ClientOptions{})
+		_, _, err := runCmd(t, cmd, projectName, "0", "--delete-ssh-signer=bob", "--yes")
+		require.NoError(t, err)
+
+		updatedProject := captureProjectUpdate(t, projects, projectName)
+		require.NotNil(t, updatedProject)
+		policy := updatedProject.Spec.SourceIntegrity.Git.Policies[0]
+		assert.Nil(t, policy.GPG)
+		assert.Equal(t, []string{"alice"}, policy.SSH.Signers)
+	})
+}
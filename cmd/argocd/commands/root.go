@@ -66,6 +66,8 @@ func NewCommand() *cobra.Command {
 	command.AddCommand(NewLogoutCommand(&clientOpts))
 	command.AddCommand(initialize.InitCommand(NewCertCommand(&clientOpts)))
 	command.AddCommand(initialize.InitCommand(NewGPGCommand(&clientOpts)))
+	command.AddCommand(initialize.InitCommand(NewSSHSignerCommand(&clientOpts)))
+	command.AddCommand(initialize.InitCommand(NewCosignKeyCommand(&clientOpts)))
 	command.AddCommand(admin.NewAdminCommand(&clientOpts))
 	command.AddCommand(initialize.InitCommand(NewConfigureCommand(&clientOpts)))
 
@@ -0,0 +1,202 @@
+package commands
+
+import (
+	stderrors "errors"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/headless"
+	"github.com/argoproj/argo-cd/v3/cmd/argocd/commands/utils"
+	argocdclient "github.com/argoproj/argo-cd/v3/pkg/apiclient"
+	sshsignerpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/sshsigner"
+	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/errors"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/templates"
+)
+
+// NewSSHSignerCommand returns a new instance of an `argocd ssh-signer` command
+func NewSSHSignerCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "ssh-signer",
+		Short: "Manage SSH signers allowed to sign git commits",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+		Example: ``,
+	}
+	command.AddCommand(NewSSHSignerListCommand(clientOpts))
+	command.AddCommand(NewSSHSignerGetCommand(clientOpts))
+	command.AddCommand(NewSSHSignerAddCommand(clientOpts))
+	command.AddCommand(NewSSHSignerDeleteCommand(clientOpts))
+	return command
+}
+
+// NewSSHSignerListCommand lists all configured SSH signers from the server
+func NewSSHSignerListCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "list",
+		Short: "List configured SSH signers",
+		Example: templates.Examples(`
+  # List all configured SSH signers in wide format (default).
+  argocd ssh-signer list
+
+  # List all configured SSH signers in JSON format.
+  argocd ssh-signer list -o json
+  		`),
+
+		Run: func(c *cobra.Command, _ []string) {
+			ctx := c.Context()
+
+			conn, signerIf := headless.NewClientOrDie(clientOpts, c).NewSSHSignerClientOrDie()
+			defer utilio.Close(conn)
+			signers, err := signerIf.List(ctx, &sshsignerpkg.SSHSignerQuery{})
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(signers.Items, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				printSSHSignerTable(signers.Items)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// NewSSHSignerGetCommand retrieves a single SSH signer from the server
+func NewSSHSignerGetCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var output string
+	command := &cobra.Command{
+		Use:   "get NAME",
+		Short: "Get the SSH signer with name <NAME> from the server",
+		Example: templates.Examples(`
+  # Get an SSH signer with the specified NAME in wide format (default).
+  argocd ssh-signer get NAME
+
+  # Get an SSH signer with the specified NAME in YAML format.
+  argocd ssh-signer get NAME -o yaml
+  		`),
+
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				errors.Fatal(errors.ErrorGeneric, "Missing NAME argument")
+			}
+			conn, signerIf := headless.NewClientOrDie(clientOpts, c).NewSSHSignerClientOrDie()
+			defer utilio.Close(conn)
+			signer, err := signerIf.Get(ctx, &sshsignerpkg.SSHSignerQuery{Name: args[0]})
+			errors.CheckError(err)
+			switch output {
+			case "yaml", "json":
+				err := PrintResourceList(signer, output, false)
+				errors.CheckError(err)
+			case "wide", "":
+				fmt.Printf("Name:         %s\n", signer.Name)
+				fmt.Printf("Fingerprints: %s\n", strings.Join(signer.Fingerprints, ", "))
+				fmt.Printf("Key data follows until EOF:\n%s\n", signer.KeyData)
+			default:
+				errors.CheckError(fmt.Errorf("unknown output format: %s", output))
+			}
+		},
+	}
+	command.Flags().StringVarP(&output, "output", "o", "wide", "Output format. One of: json|yaml|wide")
+	return command
+}
+
+// NewSSHSignerAddCommand adds an SSH signer to the server's configuration
+func NewSSHSignerAddCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	var (
+		fromFile string
+		upsert   bool
+	)
+	command := &cobra.Command{
+		Use:   "add NAME",
+		Short: "Adds an SSH signer with its public keys to the server's configuration",
+		Example: templates.Examples(`
+  # Add an SSH signer from a public key file.
+  argocd ssh-signer add alice --from ~/.ssh/id_ed25519.pub
+
+  # Replace the public keys of an existing SSH signer, the file can contain multiple keys, one per line.
+  argocd ssh-signer add alice --from /path/to/authorized_keys --upsert
+  		`),
+
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				errors.Fatal(errors.ErrorGeneric, "Missing NAME argument")
+			}
+			if fromFile == "" {
+				errors.CheckError(stderrors.New("--from is mandatory"))
+			}
+			keyData, err := os.ReadFile(fromFile)
+			if err != nil {
+				errors.CheckError(err)
+			}
+			conn, signerIf := headless.NewClientOrDie(clientOpts, c).NewSSHSignerClientOrDie()
+			defer utilio.Close(conn)
+			signer, err := signerIf.Create(ctx, &sshsignerpkg.SSHSignerCreateRequest{
+				Signer: &appsv1.SSHSigner{Name: args[0], KeyData: string(keyData)},
+				Upsert: upsert,
+			})
+			errors.CheckError(err)
+			fmt.Printf("SSH signer '%s' added with %d key(s)\n", signer.Name, len(signer.Fingerprints))
+		},
+	}
+	command.Flags().StringVarP(&fromFile, "from", "f", "", "Path to the file that contains the SSH public keys of the signer")
+	command.Flags().BoolVar(&upsert, "upsert", false, "Override the keys of the signer if it already exists")
+	return command
+}
+
+// NewSSHSignerDeleteCommand removes an SSH signer from the server's configuration
+func NewSSHSignerDeleteCommand(clientOpts *argocdclient.ClientOptions) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "rm NAME",
+		Short: "Removes an SSH signer from the server's configuration",
+		Run: func(c *cobra.Command, args []string) {
+			ctx := c.Context()
+
+			if len(args) != 1 {
+				errors.Fatal(errors.ErrorGeneric, "Missing NAME argument")
+			}
+
+			name := args[0]
+
+			conn, signerIf := headless.NewClientOrDie(clientOpts, c).NewSSHSignerClientOrDie()
+			defer utilio.Close(conn)
+
+			promptUtil := utils.NewPrompt(clientOpts.PromptsEnabled)
+			canDelete := promptUtil.Confirm(fmt.Sprintf("Are you sure you want to remove '%s'? [y/n] ", name))
+			if canDelete {
+				_, err := signerIf.Delete(ctx, &sshsignerpkg.SSHSignerQuery{Name: name})
+				errors.CheckError(err)
+				fmt.Printf("Deleted SSH signer '%s'\n", name)
+			} else {
+				fmt.Printf("The command to delete SSH signer '%s' was cancelled.\n", name)
+			}
+		},
+	}
+	return command
+}
+
+// Print table of SSH signers
+func printSSHSignerTable(signers []appsv1.SSHSigner) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprint(w, "NAME\tFINGERPRINTS\n")
+
+	for _, s := range signers {
+		fmt.Fprintf(w, "%s\t%s\n", s.Name, strings.Join(s.Fingerprints, ","))
+	}
+	_ = w.Flush()
+}
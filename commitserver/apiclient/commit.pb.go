@@ -49,7 +49,10 @@ type CommitHydratedManifestsRequest struct {
 	// AuthorEmail is the author email to use for the commit. If empty, defaults to "argo-cd@example.com".
 	AuthorEmail string `protobuf:"bytes,9,opt,name=authorEmail,proto3" json:"authorEmail,omitempty"`
 	// ReadmeMessage is the message content for README template updates.
-	ReadmeMessage        string   `protobuf:"bytes,10,opt,name=readmeMessage,proto3" json:"readmeMessage,omitempty"`
+	ReadmeMessage string `protobuf:"bytes,10,opt,name=readmeMessage,proto3" json:"readmeMessage,omitempty"`
+	// SensitiveAnnotations are the keys of the Secret annotations whose values are redacted, along with the Secret data,
+	// in the diff returned by DiffHydratedManifests. It is not used when committing.
+	SensitiveAnnotations []string `protobuf:"bytes,11,rep,name=sensitiveAnnotations,proto3" json:"sensitiveAnnotations,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -158,6 +161,13 @@ func (m *CommitHydratedManifestsRequest) GetReadmeMessage() string {
 	return ""
 }
 
+func (m *CommitHydratedManifestsRequest) GetSensitiveAnnotations() []string {
+	if m != nil {
+		return m.SensitiveAnnotations
+	}
+	return nil
+}
+
 // PathDetails holds information about hydrated manifests to be written to a particular path in the hydrated manifests
 // commit.
 type PathDetails struct {
@@ -166,7 +176,9 @@ type PathDetails struct {
 	// Manifests contains the manifests to write to the path.
 	Manifests []*HydratedManifestDetails `protobuf:"bytes,2,rep,name=manifests,proto3" json:"manifests,omitempty"`
 	// Commands contains the commands executed when hydrating the manifests.
-	Commands             []string `protobuf:"bytes,3,rep,name=commands,proto3" json:"commands,omitempty"`
+	Commands []string `protobuf:"bytes,3,rep,name=commands,proto3" json:"commands,omitempty"`
+	// Layout is the way the manifests are laid out in files within the path. Defaults to SingleFile.
+	Layout               string   `protobuf:"bytes,4,opt,name=layout,proto3" json:"layout,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -226,6 +238,13 @@ func (m *PathDetails) GetCommands() []string {
 	return nil
 }
 
+func (m *PathDetails) GetLayout() string {
+	if m != nil {
+		return m.Layout
+	}
+	return ""
+}
+
 // ManifestDetails contains the hydrated manifests.
 type HydratedManifestDetails struct {
 	// ManifestJSON is the hydrated manifest as JSON.
@@ -324,50 +343,229 @@ func (m *CommitHydratedManifestsResponse) GetHydratedSha() string {
 	return ""
 }
 
+// PromoteHydratedManifestsRequest is the request to promote a hydrated manifests commit to the sync branch.
+type PromoteHydratedManifestsRequest struct {
+	// Repo contains repository information including, at minimum, the URL of the repository. Generally it will contain
+	// repo credentials.
+	Repo *v1alpha1.Repository `protobuf:"bytes,1,opt,name=repo,proto3" json:"repo,omitempty"`
+	// TargetBranch is the branch the hydrated manifests are promoted to, i.e. the sync branch.
+	TargetBranch string `protobuf:"bytes,2,opt,name=targetBranch,proto3" json:"targetBranch,omitempty"`
+	// HydratedSha is the commit SHA of the hydrated manifests commit to promote, i.e. a commit of the hydrateTo branch.
+	HydratedSha          string   `protobuf:"bytes,3,opt,name=hydratedSha,proto3" json:"hydratedSha,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PromoteHydratedManifestsRequest) Reset()         { *m = PromoteHydratedManifestsRequest{} }
+func (m *PromoteHydratedManifestsRequest) String() string { return proto.CompactTextString(m) }
+func (*PromoteHydratedManifestsRequest) ProtoMessage()    {}
+func (*PromoteHydratedManifestsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf3a3abbc35e3069, []int{4}
+}
+func (m *PromoteHydratedManifestsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PromoteHydratedManifestsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PromoteHydratedManifestsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PromoteHydratedManifestsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromoteHydratedManifestsRequest.Merge(m, src)
+}
+func (m *PromoteHydratedManifestsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PromoteHydratedManifestsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromoteHydratedManifestsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromoteHydratedManifestsRequest proto.InternalMessageInfo
+
+func (m *PromoteHydratedManifestsRequest) GetRepo() *v1alpha1.Repository {
+	if m != nil {
+		return m.Repo
+	}
+	return nil
+}
+
+func (m *PromoteHydratedManifestsRequest) GetTargetBranch() string {
+	if m != nil {
+		return m.TargetBranch
+	}
+	return ""
+}
+
+func (m *PromoteHydratedManifestsRequest) GetHydratedSha() string {
+	if m != nil {
+		return m.HydratedSha
+	}
+	return ""
+}
+
+// PromoteHydratedManifestsResponse is the response to the PromoteHydratedManifestsRequest.
+type PromoteHydratedManifestsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PromoteHydratedManifestsResponse) Reset()         { *m = PromoteHydratedManifestsResponse{} }
+func (m *PromoteHydratedManifestsResponse) String() string { return proto.CompactTextString(m) }
+func (*PromoteHydratedManifestsResponse) ProtoMessage()    {}
+func (*PromoteHydratedManifestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf3a3abbc35e3069, []int{5}
+}
+func (m *PromoteHydratedManifestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PromoteHydratedManifestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PromoteHydratedManifestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PromoteHydratedManifestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromoteHydratedManifestsResponse.Merge(m, src)
+}
+func (m *PromoteHydratedManifestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PromoteHydratedManifestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromoteHydratedManifestsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromoteHydratedManifestsResponse proto.InternalMessageInfo
+
+// DiffHydratedManifestsResponse is the response to a DiffHydratedManifests request.
+type DiffHydratedManifestsResponse struct {
+	// HydratedSha is the commit SHA the diff is computed against, i.e. the tip of the target branch, or of the sync
+	// branch if the target branch does not exist yet.
+	HydratedSha string `protobuf:"bytes,1,opt,name=hydratedSha,proto3" json:"hydratedSha,omitempty"`
+	// Diff is the unified diff of the changes a commit of the hydrated manifests would contain, with the values of Secrets
+	// redacted. It is empty if the manifests did not change.
+	Diff                 string   `protobuf:"bytes,2,opt,name=diff,proto3" json:"diff,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DiffHydratedManifestsResponse) Reset()         { *m = DiffHydratedManifestsResponse{} }
+func (m *DiffHydratedManifestsResponse) String() string { return proto.CompactTextString(m) }
+func (*DiffHydratedManifestsResponse) ProtoMessage()    {}
+func (*DiffHydratedManifestsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cf3a3abbc35e3069, []int{6}
+}
+func (m *DiffHydratedManifestsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiffHydratedManifestsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DiffHydratedManifestsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DiffHydratedManifestsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiffHydratedManifestsResponse.Merge(m, src)
+}
+func (m *DiffHydratedManifestsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiffHydratedManifestsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiffHydratedManifestsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiffHydratedManifestsResponse proto.InternalMessageInfo
+
+func (m *DiffHydratedManifestsResponse) GetHydratedSha() string {
+	if m != nil {
+		return m.HydratedSha
+	}
+	return ""
+}
+
+func (m *DiffHydratedManifestsResponse) GetDiff() string {
+	if m != nil {
+		return m.Diff
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*CommitHydratedManifestsRequest)(nil), "CommitHydratedManifestsRequest")
 	proto.RegisterType((*PathDetails)(nil), "PathDetails")
 	proto.RegisterType((*HydratedManifestDetails)(nil), "HydratedManifestDetails")
 	proto.RegisterType((*CommitHydratedManifestsResponse)(nil), "CommitHydratedManifestsResponse")
+	proto.RegisterType((*PromoteHydratedManifestsRequest)(nil), "PromoteHydratedManifestsRequest")
+	proto.RegisterType((*PromoteHydratedManifestsResponse)(nil), "PromoteHydratedManifestsResponse")
+	proto.RegisterType((*DiffHydratedManifestsResponse)(nil), "DiffHydratedManifestsResponse")
 }
 
 func init() { proto.RegisterFile("commitserver/commit/commit.proto", fileDescriptor_cf3a3abbc35e3069) }
 
 var fileDescriptor_cf3a3abbc35e3069 = []byte{
-	// 520 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x95, 0xb5, 0x2b, 0xab, 0xbb, 0x1d, 0xf0, 0x81, 0x59, 0x3d, 0x74, 0x51, 0xc4, 0xa1,
-	0x17, 0x1c, 0xad, 0x15, 0xdc, 0xb8, 0xac, 0x20, 0x4d, 0x88, 0x15, 0x94, 0xde, 0xd0, 0x24, 0xf4,
-	0x96, 0x3c, 0x12, 0xb3, 0x26, 0x36, 0xb6, 0x1b, 0xa9, 0x12, 0x9f, 0x80, 0x4f, 0xc6, 0x91, 0x8f,
-	0x80, 0xfa, 0x49, 0x50, 0x9c, 0x84, 0xb6, 0xa0, 0xb2, 0x03, 0xa7, 0xfa, 0xfd, 0xdf, 0xeb, 0xff,
-	0xd9, 0x3f, 0x3f, 0x87, 0xf8, 0xb1, 0xcc, 0x73, 0x61, 0x0d, 0xea, 0x12, 0x75, 0x58, 0x07, 0xcd,
-	0x0f, 0x57, 0x5a, 0x5a, 0x39, 0x7c, 0x9b, 0x0a, 0x9b, 0xad, 0xee, 0x78, 0x2c, 0xf3, 0x10, 0x74,
-	0x2a, 0x95, 0x96, 0x9f, 0xdd, 0xe2, 0x59, 0x9c, 0x84, 0xe5, 0x34, 0x54, 0xf7, 0x69, 0x08, 0x4a,
-	0x98, 0x10, 0x94, 0x5a, 0x8a, 0x18, 0xac, 0x90, 0x45, 0x58, 0x5e, 0xc2, 0x52, 0x65, 0x70, 0x19,
-	0xa6, 0x58, 0xa0, 0x06, 0x8b, 0x49, 0xed, 0x16, 0x7c, 0xeb, 0x92, 0xd1, 0xcc, 0xd9, 0x5f, 0xaf,
-	0x13, 0x97, 0xb8, 0x81, 0x42, 0x7c, 0x42, 0x63, 0x4d, 0x84, 0x5f, 0x56, 0x68, 0x2c, 0xbd, 0x25,
-	0x5d, 0x8d, 0x4a, 0x32, 0xcf, 0xf7, 0xc6, 0x83, 0xc9, 0x35, 0xdf, 0xf6, 0xe7, 0x6d, 0x7f, 0xb7,
-	0xf8, 0x18, 0x27, 0xbc, 0x9c, 0x72, 0x75, 0x9f, 0xf2, 0xaa, 0x3f, 0xdf, 0xe9, 0xcf, 0xdb, 0xfe,
-	0x3c, 0x42, 0x25, 0x8d, 0xb0, 0x52, 0xaf, 0x23, 0xe7, 0x4a, 0x47, 0x84, 0x98, 0x75, 0x11, 0x5f,
-	0x69, 0x28, 0xe2, 0x8c, 0x1d, 0xf9, 0xde, 0xb8, 0x1f, 0xed, 0x28, 0x34, 0x20, 0xa7, 0x16, 0x74,
-	0x8a, 0xb6, 0xa9, 0xe8, 0xb8, 0x8a, 0x3d, 0x8d, 0x3e, 0x21, 0xbd, 0x44, 0xaf, 0x17, 0x19, 0xb0,
-	0xae, 0xcb, 0x36, 0x11, 0x7d, 0x4a, 0xce, 0x6a, 0x74, 0x37, 0x68, 0x0c, 0xa4, 0xc8, 0x8e, 0x5d,
-	0x7a, 0x5f, 0xa4, 0x01, 0x39, 0x56, 0x60, 0x33, 0xc3, 0x7a, 0x7e, 0x67, 0x3c, 0x98, 0x9c, 0xf2,
-	0xf7, 0x60, 0xb3, 0x57, 0x68, 0x41, 0x2c, 0x4d, 0x54, 0xa7, 0xe8, 0x57, 0xf2, 0x38, 0xd1, 0xeb,
-	0x59, 0xf3, 0x3f, 0x0b, 0x09, 0x58, 0x60, 0x8f, 0x1c, 0x90, 0xf9, 0xff, 0x02, 0x29, 0x85, 0x11,
-	0xb2, 0x68, 0x5d, 0xa3, 0xbf, 0x1b, 0x55, 0x8c, 0x60, 0x65, 0x33, 0xa9, 0xe7, 0x90, 0x23, 0x3b,
-	0xa9, 0x19, 0x6d, 0x15, 0xea, 0x93, 0x41, 0x1d, 0xbd, 0xce, 0x41, 0x2c, 0x59, 0xdf, 0x15, 0xec,
-	0x4a, 0x15, 0x09, 0x8d, 0x90, 0xe4, 0xd8, 0x92, 0x20, 0x35, 0x89, 0x3d, 0x31, 0x58, 0x91, 0xc1,
-	0xce, 0xd9, 0x29, 0x25, 0xdd, 0xea, 0xf4, 0xee, 0xe2, 0xfb, 0x91, 0x5b, 0xd3, 0x17, 0xa4, 0x9f,
-	0xb7, 0x03, 0xc2, 0x8e, 0x1c, 0x30, 0xc6, 0xff, 0x1c, 0x9d, 0x16, 0xde, 0xb6, 0x94, 0x0e, 0xc9,
-	0x49, 0x45, 0x1d, 0x8a, 0xc4, 0xb0, 0x8e, 0xdf, 0x19, 0xf7, 0xa3, 0xdf, 0x71, 0xf0, 0x92, 0x9c,
-	0x1f, 0x70, 0xa8, 0x6e, 0xbf, 0xf5, 0x78, 0xb3, 0x78, 0x37, 0x6f, 0xb6, 0xb2, 0xa7, 0x05, 0x33,
-	0x72, 0x71, 0x70, 0x82, 0x8d, 0x92, 0x85, 0x71, 0x80, 0xb2, 0x26, 0x59, 0x4d, 0x49, 0xed, 0xb2,
-	0x2b, 0x4d, 0x72, 0x72, 0x56, 0x9b, 0x2c, 0x50, 0x97, 0x22, 0x46, 0x7a, 0x4b, 0xce, 0x0f, 0xb8,
-	0xd2, 0x0b, 0xfe, 0xef, 0x17, 0x33, 0xf4, 0xf9, 0x03, 0x1b, 0xba, 0x9a, 0x7d, 0xdf, 0x8c, 0xbc,
-	0x1f, 0x9b, 0x91, 0xf7, 0x73, 0x33, 0xf2, 0x3e, 0x3c, 0x7f, 0xe0, 0x49, 0xef, 0x7d, 0x13, 0x40,
-	0x89, 0x78, 0x29, 0xb0, 0xb0, 0x77, 0x3d, 0xf7, 0x84, 0xa7, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff,
-	0xde, 0xe2, 0xc1, 0x3d, 0x34, 0x04, 0x00, 0x00,
+	// 643 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x95, 0xc1, 0x6e, 0xd3, 0x4c,
+	0x10, 0xc7, 0xe5, 0x24, 0xed, 0xd7, 0x4c, 0xda, 0xc3, 0xb7, 0x02, 0xba, 0x8a, 0x44, 0x6a, 0x2c,
+	0x0e, 0xb9, 0xb0, 0x56, 0x53, 0xc1, 0x8d, 0x03, 0x4d, 0x91, 0x2a, 0x44, 0x4b, 0xe5, 0x0a, 0x09,
+	0xa1, 0x4a, 0xd5, 0xd6, 0xde, 0xd8, 0x4b, 0x63, 0xef, 0xb2, 0xbb, 0xb1, 0x14, 0x89, 0x57, 0xe0,
+	0x3d, 0x78, 0x0a, 0x6e, 0x48, 0x1c, 0x79, 0x04, 0xd4, 0x27, 0x41, 0x5e, 0xdb, 0x34, 0x69, 0x93,
+	0xe6, 0xd0, 0x03, 0xa7, 0xec, 0xfc, 0x67, 0x33, 0x33, 0xfb, 0x9b, 0x59, 0x2f, 0xb8, 0xa1, 0x48,
+	0x53, 0x6e, 0x34, 0x53, 0x39, 0x53, 0x7e, 0x69, 0x54, 0x3f, 0x44, 0x2a, 0x61, 0x44, 0xf7, 0x6d,
+	0xcc, 0x4d, 0x32, 0xb9, 0x20, 0xa1, 0x48, 0x7d, 0xaa, 0x62, 0x21, 0x95, 0xf8, 0x64, 0x17, 0xcf,
+	0xc2, 0xc8, 0xcf, 0xf7, 0x7c, 0x79, 0x19, 0xfb, 0x54, 0x72, 0xed, 0x53, 0x29, 0xc7, 0x3c, 0xa4,
+	0x86, 0x8b, 0xcc, 0xcf, 0x77, 0xe9, 0x58, 0x26, 0x74, 0xd7, 0x8f, 0x59, 0xc6, 0x14, 0x35, 0x2c,
+	0x2a, 0xa3, 0x79, 0xdf, 0x5b, 0xd0, 0x1b, 0xda, 0xf0, 0x87, 0xd3, 0xc8, 0x3a, 0x8e, 0x68, 0xc6,
+	0x47, 0x4c, 0x1b, 0x1d, 0xb0, 0xcf, 0x13, 0xa6, 0x0d, 0x3a, 0x83, 0x96, 0x62, 0x52, 0x60, 0xc7,
+	0x75, 0xfa, 0x9d, 0xc1, 0x21, 0xb9, 0xce, 0x4f, 0xea, 0xfc, 0x76, 0x71, 0x1e, 0x46, 0x24, 0xdf,
+	0x23, 0xf2, 0x32, 0x26, 0x45, 0x7e, 0x32, 0x93, 0x9f, 0xd4, 0xf9, 0x49, 0xc0, 0xa4, 0xd0, 0xdc,
+	0x08, 0x35, 0x0d, 0x6c, 0x54, 0xd4, 0x03, 0xd0, 0xd3, 0x2c, 0xdc, 0x57, 0x34, 0x0b, 0x13, 0xdc,
+	0x70, 0x9d, 0x7e, 0x3b, 0x98, 0x51, 0x90, 0x07, 0x9b, 0x86, 0xaa, 0x98, 0x99, 0x6a, 0x47, 0xd3,
+	0xee, 0x98, 0xd3, 0xd0, 0x23, 0x58, 0x8f, 0xd4, 0xf4, 0x34, 0xa1, 0xb8, 0x65, 0xbd, 0x95, 0x85,
+	0x9e, 0xc2, 0x56, 0x89, 0xee, 0x88, 0x69, 0x4d, 0x63, 0x86, 0xd7, 0xac, 0x7b, 0x5e, 0x44, 0x1e,
+	0xac, 0x49, 0x6a, 0x12, 0x8d, 0xd7, 0xdd, 0x66, 0xbf, 0x33, 0xd8, 0x24, 0x27, 0xd4, 0x24, 0x07,
+	0xcc, 0x50, 0x3e, 0xd6, 0x41, 0xe9, 0x42, 0x5f, 0xe0, 0xff, 0x48, 0x4d, 0x87, 0xd5, 0xff, 0x0c,
+	0x8d, 0xa8, 0xa1, 0xf8, 0x3f, 0x0b, 0xe4, 0xf8, 0xbe, 0x40, 0x72, 0xae, 0xb9, 0xc8, 0xea, 0xa8,
+	0xc1, 0xed, 0x44, 0x05, 0x23, 0x3a, 0x31, 0x89, 0x50, 0xc7, 0x34, 0x65, 0x78, 0xa3, 0x64, 0x74,
+	0xad, 0x20, 0x17, 0x3a, 0xa5, 0xf5, 0x3a, 0xa5, 0x7c, 0x8c, 0xdb, 0x76, 0xc3, 0xac, 0x54, 0x90,
+	0x50, 0x8c, 0x46, 0x29, 0xab, 0x49, 0x40, 0x49, 0x62, 0x4e, 0x44, 0x03, 0x78, 0xa0, 0x59, 0xa6,
+	0xb9, 0xe1, 0x39, 0x7b, 0x95, 0x65, 0xc2, 0xd8, 0x5a, 0x35, 0xee, 0xb8, 0xcd, 0x7e, 0x3b, 0x58,
+	0xe8, 0xf3, 0xbe, 0x3a, 0xd0, 0x99, 0x01, 0x86, 0x10, 0xb4, 0x0a, 0x64, 0x76, 0x5a, 0xda, 0x81,
+	0x5d, 0xa3, 0x17, 0xd0, 0x4e, 0xeb, 0xa9, 0xc2, 0x0d, 0x4b, 0x19, 0x93, 0x9b, 0xf3, 0x56, 0x13,
+	0xbf, 0xde, 0x8a, 0xba, 0xb0, 0x51, 0xb4, 0x8a, 0x66, 0x91, 0xc6, 0x4d, 0x5b, 0xc3, 0x5f, 0xbb,
+	0xe8, 0xf9, 0x98, 0x4e, 0xc5, 0xc4, 0xd4, 0x3d, 0x2f, 0x2d, 0xef, 0x25, 0x6c, 0x2f, 0x89, 0x5c,
+	0x8c, 0x52, 0x1d, 0xfb, 0xcd, 0xe9, 0xbb, 0xe3, 0xaa, 0xc4, 0x39, 0xcd, 0x1b, 0xc2, 0xce, 0xd2,
+	0xeb, 0xa0, 0xa5, 0xc8, 0xb4, 0xa5, 0x9d, 0x54, 0xce, 0x62, 0xe4, 0xca, 0x28, 0xb3, 0x92, 0xf7,
+	0xc3, 0x81, 0x9d, 0x13, 0x25, 0x52, 0x61, 0xd8, 0x3f, 0xba, 0x55, 0x37, 0x6f, 0x4d, 0x63, 0xc1,
+	0xad, 0xb9, 0x71, 0x8e, 0xe6, 0xed, 0x73, 0x78, 0xe0, 0x2e, 0x3f, 0x46, 0x49, 0xc3, 0x7b, 0x0f,
+	0x8f, 0x0f, 0xf8, 0x68, 0x74, 0x0f, 0x5c, 0xc5, 0xc8, 0x44, 0x7c, 0x34, 0xaa, 0x8a, 0xb4, 0xeb,
+	0xc1, 0xb7, 0x06, 0x6c, 0x95, 0x8d, 0x38, 0x65, 0x2a, 0xe7, 0x21, 0x43, 0x67, 0xb0, 0xbd, 0xa4,
+	0x33, 0x68, 0x87, 0xdc, 0xfd, 0x09, 0xeb, 0xba, 0x64, 0x55, 0x53, 0xcf, 0x01, 0x2f, 0x3b, 0x2a,
+	0x72, 0xc9, 0x8a, 0x66, 0x76, 0x9f, 0x90, 0x55, 0x9c, 0xd0, 0x07, 0x78, 0xb8, 0x90, 0xd3, 0xea,
+	0xe2, 0x7b, 0xe4, 0x4e, 0xc0, 0xfb, 0xc3, 0x9f, 0x57, 0x3d, 0xe7, 0xd7, 0x55, 0xcf, 0xf9, 0x7d,
+	0xd5, 0x73, 0x3e, 0x3e, 0x5f, 0xf1, 0x3c, 0xcc, 0xbd, 0x2f, 0x54, 0xf2, 0x70, 0xcc, 0x59, 0x66,
+	0x2e, 0xd6, 0xed, 0x73, 0xb0, 0xf7, 0x27, 0x00, 0x00, 0xff, 0xff, 0x08, 0x99, 0x64, 0x19, 0x80,
+	0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -384,6 +582,11 @@ const _ = grpc.SupportPackageIsVersion4
 type CommitServiceClient interface {
 	// Commit commits hydrated manifests to a repository.
 	CommitHydratedManifests(ctx context.Context, in *CommitHydratedManifestsRequest, opts ...grpc.CallOption) (*CommitHydratedManifestsResponse, error)
+	// PromoteHydratedManifests fast-forwards the sync branch to a hydrated manifests commit of the hydrateTo branch.
+	PromoteHydratedManifests(ctx context.Context, in *PromoteHydratedManifestsRequest, opts ...grpc.CallOption) (*PromoteHydratedManifestsResponse, error)
+	// DiffHydratedManifests writes hydrated manifests to a clone of the repository, and returns the diff against the
+	// target branch without committing or pushing anything.
+	DiffHydratedManifests(ctx context.Context, in *CommitHydratedManifestsRequest, opts ...grpc.CallOption) (*DiffHydratedManifestsResponse, error)
 }
 
 type commitServiceClient struct {
@@ -403,10 +606,33 @@ func (c *commitServiceClient) CommitHydratedManifests(ctx context.Context, in *C
 	return out, nil
 }
 
+func (c *commitServiceClient) PromoteHydratedManifests(ctx context.Context, in *PromoteHydratedManifestsRequest, opts ...grpc.CallOption) (*PromoteHydratedManifestsResponse, error) {
+	out := new(PromoteHydratedManifestsResponse)
+	err := c.cc.Invoke(ctx, "/CommitService/PromoteHydratedManifests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *commitServiceClient) DiffHydratedManifests(ctx context.Context, in *CommitHydratedManifestsRequest, opts ...grpc.CallOption) (*DiffHydratedManifestsResponse, error) {
+	out := new(DiffHydratedManifestsResponse)
+	err := c.cc.Invoke(ctx, "/CommitService/DiffHydratedManifests", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CommitServiceServer is the server API for CommitService service.
 type CommitServiceServer interface {
 	// Commit commits hydrated manifests to a repository.
 	CommitHydratedManifests(context.Context, *CommitHydratedManifestsRequest) (*CommitHydratedManifestsResponse, error)
+	// PromoteHydratedManifests fast-forwards the sync branch to a hydrated manifests commit of the hydrateTo branch.
+	PromoteHydratedManifests(context.Context, *PromoteHydratedManifestsRequest) (*PromoteHydratedManifestsResponse, error)
+	// DiffHydratedManifests writes hydrated manifests to a clone of the repository, and returns the diff against the
+	// target branch without committing or pushing anything.
+	DiffHydratedManifests(context.Context, *CommitHydratedManifestsRequest) (*DiffHydratedManifestsResponse, error)
 }
 
 // UnimplementedCommitServiceServer can be embedded to have forward compatible implementations.
@@ -416,6 +642,12 @@ type UnimplementedCommitServiceServer struct {
 func (*UnimplementedCommitServiceServer) CommitHydratedManifests(ctx context.Context, req *CommitHydratedManifestsRequest) (*CommitHydratedManifestsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CommitHydratedManifests not implemented")
 }
+func (*UnimplementedCommitServiceServer) PromoteHydratedManifests(ctx context.Context, req *PromoteHydratedManifestsRequest) (*PromoteHydratedManifestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PromoteHydratedManifests not implemented")
+}
+func (*UnimplementedCommitServiceServer) DiffHydratedManifests(ctx context.Context, req *CommitHydratedManifestsRequest) (*DiffHydratedManifestsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffHydratedManifests not implemented")
+}
 
 func RegisterCommitServiceServer(s *grpc.Server, srv CommitServiceServer) {
 	s.RegisterService(&_CommitService_serviceDesc, srv)
@@ -439,6 +671,42 @@ func _CommitService_CommitHydratedManifests_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CommitService_PromoteHydratedManifests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PromoteHydratedManifestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommitServiceServer).PromoteHydratedManifests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CommitService/PromoteHydratedManifests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommitServiceServer).PromoteHydratedManifests(ctx, req.(*PromoteHydratedManifestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CommitService_DiffHydratedManifests_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommitHydratedManifestsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CommitServiceServer).DiffHydratedManifests(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/CommitService/DiffHydratedManifests",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CommitServiceServer).DiffHydratedManifests(ctx, req.(*CommitHydratedManifestsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _CommitService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "CommitService",
 	HandlerType: (*CommitServiceServer)(nil),
@@ -447,6 +715,14 @@ var _CommitService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CommitHydratedManifests",
 			Handler:    _CommitService_CommitHydratedManifests_Handler,
 		},
+		{
+			MethodName: "PromoteHydratedManifests",
+			Handler:    _CommitService_PromoteHydratedManifests_Handler,
+		},
+		{
+			MethodName: "DiffHydratedManifests",
+			Handler:    _CommitService_DiffHydratedManifests_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "commitserver/commit/commit.proto",
@@ -476,6 +752,15 @@ func (m *CommitHydratedManifestsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.SensitiveAnnotations) > 0 {
+		for iNdEx := len(m.SensitiveAnnotations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.SensitiveAnnotations[iNdEx])
+			copy(dAtA[i:], m.SensitiveAnnotations[iNdEx])
+			i = encodeVarintCommit(dAtA, i, uint64(len(m.SensitiveAnnotations[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ReadmeMessage) > 0 {
 		i -= len(m.ReadmeMessage)
 		copy(dAtA[i:], m.ReadmeMessage)
@@ -590,6 +875,13 @@ func (m *PathDetails) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.Layout) > 0 {
+		i -= len(m.Layout)
+		copy(dAtA[i:], m.Layout)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.Layout)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Commands) > 0 {
 		for iNdEx := len(m.Commands) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Commands[iNdEx])
@@ -691,65 +983,192 @@ func (m *CommitHydratedManifestsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintCommit(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCommit(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PromoteHydratedManifestsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CommitHydratedManifestsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PromoteHydratedManifestsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PromoteHydratedManifestsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Repo != nil {
-		l = m.Repo.Size()
-		n += 1 + l + sovCommit(uint64(l))
-	}
-	l = len(m.SyncBranch)
-	if l > 0 {
-		n += 1 + l + sovCommit(uint64(l))
-	}
-	l = len(m.TargetBranch)
-	if l > 0 {
-		n += 1 + l + sovCommit(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.DrySha)
-	if l > 0 {
-		n += 1 + l + sovCommit(uint64(l))
+	if len(m.HydratedSha) > 0 {
+		i -= len(m.HydratedSha)
+		copy(dAtA[i:], m.HydratedSha)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.HydratedSha)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.CommitMessage)
-	if l > 0 {
-		n += 1 + l + sovCommit(uint64(l))
+	if len(m.TargetBranch) > 0 {
+		i -= len(m.TargetBranch)
+		copy(dAtA[i:], m.TargetBranch)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.TargetBranch)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Paths) > 0 {
-		for _, e := range m.Paths {
-			l = e.Size()
-			n += 1 + l + sovCommit(uint64(l))
+	if m.Repo != nil {
+		{
+			size, err := m.Repo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCommit(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.DryCommitMetadata != nil {
-		l = m.DryCommitMetadata.Size()
-		n += 1 + l + sovCommit(uint64(l))
-	}
-	l = len(m.AuthorName)
-	if l > 0 {
-		n += 1 + l + sovCommit(uint64(l))
-	}
-	l = len(m.AuthorEmail)
-	if l > 0 {
-		n += 1 + l + sovCommit(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *PromoteHydratedManifestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PromoteHydratedManifestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PromoteHydratedManifestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DiffHydratedManifestsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiffHydratedManifestsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DiffHydratedManifestsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Diff) > 0 {
+		i -= len(m.Diff)
+		copy(dAtA[i:], m.Diff)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.Diff)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.HydratedSha) > 0 {
+		i -= len(m.HydratedSha)
+		copy(dAtA[i:], m.HydratedSha)
+		i = encodeVarintCommit(dAtA, i, uint64(len(m.HydratedSha)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCommit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCommit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CommitHydratedManifestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.SyncBranch)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.TargetBranch)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.DrySha)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.CommitMessage)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if len(m.Paths) > 0 {
+		for _, e := range m.Paths {
+			l = e.Size()
+			n += 1 + l + sovCommit(uint64(l))
+		}
+	}
+	if m.DryCommitMetadata != nil {
+		l = m.DryCommitMetadata.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.AuthorName)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.AuthorEmail)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
 	}
 	l = len(m.ReadmeMessage)
 	if l > 0 {
 		n += 1 + l + sovCommit(uint64(l))
 	}
+	if len(m.SensitiveAnnotations) > 0 {
+		for _, s := range m.SensitiveAnnotations {
+			l = len(s)
+			n += 1 + l + sovCommit(uint64(l))
+		}
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -778,6 +1197,10 @@ func (m *PathDetails) Size() (n int) {
 			n += 1 + l + sovCommit(uint64(l))
 		}
 	}
+	l = len(m.Layout)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -816,6 +1239,62 @@ func (m *CommitHydratedManifestsResponse) Size() (n int) {
 	return n
 }
 
+func (m *PromoteHydratedManifestsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Repo != nil {
+		l = m.Repo.Size()
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.TargetBranch)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.HydratedSha)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PromoteHydratedManifestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DiffHydratedManifestsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HydratedSha)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	l = len(m.Diff)
+	if l > 0 {
+		n += 1 + l + sovCommit(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovCommit(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1181,6 +1660,38 @@ func (m *CommitHydratedManifestsRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ReadmeMessage = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SensitiveAnnotations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SensitiveAnnotations = append(m.SensitiveAnnotations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommit(dAtA[iNdEx:])
@@ -1330,6 +1841,38 @@ func (m *PathDetails) Unmarshal(dAtA []byte) error {
 			}
 			m.Commands = append(m.Commands, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Layout", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Layout = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCommit(dAtA[iNdEx:])
@@ -1518,6 +2061,323 @@ func (m *CommitHydratedManifestsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PromoteHydratedManifestsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromoteHydratedManifestsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromoteHydratedManifestsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Repo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Repo == nil {
+				m.Repo = &v1alpha1.Repository{}
+			}
+			if err := m.Repo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetBranch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HydratedSha = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromoteHydratedManifestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromoteHydratedManifestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromoteHydratedManifestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiffHydratedManifestsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiffHydratedManifestsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiffHydratedManifestsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HydratedSha = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Diff = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCommit(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
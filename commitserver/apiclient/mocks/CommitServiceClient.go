@@ -125,3 +125,177 @@ func (_c *CommitServiceClient_CommitHydratedManifests_Call) RunAndReturn(run fun
 	_c.Call.Return(run)
 	return _c
 }
+
+// DiffHydratedManifests provides a mock function for the type CommitServiceClient
+func (_mock *CommitServiceClient) DiffHydratedManifests(ctx context.Context, in *apiclient.CommitHydratedManifestsRequest, opts ...grpc.CallOption) (*apiclient.DiffHydratedManifestsResponse, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffHydratedManifests")
+	}
+
+	var r0 *apiclient.DiffHydratedManifestsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *apiclient.CommitHydratedManifestsRequest, ...grpc.CallOption) (*apiclient.DiffHydratedManifestsResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *apiclient.CommitHydratedManifestsRequest, ...grpc.CallOption) *apiclient.DiffHydratedManifestsResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.DiffHydratedManifestsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *apiclient.CommitHydratedManifestsRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// CommitServiceClient_DiffHydratedManifests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffHydratedManifests'
+type CommitServiceClient_DiffHydratedManifests_Call struct {
+	*mock.Call
+}
+
+// DiffHydratedManifests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *apiclient.CommitHydratedManifestsRequest
+//   - opts ...grpc.CallOption
+func (_e *CommitServiceClient_Expecter) DiffHydratedManifests(ctx any, in any, opts ...any) *CommitServiceClient_DiffHydratedManifests_Call {
+	return &CommitServiceClient_DiffHydratedManifests_Call{Call: _e.mock.On("DiffHydratedManifests",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *CommitServiceClient_DiffHydratedManifests_Call) Run(run func(ctx context.Context, in *apiclient.CommitHydratedManifestsRequest, opts ...grpc.CallOption)) *CommitServiceClient_DiffHydratedManifests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *apiclient.CommitHydratedManifestsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*apiclient.CommitHydratedManifestsRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *CommitServiceClient_DiffHydratedManifests_Call) Return(diffHydratedManifestsResponse *apiclient.DiffHydratedManifestsResponse, err error) *CommitServiceClient_DiffHydratedManifests_Call {
+	_c.Call.Return(diffHydratedManifestsResponse, err)
+	return _c
+}
+
+func (_c *CommitServiceClient_DiffHydratedManifests_Call) RunAndReturn(run func(ctx context.Context, in *apiclient.CommitHydratedManifestsRequest, opts ...grpc.CallOption) (*apiclient.DiffHydratedManifestsResponse, error)) *CommitServiceClient_DiffHydratedManifests_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// PromoteHydratedManifests provides a mock function for the type CommitServiceClient
+func (_mock *CommitServiceClient) PromoteHydratedManifests(ctx context.Context, in *apiclient.PromoteHydratedManifestsRequest, opts ...grpc.CallOption) (*apiclient.PromoteHydratedManifestsResponse, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PromoteHydratedManifests")
+	}
+
+	var r0 *apiclient.PromoteHydratedManifestsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *apiclient.PromoteHydratedManifestsRequest, ...grpc.CallOption) (*apiclient.PromoteHydratedManifestsResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *apiclient.PromoteHydratedManifestsRequest, ...grpc.CallOption) *apiclient.PromoteHydratedManifestsResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*apiclient.PromoteHydratedManifestsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *apiclient.PromoteHydratedManifestsRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// CommitServiceClient_PromoteHydratedManifests_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'PromoteHydratedManifests'
+type CommitServiceClient_PromoteHydratedManifests_Call struct {
+	*mock.Call
+}
+
+// PromoteHydratedManifests is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *apiclient.PromoteHydratedManifestsRequest
+//   - opts ...grpc.CallOption
+func (_e *CommitServiceClient_Expecter) PromoteHydratedManifests(ctx any, in any, opts ...any) *CommitServiceClient_PromoteHydratedManifests_Call {
+	return &CommitServiceClient_PromoteHydratedManifests_Call{Call: _e.mock.On("PromoteHydratedManifests",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *CommitServiceClient_PromoteHydratedManifests_Call) Run(run func(ctx context.Context, in *apiclient.PromoteHydratedManifestsRequest, opts ...grpc.CallOption)) *CommitServiceClient_PromoteHydratedManifests_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *apiclient.PromoteHydratedManifestsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*apiclient.PromoteHydratedManifestsRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *CommitServiceClient_PromoteHydratedManifests_Call) Return(promoteHydratedManifestsResponse *apiclient.PromoteHydratedManifestsResponse, err error) *CommitServiceClient_PromoteHydratedManifests_Call {
+	_c.Call.Return(promoteHydratedManifestsResponse, err)
+	return _c
+}
+
+func (_c *CommitServiceClient_PromoteHydratedManifests_Call) RunAndReturn(run func(ctx context.Context, in *apiclient.PromoteHydratedManifestsRequest, opts ...grpc.CallOption) (*apiclient.PromoteHydratedManifestsResponse, error)) *CommitServiceClient_PromoteHydratedManifests_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
 	"github.com/argoproj/argo-cd/v3/commitserver/metrics"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/io"
 	"github.com/argoproj/argo-cd/v3/util/io/files"
@@ -19,6 +20,10 @@ import (
 const (
 	NoteNamespace = "hydrator.metadata" // NoteNamespace is the custom git notes namespace used by the hydrator to store and retrieve commit-related metadata.
 	ManifestYaml  = "manifest.yaml"     // ManifestYaml constant for the manifest yaml
+	// KustomizationYaml is the file listing the hydrated manifests as resources, written by the Kustomization layout
+	KustomizationYaml = "kustomization.yaml"
+	// ClusterManifestsYaml is the file holding the manifests without a namespace, written by the PerNamespace layout
+	ClusterManifestsYaml = "_cluster.yaml"
 )
 
 // Service is the service that handles commit requests.
@@ -105,12 +110,18 @@ func (s *Service) handleCommitRequest(ctx context.Context, logCtx *log.Entry, r
 
 	logCtx = logCtx.WithField("repo", r.Repo.Repo)
 	logCtx.Debug("Initiating git client")
-	gitClient, dirPath, cleanup, err := s.initGitClient(ctx, logCtx, r)
+	gitClient, dirPath, cleanup, err := s.initGitClient(ctx, logCtx, r.Repo, r.AuthorName, r.AuthorEmail)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to init git client: %w", err)
 	}
 	defer cleanup()
 
+	// The signing key must be set before checking out the sync branch, which may create an initial commit.
+	signingKey, err := setSigningKey(ctx, logCtx, gitClient, r.Repo)
+	if err != nil {
+		return "", "", err
+	}
+
 	root, err := os.OpenRoot(dirPath)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to open root dir: %w", err)
@@ -154,7 +165,7 @@ func (s *Service) handleCommitRequest(ctx context.Context, logCtx *log.Entry, r
 	}
 
 	logCtx.Debug("Writing manifests")
-	shouldCommit, err := WriteForPaths(ctx, root, r.Repo.Repo, r.DrySha, r.DryCommitMetadata, r.Paths, gitClient, r.ReadmeMessage)
+	shouldCommit, err := WriteForPaths(ctx, root, r.Repo.Repo, r.DrySha, r.DryCommitMetadata, r.Paths, gitClient, r.ReadmeMessage, signingKey)
 	if err != nil {
 		return "", "", fmt.Errorf("failed to write manifests: %w", err)
 	}
@@ -188,10 +199,168 @@ func (s *Service) handleCommitRequest(ctx context.Context, logCtx *log.Entry, r
 	return "", sha, nil
 }
 
+// PromoteHydratedManifests handles a promote request. It clones the repository, and fast-forwards the target branch to
+// the hydrated commit. It returns an error if the target branch cannot be fast-forwarded to the hydrated commit.
+func (s *Service) PromoteHydratedManifests(ctx context.Context, r *apiclient.PromoteHydratedManifestsRequest) (*apiclient.PromoteHydratedManifestsResponse, error) {
+	logCtx := log.WithFields(log.Fields{"branch": r.TargetBranch, "hydratedSHA": r.HydratedSha})
+
+	out, err := s.handlePromoteRequest(ctx, logCtx, r)
+	if err != nil {
+		logCtx.WithError(err).WithField("output", out).Error("failed to handle promote request")
+
+		// No need to wrap this error, sufficient context is build in handlePromoteRequest.
+		return &apiclient.PromoteHydratedManifestsResponse{}, err
+	}
+
+	logCtx.Info("Successfully handled promote request")
+	return &apiclient.PromoteHydratedManifestsResponse{}, nil
+}
+
+// handlePromoteRequest handles the promote request. It clones the repository, and pushes the hydrated commit to the
+// target branch. It returns the output of the git commands and an error if one occurred.
+func (s *Service) handlePromoteRequest(ctx context.Context, logCtx *log.Entry, r *apiclient.PromoteHydratedManifestsRequest) (string, error) {
+	if r.Repo == nil {
+		return "", errors.New("repo is required")
+	}
+	if r.Repo.Repo == "" {
+		return "", errors.New("repo URL is required")
+	}
+	if r.TargetBranch == "" {
+		return "", errors.New("target branch is required")
+	}
+	if r.HydratedSha == "" {
+		return "", errors.New("hydrated sha is required")
+	}
+
+	logCtx = logCtx.WithField("repo", r.Repo.Repo)
+	logCtx.Debug("Initiating git client")
+	gitClient, _, cleanup, err := s.initGitClient(ctx, logCtx, r.Repo, "", "")
+	if err != nil {
+		return "", fmt.Errorf("failed to init git client: %w", err)
+	}
+	defer cleanup()
+
+	if !gitClient.IsRevisionPresent(ctx, r.HydratedSha) {
+		return "", fmt.Errorf("hydrated commit %s not found", r.HydratedSha)
+	}
+
+	logCtx.Debugf("Fast-forwarding target branch %s", r.TargetBranch)
+	out, err := gitClient.FastForward(ctx, r.HydratedSha, r.TargetBranch)
+	if err != nil {
+		return out, fmt.Errorf("failed to fast-forward target branch: %w", err)
+	}
+	return "", nil
+}
+
+// DiffHydratedManifests handles a diff request. It clones the repository, checks out the target branch, writes the
+// manifests to the repository like CommitHydratedManifests does, and returns the diff of the changes a commit would
+// contain. Nothing is committed or pushed.
+func (s *Service) DiffHydratedManifests(ctx context.Context, r *apiclient.CommitHydratedManifestsRequest) (*apiclient.DiffHydratedManifestsResponse, error) {
+	logCtx := log.WithFields(log.Fields{"branch": r.TargetBranch, "drySHA": r.DrySha})
+
+	out, resp, err := s.handleDiffRequest(ctx, logCtx, r)
+	if err != nil {
+		logCtx.WithError(err).WithField("output", out).Error("failed to handle diff request")
+
+		// No need to wrap this error, sufficient context is build in handleDiffRequest.
+		return &apiclient.DiffHydratedManifestsResponse{}, err
+	}
+
+	logCtx.Info("Successfully handled diff request")
+	return resp, nil
+}
+
+// handleDiffRequest handles the diff request. It clones the repository, checks out the target branch, or the sync
+// branch if the target branch does not exist yet, writes the manifests to the repository, and diffs the changes,
+// redacting the values of Secrets. It returns the output of the git commands and an error if one occurred.
+func (s *Service) handleDiffRequest(ctx context.Context, logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (string, *apiclient.DiffHydratedManifestsResponse, error) {
+	if r.Repo == nil {
+		return "", nil, errors.New("repo is required")
+	}
+	if r.Repo.Repo == "" {
+		return "", nil, errors.New("repo URL is required")
+	}
+	if r.TargetBranch == "" {
+		return "", nil, errors.New("target branch is required")
+	}
+	if r.SyncBranch == "" {
+		return "", nil, errors.New("sync branch is required")
+	}
+
+	logCtx = logCtx.WithField("repo", r.Repo.Repo)
+	logCtx.Debug("Initiating git client")
+	gitClient, dirPath, cleanup, err := s.initGitClient(ctx, logCtx, r.Repo, r.AuthorName, r.AuthorEmail)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to init git client: %w", err)
+	}
+	defer cleanup()
+
+	// Nothing is committed, but the fingerprint of the signing key is part of the written metadata.
+	signingKey, err := setSigningKey(ctx, logCtx, gitClient, r.Repo)
+	if err != nil {
+		return "", nil, err
+	}
+
+	root, err := os.OpenRoot(dirPath)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to open root dir: %w", err)
+	}
+	defer io.Close(root)
+
+	// Unlike handleCommitRequest, missing branches are not created, since that would push them.
+	logCtx.Debugf("Checking out target branch %s", r.TargetBranch)
+	out, err := gitClient.Checkout(ctx, r.TargetBranch, false, true)
+	if err != nil {
+		logCtx.Debugf("Target branch not found, checking out sync branch %s", r.SyncBranch)
+		out, err = gitClient.Checkout(ctx, r.SyncBranch, false, true)
+		if err != nil {
+			return out, nil, fmt.Errorf("failed to checkout target branch %s or sync branch %s: %w", r.TargetBranch, r.SyncBranch, err)
+		}
+	}
+
+	hydratedSha, err := gitClient.CommitSHA(ctx)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get commit SHA: %w", err)
+	}
+
+	// The actual manifests are written and diffed, so that Secrets only show up if they changed. Their values, before
+	// and after, are redacted from the returned diff.
+	secrets := newSecretValues(r.SensitiveAnnotations)
+	for _, p := range r.Paths {
+		err = secrets.addFiles(root, p.Path)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to read manifests: %w", err)
+		}
+		err = secrets.addManifests(p.Manifests)
+		if err != nil {
+			return "", nil, err
+		}
+	}
+
+	logCtx.Debug("Writing manifests")
+	shouldCommit, err := WriteForPaths(ctx, root, r.Repo.Repo, r.DrySha, r.DryCommitMetadata, r.Paths, gitClient, r.ReadmeMessage, signingKey)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to write manifests: %w", err)
+	}
+	resp := &apiclient.DiffHydratedManifestsResponse{HydratedSha: hydratedSha}
+	if !shouldCommit {
+		// Manifests did not change, so no commit would be created.
+		return "", resp, nil
+	}
+
+	logCtx.Debug("Diffing changes")
+	out, err = gitClient.AddAndDiff(ctx)
+	if err != nil {
+		return out, nil, fmt.Errorf("failed to diff changes: %w", err)
+	}
+	resp.Diff = secrets.redact(out)
+	return "", resp, nil
+}
+
 // initGitClient initializes a git client for the given repository and returns the client, the path to the directory where
 // the repository is cloned, a cleanup function that should be called when the directory is no longer needed, and an error
 // if one occurred.
-func (s *Service) initGitClient(ctx context.Context, logCtx *log.Entry, r *apiclient.CommitHydratedManifestsRequest) (git.Client, string, func(), error) {
+func (s *Service) initGitClient(ctx context.Context, logCtx *log.Entry, repo *v1alpha1.Repository, requestAuthorName string, requestAuthorEmail string) (git.Client, string, func(), error) {
 	dirPath, err := files.CreateTempDir("/tmp/_commit-service")
 	if err != nil {
 		return nil, "", nil, fmt.Errorf("failed to create temp dir: %w", err)
@@ -204,20 +373,20 @@ func (s *Service) initGitClient(ctx context.Context, logCtx *log.Entry, r *apicl
 		}
 	}
 
-	gitClient, err := s.repoClientFactory.NewClient(r.Repo, dirPath)
+	gitClient, err := s.repoClientFactory.NewClient(repo, dirPath)
 	if err != nil {
 		cleanupOrLog()
 		return nil, "", nil, fmt.Errorf("failed to create git client: %w", err)
 	}
 
-	logCtx.Debugf("Initializing repo %s", r.Repo.Repo)
+	logCtx.Debugf("Initializing repo %s", repo.Repo)
 	err = gitClient.Init()
 	if err != nil {
 		cleanupOrLog()
 		return nil, "", nil, fmt.Errorf("failed to init git client: %w", err)
 	}
 
-	logCtx.Debugf("Fetching repo %s", r.Repo.Repo)
+	logCtx.Debugf("Fetching repo %s", repo.Repo)
 	err = gitClient.Fetch(ctx, "", 0)
 	if err != nil {
 		cleanupOrLog()
@@ -235,17 +404,17 @@ func (s *Service) initGitClient(ctx context.Context, logCtx *log.Entry, r *apicl
 	//	 return nil, "", nil, fmt.Errorf("failed to get github app info: %w", err)
 	// }
 	// Use author name and email from request, defaulting to "Argo CD" if not provided
-	authorName := r.AuthorName
+	authorName := requestAuthorName
 	if authorName == "" {
 		authorName = "Argo CD"
 	}
-	authorEmail := r.AuthorEmail
+	authorEmail := requestAuthorEmail
 	if authorEmail == "" {
 		authorEmail = "argo-cd@example.com"
 	}
 
 	logCtx.Debugf("Author config: request name='%s', request email='%s', final name='%s', final email='%s'",
-		r.AuthorName, r.AuthorEmail, authorName, authorEmail)
+		requestAuthorName, requestAuthorEmail, authorName, authorEmail)
 
 	logCtx.Debugf("Setting author %s <%s>", authorName, authorEmail)
 	_, err = gitClient.SetAuthor(ctx, authorName, authorEmail)
@@ -256,3 +425,18 @@ func (s *Service) initGitClient(ctx context.Context, logCtx *log.Entry, r *apicl
 
 	return gitClient, dirPath, cleanupOrLog, nil
 }
+
+// setSigningKey configures the git client to sign commits with the signing key of the repository write credential, if
+// one is set. It returns the fingerprint of the signing key, or an empty string if commits are not signed.
+func setSigningKey(ctx context.Context, logCtx *log.Entry, gitClient git.Client, repo *v1alpha1.Repository) (string, error) {
+	if repo.CommitSigningKey == "" {
+		return "", nil
+	}
+	logCtx.Debug("Setting commit signing key")
+	fingerprint, err := gitClient.SetSigningKey(ctx, repo.CommitSigningKey)
+	if err != nil {
+		return "", fmt.Errorf("failed to set commit signing key: %w", err)
+	}
+	logCtx.Debugf("Signing commits with key %s", fingerprint)
+	return fingerprint, nil
+}
@@ -1,7 +1,12 @@
 package commit
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -14,6 +19,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	gitmocks "github.com/argoproj/argo-cd/v3/util/git/mocks"
+	"github.com/argoproj/argo-cd/v3/util/hydrator"
 )
 
 func Test_CommitHydratedManifests(t *testing.T) {
@@ -270,6 +276,81 @@ func Test_CommitHydratedManifests(t *testing.T) {
 		assert.Equal(t, "subdir-path-sha", resp.HydratedSha)
 	})
 
+	t.Run("signing key configured", func(t *testing.T) {
+		t.Parallel()
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		var rootPath string
+		var metadata hydrator.HydratorCommitMetadata
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor(mock.Anything, "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		setSigningKey := mockGitClient.EXPECT().SetSigningKey(mock.Anything, "signing-key").Return("SHA256:fingerprint", nil).Once()
+		mockGitClient.EXPECT().CheckoutOrOrphan(mock.Anything, "env/test", false).Return("", nil).Once().NotBefore(setSigningKey)
+		mockGitClient.EXPECT().CheckoutOrNew(mock.Anything, "main", "env/test", false).Return("", nil).Once()
+		mockGitClient.EXPECT().GetCommitNote(mock.Anything, mock.Anything, mock.Anything).Return("", fmt.Errorf("test %w", git.ErrNoNoteFound)).Once()
+		mockGitClient.EXPECT().HasFileChanged(mock.Anything, mock.Anything).Return(true, nil).Once()
+		mockGitClient.EXPECT().CommitAndPush(mock.Anything, "main", "test commit message").RunAndReturn(func(_ context.Context, _, _ string) (string, error) {
+			metadataBytes, err := os.ReadFile(filepath.Join(rootPath, "apps/staging", "hydrator.metadata"))
+			require.NoError(t, err)
+			require.NoError(t, json.Unmarshal(metadataBytes, &metadata))
+			return "", nil
+		}).Once()
+		mockGitClient.EXPECT().AddAndPushNote(mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().CommitSHA(mock.Anything).Return("signed-sha", nil).Twice()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).RunAndReturn(func(_ *v1alpha1.Repository, path string) (git.Client, error) {
+			rootPath = path
+			return mockGitClient, nil
+		}).Once()
+
+		requestWithSigningKey := &apiclient.CommitHydratedManifestsRequest{
+			Repo: &v1alpha1.Repository{
+				Repo:             "https://github.com/argoproj/argocd-example-apps.git",
+				CommitSigningKey: "signing-key",
+			},
+			TargetBranch:  "main",
+			SyncBranch:    "env/test",
+			CommitMessage: "test commit message",
+			Paths: []*apiclient.PathDetails{
+				{
+					Path: "apps/staging",
+					Manifests: []*apiclient.HydratedManifestDetails{
+						{ManifestJSON: `{"apiVersion":"v1","kind":"Deployment","metadata":{"name":"test-app"}}`},
+					},
+				},
+			},
+		}
+
+		resp, err := service.CommitHydratedManifests(t.Context(), requestWithSigningKey)
+		require.NoError(t, err)
+		assert.Equal(t, "signed-sha", resp.HydratedSha)
+		assert.Equal(t, "SHA256:fingerprint", metadata.SigningKey)
+	})
+
+	t.Run("invalid signing key", func(t *testing.T) {
+		t.Parallel()
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor(mock.Anything, "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().SetSigningKey(mock.Anything, "invalid").Return("", errors.New("failed to parse signing key")).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		request := &apiclient.CommitHydratedManifestsRequest{
+			Repo: &v1alpha1.Repository{
+				Repo:             "https://github.com/argoproj/argocd-example-apps.git",
+				CommitSigningKey: "invalid",
+			},
+			TargetBranch:  "main",
+			SyncBranch:    "env/test",
+			CommitMessage: "test commit message",
+		}
+
+		_, err := service.CommitHydratedManifests(t.Context(), request)
+		require.ErrorContains(t, err, "failed to set commit signing key")
+	})
+
 	t.Run("mixed paths - root and subdirectory", func(t *testing.T) {
 		t.Parallel()
 
@@ -444,6 +525,190 @@ func Test_CommitHydratedManifests(t *testing.T) {
 	})
 }
 
+func Test_PromoteHydratedManifests(t *testing.T) {
+	t.Parallel()
+
+	validRequest := &apiclient.PromoteHydratedManifestsRequest{
+		Repo: &v1alpha1.Repository{
+			Repo: "https://github.com/argoproj/argocd-example-apps.git",
+		},
+		TargetBranch: "env/prod",
+		HydratedSha:  "hydrated-sha",
+	}
+
+	t.Run("missing repo", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newServiceWithMocks(t)
+		_, err := service.PromoteHydratedManifests(t.Context(), &apiclient.PromoteHydratedManifestsRequest{})
+		require.ErrorContains(t, err, "repo is required")
+	})
+
+	t.Run("missing target branch", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newServiceWithMocks(t)
+		_, err := service.PromoteHydratedManifests(t.Context(), &apiclient.PromoteHydratedManifestsRequest{Repo: validRequest.Repo})
+		require.ErrorContains(t, err, "target branch is required")
+	})
+
+	t.Run("missing hydrated sha", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newServiceWithMocks(t)
+		_, err := service.PromoteHydratedManifests(t.Context(), &apiclient.PromoteHydratedManifestsRequest{
+			Repo:         validRequest.Repo,
+			TargetBranch: validRequest.TargetBranch,
+		})
+		require.ErrorContains(t, err, "hydrated sha is required")
+	})
+
+	t.Run("hydrated commit not found", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor(mock.Anything, "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().IsRevisionPresent(mock.Anything, "hydrated-sha").Return(false).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		_, err := service.PromoteHydratedManifests(t.Context(), validRequest)
+		require.EqualError(t, err, "hydrated commit hydrated-sha not found")
+	})
+
+	t.Run("not a fast-forward", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor(mock.Anything, "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().IsRevisionPresent(mock.Anything, "hydrated-sha").Return(true).Once()
+		mockGitClient.EXPECT().FastForward(mock.Anything, "hydrated-sha", "env/prod").Return("", assert.AnError).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		_, err := service.PromoteHydratedManifests(t.Context(), validRequest)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor(mock.Anything, "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().IsRevisionPresent(mock.Anything, "hydrated-sha").Return(true).Once()
+		mockGitClient.EXPECT().FastForward(mock.Anything, "hydrated-sha", "env/prod").Return("", nil).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		resp, err := service.PromoteHydratedManifests(t.Context(), validRequest)
+		require.NoError(t, err)
+		require.NotNil(t, resp)
+	})
+}
+
+func Test_DiffHydratedManifests(t *testing.T) {
+	t.Parallel()
+
+	validRequest := &apiclient.CommitHydratedManifestsRequest{
+		Repo: &v1alpha1.Repository{
+			Repo: "https://github.com/argoproj/argocd-example-apps.git",
+		},
+		TargetBranch: "main",
+		SyncBranch:   "env/test",
+		Paths: []*apiclient.PathDetails{
+			{
+				Path: "apps/test",
+				Manifests: []*apiclient.HydratedManifestDetails{
+					{
+						ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"test"}}`,
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("missing repo", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newServiceWithMocks(t)
+		_, err := service.DiffHydratedManifests(t.Context(), &apiclient.CommitHydratedManifestsRequest{})
+		require.ErrorContains(t, err, "repo is required")
+	})
+
+	t.Run("missing sync branch", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := newServiceWithMocks(t)
+		_, err := service.DiffHydratedManifests(t.Context(), &apiclient.CommitHydratedManifestsRequest{
+			Repo:         validRequest.Repo,
+			TargetBranch: validRequest.TargetBranch,
+		})
+		require.ErrorContains(t, err, "sync branch is required")
+	})
+
+	t.Run("neither branch exists", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor(mock.Anything, "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().Checkout(mock.Anything, "main", false, true).Return("", assert.AnError).Once()
+		mockGitClient.EXPECT().Checkout(mock.Anything, "env/test", false, true).Return("", assert.AnError).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		_, err := service.DiffHydratedManifests(t.Context(), validRequest)
+		require.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("no changes", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor(mock.Anything, "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().Checkout(mock.Anything, "main", false, true).Return("", nil).Once()
+		mockGitClient.EXPECT().CommitSHA(mock.Anything).Return("target-sha", nil).Once()
+		mockGitClient.EXPECT().HasFileChanged(mock.Anything, mock.Anything).Return(false, nil).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		resp, err := service.DiffHydratedManifests(t.Context(), validRequest)
+		require.NoError(t, err)
+		assert.Equal(t, "target-sha", resp.HydratedSha)
+		assert.Empty(t, resp.Diff)
+	})
+
+	t.Run("target branch does not exist yet", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepoClientFactory := newServiceWithMocks(t)
+		mockGitClient := gitmocks.NewClient(t)
+		mockGitClient.EXPECT().Init().Return(nil).Once()
+		mockGitClient.EXPECT().Fetch(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+		mockGitClient.EXPECT().SetAuthor(mock.Anything, "Argo CD", "argo-cd@example.com").Return("", nil).Once()
+		mockGitClient.EXPECT().Checkout(mock.Anything, "main", false, true).Return("", assert.AnError).Once()
+		mockGitClient.EXPECT().Checkout(mock.Anything, "env/test", false, true).Return("", nil).Once()
+		mockGitClient.EXPECT().CommitSHA(mock.Anything).Return("sync-sha", nil).Once()
+		mockGitClient.EXPECT().HasFileChanged(mock.Anything, mock.Anything).Return(true, nil).Once()
+		mockGitClient.EXPECT().AddAndDiff(mock.Anything).Return("the diff", nil).Once()
+		mockRepoClientFactory.EXPECT().NewClient(mock.Anything, mock.Anything).Return(mockGitClient, nil).Once()
+
+		resp, err := service.DiffHydratedManifests(t.Context(), validRequest)
+		require.NoError(t, err)
+		assert.Equal(t, "sync-sha", resp.HydratedSha)
+		assert.Equal(t, "the diff", resp.Diff)
+	})
+}
+
 func newServiceWithMocks(t *testing.T) (*Service, *mocks.RepoClientFactory) {
 	t.Helper()
 
@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 	"text/template"
 
 	"github.com/Masterminds/sprig/v3"
@@ -35,12 +37,14 @@ func init() {
 }
 
 // WriteForPaths writes the manifests, hydrator.metadata, and README.md files for each path in the provided paths. It
-// also writes a root-level hydrator.metadata file containing the repo URL and dry SHA.
-func WriteForPaths(ctx context.Context, root *os.Root, repoUrl, drySha string, dryCommitMetadata *appv1.RevisionMetadata, paths []*apiclient.PathDetails, gitClient git.Client, rawReadmeTemplate string) (bool, error) { //nolint:revive //FIXME(var-naming)
+// also writes a root-level hydrator.metadata file containing the repo URL and dry SHA. The signing key is the
+// fingerprint of the key the commit will be signed with, if any, and is recorded in the hydrator.metadata files.
+func WriteForPaths(ctx context.Context, root *os.Root, repoUrl, drySha string, dryCommitMetadata *appv1.RevisionMetadata, paths []*apiclient.PathDetails, gitClient git.Client, rawReadmeTemplate string, signingKey string) (bool, error) { //nolint:revive //FIXME(var-naming)
 	hydratorMetadata, err := hydrator.GetCommitMetadata(repoUrl, drySha, dryCommitMetadata)
 	if err != nil {
 		return false, fmt.Errorf("failed to retrieve hydrator metadata: %w", err)
 	}
+	hydratorMetadata.SigningKey = signingKey
 
 	// Write the top-level readme.
 	err = writeMetadata(root, "", hydratorMetadata)
@@ -68,13 +72,19 @@ func WriteForPaths(ctx context.Context, root *os.Root, repoUrl, drySha string, d
 			}
 		}
 
+		// Remove the manifests of the previous hydration, the layout or the set of resources may have changed
+		removed, err := removeManifests(root, hydratePath)
+		if err != nil {
+			return false, fmt.Errorf("failed to remove manifests: %w", err)
+		}
+
 		// Write the manifests
-		err := writeManifests(root, hydratePath, p.Manifests)
+		written, err := writeManifests(root, hydratePath, p.Layout, p.Manifests)
 		if err != nil {
 			return false, fmt.Errorf("failed to write manifests: %w", err)
 		}
-		// Check if the manifest file has been modified compared to the git index
-		changed, err := gitClient.HasFileChanged(ctx, filepath.Join(hydratePath, ManifestYaml))
+		// Check if any manifest file has been modified compared to the git index
+		changed, err := haveFilesChanged(ctx, gitClient, hydratePath, append(slices.Clone(written), removed...))
 		if err != nil {
 			return false, fmt.Errorf("failed to check if anything changed on the manifest: %w", err)
 		}
@@ -87,9 +97,11 @@ func WriteForPaths(ctx context.Context, root *os.Root, repoUrl, drySha string, d
 
 		// Write hydrator.metadata containing information about the hydration process.
 		hydratorMetadata := hydrator.HydratorCommitMetadata{
-			Commands: p.Commands,
-			DrySHA:   drySha,
-			RepoURL:  repoUrl,
+			Commands:   p.Commands,
+			DrySHA:     drySha,
+			RepoURL:    repoUrl,
+			Manifests:  written,
+			SigningKey: signingKey,
 		}
 		err = writeMetadata(root, hydratePath, hydratorMetadata)
 		if err != nil {
@@ -175,13 +187,159 @@ func writeGitAttributes(root *os.Root) error {
 	return nil
 }
 
-// writeManifests writes the manifests to the manifest.yaml file, truncating the file if it exists and appending the
-// manifests in the order they are provided.
-func writeManifests(root *os.Root, dirPath string, manifests []*apiclient.HydratedManifestDetails) error {
-	// If the file exists, truncate it.
-	// No need to use SecureJoin here, as the path is already sanitized.
-	manifestPath := filepath.Join(dirPath, ManifestYaml)
+// removeManifests removes the manifest files written to the directory by the previous hydration, as listed in its
+// hydrator.metadata file. Other files in the directory are left untouched. It returns the names of the removed files.
+func removeManifests(root *os.Root, dirPath string) ([]string, error) {
+	metadataBytes, err := root.ReadFile(filepath.Join(dirPath, "hydrator.metadata"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read hydrator metadata: %w", err)
+	}
+	var metadata hydrator.HydratorCommitMetadata
+	if len(metadataBytes) > 0 {
+		err = json.Unmarshal(metadataBytes, &metadata)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal hydrator metadata: %w", err)
+		}
+	}
+	fileNames := metadata.Manifests
+	if len(fileNames) == 0 {
+		// Hydrations predating the manifest layouts always wrote a single manifest.yaml file
+		fileNames = []string{ManifestYaml}
+	}
+
+	var removed []string
+	for _, fileName := range fileNames {
+		if filepath.Base(fileName) != fileName {
+			return nil, fmt.Errorf("invalid manifest file name %q in hydrator metadata", fileName)
+		}
+		err = root.Remove(filepath.Join(dirPath, fileName))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to remove manifest file: %w", err)
+		}
+		removed = append(removed, fileName)
+	}
+	return removed, nil
+}
+
+// haveFilesChanged checks if any of the files in the directory has been modified compared to the git index.
+func haveFilesChanged(ctx context.Context, gitClient git.Client, dirPath string, fileNames []string) (bool, error) {
+	slices.Sort(fileNames)
+	for _, fileName := range slices.Compact(fileNames) {
+		changed, err := gitClient.HasFileChanged(ctx, filepath.Join(dirPath, fileName))
+		if err != nil {
+			return false, err
+		}
+		if changed {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// writeManifests writes the manifests to the files of the layout, truncating the files if they exist and appending
+// the manifests in the order they are provided. It returns the names of the written files.
+func writeManifests(root *os.Root, dirPath string, layout string, manifests []*apiclient.HydratedManifestDetails) ([]string, error) {
+	objs := make([]*unstructured.Unstructured, len(manifests))
+	for i, m := range manifests {
+		obj := &unstructured.Unstructured{}
+		err := json.Unmarshal([]byte(m.ManifestJSON), obj)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal manifest: %w", err)
+		}
+		objs[i] = obj
+	}
+
+	fileNames, files, err := layoutManifests(appv1.HydratedManifestLayout(layout), objs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, fileName := range fileNames {
+		// No need to use SecureJoin here, as the path is already sanitized and the file names contain no separators.
+		err = writeManifestFile(root, filepath.Join(dirPath, fileName), files[fileName])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if layout == string(appv1.HydratedManifestLayoutKustomization) {
+		err = writeManifestFile(root, filepath.Join(dirPath, KustomizationYaml), []*unstructured.Unstructured{{Object: map[string]any{
+			"apiVersion": "kustomize.config.k8s.io/v1beta1",
+			"kind":       "Kustomization",
+			"resources":  fileNames,
+		}}})
+		if err != nil {
+			return nil, err
+		}
+		return append(fileNames, KustomizationYaml), nil
+	}
+	return fileNames, nil
+}
+
+// layoutManifests distributes the manifests among files according to the layout. It returns the file names in the
+// order they should be written, and the manifests of each file.
+func layoutManifests(layout appv1.HydratedManifestLayout, objs []*unstructured.Unstructured) ([]string, map[string][]*unstructured.Unstructured, error) {
+	var fileNameOf func(obj *unstructured.Unstructured) string
+	switch layout {
+	case "", appv1.HydratedManifestLayoutSingleFile, appv1.HydratedManifestLayoutKustomization:
+		// Always write the file, so that an application without resources gets an empty manifest.yaml
+		return []string{ManifestYaml}, map[string][]*unstructured.Unstructured{ManifestYaml: objs}, nil
+	case appv1.HydratedManifestLayoutPerNamespace:
+		fileNameOf = func(obj *unstructured.Unstructured) string {
+			if obj.GetNamespace() == "" {
+				return ClusterManifestsYaml
+			}
+			return obj.GetNamespace() + ".yaml"
+		}
+	case appv1.HydratedManifestLayoutPerResource:
+		// Resources of the same kind and name in different namespaces get the namespace in their file name
+		counts := map[string]int{}
+		for _, obj := range objs {
+			counts[resourceFileName(obj.GetKind(), obj.GetName())]++
+		}
+		fileNameOf = func(obj *unstructured.Unstructured) string {
+			fileName := resourceFileName(obj.GetKind(), obj.GetName())
+			if counts[fileName] > 1 && obj.GetNamespace() != "" {
+				fileName = resourceFileName(obj.GetKind(), obj.GetNamespace(), obj.GetName())
+			}
+			return fileName
+		}
+	default:
+		return nil, nil, fmt.Errorf("unknown manifest layout %q", layout)
+	}
+
+	var fileNames []string
+	files := map[string][]*unstructured.Unstructured{}
+	for _, obj := range objs {
+		fileName := fileNameOf(obj)
+		if _, ok := files[fileName]; !ok {
+			fileNames = append(fileNames, fileName)
+		} else if layout == appv1.HydratedManifestLayoutPerResource {
+			return nil, nil, fmt.Errorf("more than one %s manifest is named %q", obj.GetKind(), obj.GetName())
+		}
+		files[fileName] = append(files[fileName], obj)
+	}
+	return fileNames, files, nil
+}
+
+// resourceFileName returns the name of the file holding a single resource, e.g. "deployment-guestbook-ui.yaml".
+// Characters not allowed in resource names, like the colons of RBAC resources, are replaced with underscores.
+func resourceFileName(parts ...string) string {
+	fileName := strings.ToLower(strings.Join(parts, "-"))
+	fileName = strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' || r == '.' {
+			return r
+		}
+		return '_'
+	}, fileName)
+	return fileName + ".yaml"
+}
 
+// writeManifestFile writes the manifests to the file, truncating the file if it exists.
+func writeManifestFile(root *os.Root, manifestPath string, objs []*unstructured.Unstructured) error {
 	file, err := root.OpenFile(manifestPath, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.ModePerm)
 	if err != nil {
 		return fmt.Errorf("failed to open manifest file: %w", err)
@@ -202,12 +360,7 @@ func writeManifests(root *os.Root, dirPath string, manifests []*apiclient.Hydrat
 	}()
 	enc.SetIndent(2)
 
-	for _, m := range manifests {
-		obj := &unstructured.Unstructured{}
-		err = json.Unmarshal([]byte(m.ManifestJSON), obj)
-		if err != nil {
-			return fmt.Errorf("failed to unmarshal manifest: %w", err)
-		}
+	for _, obj := range objs {
 		err = enc.Encode(&obj.Object)
 		if err != nil {
 			return fmt.Errorf("failed to encode manifest: %w", err)
@@ -103,7 +103,7 @@ Argocd-reference-commit-sha: abc123
 	mockGitClient := gitmocks.NewClient(t)
 	mockGitClient.EXPECT().HasFileChanged(mock.Anything, mock.Anything).Return(true, nil).Times(len(paths))
 
-	shouldCommit, err := WriteForPaths(t.Context(), root, repoURL, drySha, metadata, paths, mockGitClient, settings.DefaultManifestHydrationReadmeTemplate)
+	shouldCommit, err := WriteForPaths(t.Context(), root, repoURL, drySha, metadata, paths, mockGitClient, settings.DefaultManifestHydrationReadmeTemplate, "SHA256:signing-key")
 	require.NoError(t, err)
 	require.True(t, shouldCommit)
 
@@ -123,6 +123,7 @@ Argocd-reference-commit-sha: abc123
 	assert.Equal(t, "Signed-off-by: Test User <test@example.com>\n", topMetadata.Body)
 	assert.Equal(t, metadata.Date.Format(time.RFC3339), topMetadata.Date)
 	assert.Equal(t, metadata.References, topMetadata.References)
+	assert.Equal(t, "SHA256:signing-key", topMetadata.SigningKey)
 
 	for _, p := range paths {
 		fullHydratePath := filepath.Join(root.Name(), p.Path)
@@ -139,6 +140,7 @@ Argocd-reference-commit-sha: abc123
 		err = json.Unmarshal(metadataBytes, &readMetadata)
 		require.NoError(t, err)
 		assert.Equal(t, repoURL, readMetadata.RepoURL)
+		assert.Equal(t, "SHA256:signing-key", readMetadata.SigningKey)
 		// Check if each path contains a README.md file and contains the repo URL
 		readmePath := path.Join(fullHydratePath, "README.md")
 		readmeBytes, err := os.ReadFile(readmePath)
@@ -209,7 +211,7 @@ Argocd-reference-commit-sha: abc123
 	mockGitClient.EXPECT().HasFileChanged(mock.Anything, "path2/manifest.yaml").Return(true, nil).Once()
 	mockGitClient.EXPECT().HasFileChanged(mock.Anything, "path3/nested/manifest.yaml").Return(false, nil).Once()
 
-	shouldCommit, err := WriteForPaths(t.Context(), root, repoURL, drySha, metadata, paths, mockGitClient, settings.DefaultManifestHydrationReadmeTemplate)
+	shouldCommit, err := WriteForPaths(t.Context(), root, repoURL, drySha, metadata, paths, mockGitClient, settings.DefaultManifestHydrationReadmeTemplate, "")
 	require.NoError(t, err)
 	require.True(t, shouldCommit)
 
@@ -342,8 +344,9 @@ func TestWriteManifests(t *testing.T) {
 		{ManifestJSON: `{"kind":"Pod","apiVersion":"v1"}`},
 	}
 
-	err := writeManifests(root, "", manifests)
+	written, err := writeManifests(root, "", "", manifests)
 	require.NoError(t, err)
+	assert.Equal(t, []string{"manifest.yaml"}, written)
 
 	manifestPath := path.Join(root.Name(), "manifest.yaml")
 	manifestBytes, err := os.ReadFile(manifestPath)
@@ -351,6 +354,150 @@ func TestWriteManifests(t *testing.T) {
 	assert.Contains(t, string(manifestBytes), "kind")
 }
 
+func TestWriteManifests_Layouts(t *testing.T) {
+	t.Parallel()
+
+	manifests := []*apiclient.HydratedManifestDetails{
+		{ManifestJSON: `{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"guestbook"}}`},
+		{ManifestJSON: `{"apiVersion":"v1","kind":"Service","metadata":{"name":"guestbook-ui","namespace":"guestbook"}}`},
+		{ManifestJSON: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook-ui","namespace":"guestbook"}}`},
+		{ManifestJSON: `{"apiVersion":"v1","kind":"Service","metadata":{"name":"guestbook-ui","namespace":"preview"}}`},
+		{ManifestJSON: `{"apiVersion":"rbac.authorization.k8s.io/v1","kind":"ClusterRole","metadata":{"name":"system:guestbook"}}`},
+	}
+
+	tests := []struct {
+		layout   appsv1.HydratedManifestLayout
+		expected map[string][]string
+	}{{
+		layout:   appsv1.HydratedManifestLayoutSingleFile,
+		expected: map[string][]string{"manifest.yaml": {"Namespace", "Service", "Deployment", "Service", "ClusterRole"}},
+	}, {
+		layout: appsv1.HydratedManifestLayoutPerResource,
+		expected: map[string][]string{
+			"namespace-guestbook.yaml":            {"Namespace"},
+			"service-guestbook-guestbook-ui.yaml": {"Service"},
+			"deployment-guestbook-ui.yaml":        {"Deployment"},
+			"service-preview-guestbook-ui.yaml":   {"Service"},
+			"clusterrole-system_guestbook.yaml":   {"ClusterRole"},
+		},
+	}, {
+		layout: appsv1.HydratedManifestLayoutPerNamespace,
+		expected: map[string][]string{
+			"_cluster.yaml":  {"Namespace", "ClusterRole"},
+			"guestbook.yaml": {"Service", "Deployment"},
+			"preview.yaml":   {"Service"},
+		},
+	}, {
+		layout: appsv1.HydratedManifestLayoutKustomization,
+		expected: map[string][]string{
+			"manifest.yaml":      {"Namespace", "Service", "Deployment", "Service", "ClusterRole"},
+			"kustomization.yaml": {"Kustomization"},
+		},
+	}}
+	for _, tt := range tests {
+		t.Run(string(tt.layout), func(t *testing.T) {
+			t.Parallel()
+			root := tempRoot(t)
+			require.NoError(t, root.Mkdir("app", 0o755))
+
+			written, err := writeManifests(root, "app", string(tt.layout), manifests)
+			require.NoError(t, err)
+
+			expectedFileNames := make([]string, 0, len(tt.expected))
+			for fileName, kinds := range tt.expected {
+				expectedFileNames = append(expectedFileNames, fileName)
+				manifestBytes, err := os.ReadFile(filepath.Join(root.Name(), "app", fileName))
+				require.NoError(t, err)
+				var actualKinds []string
+				for _, doc := range strings.Split(string(manifestBytes), "---\n") {
+					for line := range strings.SplitSeq(doc, "\n") {
+						if kind, ok := strings.CutPrefix(line, "kind: "); ok {
+							actualKinds = append(actualKinds, kind)
+						}
+					}
+				}
+				assert.Equal(t, kinds, actualKinds, fileName)
+			}
+			assert.ElementsMatch(t, expectedFileNames, written)
+		})
+	}
+
+	t.Run("kustomization lists the manifest", func(t *testing.T) {
+		t.Parallel()
+		root := tempRoot(t)
+		_, err := writeManifests(root, "", string(appsv1.HydratedManifestLayoutKustomization), manifests)
+		require.NoError(t, err)
+		kustomizationBytes, err := os.ReadFile(filepath.Join(root.Name(), "kustomization.yaml"))
+		require.NoError(t, err)
+		assert.Equal(t, "apiVersion: kustomize.config.k8s.io/v1beta1\nkind: Kustomization\nresources:\n  - manifest.yaml\n", string(kustomizationBytes))
+	})
+
+	t.Run("duplicate resource file", func(t *testing.T) {
+		t.Parallel()
+		root := tempRoot(t)
+		_, err := writeManifests(root, "", string(appsv1.HydratedManifestLayoutPerResource), []*apiclient.HydratedManifestDetails{
+			{ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config"}}`},
+			{ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config"}}`},
+		})
+		require.EqualError(t, err, `more than one ConfigMap manifest is named "config"`)
+	})
+
+	t.Run("unknown layout", func(t *testing.T) {
+		t.Parallel()
+		root := tempRoot(t)
+		_, err := writeManifests(root, "", "Unknown", manifests)
+		require.EqualError(t, err, `unknown manifest layout "Unknown"`)
+	})
+}
+
+func TestWriteForPaths_LayoutChange(t *testing.T) {
+	t.Parallel()
+	root := tempRoot(t)
+
+	paths := []*apiclient.PathDetails{{
+		Path: "guestbook",
+		Manifests: []*apiclient.HydratedManifestDetails{
+			{ManifestJSON: `{"apiVersion":"v1","kind":"Service","metadata":{"name":"guestbook-ui"}}`},
+			{ManifestJSON: `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"guestbook-ui"}}`},
+		},
+	}}
+	mockGitClient := gitmocks.NewClient(t)
+	mockGitClient.EXPECT().HasFileChanged(mock.Anything, "guestbook/manifest.yaml").Return(true, nil).Once()
+	_, err := WriteForPaths(t.Context(), root, "https://github.com/example/repo", "abc123", &appsv1.RevisionMetadata{}, paths, mockGitClient, settings.DefaultManifestHydrationReadmeTemplate, "")
+	require.NoError(t, err)
+
+	// Switching to per-resource files removes the manifest.yaml of the previous hydration
+	paths[0].Layout = string(appsv1.HydratedManifestLayoutPerResource)
+	paths[0].Manifests = paths[0].Manifests[:1]
+	mockGitClient = gitmocks.NewClient(t)
+	mockGitClient.EXPECT().HasFileChanged(mock.Anything, "guestbook/manifest.yaml").Return(true, nil).Once()
+	shouldCommit, err := WriteForPaths(t.Context(), root, "https://github.com/example/repo", "def456", &appsv1.RevisionMetadata{}, paths, mockGitClient, settings.DefaultManifestHydrationReadmeTemplate, "")
+	require.NoError(t, err)
+	require.True(t, shouldCommit)
+
+	assert.Equal(t, []string{"README.md", "hydrator.metadata", "service-guestbook-ui.yaml"}, listFiles(t, root, "guestbook"))
+
+	// Files not written by the hydrator are kept
+	require.NoError(t, root.WriteFile("guestbook/extra.yaml", []byte("kind: ConfigMap\n"), 0o644))
+	paths[0].Layout = string(appsv1.HydratedManifestLayoutPerNamespace)
+	mockGitClient = gitmocks.NewClient(t)
+	mockGitClient.EXPECT().HasFileChanged(mock.Anything, "guestbook/_cluster.yaml").Return(true, nil).Once()
+	_, err = WriteForPaths(t.Context(), root, "https://github.com/example/repo", "fed789", &appsv1.RevisionMetadata{}, paths, mockGitClient, settings.DefaultManifestHydrationReadmeTemplate, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"README.md", "_cluster.yaml", "extra.yaml", "hydrator.metadata"}, listFiles(t, root, "guestbook"))
+}
+
+func listFiles(t *testing.T, root *os.Root, dirPath string) []string {
+	t.Helper()
+	entries, err := os.ReadDir(filepath.Join(root.Name(), dirPath))
+	require.NoError(t, err)
+	var fileNames []string
+	for _, entry := range entries {
+		fileNames = append(fileNames, entry.Name())
+	}
+	return fileNames
+}
+
 func TestWriteGitAttributes(t *testing.T) {
 	t.Parallel()
 	root := tempRoot(t)
@@ -515,7 +662,7 @@ func TestWriteForPaths_NoOpScenario(t *testing.T) {
 	mockGitClient1 := gitmocks.NewClient(t)
 	mockGitClient1.EXPECT().HasFileChanged(mock.Anything, "guestbook/manifest.yaml").Return(true, nil).Once()
 
-	shouldCommit1, err := WriteForPaths(t.Context(), root, repoURL, drySha1, metadata1, paths, mockGitClient1, settings.DefaultManifestHydrationReadmeTemplate)
+	shouldCommit1, err := WriteForPaths(t.Context(), root, repoURL, drySha1, metadata1, paths, mockGitClient1, settings.DefaultManifestHydrationReadmeTemplate, "")
 	require.NoError(t, err)
 	require.True(t, shouldCommit1, "First hydration should commit because manifests are new")
 
@@ -532,7 +679,7 @@ func TestWriteForPaths_NoOpScenario(t *testing.T) {
 	mockGitClient2 := gitmocks.NewClient(t)
 	mockGitClient2.EXPECT().HasFileChanged(mock.Anything, "guestbook/manifest.yaml").Return(false, nil).Once()
 
-	shouldCommit2, err := WriteForPaths(t.Context(), root, repoURL, drySha2, metadata2, paths, mockGitClient2, settings.DefaultManifestHydrationReadmeTemplate)
+	shouldCommit2, err := WriteForPaths(t.Context(), root, repoURL, drySha2, metadata2, paths, mockGitClient2, settings.DefaultManifestHydrationReadmeTemplate, "")
 	require.NoError(t, err)
 	require.False(t, shouldCommit2, "Second hydration should NOT commit because manifests didn't change")
 
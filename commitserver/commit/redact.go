@@ -0,0 +1,151 @@
+package commit
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	log "github.com/sirupsen/logrus"
+	"go.yaml.in/yaml/v3"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+)
+
+// redactedValue replaces the values of Secrets in the diff of the hydrated manifests
+const redactedValue = "++++++++"
+
+// secretValues collects the values to redact from a diff of hydrated manifests.
+type secretValues struct {
+	sensitiveAnnotations map[string]bool
+	values               map[string]bool
+}
+
+func newSecretValues(sensitiveAnnotations []string) *secretValues {
+	v := &secretValues{sensitiveAnnotations: map[string]bool{}, values: map[string]bool{}}
+	for _, key := range sensitiveAnnotations {
+		v.sensitiveAnnotations[key] = true
+	}
+	return v
+}
+
+// addManifests adds the values of the Secrets among the manifests about to be written.
+func (v *secretValues) addManifests(manifests []*apiclient.HydratedManifestDetails) error {
+	for _, m := range manifests {
+		obj := &unstructured.Unstructured{}
+		err := json.Unmarshal([]byte(m.ManifestJSON), obj)
+		if err != nil {
+			return fmt.Errorf("failed to unmarshal manifest: %w", err)
+		}
+		v.addObject(obj)
+	}
+	return nil
+}
+
+// addFiles adds the values of the Secrets in the manifest files of the directory, i.e. the manifests the diff is
+// computed against. Files which are not valid manifests are skipped.
+func (v *secretValues) addFiles(root *os.Root, dirPath string) error {
+	if dirPath == "" {
+		dirPath = "."
+	}
+	dir, err := root.Open(dirPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to open directory: %w", err)
+	}
+	defer func() {
+		err := dir.Close()
+		if err != nil {
+			log.WithError(err).Error("failed to close directory")
+		}
+	}()
+	entries, err := dir.ReadDir(-1)
+	if err != nil {
+		return fmt.Errorf("failed to read directory: %w", err)
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || (filepath.Ext(entry.Name()) != ".yaml" && filepath.Ext(entry.Name()) != ".yml") {
+			continue
+		}
+		data, err := root.ReadFile(filepath.Join(dirPath, entry.Name()))
+		if err != nil {
+			return fmt.Errorf("failed to read manifest file: %w", err)
+		}
+		objs, err := kube.SplitYAML(data)
+		if err != nil {
+			log.WithError(err).Debugf("Skipping invalid manifest file %s", entry.Name())
+			continue
+		}
+		for _, obj := range objs {
+			v.addObject(obj)
+		}
+	}
+	return nil
+}
+
+func (v *secretValues) addObject(obj *unstructured.Unstructured) {
+	if obj.GetKind() != kube.SecretKind || obj.GroupVersionKind().Group != "" {
+		return
+	}
+	for _, field := range []string{"data", "stringData"} {
+		data, _, _ := unstructured.NestedMap(obj.Object, field)
+		for _, value := range data {
+			if s, ok := value.(string); ok {
+				v.addValue(s)
+			}
+		}
+	}
+	for key, value := range obj.GetAnnotations() {
+		if v.sensitiveAnnotations[key] {
+			v.addValue(value)
+		}
+	}
+}
+
+// addValue adds a value, and each line of a multi-line value, since these are written as block scalars.
+func (v *secretValues) addValue(value string) {
+	for _, line := range strings.Split(value, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			v.values[line] = true
+		}
+	}
+}
+
+// redact redacts the collected values in a unified diff of YAML files. A line is redacted if its value, i.e. the value
+// of a `key: value` mapping entry or the line of a block scalar, is one of the collected values.
+func (v *secretValues) redact(diff string) string {
+	if len(v.values) == 0 {
+		return diff
+	}
+	lines := strings.Split(diff, "\n")
+	for i, line := range lines {
+		if line == "" || strings.HasPrefix(line, "+++ ") || strings.HasPrefix(line, "--- ") {
+			continue
+		}
+		if marker := line[0]; marker != '+' && marker != '-' && marker != ' ' {
+			continue
+		}
+		content := line[1:]
+		trimmed := strings.TrimLeft(content, " ")
+		indent := content[:len(content)-len(trimmed)]
+		trimmed = strings.TrimSpace(trimmed)
+		if v.values[trimmed] {
+			lines[i] = line[:1] + indent + redactedValue
+			continue
+		}
+		key, value, ok := strings.Cut(trimmed, ": ")
+		if !ok {
+			continue
+		}
+		var s string
+		if err := yaml.Unmarshal([]byte(value), &s); err == nil && v.values[strings.TrimSpace(s)] {
+			lines[i] = line[:1] + indent + key + ": " + redactedValue
+		}
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,96 @@
+package commit
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+)
+
+func TestSecretValues_Redact(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "apps/test"), 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "apps/test/manifest.yaml"), []byte(`apiVersion: v1
+data:
+  password: b2xkLXBhc3N3b3Jk
+kind: Secret
+metadata:
+  name: secret
+---
+apiVersion: v1
+data:
+  password: not-a-secret
+kind: ConfigMap
+metadata:
+  name: cm
+`), 0o644))
+	root, err := os.OpenRoot(dir)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = root.Close() })
+
+	secrets := newSecretValues([]string{"example.com/token"})
+	require.NoError(t, secrets.addFiles(root, "apps/test"))
+	require.NoError(t, secrets.addFiles(root, "apps/missing"))
+	require.NoError(t, secrets.addManifests([]*apiclient.HydratedManifestDetails{{
+		ManifestJSON: `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"secret","annotations":{"example.com/token":"abc","example.com/other":"def"}},` +
+			`"data":{"password":"bmV3LXBhc3N3b3Jk"},"stringData":{"config":"user: admin\npassword: \"hunter2\"\n"}}`,
+	}}))
+
+	diff := `diff --git a/apps/test/manifest.yaml b/apps/test/manifest.yaml
+--- a/apps/test/manifest.yaml
++++ b/apps/test/manifest.yaml
+@@ -1,11 +1,17 @@
+ apiVersion: v1
+ data:
+-  password: b2xkLXBhc3N3b3Jk
++  password: bmV3LXBhc3N3b3Jk
+ kind: Secret
+ metadata:
++  annotations:
++    example.com/other: def
++    example.com/token: "abc"
+   name: secret
++stringData:
++  config: |
++    user: admin
++    password: "hunter2"
+ ---
+ apiVersion: v1
+ data:
+   password: not-a-secret`
+
+	assert.Equal(t, `diff --git a/apps/test/manifest.yaml b/apps/test/manifest.yaml
+--- a/apps/test/manifest.yaml
++++ b/apps/test/manifest.yaml
+@@ -1,11 +1,17 @@
+ apiVersion: v1
+ data:
+-  password: ++++++++
++  password: ++++++++
+ kind: Secret
+ metadata:
++  annotations:
++    example.com/other: def
++    example.com/token: ++++++++
+   name: secret
++stringData:
++  config: |
++    ++++++++
++    ++++++++
+ ---
+ apiVersion: v1
+ data:
+   password: not-a-secret`, secrets.redact(diff))
+}
+
+func TestSecretValues_RedactNoSecrets(t *testing.T) {
+	secrets := newSecretValues(nil)
+	require.NoError(t, secrets.addManifests([]*apiclient.HydratedManifestDetails{{
+		ManifestJSON: `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cm"},"data":{"key":"value"}}`,
+	}}))
+	assert.Equal(t, "+  key: value", secrets.redact("+  key: value"))
+}
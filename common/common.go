@@ -59,9 +59,15 @@ const (
 	// ArgoCDTLSCertsConfigMapName contains TLS certificate data for connecting repositories. Will get mounted as volume to pods
 	ArgoCDTLSCertsConfigMapName = "argocd-tls-certs-cm"
 	ArgoCDGPGKeysConfigMapName  = "argocd-gpg-keys-cm"
+	// ArgoCDSSHSignersConfigMapName contains SSH public keys of the signers allowed to sign git commits. Will get mounted as volume to repo-server
+	ArgoCDSSHSignersConfigMapName = "argocd-ssh-signers-cm"
+	// ArgoCDCosignKeysConfigMapName contains cosign public keys trusted to sign OCI artifacts. Will get mounted as volume to repo-server
+	ArgoCDCosignKeysConfigMapName = "argocd-cosign-keys-cm"
 	// ArgoCDAppControllerShardConfigMapName contains the application controller to shard mapping
 	ArgoCDAppControllerShardConfigMapName = "argocd-app-controller-shard-cm"
 	ArgoCDCmdParamsConfigMapName          = "argocd-cmd-params-cm"
+	// ArgoCDPolicyConfigMapName contains the policies the generated manifests of the applications are evaluated against
+	ArgoCDPolicyConfigMapName = "argocd-policy-cm"
 )
 
 // Some default configurables
@@ -102,6 +108,12 @@ const (
 	DefaultSSHKnownHostsName = "ssh_known_hosts"
 	// DefaultGnuPgHomePath is the Default path to GnuPG home directory
 	DefaultGnuPgHomePath = "/app/config/gpg/keys"
+	// DefaultSSHSignersPath is the Default path where SSH public keys of the allowed git commit signers are stored
+	DefaultSSHSignersPath = "/app/config/ssh-signers"
+	// DefaultSSHAllowedSignersName is the Default name for the SSH allowed signers file composed from the configured signers
+	DefaultSSHAllowedSignersName = "argocd_ssh_allowed_signers"
+	// DefaultCosignKeysPath is the Default path where cosign public keys for OCI signature verification are stored
+	DefaultCosignKeysPath = "/app/config/cosign"
 	// DefaultAppConfigPath is the Default path to repo server TLS endpoint config
 	DefaultAppConfigPath = "/app/config"
 	// DefaultPluginSockFilePath is the Default path to cmp server plugin socket file
@@ -110,6 +122,8 @@ const (
 	DefaultPluginConfigFilePath = "/home/argocd/cmp-server/config"
 	// PluginConfigFileName is the Plugin Config File is a ConfigManagementPlugin manifest located inside the plugin container
 	PluginConfigFileName = "plugin.yaml"
+	// DefaultHealthPluginSockFilePath is the Default path to the health plugin socket files
+	DefaultHealthPluginSockFilePath = "/home/argocd/health-plugins"
 )
 
 // consts for podrequests metrics in cache/info
@@ -156,6 +170,11 @@ const (
 	// cluster changes, this algorithm minimises the changes between shard and clusters assignments.
 	ConsistentHashingWithBoundedLoadsAlgorithm = "consistent-hashing"
 
+	// LoadBalancedShardingAlgorithm uses an algorithm that weighs clusters by the load they put on the application
+	// controller, that is their resources count, event rate and reconciliation time, and rebalances the clusters across
+	// shards when the load of a shard exceeds the average load by more than a threshold.
+	LoadBalancedShardingAlgorithm = "load-balanced"
+
 	DefaultShardingAlgorithm = LegacyShardingAlgorithm
 )
 
@@ -248,6 +267,10 @@ const (
 	// Skip reconcile when the value is "true" or any other string values that can be strconv.ParseBool() to be true.
 	AnnotationKeyAppSkipReconcile = "argocd.argoproj.io/skip-reconcile"
 
+	// AnnotationKeyShardApplications tells the Application controller to distribute the Applications of a cluster across
+	// the controller shards, instead of assigning the whole cluster to a single shard. It is set on the cluster secret.
+	AnnotationKeyShardApplications = "argocd.argoproj.io/shard-applications"
+
 	// LabelKeyComponentRepoServer is the label key to identify the component as repo-server
 	LabelKeyComponentRepoServer = "app.kubernetes.io/component"
 	// LabelValueComponentRepoServer is the label value for the repo-server component
@@ -294,6 +317,8 @@ const (
 	EnvControllerShard = "ARGOCD_CONTROLLER_SHARD"
 	// EnvControllerShardingAlgorithm is the distribution sharding algorithm to be used: legacy or round-robin
 	EnvControllerShardingAlgorithm = "ARGOCD_CONTROLLER_SHARDING_ALGORITHM"
+	// EnvControllerShardingRebalanceThreshold is the relative amount by which the load of a shard must exceed the average shard load for the load-balanced sharding algorithm to move a cluster to another shard
+	EnvControllerShardingRebalanceThreshold = "ARGOCD_CONTROLLER_SHARDING_REBALANCE_THRESHOLD"
 	// EnvEnableDynamicClusterDistribution enables dynamic sharding (ALPHA)
 	EnvEnableDynamicClusterDistribution = "ARGOCD_ENABLE_DYNAMIC_CLUSTER_DISTRIBUTION"
 	// EnvEnableGRPCTimeHistogramEnv enables gRPC metrics collection
@@ -320,12 +345,18 @@ const (
 	EnvMaxCookieNumber = "ARGOCD_MAX_COOKIE_NUMBER"
 	// EnvPluginSockFilePath allows to override the pluginSockFilePath for repo server and cmp server
 	EnvPluginSockFilePath = "ARGOCD_PLUGINSOCKFILEPATH"
+	// EnvHealthPluginSockFilePath allows to override the path of the health plugin socket files for the application controller
+	EnvHealthPluginSockFilePath = "ARGOCD_HEALTH_PLUGINSOCKFILEPATH"
 	// EnvCMPChunkSize defines the chunk size in bytes used when sending files to the cmp server
 	EnvCMPChunkSize = "ARGOCD_CMP_CHUNK_SIZE"
 	// EnvCMPWorkDir defines the full path of the work directory used by the CMP server
 	EnvCMPWorkDir = "ARGOCD_CMP_WORKDIR"
 	// EnvGPGDataPath overrides the location where GPG keyring for signature verification is stored
 	EnvGPGDataPath = "ARGOCD_GPG_DATA_PATH"
+	// EnvSSHSignersDataPath overrides the location where SSH public keys of the allowed git commit signers are stored
+	EnvSSHSignersDataPath = "ARGOCD_SSH_SIGNERS_DATA_PATH"
+	// EnvCosignDataPath overrides the location where cosign public keys for signature verification are stored
+	EnvCosignDataPath = "ARGOCD_COSIGN_DATA_PATH"
 	// EnvServer is the server address of the Argo CD API server.
 	EnvServer = "ARGOCD_SERVER"
 	// EnvServerName is the name of the Argo CD server component, as specified by the value under the LabelKeyAppName label key.
@@ -343,6 +374,13 @@ const (
 	// EnvServerSideDiff defines the env var used to enable ServerSide Diff feature.
 	// If defined, value must be "true" or "false".
 	EnvServerSideDiff = "ARGOCD_APPLICATION_CONTROLLER_SERVER_SIDE_DIFF"
+	// EnvNativeResourceOperations defines the env var used to enable the native resource operations, which send the
+	// server-side applies, creates and replaces of the syncs directly to the Kubernetes API instead of using kubectl.
+	// If defined, value must be "true" or "false".
+	EnvNativeResourceOperations = "ARGOCD_APPLICATION_CONTROLLER_NATIVE_RESOURCE_OPERATIONS"
+	// EnvNativeResourceOperationsFieldManager defines the env var used to set the field manager of the native resource
+	// operations
+	EnvNativeResourceOperationsFieldManager = "ARGOCD_APPLICATION_CONTROLLER_NATIVE_RESOURCE_OPERATIONS_FIELD_MANAGER"
 	// EnvGRPCMaxSizeMB is the environment variable to look for a max GRPC message size
 	EnvGRPCMaxSizeMB = "ARGOCD_GRPC_MAX_SIZE_MB"
 )
@@ -408,6 +446,29 @@ func GetGnuPGHomePath() string {
 	return gnuPgHome
 }
 
+// GetSSHSignersPath retrieves the path to the directory with SSH public keys of the allowed git commit signers, which is either taken from ARGOCD_SSH_SIGNERS_DATA_PATH environment or a default value
+func GetSSHSignersPath() string {
+	sshSignersPath := os.Getenv(EnvSSHSignersDataPath)
+	if sshSignersPath == "" {
+		return DefaultSSHSignersPath
+	}
+	return sshSignersPath
+}
+
+// GetSSHAllowedSignersFilePath retrieves the path of the SSH allowed signers file git uses to verify SSH commit signatures
+func GetSSHAllowedSignersFilePath() string {
+	return filepath.Join(os.TempDir(), DefaultSSHAllowedSignersName)
+}
+
+// GetCosignKeysPath retrieves the path to the directory with cosign public keys, which is either taken from ARGOCD_COSIGN_DATA_PATH environment or a default value
+func GetCosignKeysPath() string {
+	cosignKeysPath := os.Getenv(EnvCosignDataPath)
+	if cosignKeysPath == "" {
+		return DefaultCosignKeysPath
+	}
+	return cosignKeysPath
+}
+
 // GetPluginSockFilePath retrieves the path of plugin sock file, which is either taken from PluginSockFilePath environment or a default value
 func GetPluginSockFilePath() string {
 	pluginSockFilePath := os.Getenv(EnvPluginSockFilePath)
@@ -417,6 +478,15 @@ func GetPluginSockFilePath() string {
 	return pluginSockFilePath
 }
 
+// GetHealthPluginSockFilePath retrieves the path of the health plugin sock files, which is either taken from HealthPluginSockFilePath environment or a default value
+func GetHealthPluginSockFilePath() string {
+	healthPluginSockFilePath := os.Getenv(EnvHealthPluginSockFilePath)
+	if healthPluginSockFilePath == "" {
+		return DefaultHealthPluginSockFilePath
+	}
+	return healthPluginSockFilePath
+}
+
 // GetCMPChunkSize will return the env var EnvCMPChunkSize value if defined or DefaultCMPChunkSize otherwise.
 // If EnvCMPChunkSize is defined but not a valid int, DefaultCMPChunkSize will be returned
 func GetCMPChunkSize() int {
@@ -443,6 +513,10 @@ func GetCMPWorkDir() string {
 const (
 	// AnnotationApplicationSetRefresh is an annotation that is added when an ApplicationSet is requested to be refreshed by a webhook. The ApplicationSet controller will remove this annotation at the end of reconciliation.
 	AnnotationApplicationSetRefresh = "argocd.argoproj.io/application-set-refresh"
+	// AnnotationApplicationSetRolloutApproveStep is an annotation that approves the RollingSync step whose 1-based index is the annotation value. The ApplicationSet controller will remove this annotation at the end of reconciliation.
+	AnnotationApplicationSetRolloutApproveStep = "argocd.argoproj.io/approve-rollout-step"
+	// AnnotationApplicationSetRolloutRejectStep is an annotation that rejects the RollingSync step whose 1-based index is the annotation value, failing the step. The ApplicationSet controller will remove this annotation at the end of reconciliation.
+	AnnotationApplicationSetRolloutRejectStep = "argocd.argoproj.io/reject-rollout-step"
 )
 
 // gRPC settings
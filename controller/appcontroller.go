@@ -313,7 +313,7 @@ func NewApplicationController(
 			return nil, err
 		}
 	}
-	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, ctrl.metricsServer, ctrl.handleObjectUpdated, clusterSharding, argo.NewResourceTracking())
+	stateCache := statecache.NewLiveStateCache(db, appInformer, ctrl.settingsMgr, ctrl.metricsServer, ctrl.handleObjectUpdated, clusterSharding, argo.NewResourceTracking(), ctrl.cache)
 	appStateManager := NewAppStateManager(db, applicationClientset, repoClientset, namespace, kubectl, ctrl.onKubectlRun, ctrl.settingsMgr, stateCache, ctrl.metricsServer, argoCache, ctrl.statusRefreshTimeout, argo.NewResourceTracking(), persistResourceHealth, repoErrorGracePeriod, serverSideDiff, ignoreNormalizerOpts)
 	ctrl.appInformer = appInformer
 	ctrl.appLister = appLister
@@ -798,6 +798,16 @@ func (ctrl *ApplicationController) getAppHosts(destCluster *appv1.Cluster, a *ap
 
 func (ctrl *ApplicationController) hideSecretData(ctx context.Context, destCluster *appv1.Cluster, app *appv1.Application, comparisonResult *comparisonResult) ([]*appv1.ResourceDiff, error) {
 	items := make([]*appv1.ResourceDiff, len(comparisonResult.managedResources))
+	var clusterCache clustercache.ClusterCache
+	getClusterCache := func() (clustercache.ClusterCache, error) {
+		if clusterCache == nil {
+			var err error
+			if clusterCache, err = ctrl.stateCache.GetClusterCache(destCluster); err != nil {
+				return nil, fmt.Errorf("error getting cluster cache: %w", err)
+			}
+		}
+		return clusterCache, nil
+	}
 	for i := range comparisonResult.managedResources {
 		res := comparisonResult.managedResources[i]
 		item := appv1.ResourceDiff{
@@ -835,9 +845,9 @@ func (ctrl *ApplicationController) hideSecretData(ctx context.Context, destClust
 				return nil, fmt.Errorf("error getting tracking method: %w", err)
 			}
 
-			clusterCache, err := ctrl.stateCache.GetClusterCache(destCluster)
+			clusterCache, err := getClusterCache()
 			if err != nil {
-				return nil, fmt.Errorf("error getting cluster cache: %w", err)
+				return nil, err
 			}
 			diffConfig, err := argodiff.NewDiffConfigBuilder().
 				WithDiffSettings(app.Spec.IgnoreDifferences, resourceOverrides, compareOptions.IgnoreAggregatedRoles, ctrl.ignoreNormalizerOpts).
@@ -879,12 +889,29 @@ func (ctrl *ApplicationController) hideSecretData(ctx context.Context, destClust
 		item.PredictedLiveState = string(resDiff.PredictedLive)
 		item.NormalizedLiveState = string(resDiff.NormalizedLive)
 		item.Modified = resDiff.Modified
+		if resDiff.Modified {
+			// the changes are informational only, so the diff is still reported without them if they cannot be computed
+			changes, err := getSemanticChanges(resDiff, getClusterCache)
+			if err != nil {
+				log.WithFields(applog.GetAppLogFields(app)).Warnf("Failed to compute the changes of %s/%s: %v", res.Kind, res.Name, err)
+			}
+			item.Changes = changes
+		}
 
 		items[i] = &item
 	}
 	return items, nil
 }
 
+// getSemanticChanges returns the field-level changes of the diff of a resource
+func getSemanticChanges(resDiff diff.DiffResult, getClusterCache func() (clustercache.ClusterCache, error)) ([]appv1.ResourceDiffChange, error) {
+	clusterCache, err := getClusterCache()
+	if err != nil {
+		return nil, err
+	}
+	return argodiff.SemanticChanges(resDiff, clusterCache.GetGVKParser())
+}
+
 // Run starts the Application CRD controller.
 // normalizeHydrationProcessors clamps the configured number of manifest hydration workers to a safe
 // minimum. The --hydration-processors flag / ARGOCD_APPLICATION_CONTROLLER_HYDRATION_PROCESSORS env var
@@ -941,6 +968,7 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 
 	go func() { errors.CheckError(ctrl.stateCache.Run(ctx)) }()
 	go func() { errors.CheckError(ctrl.metricsServer.ListenAndServe()) }()
+	go ctrl.clusterSharding.RunLoadBalancer(ctx, ctrl.kubeClientset, ctrl.settingsMgr.GetNamespace(), ctrl.getClustersResourcesCount, ctrl.requeueManagedApps)
 
 	for range statusProcessors {
 		go wait.Until(func() {
@@ -995,6 +1023,35 @@ func (ctrl *ApplicationController) Run(ctx context.Context, statusProcessors int
 	<-ctx.Done()
 }
 
+// getClustersResourcesCount returns the number of resources in the cache of each cluster managed by the controller
+func (ctrl *ApplicationController) getClustersResourcesCount() map[string]int64 {
+	clustersInfo := ctrl.stateCache.GetClustersInfo()
+	resources := make(map[string]int64, len(clustersInfo))
+	for _, info := range clustersInfo {
+		resources[info.Server] = int64(info.ResourcesCount)
+	}
+	return resources
+}
+
+// requeueManagedApps requeues all the applications which can be processed by the controller, after the clusters
+// managed by the controller changed
+func (ctrl *ApplicationController) requeueManagedApps() {
+	apps, err := ctrl.appLister.List(labels.Everything())
+	if err != nil {
+		log.Warnf("Failed to list applications: %v", err)
+		return
+	}
+	for _, app := range apps {
+		if !ctrl.canProcessApp(app) {
+			continue
+		}
+		key, err := cache.MetaNamespaceKeyFunc(app)
+		if err == nil {
+			ctrl.appRefreshQueue.AddRateLimited(key)
+		}
+	}
+}
+
 // requestAppRefresh adds a request for given app to the refresh queue. appName
 // needs to be the qualified name of the application, i.e. <namespace>/<name>.
 func (ctrl *ApplicationController) requestAppRefresh(appName string, compareWith *CompareWith, after *time.Duration) {
@@ -1292,7 +1349,7 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(ctx context.Conte
 			return fmt.Errorf("error getting permitted app live objects: %w", err)
 		}
 
-		done, err := ctrl.executePreDeleteHooks(ctx, app, proj, objsMap, config, logCtx)
+		done, err := ctrl.executePreDeleteHooks(ctx, app, proj, objsMap, config, destCluster.Server, logCtx)
 		if err != nil {
 			return fmt.Errorf("error executing pre-delete hooks: %w", err)
 		}
@@ -1374,7 +1431,7 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(ctx context.Conte
 			return err
 		}
 
-		done, err := ctrl.executePostDeleteHooks(ctx, app, proj, objsMap, config, logCtx)
+		done, err := ctrl.executePostDeleteHooks(ctx, app, proj, objsMap, config, destCluster.Server, logCtx)
 		if err != nil {
 			return err
 		}
@@ -1391,7 +1448,7 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(ctx context.Conte
 			return fmt.Errorf("error getting permitted app live objects for pre-delete cleanup: %w", err)
 		}
 
-		done, err := ctrl.cleanupPreDeleteHooks(ctx, objsMap, config, logCtx)
+		done, err := ctrl.cleanupPreDeleteHooks(ctx, objsMap, config, destCluster.Server, logCtx)
 		if err != nil {
 			return fmt.Errorf("error cleaning up pre-delete hooks: %w", err)
 		}
@@ -1408,7 +1465,7 @@ func (ctrl *ApplicationController) finalizeApplicationDeletion(ctx context.Conte
 			return err
 		}
 
-		done, err := ctrl.cleanupPostDeleteHooks(ctx, objsMap, config, logCtx)
+		done, err := ctrl.cleanupPostDeleteHooks(ctx, objsMap, config, destCluster.Server, logCtx)
 		if err != nil {
 			return err
 		}
@@ -1853,6 +1910,9 @@ func (ctrl *ApplicationController) processAppRefreshQueueItem() (processNext boo
 			destServer = destCluster.Server
 		}
 		ctrl.metricsServer.IncReconcile(origApp, destServer, reconcileDuration)
+		if destServer != "" {
+			ctrl.clusterSharding.ObserveReconcile(destServer, reconcileDuration)
+		}
 		for k, v := range ts.Timings() {
 			logCtx = logCtx.WithField(k, v.Milliseconds())
 		}
@@ -2613,7 +2673,7 @@ func (ctrl *ApplicationController) canProcessApp(obj any) bool {
 	if err != nil {
 		return ctrl.clusterSharding.IsManagedCluster(nil)
 	}
-	return ctrl.clusterSharding.IsManagedCluster(destCluster)
+	return ctrl.clusterSharding.IsManagedApp(app, destCluster)
 }
 
 func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.SharedIndexInformer, applisters.ApplicationLister) {
@@ -2676,6 +2736,7 @@ func (ctrl *ApplicationController) newApplicationInformerAndLister() (cache.Shar
 				}
 				return nil, nil
 			},
+			statecache.ManagedGroupKindIndex: statecache.ManagedGroupKindIndexFunc,
 		},
 	)
 	lister := applisters.NewApplicationLister(informer.GetIndexer())
@@ -2817,7 +2878,7 @@ func (ctrl *ApplicationController) projectErrorToCondition(err error, app *appv1
 }
 
 func (ctrl *ApplicationController) RegisterClusterSecretUpdater(ctx context.Context) {
-	updater := NewClusterInfoUpdater(ctrl.stateCache, ctrl.db, ctrl.appLister.Applications(""), ctrl.cache, ctrl.clusterSharding.IsManagedCluster, ctrl.getAppProj, ctrl.namespace)
+	updater := NewClusterInfoUpdater(ctrl.stateCache, ctrl.db, ctrl.appLister.Applications(""), ctrl.cache, ctrl.clusterSharding.IsClusterOwner, ctrl.getAppProj, ctrl.namespace)
 	go updater.Run(ctx)
 }
 
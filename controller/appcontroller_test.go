@@ -13,6 +13,7 @@ import (
 	"time"
 
 	clustercache "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/cache"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/diff"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube/kubetest"
 	"github.com/sirupsen/logrus"
@@ -4148,3 +4149,19 @@ func TestPersistAppStatus_AnnotationManagement(t *testing.T) {
 		assert.Equal(t, "other-value", otherValue)
 	})
 }
+
+func TestHideSecretData_ChangesAreBestEffort(t *testing.T) {
+	app := newFakeApp()
+	ctrl := newFakeController(t.Context(), &fakeData{apps: []runtime.Object{app, &defaultProj}}, nil)
+	mockStateCache := &mockstatecache.LiveStateCache{}
+	mockStateCache.EXPECT().GetClusterCache(mock.Anything).Return(nil, errors.New("cluster cache unavailable"))
+	ctrl.stateCache = mockStateCache
+
+	items, err := ctrl.hideSecretData(t.Context(), &v1alpha1.Cluster{Server: "https://localhost:6443"}, app, &comparisonResult{
+		managedResources: []managedResource{{Kind: "ConfigMap", Namespace: "default", Name: "cm", Diff: diff.DiffResult{Modified: true}}},
+	})
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.True(t, items[0].Modified)
+	assert.Empty(t, items[0].Changes)
+}
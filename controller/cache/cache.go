@@ -8,11 +8,14 @@ import (
 	"math"
 	"net"
 	"net/url"
+	"os"
 	"os/exec"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"syscall"
 	"time"
 
@@ -32,14 +35,15 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/controller/metrics"
 	"github.com/argoproj/argo-cd/v3/controller/sharding"
+	"github.com/argoproj/argo-cd/v3/healthplugin"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
+	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
 	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/env"
 	logutils "github.com/argoproj/argo-cd/v3/util/log"
-	"github.com/argoproj/argo-cd/v3/util/lua"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
@@ -76,6 +80,22 @@ const (
 	// EnvClusterCacheEventsProcessingInterval is the env variable to control the interval between processing events when BatchEventsProcessing is enabled
 	EnvClusterCacheEventsProcessingInterval = "ARGOCD_CLUSTER_CACHE_EVENTS_PROCESSING_INTERVAL"
 
+	// EnvClusterCacheSnapshotInterval is the env variable to control the interval between snapshots of the cluster caches.
+	// Snapshots are disabled if it is not set.
+	EnvClusterCacheSnapshotInterval = "ARGOCD_CLUSTER_CACHE_SNAPSHOT_INTERVAL"
+
+	// EnvClusterCacheSnapshotDir is the env variable that holds the directory where the cluster cache snapshots are stored.
+	// Snapshots are stored in Redis if it is not set.
+	EnvClusterCacheSnapshotDir = "ARGOCD_CLUSTER_CACHE_SNAPSHOT_DIR"
+
+	// EnvClusterCacheMetadataOnlyWatches is the env variable to control whether the resources of the group kinds which
+	// are not managed by any Application are watched with their metadata only
+	EnvClusterCacheMetadataOnlyWatches = "ARGOCD_CLUSTER_CACHE_METADATA_ONLY_WATCHES"
+
+	// ManagedGroupKindIndex is the name of the index of the Application informer which indexes the Applications by the
+	// group kinds of their managed resources
+	ManagedGroupKindIndex = "managedGroupKind"
+
 	// AnnotationIgnoreResourceUpdates when set to true on an untracked resource,
 	// argo will apply `ignoreResourceUpdates` configuration on it.
 	AnnotationIgnoreResourceUpdates = "argocd.argoproj.io/ignore-resource-updates"
@@ -116,6 +136,17 @@ var (
 
 	// clusterCacheEventsProcessingInterval specifies the interval between processing events when BatchEventsProcessing is enabled
 	clusterCacheEventsProcessingInterval = 100 * time.Millisecond
+
+	// clusterCacheSnapshotInterval specifies the interval between snapshots of the cluster caches, which allow the
+	// caches to resume watching the clusters after a restart. Snapshots are disabled if it is 0.
+	clusterCacheSnapshotInterval time.Duration
+
+	// clusterCacheSnapshotDir specifies the directory where the cluster cache snapshots are stored, instead of Redis
+	clusterCacheSnapshotDir string
+
+	// clusterCacheMetadataOnlyWatches specifies whether the resources of the group kinds which are not managed by any
+	// Application are watched with their metadata only
+	clusterCacheMetadataOnlyWatches = false
 )
 
 func init() {
@@ -129,6 +160,9 @@ func init() {
 	clusterCacheRetryUseBackoff = env.ParseBoolFromEnv(EnvClusterCacheRetryUseBackoff, false)
 	clusterCacheBatchEventsProcessing = env.ParseBoolFromEnv(EnvClusterCacheBatchEventsProcessing, true)
 	clusterCacheEventsProcessingInterval = env.ParseDurationFromEnv(EnvClusterCacheEventsProcessingInterval, clusterCacheEventsProcessingInterval, 0, math.MaxInt64)
+	clusterCacheSnapshotInterval = env.ParseDurationFromEnv(EnvClusterCacheSnapshotInterval, clusterCacheSnapshotInterval, 0, math.MaxInt64)
+	clusterCacheSnapshotDir = os.Getenv(EnvClusterCacheSnapshotDir)
+	clusterCacheMetadataOnlyWatches = env.ParseBoolFromEnv(EnvClusterCacheMetadataOnlyWatches, false)
 }
 
 type LiveStateCache interface {
@@ -171,6 +205,12 @@ type NodeInfo struct {
 	Labels     map[string]string
 }
 
+// EndpointSliceInfo holds the number of endpoints of an EndpointSlice
+type EndpointSliceInfo struct {
+	Endpoints      int
+	ReadyEndpoints int
+}
+
 type ResourceInfo struct {
 	Info    []appv1.InfoItem
 	AppName string
@@ -182,8 +222,20 @@ type ResourceInfo struct {
 	PodInfo *PodInfo
 	// NodeInfo is available for nodes only
 	NodeInfo *NodeInfo
+	// EndpointSliceInfo is available for endpoint slices only
+	EndpointSliceInfo *EndpointSliceInfo
 
 	manifestHash string
+	// pluginHealth is the health assessed by a health plugin in the background, which supersedes Health
+	pluginHealth atomic.Pointer[health.HealthStatus]
+}
+
+// getHealth returns the health of the resource
+func (r *ResourceInfo) getHealth() *health.HealthStatus {
+	if pluginHealth := r.pluginHealth.Load(); pluginHealth != nil {
+		return pluginHealth
+	}
+	return r.Health
 }
 
 func NewLiveStateCache(
@@ -194,16 +246,19 @@ func NewLiveStateCache(
 	onObjectUpdated ObjectUpdatedHandler,
 	clusterSharding sharding.ClusterShardingCache,
 	resourceTracking argo.ResourceTracking,
+	appStateCache *appstatecache.Cache,
 ) LiveStateCache {
 	return &liveStateCache{
 		appInformer:      appInformer,
 		db:               db,
 		clusters:         make(map[string]clustercache.ClusterCache),
+		clusterScopes:    make(map[string]clusterScope),
 		onObjectUpdated:  onObjectUpdated,
 		settingsMgr:      settingsMgr,
 		metricsServer:    metricsServer,
 		clusterSharding:  clusterSharding,
 		resourceTracking: resourceTracking,
+		appStateCache:    appStateCache,
 	}
 }
 
@@ -228,12 +283,22 @@ type liveStateCache struct {
 	clusterSharding      sharding.ClusterShardingCache
 	resourceTracking     argo.ResourceTracking
 	ignoreNormalizerOpts normalizers.IgnoreNormalizerOpts
+	// appStateCache stores the cluster cache snapshots if no snapshot directory is configured
+	appStateCache *appstatecache.Cache
 
-	clusters      map[string]clustercache.ClusterCache
+	clusters map[string]clustercache.ClusterCache
+	// clusterScopes are the namespaces and cluster-level resources watched by the cluster caches
+	clusterScopes map[string]clusterScope
 	cacheSettings cacheSettings
 	lock          sync.RWMutex
 }
 
+// clusterScope is the part of a cluster which is watched by its cluster cache
+type clusterScope struct {
+	namespaces       []string
+	clusterResources bool
+}
+
 func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
 	appInstanceLabelKey, err := c.settingsMgr.GetAppInstanceLabelKey()
 	if err != nil {
@@ -264,7 +329,7 @@ func (c *liveStateCache) loadCacheSettings() (*cacheSettings, error) {
 		return nil, err
 	}
 	clusterSettings := clustercache.Settings{
-		ResourceHealthOverride: lua.ResourceHealthOverrides(resourceOverrides),
+		ResourceHealthOverride: healthplugin.ResourceHealthOverrides{Overrides: resourceOverrides},
 		ResourcesFilter:        resourcesFilter,
 	}
 
@@ -306,8 +371,8 @@ func asResourceNode(r *clustercache.Resource, namespaceResources map[kube.Resour
 	}
 	var resHealth *appv1.HealthStatus
 	resourceInfo := resInfo(r)
-	if resourceInfo.Health != nil {
-		resHealth = &appv1.HealthStatus{Status: resourceInfo.Health.Status, Message: resourceInfo.Health.Message}
+	if resourceHealth := resourceInfo.getHealth(); resourceHealth != nil {
+		resHealth = &appv1.HealthStatus{Status: resourceHealth.Status, Message: resourceHealth.Message}
 	}
 	return appv1.ResourceNode{
 		ResourceRef: appv1.ResourceRef{
@@ -391,7 +456,8 @@ func skipResourceUpdate(oldInfo, newInfo *ResourceInfo) bool {
 	if oldInfo == nil || newInfo == nil {
 		return false
 	}
-	isSameHealthStatus := (oldInfo.Health == nil && newInfo.Health == nil) || oldInfo.Health != nil && newInfo.Health != nil && oldInfo.Health.Status == newInfo.Health.Status
+	oldHealth, newHealth := oldInfo.getHealth(), newInfo.getHealth()
+	isSameHealthStatus := (oldHealth == nil && newHealth == nil) || oldHealth != nil && newHealth != nil && oldHealth.Status == newHealth.Status
 	isSameManifest := oldInfo.manifestHash != "" && newInfo.manifestHash != "" && oldInfo.manifestHash == newInfo.manifestHash
 	return isSameHealthStatus && isSameManifest
 }
@@ -425,6 +491,66 @@ func shouldHashManifest(appName string, gvk schema.GroupVersionKind, un *unstruc
 	return isTrackedResource
 }
 
+// managedGroupKindIndexKey returns the key of the given group kind in the ManagedGroupKindIndex
+func managedGroupKindIndexKey(group string, kind string) string {
+	return group + "/" + kind
+}
+
+// ManagedGroupKindIndexFunc indexes the Applications by the group kinds of their managed resources
+func ManagedGroupKindIndexFunc(obj any) ([]string, error) {
+	app, ok := obj.(*appv1.Application)
+	if !ok {
+		return nil, nil
+	}
+	var keys []string
+	for _, res := range app.Status.Resources {
+		key := managedGroupKindIndexKey(res.Group, res.Kind)
+		if !slices.Contains(keys, key) {
+			keys = append(keys, key)
+		}
+	}
+	return keys, nil
+}
+
+// isMetadataOnlyGroupKind returns true if the resources of the given group kind can be watched with their metadata
+// only, which is the case if no Application manages resources of this group kind and if neither the resource info nor
+// the health of its resources depend on their spec or status. The group kinds are selected again when the resources
+// are listed, e.g. after the cache is invalidated because the resource overrides changed.
+func (c *liveStateCache) isMetadataOnlyGroupKind(gk schema.GroupKind) bool {
+	if populatesInfoFromManifest(gk) || c.hasHealthCheck(gk) {
+		return false
+	}
+	apps, err := c.appInformer.GetIndexer().ByIndex(ManagedGroupKindIndex, managedGroupKindIndexKey(gk.Group, gk.Kind))
+	return err == nil && len(apps) == 0
+}
+
+// onResourceHealthAssessed requests the refresh of the Application of the resource with the given key, whose health
+// was assessed by a health plugin in the background.
+func (c *liveStateCache) onResourceHealthAssessed(clusterCache clustercache.ClusterCache, key kube.ResourceKey) {
+	namespaceResources := clusterCache.FindResources(key.Namespace)
+	r, ok := namespaceResources[key]
+	if !ok {
+		return
+	}
+	if app := getApp(r, namespaceResources); app != "" {
+		c.onObjectUpdated(map[string]bool{app: isRootAppNode(r)}, r.Ref)
+	}
+}
+
+// hasHealthCheck returns true if the resources of the given group kind have a built-in health check, or a health check
+// configured in the resource overrides.
+func (c *liveStateCache) hasHealthCheck(gk schema.GroupKind) bool {
+	if health.GetHealthCheckFunc(gk.WithVersion("")) != nil {
+		return true
+	}
+	c.lock.RLock()
+	healthOverride := c.cacheSettings.clusterSettings.ResourceHealthOverride
+	c.lock.RUnlock()
+	// the built-in Lua health checks apply even without resource overrides
+	overrides, _ := healthOverride.(healthplugin.ResourceHealthOverrides)
+	return overrides.HasHealthCheck(gk)
+}
+
 // isRetryableError is a helper method to see whether an error
 // returned from the dynamic client is potentially retryable.
 func isRetryableError(err error) bool {
@@ -515,6 +641,8 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 		return nil, fmt.Errorf("controller is configured to ignore cluster %s", cluster.Server)
 	}
 
+	scope := c.getClusterScope(cluster)
+
 	resourceCustomLabels, err := c.settingsMgr.GetResourceCustomLabels()
 	if err != nil {
 		return nil, fmt.Errorf("error getting custom label: %w", err)
@@ -550,8 +678,8 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 		clustercache.SetClusterSyncRetryTimeout(clusterSyncRetryTimeoutDuration),
 		clustercache.SetResyncTimeout(clusterCacheResyncDuration),
 		clustercache.SetSettings(cacheSettings.clusterSettings),
-		clustercache.SetNamespaces(cluster.Namespaces),
-		clustercache.SetClusterResources(cluster.ClusterResources),
+		clustercache.SetNamespaces(scope.namespaces),
+		clustercache.SetClusterResources(scope.clusterResources),
 		clustercache.SetPopulateResourceInfoHandler(func(un *unstructured.Unstructured, isRoot bool) (any, bool) {
 			res := &ResourceInfo{}
 			populateNodeInfo(un, res, resourceCustomLabels)
@@ -559,7 +687,17 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 			cacheSettings := c.cacheSettings
 			c.lock.RUnlock()
 
-			res.Health, _ = health.GetResourceHealth(un, cacheSettings.clusterSettings.ResourceHealthOverride)
+			if overrides, ok := cacheSettings.clusterSettings.ResourceHealthOverride.(healthplugin.ResourceHealthOverrides); ok {
+				// health plugins are told which cluster the resource belongs to, and do not block the cluster cache
+				overrides.Server = cluster.Server
+				key := kube.GetResourceKey(un)
+				res.Health, _ = overrides.GetResourceHealthAsync(un, func(healthStatus *health.HealthStatus) {
+					res.pluginHealth.Store(healthStatus)
+					c.onResourceHealthAssessed(clusterCache, key)
+				})
+			} else {
+				res.Health, _ = health.GetResourceHealth(un, cacheSettings.clusterSettings.ResourceHealthOverride)
+			}
 
 			appName := c.resourceTracking.GetAppName(un, cacheSettings.appInstanceLabelKey, cacheSettings.trackingMethod, cacheSettings.installationID)
 			if isRoot && appName != "" {
@@ -587,6 +725,10 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 		clustercache.SetBatchEventsProcessing(clusterCacheBatchEventsProcessing),
 		clustercache.SetEventProcessingInterval(clusterCacheEventsProcessingInterval),
 	}
+	clusterCacheOpts = append(clusterCacheOpts, c.getSnapshotStoreSettings(cluster)...)
+	if clusterCacheMetadataOnlyWatches {
+		clusterCacheOpts = append(clusterCacheOpts, clustercache.SetMetadataOnlyFilter(c.isMetadataOnlyGroupKind))
+	}
 
 	clusterCache = clustercache.NewClusterCache(clusterCacheConfig, clusterCacheOpts...)
 
@@ -638,6 +780,7 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 	_ = clusterCache.OnEvent(func(_ watch.EventType, un *unstructured.Unstructured) {
 		gvk := un.GroupVersionKind()
 		c.metricsServer.IncClusterEventsCount(cluster.Server, gvk.Group, gvk.Kind)
+		c.clusterSharding.ObserveClusterEvent(cluster.Server)
 	})
 
 	_ = clusterCache.OnProcessEventsHandler(func(duration time.Duration, processedEventsNumber int) {
@@ -645,10 +788,105 @@ func (c *liveStateCache) getCluster(cluster *appv1.Cluster) (clustercache.Cluste
 	})
 
 	c.clusters[cluster.Server] = clusterCache
+	c.clusterScopes[cluster.Server] = scope
 
 	return clusterCache, nil
 }
 
+// getClusterScope returns the part of the cluster which should be watched by its cluster cache. When the Applications
+// of the cluster are sharded, the cache only watches the destination namespaces of the Applications managed by the
+// shard, and cluster-level resources if the cluster is not restricted to namespaces. The whole cluster is watched if
+// one of these Applications has no destination namespace.
+func (c *liveStateCache) getClusterScope(cluster *appv1.Cluster) clusterScope {
+	scope := clusterScope{namespaces: cluster.Namespaces, clusterResources: cluster.ClusterResources}
+	if !sharding.IsAppShardedCluster(cluster) || c.appInformer == nil {
+		return scope
+	}
+	var namespaces []string
+	for _, obj := range c.appInformer.GetStore().List() {
+		app, ok := obj.(*appv1.Application)
+		if !ok {
+			continue
+		}
+		destCluster, err := argo.GetDestinationCluster(context.Background(), app.Spec.Destination, c.db)
+		if err != nil || destCluster.Server != cluster.Server || !c.clusterSharding.IsManagedApp(app, destCluster) {
+			continue
+		}
+		namespace := app.Spec.Destination.Namespace
+		if namespace == "" {
+			return scope
+		}
+		if len(cluster.Namespaces) > 0 && !slices.Contains(cluster.Namespaces, namespace) {
+			continue
+		}
+		if !slices.Contains(namespaces, namespace) {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	if len(namespaces) == 0 {
+		// an empty list of namespaces would watch all the namespaces
+		return scope
+	}
+	slices.Sort(namespaces)
+	return clusterScope{namespaces: namespaces, clusterResources: cluster.ClusterResources || len(cluster.Namespaces) == 0}
+}
+
+// updateClusterScope updates the part of the cluster watched by its cluster cache, if it changed. The cache is
+// resynced when it does.
+func (c *liveStateCache) updateClusterScope(cluster *appv1.Cluster) {
+	c.lock.RLock()
+	clusterCache, ok := c.clusters[cluster.Server]
+	c.lock.RUnlock()
+	if !ok {
+		return
+	}
+	scope := c.getClusterScope(cluster)
+	c.lock.Lock()
+	previousScope := c.clusterScopes[cluster.Server]
+	c.clusterScopes[cluster.Server] = scope
+	c.lock.Unlock()
+	if slices.Equal(previousScope.namespaces, scope.namespaces) && previousScope.clusterResources == scope.clusterResources {
+		return
+	}
+	log.Infof("Updating the namespaces watched in cluster %s to %v", cluster.Server, scope.namespaces)
+	clusterCache.Invalidate(clustercache.SetNamespaces(scope.namespaces), clustercache.SetClusterResources(scope.clusterResources))
+	go func() {
+		// warm up cluster cache
+		_ = clusterCache.EnsureSynced()
+	}()
+}
+
+// handleAppEvent updates the scope of the cluster cache of the destination of an Application whose cluster is
+// sharded by Application, since the namespaces watched by the cache depend on the Applications of the shard.
+func (c *liveStateCache) handleAppEvent(obj any) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	app, ok := obj.(*appv1.Application)
+	if !ok {
+		return
+	}
+	destCluster, err := argo.GetDestinationCluster(context.Background(), app.Spec.Destination, c.db)
+	if err != nil || !sharding.IsAppShardedCluster(destCluster) {
+		return
+	}
+	c.updateClusterScope(destCluster)
+}
+
+// updateAppShardedClusterScopes updates the scope of the cluster caches of all the clusters sharded by Application
+func (c *liveStateCache) updateAppShardedClusterScopes() {
+	clusters, err := c.db.ListClusters(context.Background())
+	if err != nil {
+		log.Warnf("Failed to list clusters: %v", err)
+		return
+	}
+	for i := range clusters.Items {
+		if sharding.IsAppShardedCluster(&clusters.Items[i]) {
+			c.updateClusterScope(&clusters.Items[i])
+		}
+	}
+}
+
 func (c *liveStateCache) getSyncedCluster(server *appv1.Cluster) (clustercache.ClusterCache, error) {
 	clusterCache, err := c.getCluster(server)
 	if err != nil {
@@ -755,7 +993,7 @@ func (c *liveStateCache) isClusterHasApps(apps []any, cluster *appv1.Cluster) bo
 			log.Warnf("Failed to get destination cluster: %v", err)
 			continue
 		}
-		if destCluster.Server == cluster.Server {
+		if destCluster.Server == cluster.Server && c.clusterSharding.IsManagedApp(app, destCluster) {
 			return true
 		}
 	}
@@ -808,6 +1046,24 @@ func (c *liveStateCache) Init() error {
 func (c *liveStateCache) Run(ctx context.Context) error {
 	go c.watchSettings(ctx)
 
+	if c.appInformer != nil {
+		_, err := c.appInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc: c.handleAppEvent,
+			UpdateFunc: func(oldObj, newObj any) {
+				oldApp, oldOK := oldObj.(*appv1.Application)
+				newApp, newOK := newObj.(*appv1.Application)
+				if oldOK && newOK && oldApp.Spec.Destination != newApp.Spec.Destination {
+					c.handleAppEvent(oldApp)
+					c.handleAppEvent(newApp)
+				}
+			},
+			DeleteFunc: c.handleAppEvent,
+		})
+		if err != nil {
+			return fmt.Errorf("error adding application event handler: %w", err)
+		}
+	}
+
 	kube.RetryUntilSucceed(ctx, clustercache.ClusterRetryTimeout, "watch clusters", logutils.NewLogrusLogger(logutils.NewWithCurrentConfig()), func() error {
 		return c.db.WatchClusters(ctx, c.handleAddEvent, c.handleModEvent, c.handleDeleteEvent)
 	})
@@ -855,6 +1111,7 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 			cluster.Invalidate()
 			c.lock.Lock()
 			delete(c.clusters, newCluster.Server)
+			delete(c.clusterScopes, newCluster.Server)
 			c.lock.Unlock()
 			return
 		}
@@ -868,11 +1125,16 @@ func (c *liveStateCache) handleModEvent(oldCluster *appv1.Cluster, newCluster *a
 				log.Errorf("error getting cluster REST config: %v", err)
 			}
 		}
-		if !reflect.DeepEqual(oldCluster.Namespaces, newCluster.Namespaces) {
-			updateSettings = append(updateSettings, clustercache.SetNamespaces(newCluster.Namespaces))
+		scope := c.getClusterScope(newCluster)
+		c.lock.Lock()
+		previousScope := c.clusterScopes[newCluster.Server]
+		c.clusterScopes[newCluster.Server] = scope
+		c.lock.Unlock()
+		if !reflect.DeepEqual(previousScope.namespaces, scope.namespaces) {
+			updateSettings = append(updateSettings, clustercache.SetNamespaces(scope.namespaces))
 		}
-		if !reflect.DeepEqual(oldCluster.ClusterResources, newCluster.ClusterResources) {
-			updateSettings = append(updateSettings, clustercache.SetClusterResources(newCluster.ClusterResources))
+		if previousScope.clusterResources != scope.clusterResources {
+			updateSettings = append(updateSettings, clustercache.SetClusterResources(scope.clusterResources))
 		}
 		forceInvalidate := false
 		if newCluster.RefreshRequestedAt != nil &&
@@ -900,7 +1162,9 @@ func (c *liveStateCache) handleDeleteEvent(clusterServer string) {
 		cluster.Invalidate()
 		c.lock.Lock()
 		delete(c.clusters, clusterServer)
+		delete(c.clusterScopes, clusterServer)
 		c.lock.Unlock()
+		c.deleteClusterCacheSnapshot(clusterServer)
 	}
 }
 
@@ -927,5 +1191,8 @@ func (c *liveStateCache) GetClusterCache(server *appv1.Cluster) (clustercache.Cl
 
 // UpdateShard will update the shard of ClusterSharding when the shard has changed.
 func (c *liveStateCache) UpdateShard(shard int) bool {
-	return c.clusterSharding.UpdateShard(shard)
+	updated := c.clusterSharding.UpdateShard(shard)
+	// the Applications of the clusters sharded by Application may have changed, even if the shard was already updated
+	go c.updateAppShardedClusterScopes()
+	return updated
 }
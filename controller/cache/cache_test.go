@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net"
 	"net/url"
+	"strconv"
 	"sync"
 	"testing"
 	"time"
@@ -24,10 +25,12 @@ import (
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
 	"github.com/stretchr/testify/mock"
 	"k8s.io/client-go/kubernetes/fake"
+	k8scache "k8s.io/client-go/tools/cache"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/controller/metrics"
 	"github.com/argoproj/argo-cd/v3/controller/sharding"
+	"github.com/argoproj/argo-cd/v3/healthplugin"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	dbmocks "github.com/argoproj/argo-cd/v3/util/db/mocks"
@@ -80,6 +83,7 @@ func TestHandleModEvent_HasChanges(_ *testing.T) {
 		clusters: map[string]cache.ClusterCache{
 			"https://mycluster": clusterCache,
 		},
+		clusterScopes:   map[string]clusterScope{},
 		clusterSharding: sharding.NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm),
 	}
 
@@ -111,6 +115,7 @@ func TestHandleModEvent_ClusterExcluded(t *testing.T) {
 		clusterSharding:  sharding.NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm),
 		resourceTracking: nil,
 		clusters:         map[string]cache.ClusterCache{"https://mycluster": clusterCache},
+		clusterScopes:    map[string]clusterScope{},
 		cacheSettings:    cacheSettings{},
 		lock:             sync.RWMutex{},
 	}
@@ -137,6 +142,7 @@ func TestHandleModEvent_NoChanges(_ *testing.T) {
 		clusters: map[string]cache.ClusterCache{
 			"https://mycluster": clusterCache,
 		},
+		clusterScopes:   map[string]clusterScope{},
 		clusterSharding: sharding.NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm),
 	}
 
@@ -149,6 +155,174 @@ func TestHandleModEvent_NoChanges(_ *testing.T) {
 	})
 }
 
+func TestGetClusterScope(t *testing.T) {
+	appSharded := &appv1.Cluster{
+		ID:          "1",
+		Server:      "https://mycluster",
+		Annotations: map[string]string{common.AnnotationKeyShardApplications: "true"},
+	}
+	db := &dbmocks.ArgoDB{}
+	db.EXPECT().GetApplicationControllerReplicas().Return(1).Maybe()
+	db.EXPECT().GetCluster(mock.Anything, appSharded.Server).Return(appSharded, nil).Maybe()
+	db.EXPECT().GetCluster(mock.Anything, "https://other").Return(&appv1.Cluster{Server: "https://other"}, nil).Maybe()
+
+	appInformer := k8scache.NewSharedIndexInformer(nil, &appv1.Application{}, 0, k8scache.Indexers{})
+	newApp := func(name, server, namespace string) *appv1.Application {
+		return &appv1.Application{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "argocd"},
+			Spec:       appv1.ApplicationSpec{Destination: appv1.ApplicationDestination{Server: server, Namespace: namespace}},
+		}
+	}
+	require.NoError(t, appInformer.GetStore().Add(newApp("app1", appSharded.Server, "ns2")))
+	require.NoError(t, appInformer.GetStore().Add(newApp("app2", appSharded.Server, "ns1")))
+	require.NoError(t, appInformer.GetStore().Add(newApp("app3", appSharded.Server, "ns1")))
+	require.NoError(t, appInformer.GetStore().Add(newApp("app4", "https://other", "ns3")))
+
+	clustersCache := liveStateCache{
+		db:              db,
+		appInformer:     appInformer,
+		clusterSharding: sharding.NewClusterSharding(db, 0, 1, common.DefaultShardingAlgorithm),
+	}
+
+	t.Run("cluster sharded by application", func(t *testing.T) {
+		assert.Equal(t, clusterScope{namespaces: []string{"ns1", "ns2"}, clusterResources: true}, clustersCache.getClusterScope(appSharded))
+	})
+
+	t.Run("cluster restricted to namespaces", func(t *testing.T) {
+		restricted := appSharded.DeepCopy()
+		restricted.Namespaces = []string{"ns1"}
+		assert.Equal(t, clusterScope{namespaces: []string{"ns1"}}, clustersCache.getClusterScope(restricted))
+	})
+
+	t.Run("cluster not sharded by application", func(t *testing.T) {
+		cluster := &appv1.Cluster{Server: "https://other", Namespaces: []string{"ns4"}, ClusterResources: true}
+		assert.Equal(t, clusterScope{namespaces: []string{"ns4"}, clusterResources: true}, clustersCache.getClusterScope(cluster))
+	})
+
+	t.Run("application without destination namespace", func(t *testing.T) {
+		require.NoError(t, appInformer.GetStore().Add(newApp("app5", appSharded.Server, "")))
+		assert.Equal(t, clusterScope{}, clustersCache.getClusterScope(appSharded))
+	})
+}
+
+func TestIsMetadataOnlyGroupKind(t *testing.T) {
+	appInformer := k8scache.NewSharedIndexInformer(nil, &appv1.Application{}, 0, k8scache.Indexers{ManagedGroupKindIndex: ManagedGroupKindIndexFunc})
+	require.NoError(t, appInformer.GetStore().Add(&appv1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "argocd"},
+		Status: appv1.ApplicationStatus{Resources: []appv1.ResourceStatus{
+			{Group: "apps", Kind: "Deployment", Name: "guestbook"},
+			{Kind: "ConfigMap", Name: "guestbook"},
+		}},
+	}))
+	clustersCache := liveStateCache{appInformer: appInformer}
+
+	assert.False(t, clustersCache.isMetadataOnlyGroupKind(schema.GroupKind{Group: "apps", Kind: "Deployment"}))
+	assert.False(t, clustersCache.isMetadataOnlyGroupKind(schema.GroupKind{Kind: "ConfigMap"}))
+	assert.True(t, clustersCache.isMetadataOnlyGroupKind(schema.GroupKind{Kind: "Secret"}))
+	assert.True(t, clustersCache.isMetadataOnlyGroupKind(schema.GroupKind{Group: "example.com", Kind: "Foo"}))
+	// the info of pods is populated from their spec and status
+	assert.False(t, clustersCache.isMetadataOnlyGroupKind(schema.GroupKind{Kind: "Pod"}))
+	// built-in health checks
+	assert.False(t, clustersCache.isMetadataOnlyGroupKind(schema.GroupKind{Group: "apps", Kind: "ReplicaSet"}))
+	assert.False(t, clustersCache.isMetadataOnlyGroupKind(schema.GroupKind{Group: "batch", Kind: "Job"}))
+	assert.False(t, clustersCache.isMetadataOnlyGroupKind(schema.GroupKind{Kind: "PersistentVolumeClaim"}))
+	// built-in Lua health check
+	assert.False(t, clustersCache.isMetadataOnlyGroupKind(schema.GroupKind{Group: "argoproj.io", Kind: "Rollout"}))
+
+	t.Run("health check overrides", func(t *testing.T) {
+		clustersCache := liveStateCache{appInformer: appInformer}
+		clustersCache.cacheSettings.clusterSettings.ResourceHealthOverride = healthplugin.ResourceHealthOverrides{Overrides: map[string]appv1.ResourceOverride{
+			"example.com/Lua":   {HealthLua: "return {}"},
+			"example.com/CEL":   {HealthCEL: "{'status': 'Healthy'}"},
+			"example.com/Other": {IgnoreDifferences: appv1.OverrideIgnoreDiff{JSONPointers: []string{"/spec"}}},
+			"plugin.io/*":       {HealthPlugin: &appv1.HealthPlugin{Name: "plugin"}},
+		}}
+		assert.False(t, clustersCache.isMetadataOnlyGroupKind(schema.GroupKind{Group: "example.com", Kind: "Lua"}))
+		assert.False(t, clustersCache.isMetadataOnlyGroupKind(schema.GroupKind{Group: "example.com", Kind: "CEL"}))
+		assert.False(t, clustersCache.isMetadataOnlyGroupKind(schema.GroupKind{Group: "plugin.io", Kind: "Foo"}))
+		assert.True(t, clustersCache.isMetadataOnlyGroupKind(schema.GroupKind{Group: "example.com", Kind: "Other"}))
+	})
+
+	t.Run("informer without index", func(t *testing.T) {
+		clustersCache := liveStateCache{appInformer: k8scache.NewSharedIndexInformer(nil, &appv1.Application{}, 0, k8scache.Indexers{})}
+		assert.False(t, clustersCache.isMetadataOnlyGroupKind(schema.GroupKind{Kind: "Secret"}))
+	})
+}
+
+func TestWatchSettings_InvalidatesOnResourceOverridesChange(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+	kubeClient, settingsMgr := fixtures(ctx, map[string]string{}, func(secret *corev1.Secret) {
+		secret.Data["server.secretkey"] = []byte("test")
+	})
+	invalidated := make(chan struct{}, 1)
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.EXPECT().Invalidate(mock.Anything).Run(func(_ ...cache.UpdateSettingsFunc) {
+		invalidated <- struct{}{}
+	}).Return().Once()
+	clustersCache := liveStateCache{
+		clusters:    map[string]cache.ClusterCache{"https://mycluster": clusterCache},
+		settingsMgr: settingsMgr,
+		appInformer: k8scache.NewSharedIndexInformer(nil, &appv1.Application{}, 0, k8scache.Indexers{ManagedGroupKindIndex: ManagedGroupKindIndexFunc}),
+	}
+	require.NoError(t, clustersCache.Init())
+	go clustersCache.watchSettings(ctx)
+
+	// the resources of the kind are relisted, and watched with their full manifest since the kind has a health check
+	gk := schema.GroupKind{Group: "example.com", Kind: "Foo"}
+	require.True(t, clustersCache.isMetadataOnlyGroupKind(gk))
+	// the config map is updated until the settings are watched
+	resourceVersion := 0
+	require.Eventually(t, func() bool {
+		cm, err := kubeClient.CoreV1().ConfigMaps("default").Get(ctx, common.ArgoCDConfigMapName, metav1.GetOptions{})
+		if err != nil {
+			return false
+		}
+		resourceVersion++
+		cm.ResourceVersion = strconv.Itoa(resourceVersion)
+		cm.Data = map[string]string{"resource.customizations.health.example.com_Foo": "return {}"}
+		if _, err = kubeClient.CoreV1().ConfigMaps("default").Update(ctx, cm, metav1.UpdateOptions{}); err != nil {
+			return false
+		}
+		select {
+		case <-invalidated:
+			return true
+		case <-time.After(100 * time.Millisecond):
+			return false
+		}
+	}, 10*time.Second, 10*time.Millisecond)
+	assert.False(t, clustersCache.isMetadataOnlyGroupKind(gk))
+}
+
+func TestOnResourceHealthAssessed(t *testing.T) {
+	parent := &cache.Resource{
+		Ref:  corev1.ObjectReference{APIVersion: "example.com/v1", Kind: "Database", Namespace: "default", Name: "db", UID: "1"},
+		Info: &ResourceInfo{AppName: "my-app"},
+	}
+	child := &cache.Resource{
+		Ref:             corev1.ObjectReference{APIVersion: "example.com/v1", Kind: "Replica", Namespace: "default", Name: "db-0", UID: "2"},
+		OwnerRefs:       []metav1.OwnerReference{{APIVersion: "example.com/v1", Kind: "Database", Name: "db", UID: "1"}},
+		Info:            &ResourceInfo{},
+		ResourceVersion: "1",
+	}
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.EXPECT().FindResources("default").Return(map[kube.ResourceKey]*cache.Resource{
+		parent.ResourceKey(): parent,
+		child.ResourceKey():  child,
+	})
+	var updated map[string]bool
+	clustersCache := liveStateCache{onObjectUpdated: func(managedByApp map[string]bool, _ corev1.ObjectReference) {
+		updated = managedByApp
+	}}
+
+	clustersCache.onResourceHealthAssessed(clusterCache, child.ResourceKey())
+	assert.Equal(t, map[string]bool{"my-app": false}, updated)
+
+	updated = nil
+	clustersCache.onResourceHealthAssessed(clusterCache, kube.ResourceKey{Group: "example.com", Kind: "Replica", Namespace: "default", Name: "deleted"})
+	assert.Nil(t, updated)
+}
+
 func TestHandleAddEvent_ClusterExcluded(t *testing.T) {
 	t.Parallel()
 	db := &dbmocks.ArgoDB{}
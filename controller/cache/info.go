@@ -75,6 +75,10 @@ func populateNodeInfo(un *unstructured.Unstructured, res *ResourceInfo, customLa
 		if gvk.Kind == kube.IngressKind {
 			populateIngressInfo(un, res)
 		}
+	case "discovery.k8s.io":
+		if gvk.Kind == "EndpointSlice" {
+			populateEndpointSliceInfo(un, res)
+		}
 	case "networking.istio.io":
 		switch gvk.Kind {
 		case "VirtualService":
@@ -96,6 +100,26 @@ func populateNodeInfo(un *unstructured.Unstructured, res *ResourceInfo, customLa
 	}
 }
 
+// populatesInfoFromManifest returns true if populateNodeInfo populates the info of the resources of the given group kind
+// from their spec or status, and not only from their metadata. It must be kept in sync with populateNodeInfo.
+func populatesInfoFromManifest(gk schema.GroupKind) bool {
+	switch gk.Group {
+	case "":
+		return gk.Kind == kube.PodKind || gk.Kind == kube.ServiceKind || gk.Kind == "Node"
+	case "extensions", "networking.k8s.io":
+		return gk.Kind == kube.IngressKind
+	case "discovery.k8s.io":
+		return gk.Kind == "EndpointSlice"
+	case "networking.istio.io":
+		return gk.Kind == "VirtualService" || gk.Kind == "ServiceEntry"
+	case "gateway.networking.k8s.io":
+		return slices.Contains([]string{"HTTPRoute", "GRPCRoute", "TCPRoute", "TLSRoute", "UDPRoute", "Gateway"}, gk.Kind)
+	case "argoproj.io":
+		return gk.Kind == "Application"
+	}
+	return false
+}
+
 func getIngress(un *unstructured.Unstructured) []corev1.LoadBalancerIngress {
 	ingress, ok, err := unstructured.NestedSlice(un.Object, "status", "loadBalancer", "ingress")
 	if !ok || err != nil {
@@ -129,6 +153,21 @@ func populateServiceInfo(un *unstructured.Unstructured, res *ResourceInfo) {
 	res.NetworkingInfo = &v1alpha1.ResourceNetworkingInfo{TargetLabels: targetLabels, Ingress: ingress, ExternalURLs: urls}
 }
 
+func populateEndpointSliceInfo(un *unstructured.Unstructured, res *ResourceInfo) {
+	endpoints, _, _ := unstructured.NestedSlice(un.Object, "endpoints")
+	res.EndpointSliceInfo = &EndpointSliceInfo{Endpoints: len(endpoints)}
+	for i := range endpoints {
+		endpoint, ok := endpoints[i].(map[string]any)
+		if !ok {
+			continue
+		}
+		// an endpoint without ready condition is ready
+		if ready, ok, err := unstructured.NestedBool(endpoint, "conditions", "ready"); !ok || err != nil || ready {
+			res.EndpointSliceInfo.ReadyEndpoints++
+		}
+	}
+}
+
 func getServiceName(backend map[string]any, gvk schema.GroupVersionKind) (string, error) {
 	switch gvk.Group {
 	case "extensions":
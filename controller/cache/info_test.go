@@ -1402,6 +1402,29 @@ func TestMaliciousLinkAnnotatedServiceInfoFiltered(t *testing.T) {
 	assert.Equal(t, []string{"http://my-grafana.example.com/pre-generated-link"}, info.NetworkingInfo.ExternalURLs)
 }
 
+func TestGetEndpointSliceInfo(t *testing.T) {
+	endpointSlice := strToUnstructured(`
+  apiVersion: discovery.k8s.io/v1
+  kind: EndpointSlice
+  metadata:
+    name: helm-guestbook-abcde
+    namespace: default
+  addressType: IPv4
+  endpoints:
+  - addresses: ["10.0.0.1"]
+    conditions:
+      ready: true
+  - addresses: ["10.0.0.2"]
+    conditions:
+      ready: false
+  - addresses: ["10.0.0.3"]`)
+
+	info := &ResourceInfo{}
+	populateNodeInfo(endpointSlice, info, []string{})
+	assert.Equal(t, &EndpointSliceInfo{Endpoints: 3, ReadyEndpoints: 2}, info.EndpointSliceInfo)
+	assert.True(t, populatesInfoFromManifest(endpointSlice.GroupVersionKind().GroupKind()))
+}
+
 func TestGetIstioVirtualServiceInfo(t *testing.T) {
 	info := &ResourceInfo{}
 	populateNodeInfo(testIstioVirtualService, info, []string{})
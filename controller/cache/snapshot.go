@@ -0,0 +1,195 @@
+package cache
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	clustercache "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/cache"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	log "github.com/sirupsen/logrus"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
+)
+
+// snapshotFormatVersion is part of the fingerprint of the cluster cache snapshots, and must be changed whenever the
+// serialization of ResourceInfo changes in an incompatible way.
+const snapshotFormatVersion = "v2"
+
+// clusterCacheSnapshotStore stores the snapshots of the cache of a cluster, either in a local directory or in Redis.
+// A snapshot is prefixed by the fingerprint of the settings used to populate the resource info, and is ignored if the
+// settings changed since it was taken.
+type clusterCacheSnapshotStore struct {
+	server        string
+	dir           string
+	appStateCache *appstatecache.Cache
+	fingerprint   func() (string, error)
+}
+
+// newClusterCacheSnapshotStore returns the snapshot store of the given cluster, or nil if snapshots are disabled
+func (c *liveStateCache) newClusterCacheSnapshotStore(server string) *clusterCacheSnapshotStore {
+	if clusterCacheSnapshotInterval <= 0 || (clusterCacheSnapshotDir == "" && c.appStateCache == nil) {
+		return nil
+	}
+	return &clusterCacheSnapshotStore{
+		server:        server,
+		dir:           clusterCacheSnapshotDir,
+		appStateCache: c.appStateCache,
+		fingerprint:   c.getSnapshotFingerprint,
+	}
+}
+
+// getSnapshotFingerprint returns the fingerprint of the settings used to populate the resource info
+func (c *liveStateCache) getSnapshotFingerprint() (string, error) {
+	resourceCustomLabels, err := c.settingsMgr.GetResourceCustomLabels()
+	if err != nil {
+		return "", fmt.Errorf("error getting custom label: %w", err)
+	}
+	c.lock.RLock()
+	cacheSettings := c.cacheSettings
+	c.lock.RUnlock()
+	data, err := json.Marshal([]any{
+		snapshotFormatVersion,
+		cacheSettings.appInstanceLabelKey,
+		cacheSettings.trackingMethod,
+		cacheSettings.installationID,
+		cacheSettings.ignoreResourceUpdatesEnabled,
+		cacheSettings.resourceOverrides,
+		resourceCustomLabels,
+	})
+	if err != nil {
+		return "", fmt.Errorf("error marshaling cache settings: %w", err)
+	}
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+func (s *clusterCacheSnapshotStore) path() string {
+	hash := sha256.Sum256([]byte(s.server))
+	return filepath.Join(s.dir, hex.EncodeToString(hash[:])+".snapshot")
+}
+
+func (s *clusterCacheSnapshotStore) Load() ([]byte, error) {
+	var data []byte
+	var err error
+	if s.dir != "" {
+		data, err = os.ReadFile(s.path())
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+	} else {
+		data, err = s.appStateCache.GetClusterCacheSnapshot(s.server)
+		if errors.Is(err, appstatecache.ErrCacheMiss) {
+			return nil, nil
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading snapshot of cluster %s: %w", s.server, err)
+	}
+
+	fingerprint, err := s.fingerprint()
+	if err != nil {
+		return nil, err
+	}
+	storedFingerprint, snapshot, ok := bytes.Cut(data, []byte("\n"))
+	if !ok || string(storedFingerprint) != fingerprint {
+		log.Infof("Ignoring snapshot of cluster %s taken with different settings", s.server)
+		return nil, nil
+	}
+	return snapshot, nil
+}
+
+func (s *clusterCacheSnapshotStore) Save(snapshot []byte) error {
+	fingerprint, err := s.fingerprint()
+	if err != nil {
+		return err
+	}
+	data := make([]byte, 0, len(fingerprint)+1+len(snapshot))
+	data = append(data, fingerprint...)
+	data = append(data, '\n')
+	data = append(data, snapshot...)
+
+	if s.dir == "" {
+		return s.appStateCache.SetClusterCacheSnapshot(s.server, data)
+	}
+	// write to a temporary file first, so that a snapshot is never partially written
+	file, err := os.CreateTemp(s.dir, ".snapshot-*")
+	if err != nil {
+		return fmt.Errorf("error creating snapshot file: %w", err)
+	}
+	defer os.Remove(file.Name())
+	if _, err := file.Write(data); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("error writing snapshot file: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("error writing snapshot file: %w", err)
+	}
+	if err := os.Rename(file.Name(), s.path()); err != nil {
+		return fmt.Errorf("error writing snapshot file: %w", err)
+	}
+	return nil
+}
+
+// Delete deletes the snapshot of the cluster
+func (s *clusterCacheSnapshotStore) Delete() error {
+	if s.dir == "" {
+		return s.appStateCache.SetClusterCacheSnapshot(s.server, nil)
+	}
+	if err := os.Remove(s.path()); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("error deleting snapshot file: %w", err)
+	}
+	return nil
+}
+
+// resourceInfoCodec serializes the ResourceInfo of the resources in the cluster cache snapshots
+type resourceInfoCodec struct{}
+
+// resourceInfoSnapshot is the representation of a ResourceInfo in the cluster cache snapshots
+type resourceInfoSnapshot struct {
+	*ResourceInfo
+	ManifestHash string               `json:"manifestHash,omitempty"`
+	PluginHealth *health.HealthStatus `json:"pluginHealth,omitempty"`
+}
+
+func (resourceInfoCodec) Marshal(info any) ([]byte, error) {
+	resInfo, ok := info.(*ResourceInfo)
+	if !ok {
+		return nil, fmt.Errorf("unexpected resource info type %T", info)
+	}
+	return json.Marshal(resourceInfoSnapshot{ResourceInfo: resInfo, ManifestHash: resInfo.manifestHash, PluginHealth: resInfo.pluginHealth.Load()})
+}
+
+func (resourceInfoCodec) Unmarshal(data []byte) (any, error) {
+	snapshot := resourceInfoSnapshot{ResourceInfo: &ResourceInfo{}}
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return nil, err
+	}
+	snapshot.manifestHash = snapshot.ManifestHash
+	snapshot.pluginHealth.Store(snapshot.PluginHealth)
+	return snapshot.ResourceInfo, nil
+}
+
+// getSnapshotStoreSettings returns the cluster cache settings which enable the snapshots of the cache of the cluster
+func (c *liveStateCache) getSnapshotStoreSettings(cluster *appv1.Cluster) []clustercache.UpdateSettingsFunc {
+	store := c.newClusterCacheSnapshotStore(cluster.Server)
+	if store == nil {
+		return nil
+	}
+	return []clustercache.UpdateSettingsFunc{clustercache.SetSnapshotStore(store, clusterCacheSnapshotInterval, resourceInfoCodec{})}
+}
+
+// deleteClusterCacheSnapshot deletes the snapshot of a cluster which is not managed anymore
+func (c *liveStateCache) deleteClusterCacheSnapshot(server string) {
+	if store := c.newClusterCacheSnapshotStore(server); store != nil {
+		if err := store.Delete(); err != nil {
+			log.Warnf("Failed to delete the cache snapshot of cluster %s: %v", server, err)
+		}
+	}
+}
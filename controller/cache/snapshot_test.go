@@ -0,0 +1,104 @@
+package cache
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	cacheutil "github.com/argoproj/argo-cd/v3/util/cache"
+	appstatecache "github.com/argoproj/argo-cd/v3/util/cache/appstate"
+)
+
+func newTestSnapshotStore(dir string, appStateCache *appstatecache.Cache, fingerprint *string) *clusterCacheSnapshotStore {
+	return &clusterCacheSnapshotStore{
+		server:        "https://kubernetes.default.svc",
+		dir:           dir,
+		appStateCache: appStateCache,
+		fingerprint: func() (string, error) {
+			return *fingerprint, nil
+		},
+	}
+}
+
+func TestClusterCacheSnapshotStore(t *testing.T) {
+	appStateCache := appstatecache.NewCache(cacheutil.NewCache(cacheutil.NewInMemoryCache(time.Hour)), time.Minute)
+	for name, dir := range map[string]string{"file": t.TempDir(), "redis": ""} {
+		t.Run(name, func(t *testing.T) {
+			fingerprint := "settings"
+			store := newTestSnapshotStore(dir, appStateCache, &fingerprint)
+
+			data, err := store.Load()
+			require.NoError(t, err)
+			assert.Nil(t, data)
+
+			require.NoError(t, store.Save([]byte("snapshot")))
+			data, err = store.Load()
+			require.NoError(t, err)
+			assert.Equal(t, []byte("snapshot"), data)
+
+			fingerprint = "other settings"
+			data, err = store.Load()
+			require.NoError(t, err)
+			assert.Nil(t, data)
+
+			fingerprint = "settings"
+			require.NoError(t, store.Delete())
+			data, err = store.Load()
+			require.NoError(t, err)
+			assert.Nil(t, data)
+		})
+	}
+}
+
+func TestClusterCacheSnapshotStore_FileIsReplaced(t *testing.T) {
+	dir := t.TempDir()
+	fingerprint := "settings"
+	store := newTestSnapshotStore(dir, nil, &fingerprint)
+
+	require.NoError(t, store.Save([]byte("first")))
+	require.NoError(t, store.Save([]byte("second")))
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+
+	data, err := store.Load()
+	require.NoError(t, err)
+	assert.Equal(t, []byte("second"), data)
+}
+
+func TestResourceInfoCodec(t *testing.T) {
+	info := &ResourceInfo{
+		Info:         []appv1.InfoItem{{Name: "Status Reason", Value: "Running"}},
+		AppName:      "my-app",
+		Images:       []string{"nginx:latest"},
+		Health:       &health.HealthStatus{Status: health.HealthStatusHealthy},
+		PodInfo:      &PodInfo{NodeName: "node", Phase: "Running"},
+		manifestHash: "abc",
+	}
+	codec := resourceInfoCodec{}
+
+	data, err := codec.Marshal(info)
+	require.NoError(t, err)
+	decoded, err := codec.Unmarshal(data)
+	require.NoError(t, err)
+	assert.Equal(t, info, decoded)
+
+	_, err = codec.Marshal("not a resource info")
+	require.Error(t, err)
+
+	t.Run("health assessed by a plugin", func(t *testing.T) {
+		info := &ResourceInfo{Health: &health.HealthStatus{Status: health.HealthStatusHealthy}}
+		info.pluginHealth.Store(&health.HealthStatus{Status: health.HealthStatusDegraded, Message: "from plugin"})
+
+		data, err := codec.Marshal(info)
+		require.NoError(t, err)
+		decoded, err := codec.Unmarshal(data)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusDegraded, Message: "from plugin"}, decoded.(*ResourceInfo).getHealth())
+	})
+}
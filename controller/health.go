@@ -2,19 +2,25 @@ package controller
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
+	clustercache "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/cache"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
 	hookutil "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/hook"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/ignore"
 	kubeutil "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
 	log "github.com/sirupsen/logrus"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	statecache "github.com/argoproj/argo-cd/v3/controller/cache"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	applog "github.com/argoproj/argo-cd/v3/util/app/log"
-	"github.com/argoproj/argo-cd/v3/util/lua"
 )
 
 // maxHealthCausesShown bounds how many causes are rendered in events/logs to keep them readable.
@@ -22,7 +28,9 @@ const maxHealthCausesShown = 3
 
 // setApplicationHealth updates the health statuses of all resources performed in the comparison.
 // It returns the aggregated application health status along with the resources that caused that status.
-func setApplicationHealth(resources []managedResource, statuses []appv1.ResourceStatus, resourceOverrides map[string]appv1.ResourceOverride, app *appv1.Application, persistResourceHealth bool) (health.HealthStatusCode, string, error) {
+// The healthy or progressing resources whose related resources are missing or not ready are degraded if relations is
+// not nil.
+func setApplicationHealth(resources []managedResource, statuses []appv1.ResourceStatus, healthOverrides health.HealthOverride, relations *relatedResources, app *appv1.Application, persistResourceHealth bool) (health.HealthStatusCode, string, error) {
 	var savedErr error
 	var errCount uint
 	var containsResources, containsLiveResources bool
@@ -50,7 +58,6 @@ func setApplicationHealth(resources []managedResource, statuses []appv1.Resource
 
 		var healthStatus *health.HealthStatus
 		var err error
-		healthOverrides := lua.ResourceHealthOverrides(resourceOverrides)
 		if res.Live == nil {
 			healthStatus = &health.HealthStatus{Status: health.HealthStatusMissing}
 		} else {
@@ -65,6 +72,11 @@ func setApplicationHealth(resources []managedResource, statuses []appv1.Resource
 				// also log so we don't lose the message
 				log.WithFields(applog.GetAppLogFields(app)).Warn(savedErr)
 			}
+			if relations != nil && healthStatus != nil && (healthStatus.Status == health.HealthStatusHealthy || healthStatus.Status == health.HealthStatusProgressing) {
+				if relatedHealth := relations.getHealth(res.Live); relatedHealth != nil && health.IsWorse(healthStatus.Status, relatedHealth.Status) {
+					healthStatus = relatedHealth
+				}
+			}
 		}
 
 		if healthStatus == nil {
@@ -140,3 +152,150 @@ func formatHealthCauses(causes []managedResource) string {
 	}
 	return "Caused by " + summary
 }
+
+var (
+	endpointSliceGroupKind = schema.GroupKind{Group: "discovery.k8s.io", Kind: "EndpointSlice"}
+	serviceGroupKind       = schema.GroupKind{Kind: kubeutil.ServiceKind}
+	storageClassGroupKind  = schema.GroupKind{Group: "storage.k8s.io", Kind: "StorageClass"}
+)
+
+// relatedResources assesses the health of live resources from the resources they reference in the cluster cache, which
+// the health checks of gitops-engine cannot see since they evaluate each resource alone.
+type relatedResources struct {
+	clusterCache clustercache.ClusterCache
+	// isWatched returns true if the resources of the given group kind are watched by the cluster cache. The references
+	// to resources which are not watched are not checked, since their absence from the cache does not mean anything.
+	isWatched func(gk schema.GroupKind) bool
+	// resources are the resources of the cluster cache loaded so far, indexed by namespace
+	resources map[string]map[kubeutil.ResourceKey]*clustercache.Resource
+}
+
+func newRelatedResources(clusterCache clustercache.ClusterCache, isWatched func(gk schema.GroupKind) bool) *relatedResources {
+	return &relatedResources{
+		clusterCache: clusterCache,
+		isWatched:    isWatched,
+		resources:    make(map[string]map[kubeutil.ResourceKey]*clustercache.Resource),
+	}
+}
+
+// getResources returns the resources of the given namespace, or the cluster-level resources if namespace is empty
+func (r *relatedResources) getResources(namespace string) map[kubeutil.ResourceKey]*clustercache.Resource {
+	if resources, ok := r.resources[namespace]; ok {
+		return resources
+	}
+	resources := r.clusterCache.FindResources(namespace, func(res *clustercache.Resource) bool {
+		return res.Ref.Namespace == namespace
+	})
+	r.resources[namespace] = resources
+	return resources
+}
+
+func (r *relatedResources) exists(gk schema.GroupKind, namespace string, name string) bool {
+	_, ok := r.getResources(namespace)[kubeutil.NewResourceKey(gk.Group, gk.Kind, namespace, name)]
+	return ok
+}
+
+// getHealth returns the health of the given live resource according to the resources it references, or nil if they
+// do not affect its health
+func (r *relatedResources) getHealth(obj *unstructured.Unstructured) *health.HealthStatus {
+	gk := obj.GroupVersionKind().GroupKind()
+	switch {
+	case gk == serviceGroupKind:
+		return r.getServiceHealth(obj)
+	case (gk.Group == "networking.k8s.io" || gk.Group == "extensions") && gk.Kind == kubeutil.IngressKind:
+		return r.getIngressHealth(obj)
+	case gk.Group == "" && gk.Kind == kubeutil.PersistentVolumeClaimKind:
+		return r.getPersistentVolumeClaimHealth(obj)
+	case gk.Group == "autoscaling" && gk.Kind == kubeutil.HorizontalPodAutoscalerKind:
+		return r.getHorizontalPodAutoscalerHealth(obj)
+	}
+	return nil
+}
+
+// getServiceHealth checks the EndpointSlices of a Service, which are owned by the Service
+func (r *relatedResources) getServiceHealth(obj *unstructured.Unstructured) *health.HealthStatus {
+	serviceType, _, _ := unstructured.NestedString(obj.Object, "spec", "type")
+	selector, _, _ := unstructured.NestedStringMap(obj.Object, "spec", "selector")
+	// the endpoints of the services without selector are not managed by Kubernetes
+	if serviceType == string(corev1.ServiceTypeExternalName) || len(selector) == 0 || !r.isWatched(endpointSliceGroupKind) {
+		return nil
+	}
+	endpoints, readyEndpoints := 0, 0
+	for _, res := range r.getResources(obj.GetNamespace()) {
+		if res.ResourceKey().GroupKind() != endpointSliceGroupKind || !slices.ContainsFunc(res.OwnerRefs, func(ref metav1.OwnerReference) bool {
+			return ref.UID == obj.GetUID()
+		}) {
+			continue
+		}
+		info, ok := res.Info.(*statecache.ResourceInfo)
+		if !ok || info.EndpointSliceInfo == nil {
+			return nil
+		}
+		endpoints += info.EndpointSliceInfo.Endpoints
+		readyEndpoints += info.EndpointSliceInfo.ReadyEndpoints
+	}
+	switch {
+	case endpoints == 0:
+		return &health.HealthStatus{Status: health.HealthStatusDegraded, Message: "Service has no endpoints: no pod matches its selector"}
+	case readyEndpoints == 0:
+		return &health.HealthStatus{Status: health.HealthStatusProgressing, Message: fmt.Sprintf("Waiting for the endpoints of the Service to be ready: 0 of %d ready", endpoints)}
+	}
+	return nil
+}
+
+// getIngressHealth checks the backend Services of an Ingress, which are the Services targeted by the Ingress in the
+// cluster cache
+func (r *relatedResources) getIngressHealth(obj *unstructured.Unstructured) *health.HealthStatus {
+	if !r.isWatched(serviceGroupKind) {
+		return nil
+	}
+	res, ok := r.getResources(obj.GetNamespace())[kubeutil.GetResourceKey(obj)]
+	if !ok {
+		return nil
+	}
+	info, ok := res.Info.(*statecache.ResourceInfo)
+	if !ok || info.NetworkingInfo == nil {
+		return nil
+	}
+	var missing []string
+	for _, ref := range info.NetworkingInfo.TargetRefs {
+		if ref.Group == serviceGroupKind.Group && ref.Kind == serviceGroupKind.Kind && !r.exists(serviceGroupKind, ref.Namespace, ref.Name) && !slices.Contains(missing, ref.Name) {
+			missing = append(missing, ref.Name)
+		}
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	slices.Sort(missing)
+	return &health.HealthStatus{Status: health.HealthStatusDegraded, Message: "Backend Service not found: " + strings.Join(missing, ", ")}
+}
+
+// getPersistentVolumeClaimHealth checks the StorageClass of a PersistentVolumeClaim
+func (r *relatedResources) getPersistentVolumeClaimHealth(obj *unstructured.Unstructured) *health.HealthStatus {
+	storageClassName, _, _ := unstructured.NestedString(obj.Object, "spec", "storageClassName")
+	phase, _, _ := unstructured.NestedString(obj.Object, "status", "phase")
+	// claims without storage class use the default one, and bound claims do not depend on their storage class anymore
+	if storageClassName == "" || phase == string(corev1.ClaimBound) || !r.isWatched(storageClassGroupKind) {
+		return nil
+	}
+	if r.exists(storageClassGroupKind, "", storageClassName) {
+		return nil
+	}
+	return &health.HealthStatus{Status: health.HealthStatusDegraded, Message: fmt.Sprintf("StorageClass %q not found", storageClassName)}
+}
+
+// getHorizontalPodAutoscalerHealth checks the scale target of a HorizontalPodAutoscaler
+func (r *relatedResources) getHorizontalPodAutoscalerHealth(obj *unstructured.Unstructured) *health.HealthStatus {
+	apiVersion, _, _ := unstructured.NestedString(obj.Object, "spec", "scaleTargetRef", "apiVersion")
+	kind, _, _ := unstructured.NestedString(obj.Object, "spec", "scaleTargetRef", "kind")
+	name, _, _ := unstructured.NestedString(obj.Object, "spec", "scaleTargetRef", "name")
+	gv, err := schema.ParseGroupVersion(apiVersion)
+	if err != nil || kind == "" || name == "" {
+		return nil
+	}
+	gk := schema.GroupKind{Group: gv.Group, Kind: kind}
+	if !r.isWatched(gk) || r.exists(gk, obj.GetNamespace(), name) {
+		return nil
+	}
+	return &health.HealthStatus{Status: health.HealthStatusDegraded, Message: fmt.Sprintf("Scale target %s %q not found", kind, name)}
+}
@@ -7,17 +7,22 @@ import (
 	"testing"
 	"time"
 
+	clustercache "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/cache"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/cache/mocks"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
 	synccommon "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/yaml"
 
 	"github.com/argoproj/argo-cd/v3/common"
+	statecache "github.com/argoproj/argo-cd/v3/controller/cache"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/lua"
@@ -66,7 +71,7 @@ func TestSetApplicationHealth(t *testing.T) {
 	}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, nil, app, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusDegraded, healthStatus)
 	assert.Equal(t, health.HealthStatusHealthy, resourceStatuses[0].Health.Status)
@@ -77,7 +82,7 @@ func TestSetApplicationHealth(t *testing.T) {
 
 	// now mark the job as a hook and retry. it should ignore the hook and consider the app healthy
 	failedJob.SetAnnotations(map[string]string{synccommon.AnnotationKeyHook: "PreSync"})
-	healthStatus, healthCauses, err = setApplicationHealth(resources, resourceStatuses, nil, app, true)
+	healthStatus, healthCauses, err = setApplicationHealth(resources, resourceStatuses, nil, nil, app, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	// A Healthy app has no contributing causes.
@@ -89,7 +94,7 @@ func TestSetApplicationHealth(t *testing.T) {
 	failedJob.SetAnnotations(nil)
 	failedJobIgnoreHealthcheck := resourceFromFile("./testdata/job-failed-ignore-healthcheck.yaml")
 	resources[1].Live = &failedJobIgnoreHealthcheck
-	healthStatus, healthCauses, err = setApplicationHealth(resources, resourceStatuses, nil, app, true)
+	healthStatus, healthCauses, err = setApplicationHealth(resources, resourceStatuses, nil, nil, app, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	assert.Empty(t, healthCauses)
@@ -103,7 +108,7 @@ func TestSetApplicationHealth_ResourceHealthNotPersisted(t *testing.T) {
 	}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, false)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, nil, app, false)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusDegraded, healthStatus)
 
@@ -116,7 +121,7 @@ func TestSetApplicationHealth_NoResource(t *testing.T) {
 	resources := []managedResource{}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, nil, app, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	assert.Empty(t, healthCauses)
@@ -131,7 +136,7 @@ func TestSetApplicationHealth_OnlyHooks(t *testing.T) {
 	}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, nil, app, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	// Hooks are skipped, so the Healthy app has no causes.
@@ -149,7 +154,7 @@ func TestSetApplicationHealth_MissingResource(t *testing.T) {
 	}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, nil, app, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	// The missing target-only resource does not degrade the app, so there are no causes.
@@ -168,7 +173,7 @@ func TestSetApplicationHealth_MissingResource_WithIgnoreHealthcheck(t *testing.T
 	}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, nil, app, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	// The ignored resource is not aggregated, so the Healthy app has no causes.
@@ -184,7 +189,7 @@ func TestSetApplicationHealth_MissingResource_WithChildApp(t *testing.T) {
 	}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, nil, app, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 	// An Unknown child app does not affect the parent, so the Healthy app has no causes.
@@ -202,7 +207,7 @@ func TestSetApplicationHealth_AllMissingResources(t *testing.T) {
 	}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, nil, app, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusMissing, healthStatus)
 	// The Missing app health from the all-missing fallback does not attribute individual causes.
@@ -222,7 +227,7 @@ func TestSetApplicationHealth_AllMissingResources_WithHooks(t *testing.T) {
 	}}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, nil, app, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusMissing, healthStatus)
 	// The all-missing fallback does not attribute individual causes.
@@ -242,7 +247,7 @@ func TestSetApplicationHealth_MultipleCauses(t *testing.T) {
 	}
 	resourceStatuses := initStatuses(resources)
 
-	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, app, true)
+	healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, lua.ResourceHealthOverrides{}, nil, app, true)
 	require.NoError(t, err)
 	assert.Equal(t, health.HealthStatusDegraded, healthStatus)
 	// Both failed Jobs are causes; the healthy Pod is not.
@@ -305,7 +310,7 @@ func TestSetApplicationHealth_HealthImproves(t *testing.T) {
 		resourceStatuses := initStatuses(resources)
 
 		t.Run(string(fmt.Sprintf("%s to %s", tc.oldStatus, tc.newStatus)), func(t *testing.T) {
-			healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+			healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, nil, app, true)
 			require.NoError(t, err)
 			assert.Equal(t, tc.newStatus, healthStatus)
 			// A non-Healthy app attributes the offending Pod as its cause; a Healthy app has none.
@@ -363,7 +368,7 @@ return hs`,
 		}, {}}
 		resourceStatuses := initStatuses(resources)
 
-		healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+		healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, nil, app, true)
 		require.NoError(t, err)
 		assert.Equal(t, health.HealthStatusDegraded, healthStatus)
 		// The Degraded child app is the cause of the parent's Degraded health.
@@ -377,7 +382,7 @@ return hs`,
 		}, {}}
 		resourceStatuses := initStatuses(resources)
 
-		healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+		healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, nil, app, true)
 		require.NoError(t, err)
 		assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 		// A Missing child app does not affect the parent, so there are no causes.
@@ -391,10 +396,120 @@ return hs`,
 		}, {}}
 		resourceStatuses := initStatuses(resources)
 
-		healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, app, true)
+		healthStatus, healthCauses, err := setApplicationHealth(resources, resourceStatuses, overrides, nil, app, true)
 		require.NoError(t, err)
 		assert.Equal(t, health.HealthStatusHealthy, healthStatus)
 		// An Unknown child app does not affect the parent, so there are no causes.
 		assert.Empty(t, healthCauses)
 	})
 }
+
+func mustYAMLToUnstructured(t *testing.T, manifest string) *unstructured.Unstructured {
+	t.Helper()
+	obj := &unstructured.Unstructured{}
+	require.NoError(t, yaml.Unmarshal([]byte(manifest), &obj.Object))
+	return obj
+}
+
+func TestSetApplicationHealth_RelatedResources(t *testing.T) {
+	service := mustYAMLToUnstructured(t, `
+apiVersion: v1
+kind: Service
+metadata: {name: web, namespace: default, uid: web-uid}
+spec:
+  selector: {app: web}`)
+	ingress := mustYAMLToUnstructured(t, `
+apiVersion: networking.k8s.io/v1
+kind: Ingress
+metadata: {name: web, namespace: default}
+status:
+  loadBalancer:
+    ingress: [{ip: 10.0.0.1}]`)
+	pvc := mustYAMLToUnstructured(t, `
+apiVersion: v1
+kind: PersistentVolumeClaim
+metadata: {name: data, namespace: default}
+spec: {storageClassName: fast}
+status: {phase: Pending}`)
+	hpa := mustYAMLToUnstructured(t, `
+apiVersion: autoscaling/v2
+kind: HorizontalPodAutoscaler
+metadata: {name: web, namespace: default}
+spec:
+  scaleTargetRef: {apiVersion: apps/v1, kind: Deployment, name: web}
+status:
+  conditions:
+  - {type: AbleToScale, status: "True"}`)
+
+	endpointSlice := &clustercache.Resource{
+		Ref:       corev1.ObjectReference{APIVersion: "discovery.k8s.io/v1", Kind: "EndpointSlice", Namespace: "default", Name: "web-abcde"},
+		OwnerRefs: []metav1.OwnerReference{{APIVersion: "v1", Kind: "Service", Name: "web", UID: "web-uid"}},
+		Info:      &statecache.ResourceInfo{EndpointSliceInfo: &statecache.EndpointSliceInfo{Endpoints: 2, ReadyEndpoints: 0}},
+	}
+	cachedIngress := &clustercache.Resource{
+		Ref: corev1.ObjectReference{APIVersion: "networking.k8s.io/v1", Kind: "Ingress", Namespace: "default", Name: "web"},
+		Info: &statecache.ResourceInfo{NetworkingInfo: &appv1.ResourceNetworkingInfo{TargetRefs: []appv1.ResourceRef{
+			{Kind: "Service", Namespace: "default", Name: "web"},
+			{Kind: "Service", Namespace: "default", Name: "missing"},
+		}}},
+	}
+	cachedService := &clustercache.Resource{Ref: corev1.ObjectReference{APIVersion: "v1", Kind: "Service", Namespace: "default", Name: "web", UID: "web-uid"}}
+
+	clusterCache := &mocks.ClusterCache{}
+	clusterCache.On("FindResources", mock.Anything, mock.Anything).Return(func(namespace string, _ ...func(r *clustercache.Resource) bool) map[kube.ResourceKey]*clustercache.Resource {
+		if namespace == "" {
+			return map[kube.ResourceKey]*clustercache.Resource{}
+		}
+		return map[kube.ResourceKey]*clustercache.Resource{
+			endpointSlice.ResourceKey(): endpointSlice,
+			cachedIngress.ResourceKey(): cachedIngress,
+			cachedService.ResourceKey(): cachedService,
+		}
+	})
+
+	resources := []managedResource{
+		{Group: "", Version: "v1", Kind: "Service", Namespace: "default", Name: "web", Live: service},
+		{Group: "networking.k8s.io", Version: "v1", Kind: "Ingress", Namespace: "default", Name: "web", Live: ingress},
+		{Group: "", Version: "v1", Kind: "PersistentVolumeClaim", Namespace: "default", Name: "data", Live: pvc},
+		{Group: "autoscaling", Version: "v2", Kind: "HorizontalPodAutoscaler", Namespace: "default", Name: "web", Live: hpa},
+	}
+
+	t.Run("broken references degrade the resources", func(t *testing.T) {
+		resourceStatuses := initStatuses(resources)
+		relations := newRelatedResources(clusterCache, func(_ schema.GroupKind) bool {
+			return true
+		})
+		healthStatus, _, err := setApplicationHealth(resources, resourceStatuses, nil, relations, app, true)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, healthStatus)
+		assert.Equal(t, &appv1.HealthStatus{Status: health.HealthStatusProgressing, Message: "Waiting for the endpoints of the Service to be ready: 0 of 2 ready"}, resourceStatuses[0].Health)
+		assert.Equal(t, &appv1.HealthStatus{Status: health.HealthStatusDegraded, Message: "Backend Service not found: missing"}, resourceStatuses[1].Health)
+		assert.Equal(t, &appv1.HealthStatus{Status: health.HealthStatusDegraded, Message: `StorageClass "fast" not found`}, resourceStatuses[2].Health)
+		assert.Equal(t, &appv1.HealthStatus{Status: health.HealthStatusDegraded, Message: `Scale target Deployment "web" not found`}, resourceStatuses[3].Health)
+	})
+
+	t.Run("references to resources which are not watched are not checked", func(t *testing.T) {
+		resourceStatuses := initStatuses(resources)
+		relations := newRelatedResources(clusterCache, func(_ schema.GroupKind) bool {
+			return false
+		})
+		_, _, err := setApplicationHealth(resources, resourceStatuses, nil, relations, app, true)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusHealthy, resourceStatuses[0].Health.Status)
+		assert.Equal(t, health.HealthStatusHealthy, resourceStatuses[1].Health.Status)
+		assert.Equal(t, health.HealthStatusProgressing, resourceStatuses[2].Health.Status)
+		assert.Equal(t, health.HealthStatusHealthy, resourceStatuses[3].Health.Status)
+	})
+
+	t.Run("service without endpoints", func(t *testing.T) {
+		endpointSlice.Info = &statecache.ResourceInfo{EndpointSliceInfo: &statecache.EndpointSliceInfo{}}
+		resourceStatuses := initStatuses(resources[:1])
+		relations := newRelatedResources(clusterCache, func(_ schema.GroupKind) bool {
+			return true
+		})
+		healthStatus, _, err := setApplicationHealth(resources[:1], resourceStatuses, nil, relations, app, true)
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, healthStatus)
+		assert.Equal(t, "Service has no endpoints: no pod matches its selector", resourceStatuses[0].Health.Message)
+	})
+}
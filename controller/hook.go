@@ -17,8 +17,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/client-go/rest"
 
-	"github.com/argoproj/argo-cd/v3/util/lua"
-
+	"github.com/argoproj/argo-cd/v3/healthplugin"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	argoutil "github.com/argoproj/argo-cd/v3/util/argo"
 	traceutil "github.com/argoproj/argo-cd/v3/util/trace"
@@ -96,7 +95,7 @@ func hasGitOpsEngineSyncPhaseHook(obj *unstructured.Unstructured) bool {
 }
 
 // executeHooks is a generic function to execute hooks of a specified type
-func (ctrl *ApplicationController) executeHooks(ctx context.Context, hookType HookType, app *appv1.Application, proj *appv1.AppProject, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (completed bool, retErr error) {
+func (ctrl *ApplicationController) executeHooks(ctx context.Context, hookType HookType, app *appv1.Application, proj *appv1.AppProject, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, server string, logCtx *log.Entry) (completed bool, retErr error) {
 	ctx, span := tracer.Start(ctx, "controller.executeHooks")
 	setAppTraceAttrs(span, app, attribute.String("argocd.hook.type", string(hookType)))
 	defer func() { traceutil.EndSpan(span, retErr) }()
@@ -183,7 +182,7 @@ func (ctrl *ApplicationController) executeHooks(ctx context.Context, hookType Ho
 	if err != nil {
 		return false, err
 	}
-	healthOverrides := lua.ResourceHealthOverrides(resourceOverrides)
+	healthOverrides := healthplugin.ResourceHealthOverrides{Overrides: resourceOverrides, Server: server}
 
 	progressingHooksCount := 0
 	var failedHooks []string
@@ -241,7 +240,7 @@ func (ctrl *ApplicationController) executeHooks(ctx context.Context, hookType Ho
 }
 
 // cleanupHooks is a generic function to clean up hooks of a specified type
-func (ctrl *ApplicationController) cleanupHooks(ctx context.Context, hookType HookType, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (completed bool, retErr error) {
+func (ctrl *ApplicationController) cleanupHooks(ctx context.Context, hookType HookType, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, server string, logCtx *log.Entry) (completed bool, retErr error) {
 	ctx, span := tracer.Start(ctx, "controller.cleanupHooks")
 	span.SetAttributes(attribute.String("argocd.hook.type", string(hookType)))
 	defer func() { traceutil.EndSpan(span, retErr) }()
@@ -249,7 +248,7 @@ func (ctrl *ApplicationController) cleanupHooks(ctx context.Context, hookType Ho
 	if err != nil {
 		return false, err
 	}
-	healthOverrides := lua.ResourceHealthOverrides(resourceOverrides)
+	healthOverrides := healthplugin.ResourceHealthOverrides{Overrides: resourceOverrides, Server: server}
 
 	pendingDeletionCount := 0
 	aggregatedHealth := health.HealthStatusHealthy
@@ -321,18 +320,18 @@ func (ctrl *ApplicationController) cleanupHooks(ctx context.Context, hookType Ho
 
 // Execute and cleanup hooks for pre-delete and post-delete operations
 
-func (ctrl *ApplicationController) executePreDeleteHooks(ctx context.Context, app *appv1.Application, proj *appv1.AppProject, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
-	return ctrl.executeHooks(ctx, PreDeleteHookType, app, proj, liveObjs, config, logCtx)
+func (ctrl *ApplicationController) executePreDeleteHooks(ctx context.Context, app *appv1.Application, proj *appv1.AppProject, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, server string, logCtx *log.Entry) (bool, error) {
+	return ctrl.executeHooks(ctx, PreDeleteHookType, app, proj, liveObjs, config, server, logCtx)
 }
 
-func (ctrl *ApplicationController) cleanupPreDeleteHooks(ctx context.Context, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
-	return ctrl.cleanupHooks(ctx, PreDeleteHookType, liveObjs, config, logCtx)
+func (ctrl *ApplicationController) cleanupPreDeleteHooks(ctx context.Context, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, server string, logCtx *log.Entry) (bool, error) {
+	return ctrl.cleanupHooks(ctx, PreDeleteHookType, liveObjs, config, server, logCtx)
 }
 
-func (ctrl *ApplicationController) executePostDeleteHooks(ctx context.Context, app *appv1.Application, proj *appv1.AppProject, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
-	return ctrl.executeHooks(ctx, PostDeleteHookType, app, proj, liveObjs, config, logCtx)
+func (ctrl *ApplicationController) executePostDeleteHooks(ctx context.Context, app *appv1.Application, proj *appv1.AppProject, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, server string, logCtx *log.Entry) (bool, error) {
+	return ctrl.executeHooks(ctx, PostDeleteHookType, app, proj, liveObjs, config, server, logCtx)
 }
 
-func (ctrl *ApplicationController) cleanupPostDeleteHooks(ctx context.Context, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, logCtx *log.Entry) (bool, error) {
-	return ctrl.cleanupHooks(ctx, PostDeleteHookType, liveObjs, config, logCtx)
+func (ctrl *ApplicationController) cleanupPostDeleteHooks(ctx context.Context, liveObjs map[kube.ResourceKey]*unstructured.Unstructured, config *rest.Config, server string, logCtx *log.Entry) (bool, error) {
+	return ctrl.cleanupHooks(ctx, PostDeleteHookType, liveObjs, config, server, logCtx)
 }
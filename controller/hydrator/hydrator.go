@@ -34,6 +34,14 @@ type RepoGetter interface {
 	GetRepository(ctx context.Context, repoURL, project string) (*appv1.Repository, error)
 }
 
+// RepoObjsGetter is an interface that defines the method for getting the manifests of the dry source of an application.
+// It's the subset of the Dependencies interface needed to preview a hydration outside the app controller.
+type RepoObjsGetter interface {
+	// GetRepoObjs returns the repository objects for the given application, source, and revision. It calls the repo-
+	// server and gets the manifests (objects).
+	GetRepoObjs(ctx context.Context, app *appv1.Application, source appv1.ApplicationSource, revision string, project *appv1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error)
+}
+
 // Dependencies is the interface for the dependencies of the Hydrator. It serves two purposes: 1) it prevents the
 // hydrator from having direct access to the app controller, and 2) it allows for easy mocking of dependencies in tests.
 // If you add something here, be sure that it is something the app controller needs to provide to the hydrator.
@@ -49,6 +57,10 @@ type Dependencies interface {
 	// GetProcessableApps returns a list of applications that are processable by the controller.
 	GetProcessableApps() (*appv1.ApplicationList, error)
 
+	// GetProcessableApp returns the application with the given name and namespace, if it is processable by the
+	// controller. It returns nil if there is no such application.
+	GetProcessableApp(appName string, appNamespace string) (*appv1.Application, error)
+
 	// EvaluateAppRevisionsChanges checks if any source revisions have changes without generating manifests.
 	// The returned string is the resolved revision for the given source.
 	EvaluateAppRevisionsChanges(ctx context.Context, app *appv1.Application, source appv1.ApplicationSource, revision string, project *appv1.AppProject, noRevisionCache bool) (bool, string, error)
@@ -93,6 +105,8 @@ type Hydrator struct {
 	commitClientset      commitclient.Clientset
 	repoClientset        apiclient.Clientset
 	repoGetter           RepoGetter
+
+	pullRequestCreatorFactory pullRequestCreatorFactory
 }
 
 // NewHydrator creates a new Hydrator instance with the given dependencies, status refresh timeout, commit clientset,
@@ -105,6 +119,8 @@ func NewHydrator(dependencies Dependencies, statusRefreshTimeout time.Duration,
 		commitClientset:      commitClientset,
 		repoClientset:        repoClientset,
 		repoGetter:           repoGetter,
+
+		pullRequestCreatorFactory: newPullRequestCreator,
 	}
 }
 
@@ -140,9 +156,6 @@ func (h *Hydrator) ProcessAppHydrateQueueItem(origApp *appv1.Application) {
 		logCtx.WithField("lastComparedDryRevision", resolvedDryRevision).Debug("Updated last compared dry revision")
 	}
 
-	// Always persist to consume the hydrate annotation, even if hydration is not needed.
-	h.dependencies.PersistHydrationStatus(origApp, &app.Status.SourceHydrator)
-
 	// needsRefresh re-enqueues the hydration key for an app that was marked Hydrating on an earlier
 	// pass but whose StartedAt has aged past statusRefreshTimeout (typically because the hydration
 	// worker crashed or fell behind). CurrentOperation can be nil here for an app that has never
@@ -150,6 +163,16 @@ func (h *Hydrator) ProcessAppHydrateQueueItem(origApp *appv1.Application) {
 	needsRefresh := app.Status.SourceHydrator.CurrentOperation != nil &&
 		app.Status.SourceHydrator.CurrentOperation.Phase == appv1.HydrateOperationPhaseHydrating &&
 		metav1.Now().Sub(app.Status.SourceHydrator.CurrentOperation.StartedAt.Time) > h.statusRefreshTimeout
+
+	// Promote the hydrated manifests only once they are up-to-date with the dry source. The gates are re-evaluated
+	// each time the app is processed, until the promotion succeeds.
+	if !needsHydration && !needsRefresh && needsPromotion(app) {
+		h.reconcilePromotion(ctx, app)
+	}
+
+	// Always persist to consume the hydrate annotation, even if hydration is not needed.
+	h.dependencies.PersistHydrationStatus(origApp, &app.Status.SourceHydrator)
+
 	if needsHydration || needsRefresh {
 		logCtx.WithField("reason", reason).Info("Hydrating app")
 		h.dependencies.AddHydrationQueueItem(getHydrationQueueKey(app))
@@ -557,12 +580,21 @@ func (h *Hydrator) hydrate(ctx context.Context, logCtx *log.Entry, apps []*appv1
 //
 // If the given target revision is empty, it uses the target revision from the app dry source spec.
 func (h *Hydrator) getManifests(ctx context.Context, app *appv1.Application, targetRevision string, project *appv1.AppProject) (revision string, pathDetails *commitclient.PathDetails, err error) {
+	return GetManifests(ctx, h.dependencies, app, targetRevision, project)
+}
+
+// GetManifests gets the manifests for the given application and target revision from the repo objects getter, the
+// same way the hydrator does when hydrating the application. It returns the resolved revision (a git SHA), and path
+// details for the commit server.
+//
+// If the given target revision is empty, it uses the target revision from the app dry source spec.
+func GetManifests(ctx context.Context, repoObjsGetter RepoObjsGetter, app *appv1.Application, targetRevision string, project *appv1.AppProject) (revision string, pathDetails *commitclient.PathDetails, err error) {
 	drySource := app.Spec.SourceHydrator.GetDrySource()
 	if targetRevision == "" {
 		targetRevision = drySource.TargetRevision
 	}
 
-	objs, resp, err := h.dependencies.GetRepoObjs(ctx, app, drySource, targetRevision, project)
+	objs, resp, err := repoObjsGetter.GetRepoObjs(ctx, app, drySource, targetRevision, project)
 	if err != nil {
 		return "", nil, fmt.Errorf("failed to get repo objects for app %q: %w", app.QualifiedName(), err)
 	}
@@ -590,6 +622,7 @@ func (h *Hydrator) getManifests(ctx context.Context, app *appv1.Application, tar
 		Path:      app.Spec.SourceHydrator.SyncSource.Path,
 		Manifests: manifestDetails,
 		Commands:  resp.Commands,
+		Layout:    string(app.Spec.SourceHydrator.SyncSource.Layout),
 	}, nil
 }
 
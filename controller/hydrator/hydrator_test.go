@@ -1380,6 +1380,7 @@ func TestHydrator_getManifests_Success(t *testing.T) {
 	d := mocks.NewDependencies(t)
 	h := &Hydrator{dependencies: d}
 	app := newTestApp("test-app")
+	app.Spec.SourceHydrator.SyncSource.Layout = v1alpha1.HydratedManifestLayoutPerResource
 	proj := newTestProject()
 
 	cm := kube.MustToUnstructured(&corev1.ConfigMap{
@@ -1398,6 +1399,7 @@ func TestHydrator_getManifests_Success(t *testing.T) {
 	assert.Equal(t, "sha123", rev)
 	assert.Equal(t, app.Spec.SourceHydrator.SyncSource.Path, pathDetails.Path)
 	assert.Equal(t, []string{"cmd1", "cmd2"}, pathDetails.Commands)
+	assert.Equal(t, "PerResource", pathDetails.Layout)
 	assert.Len(t, pathDetails.Manifests, 1)
 	assert.JSONEq(t, `{"metadata":{"name":"test"}}`, pathDetails.Manifests[0].ManifestJSON)
 }
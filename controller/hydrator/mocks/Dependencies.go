@@ -389,6 +389,74 @@ func (_c *Dependencies_GetHydratorReadmeMessageTemplate_Call) RunAndReturn(run f
 	return _c
 }
 
+// GetProcessableApp provides a mock function for the type Dependencies
+func (_mock *Dependencies) GetProcessableApp(appName string, appNamespace string) (*v1alpha1.Application, error) {
+	ret := _mock.Called(appName, appNamespace)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProcessableApp")
+	}
+
+	var r0 *v1alpha1.Application
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(string, string) (*v1alpha1.Application, error)); ok {
+		return returnFunc(appName, appNamespace)
+	}
+	if returnFunc, ok := ret.Get(0).(func(string, string) *v1alpha1.Application); ok {
+		r0 = returnFunc(appName, appNamespace)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.Application)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = returnFunc(appName, appNamespace)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Dependencies_GetProcessableApp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProcessableApp'
+type Dependencies_GetProcessableApp_Call struct {
+	*mock.Call
+}
+
+// GetProcessableApp is a helper method to define mock.On call
+//   - appName string
+//   - appNamespace string
+func (_e *Dependencies_Expecter) GetProcessableApp(appName any, appNamespace any) *Dependencies_GetProcessableApp_Call {
+	return &Dependencies_GetProcessableApp_Call{Call: _e.mock.On("GetProcessableApp", appName, appNamespace)}
+}
+
+func (_c *Dependencies_GetProcessableApp_Call) Run(run func(appName string, appNamespace string)) *Dependencies_GetProcessableApp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 string
+		if args[0] != nil {
+			arg0 = args[0].(string)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Dependencies_GetProcessableApp_Call) Return(application *v1alpha1.Application, err error) *Dependencies_GetProcessableApp_Call {
+	_c.Call.Return(application, err)
+	return _c
+}
+
+func (_c *Dependencies_GetProcessableApp_Call) RunAndReturn(run func(appName string, appNamespace string) (*v1alpha1.Application, error)) *Dependencies_GetProcessableApp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetProcessableAppProj provides a mock function for the type Dependencies
 func (_mock *Dependencies) GetProcessableAppProj(app *v1alpha1.Application) (*v1alpha1.AppProject, error) {
 	ret := _mock.Called(app)
@@ -0,0 +1,252 @@
+package hydrator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	giturls "github.com/chainguard-dev/git-urls"
+	log "github.com/sirupsen/logrus"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+
+	"github.com/argoproj/argo-cd/v3/applicationset/services/github_app_auth"
+	pullrequest "github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applog "github.com/argoproj/argo-cd/v3/util/app/log"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+)
+
+const (
+	// promotionRetryInterval is how long a failed promotion waits before it is retried
+	promotionRetryInterval = 2 * time.Minute
+	// promotionTimeout bounds the Git and SCM provider calls of a promotion, which run on the hydration worker
+	promotionTimeout = time.Minute
+)
+
+// pullRequestCreatorFactory returns a client opening pull requests with the SCM provider hosting the repository. The
+// repository holds the write credentials of the hydrated repository.
+type pullRequestCreatorFactory func(ctx context.Context, config *appv1.HydratePromotionPullRequest, repo *appv1.Repository) (pullrequest.PullRequestCreator, error)
+
+// needsPromotion answers if the most recently hydrated manifests of the application are still to be promoted to the
+// sync branch.
+func needsPromotion(app *appv1.Application) bool {
+	if app.Spec.SourceHydrator == nil || app.Spec.SourceHydrator.HydrateTo == nil || app.Spec.SourceHydrator.HydrateTo.Promotion == nil {
+		return false
+	}
+	lastOperation := app.Status.SourceHydrator.LastSuccessfulOperation
+	if lastOperation == nil || lastOperation.HydratedSHA == "" {
+		return false
+	}
+	if currentOperation := app.Status.SourceHydrator.CurrentOperation; currentOperation != nil && currentOperation.Phase == appv1.HydrateOperationPhaseHydrating {
+		return false
+	}
+	promotion := app.Status.SourceHydrator.CurrentPromotion
+	if promotion == nil || promotion.HydratedSHA != lastOperation.HydratedSHA {
+		return true
+	}
+	switch promotion.Phase {
+	case appv1.HydratePromotionPhasePromoted:
+		return false
+	case appv1.HydratePromotionPhaseFailed:
+		return promotion.FinishedAt == nil || metav1.Now().Sub(promotion.FinishedAt.Time) > promotionRetryInterval
+	}
+	return true
+}
+
+// reconcilePromotion promotes the most recently hydrated manifests of the application from the hydrateTo branch to the
+// sync branch, once all the promotion gates pass. It records the outcome in the CurrentPromotion status of the app.
+func (h *Hydrator) reconcilePromotion(ctx context.Context, app *appv1.Application) {
+	logCtx := log.WithFields(applog.GetAppLogFields(app))
+	promotion := app.Spec.SourceHydrator.HydrateTo.Promotion
+	lastOperation := app.Status.SourceHydrator.LastSuccessfulOperation
+	status := &appv1.HydratePromotionStatus{HydratedSHA: lastOperation.HydratedSHA}
+
+	if reason := h.evaluatePromotionGates(app, promotion.Gates, lastOperation.DrySHA); reason != "" {
+		logCtx.WithField("reason", reason).Debug("Promotion gates did not pass")
+		status.Phase = appv1.HydratePromotionPhasePending
+		status.Message = "Waiting for promotion gates: " + reason
+		app.Status.SourceHydrator.CurrentPromotion = status
+		return
+	}
+
+	logCtx.WithField("hydratedSHA", lastOperation.HydratedSHA).Info("Promoting hydrated manifests")
+	promoteCtx, cancel := context.WithTimeout(ctx, promotionTimeout)
+	defer cancel()
+	message, pullRequestURL, err := h.promote(promoteCtx, app, promotion, lastOperation.HydratedSHA)
+	finishedAt := metav1.Now()
+	status.FinishedAt = &finishedAt
+	if err != nil {
+		logCtx.WithError(err).Error("Failed to promote hydrated manifests")
+		status.Phase = appv1.HydratePromotionPhaseFailed
+		status.Message = fmt.Sprintf("Failed to promote: %v", err)
+		app.Status.SourceHydrator.CurrentPromotion = status
+		return
+	}
+	status.Phase = appv1.HydratePromotionPhasePromoted
+	status.Message = message
+	status.PullRequestURL = pullRequestURL
+	app.Status.SourceHydrator.CurrentPromotion = status
+}
+
+// evaluatePromotionGates checks the promotion gates of the application. It returns the reason the first failing gate
+// does not pass, or an empty string if all the gates pass.
+func (h *Hydrator) evaluatePromotionGates(app *appv1.Application, gates []appv1.HydratePromotionGate, drySHA string) string {
+	for _, gate := range gates {
+		if gate.Application == nil {
+			continue
+		}
+		namespace := gate.Application.Namespace
+		if namespace == "" {
+			namespace = app.Namespace
+		}
+		qualifiedName := namespace + "/" + gate.Application.Name
+		gateApp, err := h.dependencies.GetProcessableApp(gate.Application.Name, namespace)
+		if err != nil {
+			return fmt.Sprintf("failed to get application %s: %v", qualifiedName, err)
+		}
+		if gateApp == nil {
+			return fmt.Sprintf("application %s not found", qualifiedName)
+		}
+		expectedHealth := gate.Application.Health
+		if expectedHealth == "" {
+			expectedHealth = health.HealthStatusHealthy
+		}
+		if gateApp.Status.Health.Status != expectedHealth {
+			return fmt.Sprintf("application %s is %s, expected %s", qualifiedName, gateApp.Status.Health.Status, expectedHealth)
+		}
+		if gate.Application.Synced && gateApp.Status.Sync.Status != appv1.SyncStatusCodeSynced {
+			return fmt.Sprintf("application %s is %s, expected %s", qualifiedName, gateApp.Status.Sync.Status, appv1.SyncStatusCodeSynced)
+		}
+		if gate.Application.SameDryRevision {
+			gateOperation := gateApp.Status.SourceHydrator.LastSuccessfulOperation
+			if gateOperation == nil || gateOperation.DrySHA != drySHA {
+				return fmt.Sprintf("application %s is not hydrated from dry revision %s", qualifiedName, drySHA)
+			}
+		}
+	}
+	return ""
+}
+
+// promote moves the hydrated commit to the sync branch with the promotion strategy of the application. It returns a
+// message describing the promotion, and the URL of the pull request of the PullRequest strategy. The PullRequest
+// strategy reuses the pull request left open by a previous attempt, so that retries do not open duplicates.
+func (h *Hydrator) promote(ctx context.Context, app *appv1.Application, promotion *appv1.HydratePromotion, hydratedSHA string) (string, string, error) {
+	hydrateToSource := app.Spec.GetHydrateToSource()
+	syncSource := app.Spec.SourceHydrator.GetSyncSource()
+
+	repo, err := h.dependencies.GetWriteCredentials(ctx, syncSource.RepoURL, app.Spec.Project)
+	if err != nil {
+		return "", "", fmt.Errorf("failed to get hydrator credentials: %w", err)
+	}
+	if repo == nil {
+		// Try without credentials.
+		repo = &appv1.Repository{
+			Repo: syncSource.RepoURL,
+		}
+	}
+
+	switch promotion.Strategy {
+	case appv1.HydratePromotionStrategyFastForward:
+		closer, commitService, err := h.commitClientset.NewCommitServerClient()
+		if err != nil {
+			return "", "", fmt.Errorf("failed to create commit service: %w", err)
+		}
+		defer utilio.Close(closer)
+		_, err = commitService.PromoteHydratedManifests(ctx, &commitclient.PromoteHydratedManifestsRequest{
+			Repo:         repo,
+			TargetBranch: syncSource.TargetRevision,
+			HydratedSha:  hydratedSHA,
+		})
+		if err != nil {
+			return "", "", fmt.Errorf("failed to fast-forward branch %q: %w", syncSource.TargetRevision, err)
+		}
+		// Request a refresh since the sync branch moved.
+		if err := h.dependencies.RequestAppRefresh(app.Name, app.Namespace); err != nil {
+			log.WithFields(applog.GetAppLogFields(app)).WithError(err).Error("Failed to request app refresh after promotion")
+		}
+		return fmt.Sprintf("Fast-forwarded branch %s to %s", syncSource.TargetRevision, hydratedSHA), "", nil
+	case appv1.HydratePromotionStrategyPullRequest:
+		if promotion.PullRequest == nil {
+			return "", "", errors.New("the PullRequest strategy requires the pullRequest configuration")
+		}
+		creator, err := h.pullRequestCreatorFactory(ctx, promotion.PullRequest, repo)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to create %s client: %w", promotion.PullRequest.Provider, err)
+		}
+		pullRequest, err := creator.GetOpen(ctx, hydrateToSource.TargetRevision, syncSource.TargetRevision)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to look up open pull request: %w", err)
+		}
+		if pullRequest != nil {
+			return fmt.Sprintf("Pull request #%d is already open", pullRequest.Number), pullRequest.URL, nil
+		}
+		title := "Promote hydrated manifests to " + syncSource.TargetRevision
+		body := fmt.Sprintf("Promotes the manifests hydrated by Argo CD to %s, up to commit %s, to %s.", hydrateToSource.TargetRevision, hydratedSHA, syncSource.TargetRevision)
+		pullRequest, err = creator.Create(ctx, title, body, hydrateToSource.TargetRevision, syncSource.TargetRevision)
+		if err != nil {
+			return "", "", fmt.Errorf("failed to open pull request: %w", err)
+		}
+		return fmt.Sprintf("Opened pull request #%d", pullRequest.Number), pullRequest.URL, nil
+	default:
+		return "", "", fmt.Errorf("unknown promotion strategy %q", promotion.Strategy)
+	}
+}
+
+// newPullRequestCreator returns a client opening pull requests with the configured SCM provider. The client
+// authenticates with the GitHub App or the password of the repository write credentials.
+func newPullRequestCreator(ctx context.Context, config *appv1.HydratePromotionPullRequest, repo *appv1.Repository) (pullrequest.PullRequestCreator, error) {
+	project, err := repositoryProject(repo.Repo)
+	if err != nil {
+		return nil, err
+	}
+
+	var service pullrequest.PullRequestService
+	switch config.Provider {
+	case appv1.HydratePromotionPullRequestProviderGitHub:
+		owner, name, ok := strings.Cut(project, "/")
+		if !ok || strings.Contains(name, "/") {
+			return nil, fmt.Errorf("repository URL %q does not match the GitHub owner/repo format", git.SanitizeRepoURL(repo.Repo))
+		}
+		if repo.GithubAppPrivateKey != "" {
+			service, err = pullrequest.NewGithubAppService(ctx, github_app_auth.Authentication{
+				Id:                repo.GithubAppId,
+				InstallationId:    repo.GithubAppInstallationId,
+				EnterpriseBaseURL: repo.GitHubAppEnterpriseBaseURL,
+				PrivateKey:        repo.GithubAppPrivateKey,
+			}, config.API, owner, name, nil)
+		} else {
+			service, err = pullrequest.NewGithubService(repo.Password, config.API, owner, name, nil)
+		}
+	case appv1.HydratePromotionPullRequestProviderGitLab:
+		service, err = pullrequest.NewGitLabService(repo.Password, config.API, project, nil, "", "", repo.Insecure, nil, repo.Proxy, repo.NoProxy)
+	default:
+		return nil, fmt.Errorf("unsupported pull request provider %q", config.Provider)
+	}
+	if err != nil {
+		return nil, err
+	}
+	creator, ok := service.(pullrequest.PullRequestCreator)
+	if !ok {
+		return nil, fmt.Errorf("pull request provider %q cannot open pull requests", config.Provider)
+	}
+	return creator, nil
+}
+
+// repositoryProject returns the path of the repository on the SCM provider, e.g. "owner/repo".
+func repositoryProject(repoURL string) (string, error) {
+	parsed, err := giturls.Parse(repoURL)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse repository URL %q: %w", git.SanitizeRepoURL(repoURL), err)
+	}
+	project := strings.TrimSuffix(strings.Trim(parsed.Path, "/"), ".git")
+	if project == "" {
+		return "", fmt.Errorf("repository URL %q does not contain a path", git.SanitizeRepoURL(repoURL))
+	}
+	return project, nil
+}
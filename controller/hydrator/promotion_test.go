@@ -0,0 +1,424 @@
+package hydrator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+
+	pullrequest "github.com/argoproj/argo-cd/v3/applicationset/services/pull_request"
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	commitservermocks "github.com/argoproj/argo-cd/v3/commitserver/apiclient/mocks"
+	"github.com/argoproj/argo-cd/v3/controller/hydrator/mocks"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+type fakePullRequestCreator struct {
+	openPullRequest *pullrequest.PullRequest
+	pullRequest     *pullrequest.PullRequest
+	err             error
+	branch          string
+	target          string
+	created         bool
+}
+
+func (f *fakePullRequestCreator) GetOpen(ctx context.Context, branch, targetBranch string) (*pullrequest.PullRequest, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		return nil, errors.New("promotion context has no deadline")
+	}
+	f.branch = branch
+	f.target = targetBranch
+	return f.openPullRequest, nil
+}
+
+func (f *fakePullRequestCreator) Create(_ context.Context, _, _, branch, targetBranch string) (*pullrequest.PullRequest, error) {
+	f.created = true
+	f.branch = branch
+	f.target = targetBranch
+	return f.pullRequest, f.err
+}
+
+// newTestPromotedApp returns an app whose manifests were hydrated to the hydrateTo branch, and are still to be promoted
+func newTestPromotedApp(name string, promotion *v1alpha1.HydratePromotion) *v1alpha1.Application {
+	app := newTestApp(name)
+	app.Spec.SourceHydrator.HydrateTo.Promotion = promotion
+	app.Status.SourceHydrator = v1alpha1.SourceHydratorStatus{
+		CurrentOperation: &v1alpha1.HydrateOperation{
+			Phase:          v1alpha1.HydrateOperationPhaseHydrated,
+			DrySHA:         "dry-sha",
+			HydratedSHA:    "hydrated-sha",
+			SourceHydrator: *app.Spec.SourceHydrator,
+		},
+		LastSuccessfulOperation: &v1alpha1.SuccessfulHydrateOperation{
+			DrySHA:         "dry-sha",
+			HydratedSHA:    "hydrated-sha",
+			SourceHydrator: *app.Spec.SourceHydrator,
+		},
+		LastComparedDryRevision: "dry-sha",
+	}
+	return app
+}
+
+func newTestGateApp(healthStatus health.HealthStatusCode, syncStatus v1alpha1.SyncStatusCode, drySHA string) *v1alpha1.Application {
+	app := newTestApp("staging")
+	app.Status.Health.Status = healthStatus
+	app.Status.Sync.Status = syncStatus
+	app.Status.SourceHydrator.LastSuccessfulOperation = &v1alpha1.SuccessfulHydrateOperation{DrySHA: drySHA}
+	return app
+}
+
+func expectNoRevisionChanges(d *mocks.Dependencies) {
+	proj := newTestProject()
+	d.EXPECT().GetProcessableAppProj(mock.Anything).Return(proj, nil).Once()
+	d.EXPECT().EvaluateAppRevisionsChanges(mock.Anything, mock.Anything, mock.Anything, mock.Anything, proj, mock.Anything).Return(false, "dry-sha", nil).Once()
+}
+
+func TestProcessAppHydrateQueueItem_Promotion_FastForward(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	cc := commitservermocks.NewCommitServiceClient(t)
+	app := newTestPromotedApp("test-app", &v1alpha1.HydratePromotion{Strategy: v1alpha1.HydratePromotionStrategyFastForward})
+	writeRepo := &v1alpha1.Repository{Repo: "https://example.com/repo", Password: "secret"}
+
+	expectNoRevisionChanges(d)
+	d.EXPECT().GetWriteCredentials(mock.Anything, "https://example.com/repo", "test-project").Return(writeRepo, nil).Once()
+	cc.EXPECT().PromoteHydratedManifests(mock.Anything, mock.Anything).Return(&commitclient.PromoteHydratedManifestsResponse{}, nil).Run(func(_ context.Context, in *commitclient.PromoteHydratedManifestsRequest, _ ...grpc.CallOption) {
+		assert.Equal(t, writeRepo, in.Repo)
+		assert.Equal(t, "hydrated", in.TargetBranch)
+		assert.Equal(t, "hydrated-sha", in.HydratedSha)
+	}).Once()
+	d.EXPECT().RequestAppRefresh("test-app", "default").Return(nil).Once()
+	var persisted *v1alpha1.SourceHydratorStatus
+	d.EXPECT().PersistHydrationStatus(mock.Anything, mock.Anything).Run(func(_ *v1alpha1.Application, st *v1alpha1.SourceHydratorStatus) {
+		persisted = st
+	}).Return().Once()
+
+	h := &Hydrator{
+		dependencies:         d,
+		statusRefreshTimeout: time.Minute,
+		commitClientset:      &commitservermocks.Clientset{CommitServiceClient: cc},
+	}
+	h.ProcessAppHydrateQueueItem(app)
+
+	d.AssertNotCalled(t, "AddHydrationQueueItem", mock.Anything)
+	require.NotNil(t, persisted)
+	require.NotNil(t, persisted.CurrentPromotion)
+	assert.Equal(t, v1alpha1.HydratePromotionPhasePromoted, persisted.CurrentPromotion.Phase)
+	assert.Equal(t, "hydrated-sha", persisted.CurrentPromotion.HydratedSHA)
+	assert.Equal(t, "Fast-forwarded branch hydrated to hydrated-sha", persisted.CurrentPromotion.Message)
+	assert.NotNil(t, persisted.CurrentPromotion.FinishedAt)
+}
+
+func TestProcessAppHydrateQueueItem_Promotion_PullRequest(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	app := newTestPromotedApp("test-app", &v1alpha1.HydratePromotion{
+		Strategy:    v1alpha1.HydratePromotionStrategyPullRequest,
+		PullRequest: &v1alpha1.HydratePromotionPullRequest{Provider: v1alpha1.HydratePromotionPullRequestProviderGitHub},
+	})
+	writeRepo := &v1alpha1.Repository{Repo: "https://example.com/repo", Password: "secret"}
+	creator := &fakePullRequestCreator{pullRequest: &pullrequest.PullRequest{Number: 42, URL: "https://example.com/repo/pull/42"}}
+
+	expectNoRevisionChanges(d)
+	d.EXPECT().GetWriteCredentials(mock.Anything, "https://example.com/repo", "test-project").Return(writeRepo, nil).Once()
+	var persisted *v1alpha1.SourceHydratorStatus
+	d.EXPECT().PersistHydrationStatus(mock.Anything, mock.Anything).Run(func(_ *v1alpha1.Application, st *v1alpha1.SourceHydratorStatus) {
+		persisted = st
+	}).Return().Once()
+
+	h := &Hydrator{
+		dependencies:         d,
+		statusRefreshTimeout: time.Minute,
+		pullRequestCreatorFactory: func(_ context.Context, config *v1alpha1.HydratePromotionPullRequest, repo *v1alpha1.Repository) (pullrequest.PullRequestCreator, error) {
+			assert.Equal(t, v1alpha1.HydratePromotionPullRequestProviderGitHub, config.Provider)
+			assert.Equal(t, writeRepo, repo)
+			return creator, nil
+		},
+	}
+	h.ProcessAppHydrateQueueItem(app)
+
+	assert.True(t, creator.created)
+	assert.Equal(t, "hydrated-next", creator.branch)
+	assert.Equal(t, "hydrated", creator.target)
+	d.AssertNotCalled(t, "RequestAppRefresh", mock.Anything, mock.Anything)
+	require.NotNil(t, persisted.CurrentPromotion)
+	assert.Equal(t, v1alpha1.HydratePromotionPhasePromoted, persisted.CurrentPromotion.Phase)
+	assert.Equal(t, "Opened pull request #42", persisted.CurrentPromotion.Message)
+	assert.Equal(t, "https://example.com/repo/pull/42", persisted.CurrentPromotion.PullRequestURL)
+}
+
+func TestProcessAppHydrateQueueItem_Promotion_PullRequestAlreadyOpen(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	app := newTestPromotedApp("test-app", &v1alpha1.HydratePromotion{
+		Strategy:    v1alpha1.HydratePromotionStrategyPullRequest,
+		PullRequest: &v1alpha1.HydratePromotionPullRequest{Provider: v1alpha1.HydratePromotionPullRequestProviderGitHub},
+	})
+	creator := &fakePullRequestCreator{openPullRequest: &pullrequest.PullRequest{Number: 41, URL: "https://example.com/repo/pull/41"}}
+
+	expectNoRevisionChanges(d)
+	d.EXPECT().GetWriteCredentials(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+	var persisted *v1alpha1.SourceHydratorStatus
+	d.EXPECT().PersistHydrationStatus(mock.Anything, mock.Anything).Run(func(_ *v1alpha1.Application, st *v1alpha1.SourceHydratorStatus) {
+		persisted = st
+	}).Return().Once()
+
+	h := &Hydrator{
+		dependencies:         d,
+		statusRefreshTimeout: time.Minute,
+		pullRequestCreatorFactory: func(_ context.Context, _ *v1alpha1.HydratePromotionPullRequest, _ *v1alpha1.Repository) (pullrequest.PullRequestCreator, error) {
+			return creator, nil
+		},
+	}
+	h.ProcessAppHydrateQueueItem(app)
+
+	assert.False(t, creator.created)
+	assert.Equal(t, "hydrated-next", creator.branch)
+	assert.Equal(t, "hydrated", creator.target)
+	require.NotNil(t, persisted.CurrentPromotion)
+	assert.Equal(t, v1alpha1.HydratePromotionPhasePromoted, persisted.CurrentPromotion.Phase)
+	assert.Equal(t, "Pull request #41 is already open", persisted.CurrentPromotion.Message)
+	assert.Equal(t, "https://example.com/repo/pull/41", persisted.CurrentPromotion.PullRequestURL)
+}
+
+func TestProcessAppHydrateQueueItem_Promotion_Failed(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	cc := commitservermocks.NewCommitServiceClient(t)
+	app := newTestPromotedApp("test-app", &v1alpha1.HydratePromotion{Strategy: v1alpha1.HydratePromotionStrategyFastForward})
+
+	expectNoRevisionChanges(d)
+	d.EXPECT().GetWriteCredentials(mock.Anything, mock.Anything, mock.Anything).Return(nil, nil).Once()
+	cc.EXPECT().PromoteHydratedManifests(mock.Anything, mock.Anything).Return(nil, errors.New("non-fast-forward")).Once()
+	var persisted *v1alpha1.SourceHydratorStatus
+	d.EXPECT().PersistHydrationStatus(mock.Anything, mock.Anything).Run(func(_ *v1alpha1.Application, st *v1alpha1.SourceHydratorStatus) {
+		persisted = st
+	}).Return().Once()
+
+	h := &Hydrator{
+		dependencies:         d,
+		statusRefreshTimeout: time.Minute,
+		commitClientset:      &commitservermocks.Clientset{CommitServiceClient: cc},
+	}
+	h.ProcessAppHydrateQueueItem(app)
+
+	d.AssertNotCalled(t, "RequestAppRefresh", mock.Anything, mock.Anything)
+	require.NotNil(t, persisted.CurrentPromotion)
+	assert.Equal(t, v1alpha1.HydratePromotionPhaseFailed, persisted.CurrentPromotion.Phase)
+	assert.Equal(t, `Failed to promote: failed to fast-forward branch "hydrated": non-fast-forward`, persisted.CurrentPromotion.Message)
+}
+
+func TestProcessAppHydrateQueueItem_Promotion_NotNeededWhileHydrating(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	app := newTestPromotedApp("test-app", &v1alpha1.HydratePromotion{Strategy: v1alpha1.HydratePromotionStrategyFastForward})
+	app.Status.SourceHydrator.CurrentOperation.Phase = v1alpha1.HydrateOperationPhaseHydrating
+	app.Status.SourceHydrator.CurrentOperation.StartedAt = metav1.Now()
+
+	d.EXPECT().PersistHydrationStatus(mock.Anything, mock.Anything).Return().Once()
+
+	h := &Hydrator{
+		dependencies:         d,
+		statusRefreshTimeout: time.Minute,
+	}
+	h.ProcessAppHydrateQueueItem(app)
+
+	d.AssertNotCalled(t, "GetWriteCredentials", mock.Anything, mock.Anything, mock.Anything)
+	assert.Nil(t, app.Status.SourceHydrator.CurrentPromotion)
+}
+
+func Test_needsPromotion(t *testing.T) {
+	t.Parallel()
+	promotion := &v1alpha1.HydratePromotion{Strategy: v1alpha1.HydratePromotionStrategyFastForward}
+	recently := metav1.Now()
+	longAgo := metav1.NewTime(recently.Add(-time.Hour))
+
+	testCases := []struct {
+		name     string
+		app      func() *v1alpha1.Application
+		expected bool
+	}{{
+		name:     "not promoted yet",
+		app:      func() *v1alpha1.Application { return newTestPromotedApp("app", promotion) },
+		expected: true,
+	}, {
+		name: "no promotion configured",
+		app: func() *v1alpha1.Application {
+			return newTestPromotedApp("app", nil)
+		},
+		expected: false,
+	}, {
+		name: "never hydrated",
+		app: func() *v1alpha1.Application {
+			app := newTestPromotedApp("app", promotion)
+			app.Status.SourceHydrator.LastSuccessfulOperation = nil
+			return app
+		},
+		expected: false,
+	}, {
+		name: "already promoted",
+		app: func() *v1alpha1.Application {
+			app := newTestPromotedApp("app", promotion)
+			app.Status.SourceHydrator.CurrentPromotion = &v1alpha1.HydratePromotionStatus{HydratedSHA: "hydrated-sha", Phase: v1alpha1.HydratePromotionPhasePromoted}
+			return app
+		},
+		expected: false,
+	}, {
+		name: "previous hydrated commit promoted",
+		app: func() *v1alpha1.Application {
+			app := newTestPromotedApp("app", promotion)
+			app.Status.SourceHydrator.CurrentPromotion = &v1alpha1.HydratePromotionStatus{HydratedSHA: "old-hydrated-sha", Phase: v1alpha1.HydratePromotionPhasePromoted}
+			return app
+		},
+		expected: true,
+	}, {
+		name: "waiting for gates",
+		app: func() *v1alpha1.Application {
+			app := newTestPromotedApp("app", promotion)
+			app.Status.SourceHydrator.CurrentPromotion = &v1alpha1.HydratePromotionStatus{HydratedSHA: "hydrated-sha", Phase: v1alpha1.HydratePromotionPhasePending}
+			return app
+		},
+		expected: true,
+	}, {
+		name: "failed recently",
+		app: func() *v1alpha1.Application {
+			app := newTestPromotedApp("app", promotion)
+			app.Status.SourceHydrator.CurrentPromotion = &v1alpha1.HydratePromotionStatus{HydratedSHA: "hydrated-sha", Phase: v1alpha1.HydratePromotionPhaseFailed, FinishedAt: &recently}
+			return app
+		},
+		expected: false,
+	}, {
+		name: "failed long ago",
+		app: func() *v1alpha1.Application {
+			app := newTestPromotedApp("app", promotion)
+			app.Status.SourceHydrator.CurrentPromotion = &v1alpha1.HydratePromotionStatus{HydratedSHA: "hydrated-sha", Phase: v1alpha1.HydratePromotionPhaseFailed, FinishedAt: &longAgo}
+			return app
+		},
+		expected: true,
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.expected, needsPromotion(tc.app()))
+		})
+	}
+}
+
+func TestHydrator_evaluatePromotionGates(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		gate     v1alpha1.HydratePromotionApplicationGate
+		gateApp  *v1alpha1.Application
+		getErr   error
+		expected string
+	}{{
+		name:     "healthy",
+		gate:     v1alpha1.HydratePromotionApplicationGate{Name: "staging"},
+		gateApp:  newTestGateApp(health.HealthStatusHealthy, v1alpha1.SyncStatusCodeOutOfSync, "other-sha"),
+		expected: "",
+	}, {
+		name:     "not healthy",
+		gate:     v1alpha1.HydratePromotionApplicationGate{Name: "staging"},
+		gateApp:  newTestGateApp(health.HealthStatusProgressing, v1alpha1.SyncStatusCodeSynced, "dry-sha"),
+		expected: "application default/staging is Progressing, expected Healthy",
+	}, {
+		name:     "expected health",
+		gate:     v1alpha1.HydratePromotionApplicationGate{Name: "staging", Health: health.HealthStatusSuspended},
+		gateApp:  newTestGateApp(health.HealthStatusSuspended, v1alpha1.SyncStatusCodeSynced, "dry-sha"),
+		expected: "",
+	}, {
+		name:     "not synced",
+		gate:     v1alpha1.HydratePromotionApplicationGate{Name: "staging", Synced: true},
+		gateApp:  newTestGateApp(health.HealthStatusHealthy, v1alpha1.SyncStatusCodeOutOfSync, "dry-sha"),
+		expected: "application default/staging is OutOfSync, expected Synced",
+	}, {
+		name:     "different dry revision",
+		gate:     v1alpha1.HydratePromotionApplicationGate{Name: "staging", Synced: true, SameDryRevision: true},
+		gateApp:  newTestGateApp(health.HealthStatusHealthy, v1alpha1.SyncStatusCodeSynced, "other-sha"),
+		expected: "application default/staging is not hydrated from dry revision dry-sha",
+	}, {
+		name:     "all checks pass",
+		gate:     v1alpha1.HydratePromotionApplicationGate{Name: "staging", Synced: true, SameDryRevision: true},
+		gateApp:  newTestGateApp(health.HealthStatusHealthy, v1alpha1.SyncStatusCodeSynced, "dry-sha"),
+		expected: "",
+	}, {
+		name:     "not found",
+		gate:     v1alpha1.HydratePromotionApplicationGate{Name: "staging", Namespace: "other"},
+		expected: "application other/staging not found",
+	}, {
+		name:     "lookup error",
+		gate:     v1alpha1.HydratePromotionApplicationGate{Name: "staging"},
+		getErr:   errors.New("boom"),
+		expected: "failed to get application default/staging: boom",
+	}}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			d := mocks.NewDependencies(t)
+			namespace := tc.gate.Namespace
+			if namespace == "" {
+				namespace = "default"
+			}
+			d.EXPECT().GetProcessableApp("staging", namespace).Return(tc.gateApp, tc.getErr).Once()
+			h := &Hydrator{dependencies: d}
+
+			app := newTestPromotedApp("prod", nil)
+			gates := []v1alpha1.HydratePromotionGate{{Application: &tc.gate}}
+			assert.Equal(t, tc.expected, h.evaluatePromotionGates(app, gates, "dry-sha"))
+		})
+	}
+}
+
+func TestProcessAppHydrateQueueItem_Promotion_WaitsForGates(t *testing.T) {
+	t.Parallel()
+	d := mocks.NewDependencies(t)
+	app := newTestPromotedApp("prod", &v1alpha1.HydratePromotion{
+		Strategy: v1alpha1.HydratePromotionStrategyFastForward,
+		Gates:    []v1alpha1.HydratePromotionGate{{Application: &v1alpha1.HydratePromotionApplicationGate{Name: "staging"}}},
+	})
+
+	expectNoRevisionChanges(d)
+	d.EXPECT().GetProcessableApp("staging", "default").Return(newTestGateApp(health.HealthStatusDegraded, v1alpha1.SyncStatusCodeSynced, "dry-sha"), nil).Once()
+	var persisted *v1alpha1.SourceHydratorStatus
+	d.EXPECT().PersistHydrationStatus(mock.Anything, mock.Anything).Run(func(_ *v1alpha1.Application, st *v1alpha1.SourceHydratorStatus) {
+		persisted = st
+	}).Return().Once()
+
+	h := &Hydrator{
+		dependencies:         d,
+		statusRefreshTimeout: time.Minute,
+	}
+	h.ProcessAppHydrateQueueItem(app)
+
+	d.AssertNotCalled(t, "GetWriteCredentials", mock.Anything, mock.Anything, mock.Anything)
+	require.NotNil(t, persisted.CurrentPromotion)
+	assert.Equal(t, v1alpha1.HydratePromotionPhasePending, persisted.CurrentPromotion.Phase)
+	assert.Equal(t, "Waiting for promotion gates: application default/staging is Degraded, expected Healthy", persisted.CurrentPromotion.Message)
+	assert.Nil(t, persisted.CurrentPromotion.FinishedAt)
+}
+
+func Test_repositoryProject(t *testing.T) {
+	t.Parallel()
+	for repoURL, expected := range map[string]string{
+		"https://github.com/argoproj/gitops.git":       "argoproj/gitops",
+		"git@github.com:argoproj/gitops.git":           "argoproj/gitops",
+		"ssh://git@gitlab.com/group/subgroup/gitops":   "group/subgroup/gitops",
+		"https://gitlab.example.com/group/gitops.git/": "group/gitops",
+	} {
+		project, err := repositoryProject(repoURL)
+		require.NoError(t, err)
+		assert.Equal(t, expected, project, repoURL)
+	}
+	_, err := repositoryProject("https://github.com")
+	require.Error(t, err)
+}
@@ -6,10 +6,15 @@ import (
 	"fmt"
 	"maps"
 
+	"github.com/argoproj/argo-cd/v3/controller/hydrator"
 	"github.com/argoproj/argo-cd/v3/controller/hydrator/types"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
 	argoutil "github.com/argoproj/argo-cd/v3/util/argo"
+	"github.com/argoproj/argo-cd/v3/util/argo/normalizers"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	settings_util "github.com/argoproj/argo-cd/v3/util/settings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -33,6 +38,22 @@ func (ctrl *ApplicationController) GetProcessableApps() (*appv1.ApplicationList,
 	return ctrl.getAppList(metav1.ListOptions{})
 }
 
+// GetProcessableApp returns the application with the given name and namespace, if it is processable by the controller.
+func (ctrl *ApplicationController) GetProcessableApp(appName string, appNamespace string) (*appv1.Application, error) {
+	obj, exists, err := ctrl.appInformer.GetIndexer().GetByKey(appNamespace + "/" + appName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get application from informer index: %w", err)
+	}
+	if !exists {
+		return nil, nil
+	}
+	app, ok := obj.(*appv1.Application)
+	if !ok || !ctrl.isAppNamespaceAllowed(app) {
+		return nil, nil
+	}
+	return app.DeepCopy(), nil
+}
+
 func (ctrl *ApplicationController) EvaluateAppRevisionsChanges(ctx context.Context, app *appv1.Application, source appv1.ApplicationSource, revision string, project *appv1.AppProject, noRevisionCache bool) (bool, string, error) {
 	sources := []appv1.ApplicationSource{source}
 	revisions := []string{revision}
@@ -51,21 +72,41 @@ func (ctrl *ApplicationController) EvaluateAppRevisionsChanges(ctx context.Conte
 }
 
 func (ctrl *ApplicationController) GetRepoObjs(ctx context.Context, app *appv1.Application, drySource appv1.ApplicationSource, revision string, project *appv1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error) {
+	return (&hydratorRepoObjsGetter{appStateManager: ctrl.appStateManager, settingsMgr: ctrl.settingsMgr}).GetRepoObjs(ctx, app, drySource, revision, project)
+}
+
+// hydratorRepoObjsGetter implements hydrator.RepoObjsGetter with the app state manager, so that the manifests of the
+// dry source are generated the same way wherever they are needed.
+type hydratorRepoObjsGetter struct {
+	appStateManager AppStateManager
+	settingsMgr     *settings_util.SettingsManager
+}
+
+// NewHydratorRepoObjsGetter returns a hydrator.RepoObjsGetter generating the manifests of dry sources like the app
+// controller does for the hydrator. It is meant for components other than the app controller, like the API server's
+// hydration preview. The manifests are generated without the runtime state of the destination cluster, so no live
+// state cache is needed.
+func NewHydratorRepoObjsGetter(argoDB db.ArgoDB, applicationClientset appclientset.Interface, repoClientset apiclient.Clientset, namespace string, settingsMgr *settings_util.SettingsManager) hydrator.RepoObjsGetter {
+	appStateManager := NewAppStateManager(argoDB, applicationClientset, repoClientset, namespace, nil, nil, settingsMgr, nil, nil, nil, 0, argoutil.NewResourceTracking(), false, 0, false, normalizers.IgnoreNormalizerOpts{})
+	return &hydratorRepoObjsGetter{appStateManager: appStateManager, settingsMgr: settingsMgr}
+}
+
+func (g *hydratorRepoObjsGetter) GetRepoObjs(ctx context.Context, app *appv1.Application, drySource appv1.ApplicationSource, revision string, project *appv1.AppProject) ([]*unstructured.Unstructured, *apiclient.ManifestResponse, error) {
 	drySources := []appv1.ApplicationSource{drySource}
 	dryRevisions := []string{revision}
 
-	appLabelKey, err := ctrl.settingsMgr.GetAppInstanceLabelKey()
+	appLabelKey, err := g.settingsMgr.GetAppInstanceLabelKey()
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get app instance label key: %w", err)
 	}
 
 	// FIXME: use cache and revision cache
-	objs, resp, _, err := ctrl.appStateManager.GetRepoObjs(ctx, app, drySources, appLabelKey, dryRevisions, true, true, project.EffectiveSourceIntegrity(), project, false)
+	objs, resp, _, err := g.appStateManager.GetRepoObjs(ctx, app, drySources, appLabelKey, dryRevisions, true, true, project.EffectiveSourceIntegrity(), project, false)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get repo objects: %w", err)
 	}
 
-	trackingMethod, err := ctrl.settingsMgr.GetTrackingMethod()
+	trackingMethod, err := g.settingsMgr.GetTrackingMethod()
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to get tracking method: %w", err)
 	}
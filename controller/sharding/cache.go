@@ -1,11 +1,14 @@
 package sharding
 
 import (
+	"context"
 	"maps"
 	"strconv"
 	"sync"
+	"time"
 
 	log "github.com/sirupsen/logrus"
+	"k8s.io/client-go/kubernetes"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -21,9 +24,14 @@ type ClusterShardingCache interface {
 	DeleteApp(a *v1alpha1.Application)
 	UpdateApp(a *v1alpha1.Application)
 	IsManagedCluster(c *v1alpha1.Cluster) bool
+	IsManagedApp(a *v1alpha1.Application, c *v1alpha1.Cluster) bool
+	IsClusterOwner(c *v1alpha1.Cluster) bool
 	GetDistribution() map[string]int
 	GetAppDistribution() map[string]int
 	UpdateShard(shard int) bool
+	ObserveClusterEvent(server string)
+	ObserveReconcile(server string, duration time.Duration)
+	RunLoadBalancer(ctx context.Context, kubeClient kubernetes.Interface, namespace string, resources func() map[string]int64, onChange func())
 }
 
 type ClusterSharding struct {
@@ -34,6 +42,10 @@ type ClusterSharding struct {
 	Apps            map[string]*v1alpha1.Application
 	lock            sync.RWMutex
 	getClusterShard DistributionFunction
+	algorithm       string
+	// assignments is the cluster assignment of the load-balanced sharding algorithm
+	assignments map[string]clusterShardAssignment
+	loadTracker *clusterLoadTracker
 }
 
 func NewClusterSharding(_ db.ArgoDB, shard, replicas int, shardingAlgorithm string) ClusterShardingCache {
@@ -44,11 +56,14 @@ func NewClusterSharding(_ db.ArgoDB, shard, replicas int, shardingAlgorithm stri
 		Shards:   make(map[string]int),
 		Clusters: make(map[string]*v1alpha1.Cluster),
 		Apps:     make(map[string]*v1alpha1.Application),
+
+		algorithm:   shardingAlgorithm,
+		loadTracker: newClusterLoadTracker(),
 	}
 	distributionFunction := NoShardingDistributionFunction()
 	if replicas > 1 {
 		log.Debugf("Processing clusters from shard %d: Using filter function:  %s", shard, shardingAlgorithm)
-		distributionFunction = GetDistributionFunction(clusterSharding.getClusterAccessor(), clusterSharding.getAppAccessor(), shardingAlgorithm, replicas)
+		distributionFunction = getDistributionFunction(clusterSharding.getClusterAccessor(), clusterSharding.getAppAccessor(), clusterSharding.getAssignmentAccessor(), shardingAlgorithm, replicas)
 	} else {
 		log.Info("Processing all cluster shards")
 	}
@@ -56,15 +71,40 @@ func NewClusterSharding(_ db.ArgoDB, shard, replicas int, shardingAlgorithm stri
 	return clusterSharding
 }
 
-// IsManagedCluster returns whether or not the cluster should be processed by a given shard.
+// IsManagedCluster returns whether or not the cluster should be processed by a given shard. A cluster whose
+// Applications are sharded is processed by every shard, each of them managing a subset of its Applications.
 func (sharding *ClusterSharding) IsManagedCluster(c *v1alpha1.Cluster) bool {
+	if IsAppShardedCluster(c) && !isSkipReconcileCluster(c) {
+		return true
+	}
+	return sharding.IsClusterOwner(c)
+}
+
+// IsManagedApp returns whether or not the Application with the given destination cluster should be processed by a
+// given shard.
+func (sharding *ClusterSharding) IsManagedApp(a *v1alpha1.Application, c *v1alpha1.Cluster) bool {
+	if !IsAppShardedCluster(c) {
+		return sharding.IsManagedCluster(c)
+	}
+	if isSkipReconcileCluster(c) {
+		return false
+	}
+	sharding.lock.RLock()
+	defer sharding.lock.RUnlock()
+	appShard := GetApplicationShard(a, sharding.Replicas)
+	log.Debugf("Checking if application %s/%s with shard %d should be processed by shard %d", a.Namespace, a.Name, appShard, sharding.Shard)
+	return appShard == sharding.Shard
+}
+
+// IsClusterOwner returns whether or not the cluster is assigned to a given shard. The owner of a cluster whose
+// Applications are sharded is the only shard that reports the information of the cluster.
+func (sharding *ClusterSharding) IsClusterOwner(c *v1alpha1.Cluster) bool {
 	sharding.lock.RLock()
 	defer sharding.lock.RUnlock()
 	if c == nil { // nil cluster (in-cluster) is always managed by current clusterShard
 		return true
 	}
-	if skipReconcile, err := strconv.ParseBool(c.Annotations[common.AnnotationKeyAppSkipReconcile]); err == nil && skipReconcile {
-		log.Debugf("Cluster %s has %s annotation set, skipping", c.Server, common.AnnotationKeyAppSkipReconcile)
+	if isSkipReconcileCluster(c) {
 		return false
 	}
 	clusterShard := 0
@@ -77,6 +117,14 @@ func (sharding *ClusterSharding) IsManagedCluster(c *v1alpha1.Cluster) bool {
 	return clusterShard == sharding.Shard
 }
 
+func isSkipReconcileCluster(c *v1alpha1.Cluster) bool {
+	if skipReconcile, err := strconv.ParseBool(c.Annotations[common.AnnotationKeyAppSkipReconcile]); err == nil && skipReconcile {
+		log.Debugf("Cluster %s has %s annotation set, skipping", c.Server, common.AnnotationKeyAppSkipReconcile)
+		return true
+	}
+	return false
+}
+
 func (sharding *ClusterSharding) Init(clusters *v1alpha1.ClusterList, apps *v1alpha1.ApplicationList) {
 	sharding.lock.Lock()
 	defer sharding.lock.Unlock()
@@ -1,9 +1,11 @@
 package sharding
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -353,6 +355,46 @@ func TestIsManagedCluster_SkipReconcileAnnotation(t *testing.T) {
 	assert.True(t, sharding.IsManagedCluster(nil))
 }
 
+func TestClusterSharding_IsManagedApp(t *testing.T) {
+	t.Parallel()
+	appSharded := &v1alpha1.Cluster{
+		ID:          "1",
+		Server:      "https://cluster1",
+		Annotations: map[string]string{common.AnnotationKeyShardApplications: "true"},
+	}
+	other := &v1alpha1.Cluster{ID: "2", Server: "https://cluster2"}
+	shards := []*ClusterSharding{setupTestSharding(0, 2), setupTestSharding(1, 2)}
+	for _, sharding := range shards {
+		sharding.Init(&v1alpha1.ClusterList{Items: []v1alpha1.Cluster{*appSharded, *other}}, &v1alpha1.ApplicationList{})
+		// every shard manages some of the applications of the cluster
+		assert.True(t, sharding.IsManagedCluster(appSharded))
+	}
+	assert.True(t, shards[0].IsClusterOwner(appSharded))
+	assert.False(t, shards[1].IsClusterOwner(appSharded))
+
+	managedApps := make([]int, len(shards))
+	for i := range 20 {
+		app := &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("app-%d", i), Namespace: "argocd"}}
+		managingShards := 0
+		for shard, sharding := range shards {
+			if sharding.IsManagedApp(app, appSharded) {
+				managedApps[shard]++
+				managingShards++
+			}
+		}
+		assert.Equal(t, 1, managingShards)
+		// applications of other clusters are managed by the shard of their cluster
+		assert.Equal(t, shards[1].IsManagedCluster(other), shards[1].IsManagedApp(app, other))
+	}
+	assert.Positive(t, managedApps[0])
+	assert.Positive(t, managedApps[1])
+
+	skipped := appSharded.DeepCopy()
+	skipped.Annotations[common.AnnotationKeyAppSkipReconcile] = "true"
+	assert.False(t, shards[0].IsManagedCluster(skipped))
+	assert.False(t, shards[0].IsManagedApp(&v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app"}}, skipped))
+}
+
 func TestClusterSharding_ClusterShardOfResourceShouldNotBeChanged(t *testing.T) {
 	t.Parallel()
 	shard := 1
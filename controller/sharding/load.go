@@ -0,0 +1,450 @@
+package sharding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/env"
+)
+
+// ShardClusterMappingKey is the key of the shard mapping ConfigMap which stores the assignment of the clusters to the
+// shards computed by the load-balanced sharding algorithm
+const ShardClusterMappingKey = "shardClusterMapping"
+
+// RebalanceThreshold is the relative amount by which the load of a shard must exceed the average shard load for the
+// load-balanced sharding algorithm to move a cluster to another shard
+var RebalanceThreshold = env.ParseFloat64FromEnv(common.EnvControllerShardingRebalanceThreshold, 0.2, 0, 10)
+
+// ClusterLoad is the load that a cluster puts on the application controller which manages it
+type ClusterLoad struct {
+	// ResourcesCount is the number of Kubernetes resources in the cluster cache
+	ResourcesCount int64 `json:"resourcesCount,omitempty"`
+	// EventsPerSecond is the rate of the watch events received from the cluster
+	EventsPerSecond float64 `json:"eventsPerSecond,omitempty"`
+	// ReconcileSecondsPerSecond is the time spent reconciling the applications of the cluster, per second
+	ReconcileSecondsPerSecond float64 `json:"reconcileSecondsPerSecond,omitempty"`
+}
+
+// clusterShardAssignment is the shard of a cluster computed by the load-balanced sharding algorithm
+type clusterShardAssignment struct {
+	Shard int
+	// PreviousShard is the shard which hands the cluster over to Shard. Neither shard manages the cluster until
+	// PreviousShard released it, so that both shards never reconcile the cluster at the same time.
+	PreviousShard *int `json:",omitempty"`
+}
+
+type assignmentAccessor func() map[string]clusterShardAssignment
+
+// clusterLoadTracker accumulates the events and reconciliations of the clusters, and turns them into rates
+// every time the loads are sampled
+type clusterLoadTracker struct {
+	lock       sync.Mutex
+	events     map[string]int64
+	reconciles map[string]time.Duration
+	loads      map[string]ClusterLoad
+	lastSample time.Time
+}
+
+func newClusterLoadTracker() *clusterLoadTracker {
+	return &clusterLoadTracker{
+		events:     make(map[string]int64),
+		reconciles: make(map[string]time.Duration),
+		loads:      make(map[string]ClusterLoad),
+	}
+}
+
+func (t *clusterLoadTracker) observeEvent(server string) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.events[server]++
+}
+
+func (t *clusterLoadTracker) observeReconcile(server string, duration time.Duration) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+	t.reconciles[server] += duration
+}
+
+// sample returns the load of the given managed clusters, and of the clusters which had reconciliations since the
+// previous sample, as their reconciliation may still be in progress. Rates are smoothed with the previous sample to
+// dampen short bursts.
+func (t *clusterLoadTracker) sample(now time.Time, managed []string, resources map[string]int64) map[string]ClusterLoad {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	elapsed := time.Duration(HeartbeatDuration) * time.Second
+	if !t.lastSample.IsZero() && now.After(t.lastSample) {
+		elapsed = now.Sub(t.lastSample)
+	}
+	t.lastSample = now
+
+	servers := make(map[string]bool, len(managed))
+	for _, server := range managed {
+		servers[server] = true
+	}
+	for server := range t.reconciles {
+		servers[server] = true
+	}
+
+	loads := make(map[string]ClusterLoad, len(servers))
+	for server := range servers {
+		load := ClusterLoad{
+			ResourcesCount:            resources[server],
+			EventsPerSecond:           float64(t.events[server]) / elapsed.Seconds(),
+			ReconcileSecondsPerSecond: t.reconciles[server].Seconds() / elapsed.Seconds(),
+		}
+		if previous, ok := t.loads[server]; ok {
+			load.EventsPerSecond = (load.EventsPerSecond + previous.EventsPerSecond) / 2
+			load.ReconcileSecondsPerSecond = (load.ReconcileSecondsPerSecond + previous.ReconcileSecondsPerSecond) / 2
+		}
+		loads[server] = load
+	}
+	t.loads = loads
+	t.events = make(map[string]int64)
+	t.reconciles = make(map[string]time.Duration)
+	return maps.Clone(loads)
+}
+
+// getClusterWeights returns the weight of each cluster, which is its average share of the total resources count,
+// event rate and reconciliation time. Dimensions without any load are ignored, and clusters are weighted equally
+// when no load is known at all.
+func getClusterWeights(servers []string, loads map[string]ClusterLoad) map[string]float64 {
+	var totalResources, totalEvents, totalReconciles float64
+	for _, server := range servers {
+		load := loads[server]
+		totalResources += float64(load.ResourcesCount)
+		totalEvents += load.EventsPerSecond
+		totalReconciles += load.ReconcileSecondsPerSecond
+	}
+
+	weights := make(map[string]float64, len(servers))
+	for _, server := range servers {
+		load := loads[server]
+		var share float64
+		dimensions := 0
+		for _, dimension := range []struct{ value, total float64 }{
+			{float64(load.ResourcesCount), totalResources},
+			{load.EventsPerSecond, totalEvents},
+			{load.ReconcileSecondsPerSecond, totalReconciles},
+		} {
+			if dimension.total > 0 {
+				share += dimension.value / dimension.total
+				dimensions++
+			}
+		}
+		if dimensions == 0 {
+			weights[server] = 1 / float64(len(servers))
+		} else {
+			weights[server] = share / float64(dimensions)
+		}
+	}
+	return weights
+}
+
+// isShardAlive returns true if a controller holds the shard and its heartbeat did not time out
+func isShardAlive(mapping shardApplicationControllerMapping, now time.Time) bool {
+	return mapping.ControllerName != "" && now.Before(mapping.HeartbeatTime.Add(time.Duration(HeartbeatTimeout)*time.Second))
+}
+
+// balanceClusterShards returns the assignment of the clusters which are neither pinned to a shard nor sharded by
+// Application. Clusters keep their current shard, new clusters are assigned to the least loaded shard, and a single
+// cluster is moved from the most loaded shard to the least loaded one when the load of the former exceeds the average
+// load by more than the threshold. A moved cluster is handed over: it is not managed by any shard until its previous shard released it,
+// which it does by no longer publishing the cluster load in the shard mapping.
+func balanceClusterShards(clusters []*v1alpha1.Cluster, replicas int, current map[string]clusterShardAssignment, mappings []shardApplicationControllerMapping, threshold float64, now time.Time) map[string]clusterShardAssignment {
+	// holders are the alive shards which still manage each cluster, according to the loads they published
+	holders := make(map[string][]int)
+	loads := make(map[string]ClusterLoad)
+	for _, mapping := range mappings {
+		if mapping.ShardNumber >= replicas || !isShardAlive(mapping, now) {
+			continue
+		}
+		for server, load := range mapping.ClusterLoads {
+			holders[server] = append(holders[server], mapping.ShardNumber)
+			loads[server] = load
+		}
+	}
+
+	clusters = slices.Clone(clusters)
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i].ID < clusters[j].ID
+	})
+	servers := make([]string, 0, len(clusters))
+	for _, c := range clusters {
+		servers = append(servers, c.Server)
+	}
+	weights := getClusterWeights(servers, loads)
+
+	shardLoads := make([]float64, replicas)
+	shardClusters := make([]int, replicas)
+	leastLoadedShard := func(exclude int) int {
+		least := -1
+		for shard := range replicas {
+			if shard == exclude {
+				continue
+			}
+			if least == -1 || shardLoads[shard] < shardLoads[least] || (shardLoads[shard] == shardLoads[least] && shardClusters[shard] < shardClusters[least]) {
+				least = shard
+			}
+		}
+		return least
+	}
+
+	assignments := make(map[string]clusterShardAssignment, len(clusters))
+	var unassigned []*v1alpha1.Cluster
+	for _, c := range clusters {
+		if IsAppShardedCluster(c) {
+			// the Applications of the cluster are spread over all the shards
+			continue
+		}
+		if c.Shard != nil && int(*c.Shard) < replicas {
+			// pinned clusters are not balanced, but count towards the load of their shard
+			shardLoads[*c.Shard] += weights[c.Server]
+			shardClusters[*c.Shard]++
+			continue
+		}
+		assignment, ok := current[c.Server]
+		if !ok || assignment.Shard >= replicas {
+			if len(holders[c.Server]) == 0 {
+				unassigned = append(unassigned, c)
+				continue
+			}
+			// keep the cluster on a shard which already manages it, to avoid a handover
+			assignment = clusterShardAssignment{Shard: holders[c.Server][0]}
+		}
+		if assignment.PreviousShard != nil && !slices.Contains(holders[c.Server], *assignment.PreviousShard) {
+			log.Infof("Cluster %s has been released by shard %d", c.Server, *assignment.PreviousShard)
+			assignment.PreviousShard = nil
+		}
+		if assignment.PreviousShard == nil {
+			for _, holder := range holders[c.Server] {
+				if holder != assignment.Shard {
+					assignment.PreviousShard = &holder
+					break
+				}
+			}
+		}
+		assignments[c.Server] = assignment
+		shardLoads[assignment.Shard] += weights[c.Server]
+		shardClusters[assignment.Shard]++
+	}
+
+	for _, c := range unassigned {
+		shard := leastLoadedShard(-1)
+		log.Infof("Cluster %s has been assigned to shard %d", c.Server, shard)
+		assignments[c.Server] = clusterShardAssignment{Shard: shard}
+		shardLoads[shard] += weights[c.Server]
+		shardClusters[shard]++
+	}
+
+	// move at most one cluster at a time, once every previous handover completed
+	for _, assignment := range assignments {
+		if assignment.PreviousShard != nil {
+			return assignments
+		}
+	}
+	mostLoaded := 0
+	var totalLoad float64
+	for shard := range replicas {
+		totalLoad += shardLoads[shard]
+		if shardLoads[shard] > shardLoads[mostLoaded] {
+			mostLoaded = shard
+		}
+	}
+	averageLoad := totalLoad / float64(replicas)
+	if replicas < 2 || shardLoads[mostLoaded] <= averageLoad*(1+threshold) {
+		return assignments
+	}
+	leastLoaded := leastLoadedShard(mostLoaded)
+	gap := shardLoads[mostLoaded] - shardLoads[leastLoaded]
+	candidate := ""
+	for _, server := range servers {
+		assignment, ok := assignments[server]
+		if !ok || assignment.Shard != mostLoaded || weights[server] <= 0 || weights[server] >= gap {
+			continue
+		}
+		// the best move leaves both shards as close as possible to each other
+		if candidate == "" || math.Abs(gap/2-weights[server]) < math.Abs(gap/2-weights[candidate]) {
+			candidate = server
+		}
+	}
+	if candidate == "" {
+		return assignments
+	}
+	log.Infof("Shard %d is overloaded (%.2f, average %.2f): handing cluster %s over to shard %d", mostLoaded, shardLoads[mostLoaded], averageLoad, candidate, leastLoaded)
+	previousShard := mostLoaded
+	assignments[candidate] = clusterShardAssignment{Shard: leastLoaded, PreviousShard: &previousShard}
+	return assignments
+}
+
+// RunLoadBalancer periodically publishes the load of the clusters managed by the shard in the shard mapping ConfigMap
+// and applies the cluster assignment found in it. The controller of shard 0 additionally computes the assignment of
+// the clusters from the loads published by every shard. It returns immediately unless the load-balanced sharding
+// algorithm is used. The onChange function is called when the clusters managed by the shard changed.
+func (sharding *ClusterSharding) RunLoadBalancer(ctx context.Context, kubeClient kubernetes.Interface, namespace string, resources func() map[string]int64, onChange func()) {
+	if sharding.algorithm != common.LoadBalancedShardingAlgorithm || sharding.Replicas <= 1 {
+		return
+	}
+	ticker := time.NewTicker(time.Duration(HeartbeatDuration) * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			changed, err := sharding.balanceLoad(ctx, kubeClient, namespace, resources(), time.Now())
+			if err != nil {
+				log.Warnf("Failed to balance the cluster load across shards: %v", err)
+				continue
+			}
+			if changed && onChange != nil {
+				onChange()
+			}
+		}
+	}
+}
+
+// balanceLoad publishes the cluster loads of the shard, computes the cluster assignment when the shard is the shard 0,
+// and applies the cluster assignment. It returns true if the clusters managed by the shard changed.
+func (sharding *ClusterSharding) balanceLoad(ctx context.Context, kubeClient kubernetes.Interface, namespace string, resources map[string]int64, now time.Time) (bool, error) {
+	hostname, err := osHostnameFunction()
+	if err != nil {
+		return false, err
+	}
+
+	sharding.lock.RLock()
+	shard := sharding.Shard
+	clusters := make([]*v1alpha1.Cluster, 0, len(sharding.Clusters))
+	managed := make([]string, 0, len(sharding.Clusters))
+	for server, c := range sharding.Clusters {
+		clusters = append(clusters, c)
+		if s, ok := sharding.Shards[server]; ok && s == shard {
+			managed = append(managed, server)
+		}
+	}
+	sharding.lock.RUnlock()
+	loads := sharding.loadTracker.sample(now, managed, resources)
+
+	shardMappingCM, err := kubeClient.CoreV1().ConfigMaps(namespace).Get(ctx, common.ArgoCDAppControllerShardConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, fmt.Errorf("error getting sharding config map: %w", err)
+		}
+		shardMappingCM, err = generateDefaultShardMappingCM(namespace, hostname, sharding.Replicas, shard)
+		if err != nil {
+			return false, fmt.Errorf("error generating default shard mapping configmap %w", err)
+		}
+		if shardMappingCM, err = kubeClient.CoreV1().ConfigMaps(namespace).Create(ctx, shardMappingCM, metav1.CreateOptions{}); err != nil {
+			return false, fmt.Errorf("error creating shard mapping configmap %w", err)
+		}
+	}
+	if shardMappingCM.Data == nil {
+		shardMappingCM.Data = map[string]string{}
+	}
+
+	var shardMappingData []shardApplicationControllerMapping
+	if data := shardMappingCM.Data[ShardControllerMappingKey]; data != "" {
+		if err := json.Unmarshal([]byte(data), &shardMappingData); err != nil {
+			return false, fmt.Errorf("error unmarshalling shard config map data: %w", err)
+		}
+	}
+	for currentShard := len(shardMappingData); currentShard < sharding.Replicas; currentShard++ {
+		shardMappingData = append(shardMappingData, shardApplicationControllerMapping{ShardNumber: currentShard})
+	}
+	for i := range shardMappingData {
+		if shardMappingData[i].ShardNumber == shard {
+			shardMappingData[i].ControllerName = hostname
+			shardMappingData[i].HeartbeatTime = heartbeatCurrentTime()
+			shardMappingData[i].ClusterLoads = loads
+		}
+	}
+
+	assignments := map[string]clusterShardAssignment{}
+	if data := shardMappingCM.Data[ShardClusterMappingKey]; data != "" {
+		if err := json.Unmarshal([]byte(data), &assignments); err != nil {
+			return false, fmt.Errorf("error unmarshalling shard cluster mapping: %w", err)
+		}
+	}
+	if shard == 0 {
+		assignments = balanceClusterShards(clusters, sharding.Replicas, assignments, shardMappingData, RebalanceThreshold, now)
+		data, err := json.Marshal(assignments)
+		if err != nil {
+			return false, fmt.Errorf("error marshalling shard cluster mapping: %w", err)
+		}
+		shardMappingCM.Data[ShardClusterMappingKey] = string(data)
+	}
+
+	data, err := json.Marshal(shardMappingData)
+	if err != nil {
+		return false, fmt.Errorf("error marshalling data of shard mapping ConfigMap: %w", err)
+	}
+	shardMappingCM.Data[ShardControllerMappingKey] = string(data)
+	if _, err := kubeClient.CoreV1().ConfigMaps(namespace).Update(ctx, shardMappingCM, metav1.UpdateOptions{}); err != nil {
+		return false, fmt.Errorf("error updating shard mapping configmap: %w", err)
+	}
+
+	return sharding.setAssignments(assignments), nil
+}
+
+// setAssignments updates the cluster assignment of the load-balanced sharding algorithm, and returns true if the
+// clusters managed by the shard changed
+func (sharding *ClusterSharding) setAssignments(assignments map[string]clusterShardAssignment) bool {
+	sharding.lock.Lock()
+	defer sharding.lock.Unlock()
+	if equalAssignments(sharding.assignments, assignments) {
+		return false
+	}
+	previousShards := maps.Clone(sharding.Shards)
+	sharding.assignments = assignments
+	sharding.updateDistribution()
+	for server, shard := range sharding.Shards {
+		if previousShard, ok := previousShards[server]; (shard == sharding.Shard) != (ok && previousShard == sharding.Shard) {
+			return true
+		}
+	}
+	return false
+}
+
+func equalAssignments(a, b map[string]clusterShardAssignment) bool {
+	return maps.EqualFunc(a, b, func(x, y clusterShardAssignment) bool {
+		if x.Shard != y.Shard || (x.PreviousShard == nil) != (y.PreviousShard == nil) {
+			return false
+		}
+		return x.PreviousShard == nil || *x.PreviousShard == *y.PreviousShard
+	})
+}
+
+// A read lock should be acquired before calling getAssignmentAccessor.
+func (sharding *ClusterSharding) getAssignmentAccessor() assignmentAccessor {
+	return func() map[string]clusterShardAssignment {
+		return sharding.assignments
+	}
+}
+
+// ObserveClusterEvent records a watch event received from the cluster, for the load-balanced sharding algorithm
+func (sharding *ClusterSharding) ObserveClusterEvent(server string) {
+	if sharding.algorithm == common.LoadBalancedShardingAlgorithm {
+		sharding.loadTracker.observeEvent(server)
+	}
+}
+
+// ObserveReconcile records the reconciliation of an application of the cluster, for the load-balanced sharding algorithm
+func (sharding *ClusterSharding) ObserveReconcile(server string, duration time.Duration) {
+	if sharding.algorithm == common.LoadBalancedShardingAlgorithm {
+		sharding.loadTracker.observeReconcile(server, duration)
+	}
+}
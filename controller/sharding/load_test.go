@@ -0,0 +1,258 @@
+package sharding
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+func TestClusterLoadTrackerSample(t *testing.T) {
+	tracker := newClusterLoadTracker()
+	start := time.Now()
+	tracker.lastSample = start
+
+	for range 20 {
+		tracker.observeEvent("https://cluster1")
+	}
+	tracker.observeEvent("https://cluster3")
+	tracker.observeReconcile("https://cluster1", 2*time.Second)
+	tracker.observeReconcile("https://cluster2", time.Second)
+
+	loads := tracker.sample(start.Add(10*time.Second), []string{"https://cluster1"}, map[string]int64{"https://cluster1": 100, "https://cluster3": 50})
+	assert.Equal(t, map[string]ClusterLoad{
+		"https://cluster1": {ResourcesCount: 100, EventsPerSecond: 2, ReconcileSecondsPerSecond: 0.2},
+		// cluster2 is not managed anymore, but its reconciliation may still be in progress
+		"https://cluster2": {ReconcileSecondsPerSecond: 0.1},
+	}, loads)
+
+	// rates are smoothed with the previous sample
+	loads = tracker.sample(start.Add(20*time.Second), []string{"https://cluster1"}, map[string]int64{"https://cluster1": 120})
+	assert.Equal(t, map[string]ClusterLoad{
+		"https://cluster1": {ResourcesCount: 120, EventsPerSecond: 1, ReconcileSecondsPerSecond: 0.1},
+	}, loads)
+}
+
+func TestGetClusterWeights(t *testing.T) {
+	weights := getClusterWeights([]string{"a", "b"}, map[string]ClusterLoad{
+		"a": {ResourcesCount: 300, EventsPerSecond: 1},
+		"b": {ResourcesCount: 100, EventsPerSecond: 3},
+	})
+	assert.InDelta(t, 0.5, weights["a"], 0.0001)
+	assert.InDelta(t, 0.5, weights["b"], 0.0001)
+
+	weights = getClusterWeights([]string{"a", "b"}, map[string]ClusterLoad{
+		"a": {ResourcesCount: 300, ReconcileSecondsPerSecond: 0.3},
+		"b": {ResourcesCount: 100, ReconcileSecondsPerSecond: 0.1},
+	})
+	assert.InDelta(t, 0.75, weights["a"], 0.0001)
+	assert.InDelta(t, 0.25, weights["b"], 0.0001)
+
+	// clusters are weighted equally without any load
+	weights = getClusterWeights([]string{"a", "b", "c", "d"}, nil)
+	assert.InDelta(t, 0.25, weights["a"], 0.0001)
+}
+
+func newLoadTestCluster(id string) *v1alpha1.Cluster {
+	return &v1alpha1.Cluster{ID: id, Server: "https://" + id}
+}
+
+func newLoadTestMapping(shard int, heartbeat time.Time, loads map[string]ClusterLoad) shardApplicationControllerMapping {
+	return shardApplicationControllerMapping{
+		ShardNumber:    shard,
+		ControllerName: "controller-" + string(rune('a'+shard)),
+		HeartbeatTime:  metav1.NewTime(heartbeat),
+		ClusterLoads:   loads,
+	}
+}
+
+func TestBalanceClusterShards(t *testing.T) {
+	now := time.Now()
+	shard0, shard1 := 0, 1
+	clusters := []*v1alpha1.Cluster{newLoadTestCluster("c1"), newLoadTestCluster("c2"), newLoadTestCluster("c3")}
+
+	t.Run("new clusters are assigned to the least loaded shards", func(t *testing.T) {
+		assignments := balanceClusterShards(clusters, 2, nil, nil, 0.2, now)
+		assert.Equal(t, map[string]clusterShardAssignment{
+			"https://c1": {Shard: 0},
+			"https://c2": {Shard: 1},
+			"https://c3": {Shard: 0},
+		}, assignments)
+	})
+
+	t.Run("new clusters stay on the shard which already manages them", func(t *testing.T) {
+		mappings := []shardApplicationControllerMapping{
+			newLoadTestMapping(0, now, nil),
+			newLoadTestMapping(1, now, map[string]ClusterLoad{"https://c1": {ResourcesCount: 10}}),
+		}
+		assignments := balanceClusterShards(clusters[:1], 2, nil, mappings, 0.2, now)
+		assert.Equal(t, map[string]clusterShardAssignment{"https://c1": {Shard: 1}}, assignments)
+	})
+
+	t.Run("clusters keep their shard while the load is balanced", func(t *testing.T) {
+		current := map[string]clusterShardAssignment{
+			"https://c1": {Shard: 1},
+			"https://c2": {Shard: 0},
+			"https://c3": {Shard: 0},
+		}
+		mappings := []shardApplicationControllerMapping{
+			newLoadTestMapping(0, now, map[string]ClusterLoad{"https://c2": {ResourcesCount: 500}, "https://c3": {ResourcesCount: 500}}),
+			newLoadTestMapping(1, now, map[string]ClusterLoad{"https://c1": {ResourcesCount: 1000}}),
+		}
+		assert.Equal(t, current, balanceClusterShards(clusters, 2, current, mappings, 0.2, now))
+	})
+
+	t.Run("a cluster of the overloaded shard is handed over", func(t *testing.T) {
+		current := map[string]clusterShardAssignment{
+			"https://c1": {Shard: 0},
+			"https://c2": {Shard: 0},
+			"https://c3": {Shard: 1},
+		}
+		mappings := []shardApplicationControllerMapping{
+			newLoadTestMapping(0, now, map[string]ClusterLoad{"https://c1": {ResourcesCount: 4000}, "https://c2": {ResourcesCount: 3000}}),
+			newLoadTestMapping(1, now, map[string]ClusterLoad{"https://c3": {ResourcesCount: 1000}}),
+		}
+		assert.Equal(t, map[string]clusterShardAssignment{
+			"https://c1": {Shard: 0},
+			"https://c2": {Shard: 1, PreviousShard: &shard0},
+			"https://c3": {Shard: 1},
+		}, balanceClusterShards(clusters, 2, current, mappings, 0.2, now))
+	})
+
+	t.Run("a cluster heavier than the imbalance is not moved", func(t *testing.T) {
+		current := map[string]clusterShardAssignment{
+			"https://c1": {Shard: 0},
+			"https://c2": {Shard: 1},
+		}
+		mappings := []shardApplicationControllerMapping{
+			newLoadTestMapping(0, now, map[string]ClusterLoad{"https://c1": {ResourcesCount: 40000}}),
+			newLoadTestMapping(1, now, map[string]ClusterLoad{"https://c2": {ResourcesCount: 1000}}),
+		}
+		assert.Equal(t, current, balanceClusterShards(clusters[:2], 2, current, mappings, 0.2, now))
+	})
+
+	t.Run("the handover completes once the previous shard released the cluster", func(t *testing.T) {
+		current := map[string]clusterShardAssignment{
+			"https://c1": {Shard: 0},
+			"https://c2": {Shard: 1, PreviousShard: &shard0},
+		}
+		mappings := []shardApplicationControllerMapping{
+			newLoadTestMapping(0, now, map[string]ClusterLoad{"https://c1": {ResourcesCount: 4000}, "https://c2": {ResourcesCount: 3000}}),
+			newLoadTestMapping(1, now, nil),
+		}
+		assert.Equal(t, current, balanceClusterShards(clusters[:2], 2, current, mappings, 0.2, now))
+
+		mappings[0].ClusterLoads = map[string]ClusterLoad{"https://c1": {ResourcesCount: 4000}}
+		assert.Equal(t, map[string]clusterShardAssignment{
+			"https://c1": {Shard: 0},
+			"https://c2": {Shard: 1},
+		}, balanceClusterShards(clusters[:2], 2, current, mappings, 0.2, now))
+	})
+
+	t.Run("the handover completes once the previous shard is not alive anymore", func(t *testing.T) {
+		current := map[string]clusterShardAssignment{
+			"https://c1": {Shard: 0},
+			"https://c2": {Shard: 0, PreviousShard: &shard1},
+		}
+		mappings := []shardApplicationControllerMapping{
+			newLoadTestMapping(0, now, map[string]ClusterLoad{"https://c1": {ResourcesCount: 4000}}),
+			newLoadTestMapping(1, now.Add(-time.Hour), map[string]ClusterLoad{"https://c2": {ResourcesCount: 3000}}),
+		}
+		assert.Equal(t, map[string]clusterShardAssignment{
+			"https://c1": {Shard: 0},
+			"https://c2": {Shard: 0},
+		}, balanceClusterShards(clusters[:2], 2, current, mappings, 0.2, now))
+	})
+
+	t.Run("pinned clusters are not assigned", func(t *testing.T) {
+		pinned := newLoadTestCluster("c1")
+		pinned.Shard = new(int64)
+		assignments := balanceClusterShards([]*v1alpha1.Cluster{pinned, newLoadTestCluster("c2")}, 2, nil, nil, 0.2, now)
+		assert.Equal(t, map[string]clusterShardAssignment{"https://c2": {Shard: 1}}, assignments)
+	})
+
+	t.Run("clusters sharded by application are not assigned", func(t *testing.T) {
+		appSharded := newLoadTestCluster("c1")
+		appSharded.Annotations = map[string]string{common.AnnotationKeyShardApplications: "true"}
+		assignments := balanceClusterShards([]*v1alpha1.Cluster{appSharded, newLoadTestCluster("c2")}, 2, nil, nil, 0.2, now)
+		assert.Equal(t, map[string]clusterShardAssignment{"https://c2": {Shard: 0}}, assignments)
+	})
+}
+
+func TestLoadBalancedDistributionFunction(t *testing.T) {
+	shard0 := 0
+	c1, c2, c3 := newLoadTestCluster("c1"), newLoadTestCluster("c2"), newLoadTestCluster("c3")
+	clusters := func() []*v1alpha1.Cluster { return []*v1alpha1.Cluster{c1, c2, c3} }
+	apps := func() []*v1alpha1.Application { return nil }
+	assignments := func() map[string]clusterShardAssignment {
+		return map[string]clusterShardAssignment{
+			"https://c1": {Shard: 1},
+			"https://c2": {Shard: 1, PreviousShard: &shard0},
+		}
+	}
+
+	distributionFunction := LoadBalancedDistributionFunction(clusters, apps, assignments, 2)
+	assert.Equal(t, 0, distributionFunction(nil))
+	assert.Equal(t, 1, distributionFunction(c1))
+	// handed over clusters are not managed by any shard
+	assert.Equal(t, -1, distributionFunction(c2))
+	// clusters without assignment are distributed with consistent hashing
+	assert.Equal(t, ConsistentHashingWithBoundedLoadsDistributionFunction(clusters, apps, 2)(c3), distributionFunction(c3))
+}
+
+func TestBalanceLoad(t *testing.T) {
+	defer func() { osHostnameFunction = os.Hostname }()
+	osHostnameFunction = func() (string, error) { return "controller-0", nil }
+
+	now := time.Now()
+	shard1 := 1
+	mappings, err := json.Marshal([]shardApplicationControllerMapping{
+		{ShardNumber: 0},
+		newLoadTestMapping(1, now, map[string]ClusterLoad{"https://c2": {ResourcesCount: 100}}),
+	})
+	require.NoError(t, err)
+	kubeClient := kubefake.NewClientset(&corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: common.ArgoCDAppControllerShardConfigMapName, Namespace: "argocd"},
+		Data:       map[string]string{ShardControllerMappingKey: string(mappings)},
+	})
+
+	clusterSharding := NewClusterSharding(nil, 0, 2, common.LoadBalancedShardingAlgorithm).(*ClusterSharding)
+	clusterSharding.Init(&v1alpha1.ClusterList{Items: []v1alpha1.Cluster{*newLoadTestCluster("c1"), *newLoadTestCluster("c2")}}, &v1alpha1.ApplicationList{})
+	// pretend shard 0 currently manages both clusters, which shard 1 also claims
+	clusterSharding.Shards = map[string]int{"https://c1": 0, "https://c2": 0}
+
+	changed, err := clusterSharding.balanceLoad(t.Context(), kubeClient, "argocd", map[string]int64{"https://c1": 10, "https://c2": 100}, now)
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	cm, err := kubeClient.CoreV1().ConfigMaps("argocd").Get(t.Context(), common.ArgoCDAppControllerShardConfigMapName, metav1.GetOptions{})
+	require.NoError(t, err)
+
+	var updatedMappings []shardApplicationControllerMapping
+	require.NoError(t, json.Unmarshal([]byte(cm.Data[ShardControllerMappingKey]), &updatedMappings))
+	require.Len(t, updatedMappings, 2)
+	assert.Equal(t, "controller-0", updatedMappings[0].ControllerName)
+	assert.Equal(t, int64(10), updatedMappings[0].ClusterLoads["https://c1"].ResourcesCount)
+	assert.Equal(t, int64(100), updatedMappings[0].ClusterLoads["https://c2"].ResourcesCount)
+
+	var assignments map[string]clusterShardAssignment
+	require.NoError(t, json.Unmarshal([]byte(cm.Data[ShardClusterMappingKey]), &assignments))
+	assert.Equal(t, map[string]clusterShardAssignment{
+		"https://c1": {Shard: 0},
+		"https://c2": {Shard: 0, PreviousShard: &shard1},
+	}, assignments)
+
+	// no shard manages the cluster until shard 1 releases it
+	assert.Equal(t, map[string]int{"https://c1": 0, "https://c2": -1}, clusterSharding.GetDistribution())
+	assert.True(t, clusterSharding.IsManagedCluster(newLoadTestCluster("c1")))
+	assert.False(t, clusterSharding.IsManagedCluster(newLoadTestCluster("c2")))
+}
@@ -57,6 +57,8 @@ type shardApplicationControllerMapping struct {
 	ShardNumber    int
 	ControllerName string
 	HeartbeatTime  metav1.Time
+	// ClusterLoads holds the load of the clusters managed by the shard, published for the load-balanced sharding algorithm
+	ClusterLoads map[string]ClusterLoad `json:",omitempty"`
 }
 
 // GetClusterFilter returns a ClusterFilterFunction which is a function taking a cluster as a parameter
@@ -83,6 +85,10 @@ func GetClusterFilter(_ db.ArgoDB, distributionFunction DistributionFunction, re
 // GetDistributionFunction returns which DistributionFunction should be used based on the passed algorithm and
 // the current datas.
 func GetDistributionFunction(clusters clusterAccessor, apps appAccessor, shardingAlgorithm string, replicasCount int) DistributionFunction {
+	return getDistributionFunction(clusters, apps, nil, shardingAlgorithm, replicasCount)
+}
+
+func getDistributionFunction(clusters clusterAccessor, apps appAccessor, assignments assignmentAccessor, shardingAlgorithm string, replicasCount int) DistributionFunction {
 	log.Debugf("Using filter function:  %s", shardingAlgorithm)
 	distributionFunction := LegacyDistributionFunction(replicasCount)
 	switch shardingAlgorithm {
@@ -92,6 +98,8 @@ func GetDistributionFunction(clusters clusterAccessor, apps appAccessor, shardin
 		distributionFunction = LegacyDistributionFunction(replicasCount)
 	case common.ConsistentHashingWithBoundedLoadsAlgorithm:
 		distributionFunction = ConsistentHashingWithBoundedLoadsDistributionFunction(clusters, apps, replicasCount)
+	case common.LoadBalancedShardingAlgorithm:
+		distributionFunction = LoadBalancedDistributionFunction(clusters, apps, assignments, replicasCount)
 	default:
 		log.Warnf("distribution type %s is not supported, defaulting to %s", shardingAlgorithm, common.DefaultShardingAlgorithm)
 	}
@@ -131,6 +139,28 @@ func LegacyDistributionFunction(replicas int) DistributionFunction {
 	}
 }
 
+// IsAppShardedCluster returns whether the Applications of the cluster are distributed across the controller shards
+// rather than the whole cluster being managed by a single shard.
+func IsAppShardedCluster(c *v1alpha1.Cluster) bool {
+	if c == nil {
+		return false
+	}
+	shardApps, err := strconv.ParseBool(c.Annotations[common.AnnotationKeyShardApplications])
+	return err == nil && shardApps
+}
+
+// GetApplicationShard returns the shard of an Application whose destination cluster is sharded by Application. The
+// shard is based on a stable hash of the namespace and name of the Application, so it does not change when other
+// Applications are added or removed.
+func GetApplicationShard(a *v1alpha1.Application, replicas int) int {
+	if replicas <= 1 {
+		return 0
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(a.Namespace + "/" + a.Name))
+	return int(h.Sum32() % uint32(replicas))
+}
+
 // RoundRobinDistributionFunction returns a DistributionFunction using an homogeneous distribution algorithm:
 // for a given cluster the function will return the shard number based on the modulo of the cluster rank in
 // the cluster's list sorted by uid on the shard number.
@@ -200,6 +230,40 @@ func ConsistentHashingWithBoundedLoadsDistributionFunction(clusters clusterAcces
 	}
 }
 
+// LoadBalancedDistributionFunction returns a DistributionFunction using the cluster assignment computed from the load
+// of the clusters, which is published in the shard mapping ConfigMap by the controllers.
+// A cluster which is being handed over from a shard to another is not managed by any shard until the handover
+// completes, and the clusters which are not assigned yet are distributed with the consistent hashing with bounded
+// loads algorithm.
+func LoadBalancedDistributionFunction(clusters clusterAccessor, apps appAccessor, assignments assignmentAccessor, replicas int) DistributionFunction {
+	fallback := ConsistentHashingWithBoundedLoadsDistributionFunction(clusters, apps, replicas)
+	return func(c *v1alpha1.Cluster) int {
+		if replicas <= 0 {
+			log.Warnf("The number of replicas (%d) is lower than 1", replicas)
+			return -1
+		}
+		if c == nil { // in-cluster does not necessarily have a secret assigned. So we are receiving a nil cluster here.
+			return 0
+		}
+		// if Shard is manually set and the assigned value is lower than the number of replicas,
+		// then its value is returned otherwise it is the default calculated value
+		if c.Shard != nil && int(*c.Shard) < replicas {
+			return int(*c.Shard)
+		}
+		if assignments != nil {
+			if assignment, ok := assignments()[c.Server]; ok && assignment.Shard < replicas {
+				if assignment.PreviousShard != nil {
+					log.Debugf("Cluster with id=%s is being handed over from shard %d to shard %d", c.ID, *assignment.PreviousShard, assignment.Shard)
+					return -1
+				}
+				log.Debugf("Cluster with id=%s will be processed by shard %d", c.ID, assignment.Shard)
+				return assignment.Shard
+			}
+		}
+		return fallback(c)
+	}
+}
+
 func createConsistentHashingWithBoundLoads(replicas int, getCluster clusterAccessor, getApp appAccessor) map[string]int {
 	clusters := getSortedClustersList(getCluster)
 	appDistribution := getAppDistribution(getCluster, getApp)
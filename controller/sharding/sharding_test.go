@@ -1053,3 +1053,23 @@ spec:
 	}
 	return app
 }
+
+func TestIsAppShardedCluster(t *testing.T) {
+	assert.False(t, IsAppShardedCluster(nil))
+	assert.False(t, IsAppShardedCluster(&v1alpha1.Cluster{Server: "https://cluster1"}))
+	assert.False(t, IsAppShardedCluster(&v1alpha1.Cluster{Annotations: map[string]string{common.AnnotationKeyShardApplications: "invalid"}}))
+	assert.True(t, IsAppShardedCluster(&v1alpha1.Cluster{Annotations: map[string]string{common.AnnotationKeyShardApplications: "true"}}))
+}
+
+func TestGetApplicationShard(t *testing.T) {
+	app := &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "argocd"}}
+	assert.Equal(t, 0, GetApplicationShard(app, 1))
+	assert.Equal(t, 0, GetApplicationShard(app, 0))
+
+	shard := GetApplicationShard(app, 3)
+	assert.GreaterOrEqual(t, shard, 0)
+	assert.Less(t, shard, 3)
+	// the shard only depends on the application name and namespace
+	app.Spec.Destination.Namespace = "other"
+	assert.Equal(t, shard, GetApplicationShard(app, 3))
+}
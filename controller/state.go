@@ -37,6 +37,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/common"
 	statecache "github.com/argoproj/argo-cd/v3/controller/cache"
 	"github.com/argoproj/argo-cd/v3/controller/metrics"
+	"github.com/argoproj/argo-cd/v3/healthplugin"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
@@ -49,6 +50,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/db"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/policy"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 	"github.com/argoproj/argo-cd/v3/util/stats"
 	traceutil "github.com/argoproj/argo-cd/v3/util/trace"
@@ -147,6 +149,7 @@ type appStateManager struct {
 	repoErrorGracePeriod  time.Duration
 	serverSideDiff        bool
 	ignoreNormalizerOpts  normalizers.IgnoreNormalizerOpts
+	policyEvaluator       *policy.Evaluator
 }
 
 // EvaluateAppRevisionsChanges checks if any source revisions have changes without generating manifests.
@@ -674,6 +677,28 @@ func partitionTargetObjsForSync(targetObjs []*unstructured.Unstructured) (syncOb
 	return syncObjs, hasPreDeleteHooks, hasPostDeleteHooks
 }
 
+// getRelatedResources returns the related resources used to assess the health of the resources of the given cluster,
+// or nil if the health of resources does not consider their related resources
+func (m *appStateManager) getRelatedResources(destCluster *v1alpha1.Cluster, resFilter *settings.ResourcesFilter) (*relatedResources, error) {
+	enabled, err := m.settingsMgr.GetIsRelationalHealthEnabled()
+	if err != nil || !enabled {
+		return nil, err
+	}
+	clusterCache, err := m.liveStateCache.GetClusterCache(destCluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cluster cache: %w", err)
+	}
+	clusterResourcesWatched := destCluster.ClusterResources || len(destCluster.Namespaces) == 0
+	return newRelatedResources(clusterCache, func(gk schema.GroupKind) bool {
+		namespaced, err := clusterCache.IsNamespaced(gk)
+		if err != nil {
+			// the API does not exist in the cluster
+			return false
+		}
+		return (namespaced || clusterResourcesWatched) && !resFilter.IsExcludedResource(gk.Group, gk.Kind, destCluster.Server)
+	}), nil
+}
+
 // CompareAppState compares application git state to the live app state, using the specified
 // revision and supplied source. If revision or overrides are empty, then compares against
 // revision and overrides in the app spec.
@@ -821,6 +846,16 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 	}
 	ts.AddCheckpoint("dedup_ms")
 
+	manifestPolicies, err := m.settingsMgr.GetManifestPolicies()
+	if err != nil {
+		msg := "Failed to load manifest policies: " + err.Error()
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: msg, LastTransitionTime: &now})
+	} else if len(manifestPolicies) > 0 {
+		violations := m.policyEvaluator.Evaluate(manifestPolicies, app, destCluster.Server, targetObjs)
+		conditions = append(conditions, policy.ViolationConditions(violations, &now)...)
+	}
+	ts.AddCheckpoint("policies_ms")
+
 	liveObjByKey, err := m.liveStateCache.GetManagedLiveObjs(destCluster, app, targetObjs)
 	if err != nil {
 		liveObjByKey = make(map[kubeutil.ResourceKey]*unstructured.Unstructured)
@@ -1106,7 +1141,11 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 
 	ts.AddCheckpoint("sync_ms")
 
-	healthStatus, healthMessage, err := setApplicationHealth(managedResources, resourceSummaries, resourceOverrides, app, m.persistResourceHealth)
+	relations, err := m.getRelatedResources(destCluster, resFilter)
+	if err != nil {
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: "error getting related resources: " + err.Error(), LastTransitionTime: &now})
+	}
+	healthStatus, healthMessage, err := setApplicationHealth(managedResources, resourceSummaries, healthplugin.ResourceHealthOverrides{Overrides: resourceOverrides, Server: destCluster.Server}, relations, app, m.persistResourceHealth)
 	if err != nil {
 		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: v1alpha1.ApplicationConditionComparisonError, Message: "error setting app health: " + err.Error(), LastTransitionTime: &now})
 	}
@@ -1169,6 +1208,8 @@ func (m *appStateManager) CompareAppState(ctx context.Context, app *v1alpha1.App
 		v1alpha1.ApplicationConditionSharedResourceWarning:   true,
 		v1alpha1.ApplicationConditionRepeatedResourceWarning: true,
 		v1alpha1.ApplicationConditionExcludedResourceWarning: true,
+		v1alpha1.ApplicationConditionPolicyViolationWarning:  true,
+		v1alpha1.ApplicationConditionPolicyViolationError:    true,
 	})
 	ts.AddCheckpoint("health_ms")
 	compRes.timings = ts.Timings()
@@ -1334,6 +1375,7 @@ func NewAppStateManager(
 		repoErrorGracePeriod:  repoErrorGracePeriod,
 		serverSideDiff:        serverSideDiff,
 		ignoreNormalizerOpts:  ignoreNormalizerOpts,
+		policyEvaluator:       policy.NewEvaluator(),
 	}
 }
 
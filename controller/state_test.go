@@ -734,6 +734,62 @@ func TestCompareAppStateDuplicatedNamespacedResources(t *testing.T) {
 	assert.Len(t, compRes.resources, 4)
 }
 
+func TestCompareAppStatePolicyViolations(t *testing.T) {
+	pod := NewPod()
+	pod.SetNamespace(test.FakeDestNamespace)
+
+	policyCM := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDPolicyConfigMapName,
+			Namespace: test.FakeArgoCDNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: map[string]string{
+			"policies": `
+- name: required-labels
+  expression: has(object.metadata.labels) && 'team' in object.metadata.labels
+  message: the team label is required
+- name: no-pods
+  match:
+    kinds: [Pod]
+  projects: [default]
+  expression: "false"
+  message: pods must be managed by a workload
+  action: deny
+- name: other-project
+  projects: [other]
+  expression: "false"
+  action: deny
+`,
+		},
+	}
+
+	app := newFakeApp()
+	data := fakeData{
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, pod)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		additionalObjs:  []runtime.Object{policyCM},
+	}
+	ctrl := newFakeController(t.Context(), &data, nil)
+	sources := []v1alpha1.ApplicationSource{app.Spec.GetSource()}
+	revisions := []string{""}
+	_, err := ctrl.appStateManager.CompareAppState(t.Context(), app, &defaultProj, revisions, sources, false, false, nil, false)
+	require.NoError(t, err)
+
+	require.Len(t, app.Status.Conditions, 2)
+	assert.Equal(t, v1alpha1.ApplicationConditionPolicyViolationError, app.Status.Conditions[0].Type)
+	assert.Equal(t, "Resource /Pod my-pod violates policy no-pods: pods must be managed by a workload", app.Status.Conditions[0].Message)
+	assert.Equal(t, v1alpha1.ApplicationConditionPolicyViolationWarning, app.Status.Conditions[1].Type)
+	assert.Equal(t, "Resource /Pod my-pod violates policy required-labels: the team label is required", app.Status.Conditions[1].Message)
+}
+
 func TestCompareAppStateManagedNamespaceMetadataWithLiveNsDoesNotGetPruned(t *testing.T) {
 	app := newFakeApp()
 	app.Spec.SyncPolicy = &v1alpha1.SyncPolicy{
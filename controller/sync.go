@@ -31,13 +31,13 @@ import (
 
 	"github.com/argoproj/argo-cd/v3/controller/metrics"
 	"github.com/argoproj/argo-cd/v3/controller/syncid"
+	"github.com/argoproj/argo-cd/v3/healthplugin"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	applog "github.com/argoproj/argo-cd/v3/util/app/log"
 	"github.com/argoproj/argo-cd/v3/util/argo"
 	"github.com/argoproj/argo-cd/v3/util/argo/diff"
 	kubeutil "github.com/argoproj/argo-cd/v3/util/kube"
 	logutils "github.com/argoproj/argo-cd/v3/util/log"
-	"github.com/argoproj/argo-cd/v3/util/lua"
 	"github.com/argoproj/argo-cd/v3/util/settings"
 )
 
@@ -181,10 +181,11 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 		return
 	}
 
-	// If there are any comparison or spec errors error conditions do not perform the operation
+	// If there are any comparison, spec or denying policy error conditions do not perform the operation
 	if errConditions := app.Status.GetConditions(map[v1alpha1.ApplicationConditionType]bool{
-		v1alpha1.ApplicationConditionComparisonError:  true,
-		v1alpha1.ApplicationConditionInvalidSpecError: true,
+		v1alpha1.ApplicationConditionComparisonError:      true,
+		v1alpha1.ApplicationConditionInvalidSpecError:     true,
+		v1alpha1.ApplicationConditionPolicyViolationError: true,
 	}); len(errConditions) > 0 {
 		state.Phase = common.OperationError
 		state.Message = argo.FormatAppConditions(errConditions)
@@ -234,6 +235,9 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 			Images:      res.Images,
 			Order:       i + 1,
 		}
+		if res.StartedAt != nil {
+			initialResourcesRes[i].StartedAt = *res.StartedAt
+		}
 	}
 
 	prunePropagationPolicy := metav1.DeletePropagationForeground
@@ -246,6 +250,16 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 		prunePropagationPolicy = metav1.DeletePropagationOrphan
 	}
 
+	var applyConcurrency int
+	if value := syncOp.SyncOptions.GetOptionValue(common.SyncOptionApplyConcurrency); value != nil {
+		applyConcurrency, err = strconv.Atoi(*value)
+		if err != nil || applyConcurrency < 1 {
+			state.Phase = common.OperationError
+			state.Message = fmt.Sprintf("Invalid %s sync option %q: must be a positive integer", common.SyncOptionApplyConcurrency, *value)
+			return
+		}
+	}
+
 	clientSideApplyManager := common.DefaultClientSideApplyMigrationManager
 	// Check for custom field manager from application annotation
 	if managerValue := app.GetAnnotation(cdcommon.AnnotationClientSideApplyMigrationManager); managerValue != "" {
@@ -330,7 +344,7 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 
 	opts := []sync.SyncOpt{
 		sync.WithLogr(logutils.NewLogrusLogger(logEntry)),
-		sync.WithHealthOverride(lua.ResourceHealthOverrides(resourceOverrides)),
+		sync.WithHealthOverride(healthplugin.ResourceHealthOverrides{Overrides: resourceOverrides, Server: destCluster.Server}),
 		sync.WithPermissionValidator(func(un *unstructured.Unstructured, res *metav1.APIResource) error {
 			return validateSyncPermissions(project, destCluster, func(proj string) ([]*v1alpha1.Cluster, error) {
 				return m.db.GetProjectClusters(ctx, proj)
@@ -360,6 +374,8 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 		sync.WithPruneConfirmed(app.IsDeletionConfirmed(state.StartedAt.Time)),
 		sync.WithDefaultPruneOption(syncOp.SyncOptions.GetOptionValue(common.SyncOptionPrune)),
 		sync.WithSkipDryRunOnMissingResource(syncOp.SyncOptions.HasOption(common.SyncOptionSkipDryRunOnMissingResource)),
+		// the concurrent applies still go through the kubectl parallelism limit of the controller
+		sync.WithApplyConcurrency(applyConcurrency),
 	}
 
 	if syncOp.SyncOptions.HasOption("CreateNamespace=true") {
@@ -416,7 +432,7 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 			res.Message = augmentedMsg
 		}
 
-		state.SyncResult.Resources = append(state.SyncResult.Resources, &v1alpha1.ResourceResult{
+		resourceResult := &v1alpha1.ResourceResult{
 			HookType:  res.HookType,
 			Group:     res.ResourceKey.Group,
 			Kind:      res.ResourceKey.Kind,
@@ -428,7 +444,11 @@ func (m *appStateManager) SyncAppState(ctx context.Context, app *v1alpha1.Applic
 			Status:    res.Status,
 			Message:   res.Message,
 			Images:    res.Images,
-		})
+		}
+		if !res.StartedAt.IsZero() {
+			resourceResult.StartedAt = &res.StartedAt
+		}
+		state.SyncResult.Resources = append(state.SyncResult.Resources, resourceResult)
 	}
 
 	logEntry.WithField("duration", time.Since(start)).Info("sync/terminate complete")
@@ -181,6 +181,47 @@ func TestPersistRevisionHistoryRollback(t *testing.T) {
 	assert.Equal(t, "abc123", updatedApp.Status.History[0].Revision)
 }
 
+func TestSyncPolicyViolationError(t *testing.T) {
+	app := newFakeApp()
+	app.Status.OperationState = nil
+	app.Status.History = nil
+	cm := test.NewConfigMap()
+	cm.SetNamespace(test.FakeDestNamespace)
+
+	policyCM := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDPolicyConfigMapName,
+			Namespace: test.FakeArgoCDNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: map[string]string{
+			"policies": `[{name: no-config-maps, match: {kinds: [ConfigMap]}, expression: "false", message: config maps are not allowed, action: deny}]`,
+		},
+	}
+	data := fakeData{
+		apps: []runtime.Object{app, &defaultProj},
+		manifestResponse: &apiclient.ManifestResponse{
+			Manifests: []string{toJSON(t, cm)},
+			Namespace: test.FakeDestNamespace,
+			Server:    test.FakeClusterURL,
+			Revision:  "abc123",
+		},
+		managedLiveObjs: make(map[kube.ResourceKey]*unstructured.Unstructured),
+		additionalObjs:  []runtime.Object{policyCM},
+	}
+	ctrl := newFakeController(t.Context(), &data, nil)
+
+	opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+		Sync: &v1alpha1.SyncOperation{},
+	}}
+	ctrl.appStateManager.SyncAppState(t.Context(), app, &defaultProj, opState)
+
+	assert.Equal(t, synccommon.OperationError, opState.Phase)
+	assert.Contains(t, opState.Message, "violates policy no-config-maps: config maps are not allowed")
+}
+
 func TestSyncComparisonError(t *testing.T) {
 	app := newFakeApp()
 	app.Status.OperationState = nil
@@ -311,6 +352,26 @@ func TestAppStateManager_SyncAppState(t *testing.T) {
 		assert.Equal(t, synccommon.OperationFailed, opState.Phase)
 		assert.Contains(t, opState.Message, "ConfigMap/configmap1 is part of applications fake-argocd-ns/my-app and guestbook")
 	})
+
+	t.Run("will fail the sync if the apply concurrency is invalid", func(t *testing.T) {
+		// given
+		t.Parallel()
+		f := setup(nil)
+
+		opState := &v1alpha1.OperationState{Operation: v1alpha1.Operation{
+			Sync: &v1alpha1.SyncOperation{
+				Source:      &v1alpha1.ApplicationSource{},
+				SyncOptions: []string{"ApplyConcurrency=none"},
+			},
+		}}
+
+		// when
+		f.controller.appStateManager.SyncAppState(t.Context(), f.application, f.project, opState)
+
+		// then
+		assert.Equal(t, synccommon.OperationError, opState.Phase)
+		assert.Equal(t, `Invalid ApplyConcurrency sync option "none": must be a positive integer`, opState.Message)
+	})
 }
 
 func TestSyncWindowDeniesSync(t *testing.T) {
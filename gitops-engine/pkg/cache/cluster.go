@@ -48,6 +48,7 @@ import (
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	authType1 "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/pager"
@@ -221,6 +222,7 @@ func NewClusterCache(config *rest.Config, opts ...UpdateSettingsFunc) *clusterCa
 		listRetryUseBackoff:     false,
 		listRetryFunc:           ListRetryFuncNever,
 		parentUIDToChildren:     make(map[types.UID]map[kube.ResourceKey]struct{}),
+		watchResourceVersions:   make(map[string]string),
 	}
 	for i := range opts {
 		opts[i](cache)
@@ -285,6 +287,19 @@ type clusterCache struct {
 	// Using a set eliminates O(k) duplicate checking on insertions
 	// Used for cross-namespace hierarchy traversal; namespaced traversal still builds a graph
 	parentUIDToChildren map[types.UID]map[kube.ResourceKey]struct{}
+
+	// snapshotStore persists periodic snapshots of the cache, which are restored on the first sync
+	snapshotStore     SnapshotStore
+	snapshotInterval  time.Duration
+	resourceInfoCodec ResourceInfoCodec
+	snapshotLoaded    bool
+	snapshotCancel    context.CancelFunc
+	// watchResourceVersions holds the resource version of the latest state loaded in the cache for each watch. It is
+	// only maintained when snapshots are enabled.
+	watchResourceVersions map[string]string
+
+	// metadataOnlyFilter selects the group kinds whose resources are listed and watched with their metadata only
+	metadataOnlyFilter MetadataOnlyFilter
 }
 
 type clusterCacheSync struct {
@@ -613,6 +628,7 @@ func (c *clusterCache) Invalidate(opts ...UpdateSettingsFunc) {
 	for i := range c.apisMeta {
 		c.apisMeta[i].watchCancel()
 	}
+	c.stopSnapshots()
 	for i := range opts {
 		opts[i](c)
 	}
@@ -649,6 +665,7 @@ func (c *clusterCache) stopWatching(gk schema.GroupKind, ns string) {
 		info.watchCancel()
 		delete(c.apisMeta, gk)
 		c.replaceResourceCache(gk, nil, ns)
+		delete(c.watchResourceVersions, watchKey(gk, ns))
 		c.log.Info(fmt.Sprintf("Stop watching: %s not found", gk))
 	}
 }
@@ -663,6 +680,10 @@ func (c *clusterCache) startMissingWatches() error {
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
+	metadataClient, err := c.newMetadataClient()
+	if err != nil {
+		return err
+	}
 	clientset, err := kubernetes.NewForConfig(c.config)
 	if err != nil {
 		return fmt.Errorf("failed to create clientset: %w", err)
@@ -675,7 +696,7 @@ func (c *clusterCache) startMissingWatches() error {
 			ctx, cancel := context.WithCancel(context.Background())
 			c.apisMeta[api.GroupKind] = &apiMeta{namespaced: api.Meta.Namespaced, watchCancel: cancel}
 
-			err := c.processApi(client, api, func(resClient dynamic.ResourceInterface, ns string) error {
+			err := c.processApi(client, metadataClient, api, func(resClient dynamic.ResourceInterface, ns string) error {
 				resourceVersion, err := c.loadInitialState(ctx, api, resClient, ns, false) // don't lock here, we are already in a lock before startMissingWatches is called inside watchEvents
 				if err != nil && c.isRestrictedResource(err) {
 					keep := false
@@ -782,10 +803,12 @@ func (c *clusterCache) loadInitialState(ctx context.Context, api kube.APIResourc
 	if lock {
 		return resourceVersion, runSynced(&c.lock, func() error {
 			c.replaceResourceCache(api.GroupKind, items, ns)
+			c.setWatchResourceVersion(watchKey(api.GroupKind, ns), resourceVersion)
 			return nil
 		})
 	}
 	c.replaceResourceCache(api.GroupKind, items, ns)
+	c.setWatchResourceVersion(watchKey(api.GroupKind, ns), resourceVersion)
 	return resourceVersion, nil
 }
 
@@ -850,6 +873,12 @@ func (c *clusterCache) watchEvents(ctx context.Context, api kube.APIResourceInfo
 					return fmt.Errorf("watch %s on %s has closed", api.GroupKind, c.config.Host)
 				}
 
+				if event.Type == watch.Error {
+					// the resources are listed again when the watch is restarted, which is how watches resumed from
+					// an expired resource version (410 Gone) recover
+					return fmt.Errorf("watch %s on %s failed: %w", api.GroupKind, c.config.Host, apierrors.FromObject(event.Object))
+				}
+
 				obj, ok := event.Object.(*unstructured.Unstructured)
 				if !ok {
 					return fmt.Errorf("failed to convert to *unstructured.Unstructured: %v", event.Object)
@@ -1012,16 +1041,16 @@ func isAPIServiceAvailable(obj *unstructured.Unstructured) bool {
 // processApi processes all the resources for a given API. First we construct an API client for the given API. Then we
 // call the callback. If we're managing the whole cluster, we call the callback with the client and an empty namespace.
 // If we're managing specific namespaces, we call the callback for each namespace.
-func (c *clusterCache) processApi(client dynamic.Interface, api kube.APIResourceInfo, callback func(resClient dynamic.ResourceInterface, ns string) error) error {
+func (c *clusterCache) processApi(client dynamic.Interface, metadataClient metadata.Interface, api kube.APIResourceInfo, callback func(resClient dynamic.ResourceInterface, ns string) error) error {
 	resClient := client.Resource(api.GroupVersionResource)
 	switch {
 	// if manage whole cluster or resource is cluster level and cluster resources enabled
 	case len(c.namespaces) == 0 || (!api.Meta.Namespaced && c.clusterResources):
-		return callback(resClient, "")
+		return callback(c.newResourceClient(resClient, metadataClient, api, ""), "")
 	// if manage some namespaces and resource is namespaced
 	case len(c.namespaces) != 0 && api.Meta.Namespaced:
 		for _, ns := range c.namespaces {
-			err := callback(resClient.Namespace(ns), ns)
+			err := callback(c.newResourceClient(resClient, metadataClient, api, ns), ns)
 			if err != nil {
 				return err
 			}
@@ -1099,6 +1128,7 @@ func (c *clusterCache) sync() error {
 	for i := range c.apisMeta {
 		c.apisMeta[i].watchCancel()
 	}
+	c.stopSnapshots()
 
 	if c.batchEventsProcessing {
 		c.invalidateEventMeta()
@@ -1111,7 +1141,9 @@ func (c *clusterCache) sync() error {
 	c.nsIndex = make(map[string]map[kube.ResourceKey]*Resource)
 	c.namespacedResources = make(map[schema.GroupKind]bool)
 	c.parentUIDToChildren = make(map[types.UID]map[kube.ResourceKey]struct{})
+	c.watchResourceVersions = make(map[string]string)
 	syncLock.Unlock()
+	restored := c.loadSnapshot()
 	config := c.config
 	version, err := c.kubectl.GetServerVersion(config)
 	if err != nil {
@@ -1143,6 +1175,10 @@ func (c *clusterCache) sync() error {
 	if err != nil {
 		return fmt.Errorf("failed to create client: %w", err)
 	}
+	metadataClient, err := c.newMetadataClient()
+	if err != nil {
+		return err
+	}
 	clientset, err := kubernetes.NewForConfig(config)
 	if err != nil {
 		return fmt.Errorf("failed to create clientset: %w", err)
@@ -1162,7 +1198,20 @@ func (c *clusterCache) sync() error {
 		c.namespacedResources[api.GroupKind] = api.Meta.Namespaced
 		syncLock.Unlock()
 
-		return c.processApi(client, api, func(resClient dynamic.ResourceInterface, ns string) error {
+		return c.processApi(client, metadataClient, api, func(resClient dynamic.ResourceInterface, ns string) error {
+			key := watchKey(api.GroupKind, ns)
+			if resourceVersion, ok := restored.resourceVersion(key); ok {
+				// resume the watch from the snapshot instead of listing the resources
+				syncLock.Lock()
+				for _, res := range restored.resources[key] {
+					c.setNode(res)
+				}
+				c.setWatchResourceVersion(key, resourceVersion)
+				syncLock.Unlock()
+				go c.watchEvents(ctx, api, resClient, ns, resourceVersion)
+				return nil
+			}
+
 			resourceVersion, err := c.listResources(ctx, resClient, func(listPager *pager.ListPager) error {
 				return listPager.EachListItem(context.Background(), metav1.ListOptions{}, func(obj runtime.Object) error {
 					if un, ok := obj.(*unstructured.Unstructured); !ok {
@@ -1197,6 +1246,9 @@ func (c *clusterCache) sync() error {
 				}
 				return fmt.Errorf("failed to load initial state of resource %s: %w", api.GroupKind.String(), err)
 			}
+			syncLock.Lock()
+			c.setWatchResourceVersion(key, resourceVersion)
+			syncLock.Unlock()
 
 			go c.watchEvents(ctx, api, resClient, ns, resourceVersion)
 
@@ -1208,6 +1260,7 @@ func (c *clusterCache) sync() error {
 		return fmt.Errorf("failed to sync cluster %s: %w", c.config.Host, err)
 	}
 
+	c.startSnapshots()
 	c.log.Info("Cluster successfully synced")
 	return nil
 }
@@ -1696,6 +1749,7 @@ func (c *clusterCache) processEvent(key kube.ResourceKey, evMeta eventMeta) {
 	} else {
 		c.onNodeUpdated(existingNode, c.newResource(evMeta.un))
 	}
+	c.setWatchResourceVersion(c.resourceWatchKey(key), evMeta.un.GetResourceVersion())
 }
 
 func (c *clusterCache) onNodeUpdated(oldRes *Resource, newRes *Resource) {
@@ -0,0 +1,166 @@
+package cache
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/metadata"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+)
+
+// MetadataOnlyFilter returns true if the resources of the given group kind can be listed and watched with their
+// metadata only (as PartialObjectMetadata), instead of their full manifest. The filter is evaluated every time the
+// resources are listed or watched, so a group kind switches to full manifests when its resources are listed again.
+type MetadataOnlyFilter func(gk schema.GroupKind) bool
+
+// newMetadataClient returns the client used to list and watch the resources with their metadata only, or nil if
+// metadata-only watches are disabled
+func (c *clusterCache) newMetadataClient() (metadata.Interface, error) {
+	if c.metadataOnlyFilter == nil {
+		return nil, nil
+	}
+	client, err := c.kubectl.NewMetadataClient(c.config)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create metadata client: %w", err)
+	}
+	return client, nil
+}
+
+// isMetadataOnly returns true if the resources of the given group kind are listed and watched with their metadata only
+func (c *clusterCache) isMetadataOnly(gk schema.GroupKind) bool {
+	return c.metadataOnlyFilter != nil && c.metadataOnlyFilter(gk)
+}
+
+// requiresFullManifest returns true if the manifest of the resource with the given metadata is cached, in which case
+// its full manifest has to be fetched.
+func (c *clusterCache) requiresFullManifest(un *unstructured.Unstructured) bool {
+	if c.populateResourceInfoHandler == nil {
+		return false
+	}
+	// the info is populated again from the full manifest once it has been fetched
+	_, cacheManifest := c.populateResourceInfoHandler(un, len(un.GetOwnerReferences()) == 0)
+	return cacheManifest
+}
+
+// newResourceClient returns the client used to list and watch the resources of the given API in the given namespace.
+// CRDs and APIServices are always watched with their full manifest, since the cache relies on their spec and status
+// to discover new APIs.
+func (c *clusterCache) newResourceClient(client dynamic.NamespaceableResourceInterface, metadataClient metadata.Interface, api kube.APIResourceInfo, ns string) dynamic.ResourceInterface {
+	var resClient dynamic.ResourceInterface = client
+	if ns != "" {
+		resClient = client.Namespace(ns)
+	}
+	gvk := api.GroupKind.WithVersion(api.GroupVersionResource.Version)
+	if metadataClient == nil || kube.IsCRDGroupVersionKind(gvk) || kube.IsAPIServiceGroupVersionKind(gvk) {
+		return resClient
+	}
+	var metadataResClient metadata.ResourceInterface = metadataClient.Resource(api.GroupVersionResource)
+	if ns != "" {
+		metadataResClient = metadataClient.Resource(api.GroupVersionResource).Namespace(ns)
+	}
+	return &metadataOnlyResourceClient{
+		ResourceInterface: resClient,
+		metadataClient:    metadataResClient,
+		client:            client,
+		gvk:               gvk,
+		cache:             c,
+	}
+}
+
+// metadataOnlyResourceClient lists and watches resources with their metadata only if the MetadataOnlyFilter allows
+// it. The full manifest is only fetched for the resources whose manifest is cached.
+type metadataOnlyResourceClient struct {
+	dynamic.ResourceInterface
+	metadataClient metadata.ResourceInterface
+	// client is used to fetch the full manifest of resources in any namespace
+	client dynamic.NamespaceableResourceInterface
+	gvk    schema.GroupVersionKind
+	cache  *clusterCache
+}
+
+func (r *metadataOnlyResourceClient) List(ctx context.Context, opts metav1.ListOptions) (*unstructured.UnstructuredList, error) {
+	if !r.cache.isMetadataOnly(r.gvk.GroupKind()) {
+		//nolint:wrapcheck // wrapped by the caller
+		return r.ResourceInterface.List(ctx, opts)
+	}
+	list, err := r.metadataClient.List(ctx, opts)
+	if err != nil {
+		//nolint:wrapcheck // wrapped by the caller
+		return nil, err
+	}
+	res := &unstructured.UnstructuredList{Object: map[string]any{}}
+	res.SetResourceVersion(list.ResourceVersion)
+	res.SetContinue(list.Continue)
+	res.SetRemainingItemCount(list.RemainingItemCount)
+	res.Items = make([]unstructured.Unstructured, 0, len(list.Items))
+	for i := range list.Items {
+		un, err := r.toUnstructured(ctx, &list.Items[i], true)
+		if err != nil {
+			return nil, err
+		}
+		res.Items = append(res.Items, *un)
+	}
+	return res, nil
+}
+
+func (r *metadataOnlyResourceClient) Watch(ctx context.Context, opts metav1.ListOptions) (watch.Interface, error) {
+	if !r.cache.isMetadataOnly(r.gvk.GroupKind()) {
+		//nolint:wrapcheck // wrapped by the caller
+		return r.ResourceInterface.Watch(ctx, opts)
+	}
+	w, err := r.metadataClient.Watch(ctx, opts)
+	if err != nil {
+		//nolint:wrapcheck // wrapped by the caller
+		return nil, err
+	}
+	return watch.Filter(w, func(event watch.Event) (watch.Event, bool) {
+		obj, ok := event.Object.(*metav1.PartialObjectMetadata)
+		if !ok {
+			return event, true
+		}
+		un, err := r.toUnstructured(ctx, obj, event.Type != watch.Deleted && event.Type != watch.Bookmark)
+		if err != nil {
+			return watch.Event{Type: watch.Error, Object: &apierrors.NewInternalError(err).ErrStatus}, true
+		}
+		event.Object = un
+		return event, true
+	}), nil
+}
+
+// toUnstructured converts the metadata of a resource to an unstructured object. The full manifest of the resource is
+// fetched instead if fetchManifest is true and its manifest is cached.
+func (r *metadataOnlyResourceClient) toUnstructured(ctx context.Context, obj *metav1.PartialObjectMetadata, fetchManifest bool) (*unstructured.Unstructured, error) {
+	objMeta, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&obj.ObjectMeta)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert metadata of %s %s: %w", r.gvk.Kind, obj.Name, err)
+	}
+	un := &unstructured.Unstructured{Object: map[string]any{"metadata": objMeta}}
+	un.SetGroupVersionKind(r.gvk)
+	if !fetchManifest || !r.cache.requiresFullManifest(un) {
+		return un, nil
+	}
+
+	var full *unstructured.Unstructured
+	if ns := un.GetNamespace(); ns != "" {
+		full, err = r.client.Namespace(ns).Get(ctx, un.GetName(), metav1.GetOptions{})
+	} else {
+		full, err = r.client.Get(ctx, un.GetName(), metav1.GetOptions{})
+	}
+	if err != nil {
+		// the resource keeps its metadata only until its next update: a deleted resource is removed by the following
+		// watch event
+		if !apierrors.IsNotFound(err) {
+			r.cache.log.Error(err, "Failed to fetch the manifest of a resource watched with its metadata only", "kind", r.gvk.Kind, "name", un.GetName(), "namespace", un.GetNamespace())
+		}
+		return un, nil
+	}
+	return full, nil
+}
@@ -0,0 +1,114 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic/fake"
+	metadatafake "k8s.io/client-go/metadata/fake"
+	testcore "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube/kubetest"
+)
+
+func newMetadataOnlyCluster(t *testing.T, filter MetadataOnlyFilter, objs ...*corev1.Pod) (*clusterCache, *metadatafake.FakeMetadataClient) {
+	t.Helper()
+	var runtimeObjs []runtime.Object
+	var metadataObjs []runtime.Object
+	for _, obj := range objs {
+		runtimeObjs = append(runtimeObjs, obj)
+		metadataObjs = append(metadataObjs, &metav1.PartialObjectMetadata{TypeMeta: obj.TypeMeta, ObjectMeta: obj.ObjectMeta})
+	}
+	scheme := metadatafake.NewTestScheme()
+	require.NoError(t, metav1.AddMetaToScheme(scheme))
+	metadataClient := metadatafake.NewSimpleMetadataClient(scheme, metadataObjs...)
+
+	cluster := newClusterWithOptions(t, []UpdateSettingsFunc{
+		SetMetadataOnlyFilter(filter),
+		SetPopulateResourceInfoHandler(func(un *unstructured.Unstructured, _ bool) (any, bool) {
+			_, hasSpec := un.Object["spec"]
+			return hasSpec, un.GetLabels()["app"] != ""
+		}),
+	}, runtimeObjs...)
+	cluster.kubectl.(*kubetest.MockKubectlCmd).MetadataClient = metadataClient
+	return cluster, metadataClient
+}
+
+func newMetadataOnlyTestPod(name string, labels map[string]string) *corev1.Pod {
+	return &corev1.Pod{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: kube.PodKind},
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", UID: types.UID("uid-" + name), Labels: labels},
+		Spec:       corev1.PodSpec{NodeName: "node"},
+	}
+}
+
+func TestMetadataOnlyWatches(t *testing.T) {
+	managedPod := newMetadataOnlyTestPod("managed", map[string]string{"app": "my-app"})
+	unmanagedPod := newMetadataOnlyTestPod("unmanaged", nil)
+
+	t.Run("resources are listed with their metadata only", func(t *testing.T) {
+		cluster, metadataClient := newMetadataOnlyCluster(t, func(gk schema.GroupKind) bool {
+			return gk.Kind == kube.PodKind
+		}, managedPod, unmanagedPod)
+		podLists := 0
+		cluster.kubectl.(*kubetest.MockKubectlCmd).DynamicClient.(*fake.FakeDynamicClient).PrependReactor("list", "pods", func(_ testcore.Action) (bool, runtime.Object, error) {
+			podLists++
+			return false, nil, nil
+		})
+		require.NoError(t, cluster.EnsureSynced())
+		assert.Zero(t, podLists)
+		assert.NotEmpty(t, metadataClient.Actions())
+
+		unmanaged := cluster.resources[kube.GetResourceKey(mustToUnstructured(unmanagedPod))]
+		require.NotNil(t, unmanaged)
+		assert.Equal(t, false, unmanaged.Info)
+		assert.Nil(t, unmanaged.Resource)
+
+		// the full manifest of the resources whose manifest is cached is fetched
+		managed := cluster.resources[kube.GetResourceKey(mustToUnstructured(managedPod))]
+		require.NotNil(t, managed)
+		assert.Equal(t, true, managed.Info)
+		require.NotNil(t, managed.Resource)
+		nodeName, _, _ := unstructured.NestedString(managed.Resource.Object, "spec", "nodeName")
+		assert.Equal(t, "node", nodeName)
+	})
+
+	t.Run("watch events carry the metadata only", func(t *testing.T) {
+		cluster, metadataClient := newMetadataOnlyCluster(t, func(gk schema.GroupKind) bool {
+			return gk.Kind == kube.PodKind
+		})
+		require.NoError(t, cluster.EnsureSynced())
+
+		require.NoError(t, cluster.kubectl.(*kubetest.MockKubectlCmd).DynamicClient.(*fake.FakeDynamicClient).Tracker().Add(mustToUnstructured(managedPod)))
+		require.NoError(t, metadataClient.Tracker().Add(&metav1.PartialObjectMetadata{TypeMeta: managedPod.TypeMeta, ObjectMeta: managedPod.ObjectMeta}))
+		require.NoError(t, metadataClient.Tracker().Add(&metav1.PartialObjectMetadata{TypeMeta: unmanagedPod.TypeMeta, ObjectMeta: unmanagedPod.ObjectMeta}))
+
+		require.Eventually(t, func() bool {
+			return len(cluster.FindResources("default")) == 2
+		}, 5*time.Second, 10*time.Millisecond)
+		resources := cluster.FindResources("default")
+		assert.Nil(t, resources[kube.GetResourceKey(mustToUnstructured(unmanagedPod))].Resource)
+		assert.NotNil(t, resources[kube.GetResourceKey(mustToUnstructured(managedPod))].Resource)
+	})
+
+	t.Run("resources not selected by the filter are listed with their manifest", func(t *testing.T) {
+		cluster, metadataClient := newMetadataOnlyCluster(t, func(_ schema.GroupKind) bool {
+			return false
+		}, unmanagedPod)
+		require.NoError(t, cluster.EnsureSynced())
+		assert.Empty(t, metadataClient.Actions())
+
+		unmanaged := cluster.resources[kube.GetResourceKey(mustToUnstructured(unmanagedPod))]
+		require.NotNil(t, unmanaged)
+		assert.Equal(t, true, unmanaged.Info)
+	})
+}
@@ -183,3 +183,24 @@ func SetEventProcessingInterval(interval time.Duration) UpdateSettingsFunc {
 		cache.eventProcessingInterval = interval
 	}
 }
+
+// SetSnapshotStore enables the periodic snapshots of the cache to the given store. The first sync of the cache restores
+// the latest snapshot and resumes watching from the stored resource versions instead of listing all the resources.
+// The codec serializes the information populated by the OnPopulateResourceInfoHandler: it is not stored if nil.
+func SetSnapshotStore(store SnapshotStore, interval time.Duration, codec ResourceInfoCodec) UpdateSettingsFunc {
+	return func(cache *clusterCache) {
+		cache.snapshotStore = store
+		cache.snapshotInterval = interval
+		cache.resourceInfoCodec = codec
+	}
+}
+
+// SetMetadataOnlyFilter enables listing and watching the resources of the group kinds selected by the given filter with
+// their metadata only, which reduces the memory and network usage of the cache. The full manifest of such resources is
+// only fetched when OnPopulateResourceInfoHandler requests it to be cached, and the resource info is populated from the
+// metadata of the other resources.
+func SetMetadataOnlyFilter(filter MetadataOnlyFilter) UpdateSettingsFunc {
+	return func(cache *clusterCache) {
+		cache.metadataOnlyFilter = filter
+	}
+}
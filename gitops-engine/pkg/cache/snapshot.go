@@ -0,0 +1,264 @@
+package cache
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+)
+
+// SnapshotStore persists the snapshots of a cluster cache. A snapshot allows the cache to resume watching the cluster
+// from the stored resource versions after a restart, instead of listing all the resources again.
+type SnapshotStore interface {
+	// Load returns the latest saved snapshot, or nil if there is none
+	Load() ([]byte, error)
+	// Save persists the given snapshot, replacing the previous one
+	Save(data []byte) error
+}
+
+// ResourceInfoCodec serializes the information populated by the OnPopulateResourceInfoHandler, so that it can be
+// stored in the cluster cache snapshots.
+type ResourceInfoCodec interface {
+	Marshal(info any) ([]byte, error)
+	Unmarshal(data []byte) (any, error)
+}
+
+// snapshot is the persisted state of a cluster cache
+type snapshot struct {
+	// Namespaces and ClusterResources are the settings of the cache when the snapshot was taken. The snapshot is only
+	// restored if they did not change.
+	Namespaces       []string `json:"namespaces,omitempty"`
+	ClusterResources bool     `json:"clusterResources,omitempty"`
+	// ResourceVersions holds the resource version from which each watch resumes, keyed by watch
+	ResourceVersions map[string]string  `json:"resourceVersions"`
+	Resources        []snapshotResource `json:"resources"`
+}
+
+type snapshotResource struct {
+	ResourceVersion   string                  `json:"resourceVersion"`
+	Ref               corev1.ObjectReference  `json:"ref"`
+	OwnerRefs         []metav1.OwnerReference `json:"ownerRefs,omitempty"`
+	CreationTimestamp *metav1.Time            `json:"creationTimestamp,omitempty"`
+	Info              json.RawMessage         `json:"info,omitempty"`
+	// Manifest is only stored if the manifest of the resource is cached
+	Manifest *unstructured.Unstructured `json:"manifest,omitempty"`
+}
+
+// watchKey returns the key of the watch of the given group kind in the given namespace, which is empty if the watch
+// covers the whole cluster
+func watchKey(gk schema.GroupKind, ns string) string {
+	return fmt.Sprintf("%s/%s/%s", gk.Group, gk.Kind, ns)
+}
+
+// resourceWatchKey returns the key of the watch which observes the given resource
+func (c *clusterCache) resourceWatchKey(key kube.ResourceKey) string {
+	ns := ""
+	if len(c.namespaces) > 0 && key.Namespace != "" {
+		ns = key.Namespace
+	}
+	return watchKey(schema.GroupKind{Group: key.Group, Kind: key.Kind}, ns)
+}
+
+// setWatchResourceVersion records the resource version of the latest state of a watch loaded in the cache. The lock
+// should be held before calling this method.
+func (c *clusterCache) setWatchResourceVersion(key string, resourceVersion string) {
+	if c.snapshotStore != nil && resourceVersion != "" {
+		c.watchResourceVersions[key] = resourceVersion
+	}
+}
+
+// stopSnapshots stops saving snapshots of the cache. The lock should be held before calling this method.
+func (c *clusterCache) stopSnapshots() {
+	if c.snapshotCancel != nil {
+		c.snapshotCancel()
+		c.snapshotCancel = nil
+	}
+}
+
+// startSnapshots periodically saves snapshots of the cache until the cache is invalidated or synced again. The lock
+// should be held before calling this method.
+func (c *clusterCache) startSnapshots() {
+	c.stopSnapshots()
+	if c.snapshotStore == nil || c.snapshotInterval <= 0 {
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	c.snapshotCancel = cancel
+	go func() {
+		ticker := time.NewTicker(c.snapshotInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				if err := c.saveSnapshot(ctx); err != nil {
+					c.log.Error(err, "Failed to save cluster cache snapshot")
+				}
+			}
+		}
+	}()
+}
+
+// saveSnapshot saves a snapshot of the resources of the cache and of the resource versions of the watches
+func (c *clusterCache) saveSnapshot(ctx context.Context) error {
+	start := time.Now()
+	data, resourcesCount, err := c.marshalSnapshot(ctx)
+	if err != nil || data == nil {
+		return err
+	}
+	if err := c.snapshotStore.Save(data); err != nil {
+		return fmt.Errorf("failed to store snapshot: %w", err)
+	}
+	c.log.V(1).Info("Saved cluster cache snapshot", "resources", resourcesCount, "size", len(data), "duration", time.Since(start))
+	return nil
+}
+
+// marshalSnapshot returns the compressed snapshot of the cache, or nil if the cache stopped saving snapshots
+func (c *clusterCache) marshalSnapshot(ctx context.Context) ([]byte, int, error) {
+	c.lock.RLock()
+	if ctx.Err() != nil {
+		c.lock.RUnlock()
+		return nil, 0, nil
+	}
+	s := snapshot{
+		Namespaces:       c.namespaces,
+		ClusterResources: c.clusterResources,
+		ResourceVersions: make(map[string]string, len(c.watchResourceVersions)),
+		Resources:        make([]snapshotResource, 0, len(c.resources)),
+	}
+	for key, resourceVersion := range c.watchResourceVersions {
+		s.ResourceVersions[key] = resourceVersion
+	}
+	for _, res := range c.resources {
+		sr := snapshotResource{
+			ResourceVersion:   res.ResourceVersion,
+			Ref:               res.Ref,
+			OwnerRefs:         res.OwnerRefs,
+			CreationTimestamp: res.CreationTimestamp,
+		}
+		if res.Info != nil && c.resourceInfoCodec != nil {
+			info, err := c.resourceInfoCodec.Marshal(res.Info)
+			if err != nil {
+				c.lock.RUnlock()
+				return nil, 0, fmt.Errorf("failed to marshal info of resource %s: %w", res.Ref.String(), err)
+			}
+			sr.Info = info
+		}
+		if res.Resource != nil {
+			sr.Manifest = res.Resource
+		}
+		s.Resources = append(s.Resources, sr)
+	}
+	// the resources are marshaled while holding the lock, since their owner references might be updated concurrently
+	data, err := json.Marshal(s)
+	c.lock.RUnlock()
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to marshal snapshot: %w", err)
+	}
+
+	var buf bytes.Buffer
+	gzipWriter := gzip.NewWriter(&buf)
+	if _, err := gzipWriter.Write(data); err != nil {
+		return nil, 0, fmt.Errorf("failed to compress snapshot: %w", err)
+	}
+	if err := gzipWriter.Close(); err != nil {
+		return nil, 0, fmt.Errorf("failed to compress snapshot: %w", err)
+	}
+	return buf.Bytes(), len(s.Resources), nil
+}
+
+// restoredSnapshot holds the resources of a snapshot, grouped by watch
+type restoredSnapshot struct {
+	resourceVersions map[string]string
+	resources        map[string][]*Resource
+}
+
+// resourceVersion returns the resource version from which the given watch resumes
+func (r *restoredSnapshot) resourceVersion(key string) (string, bool) {
+	if r == nil {
+		return "", false
+	}
+	resourceVersion, ok := r.resourceVersions[key]
+	return resourceVersion, ok && resourceVersion != ""
+}
+
+// loadSnapshot loads the snapshot of the cache, if it is the first sync of the cache and the snapshot was taken with
+// the current settings. It returns nil if there is no snapshot to restore.
+func (c *clusterCache) loadSnapshot() *restoredSnapshot {
+	if c.snapshotStore == nil || c.snapshotLoaded {
+		return nil
+	}
+	// the snapshot is only restored on the first sync: later syncs are caused by invalidations or resync timeouts,
+	// which both require the resources to be listed again
+	c.snapshotLoaded = true
+
+	restored, err := c.unmarshalSnapshot()
+	if err != nil {
+		c.log.Error(err, "Failed to load cluster cache snapshot, listing all resources")
+		return nil
+	}
+	return restored
+}
+
+func (c *clusterCache) unmarshalSnapshot() (*restoredSnapshot, error) {
+	data, err := c.snapshotStore.Load()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load snapshot: %w", err)
+	}
+	if data == nil {
+		return nil, nil
+	}
+	gzipReader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress snapshot: %w", err)
+	}
+	data, err = io.ReadAll(gzipReader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decompress snapshot: %w", err)
+	}
+	var s snapshot
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal snapshot: %w", err)
+	}
+	if !slices.Equal(s.Namespaces, c.namespaces) || s.ClusterResources != c.clusterResources {
+		c.log.Info("Ignoring cluster cache snapshot taken with different namespaces")
+		return nil, nil
+	}
+
+	restored := &restoredSnapshot{resourceVersions: s.ResourceVersions, resources: make(map[string][]*Resource)}
+	for i := range s.Resources {
+		sr := s.Resources[i]
+		res := &Resource{
+			ResourceVersion:   sr.ResourceVersion,
+			Ref:               sr.Ref,
+			OwnerRefs:         sr.OwnerRefs,
+			CreationTimestamp: sr.CreationTimestamp,
+		}
+		if len(sr.Info) > 0 && c.resourceInfoCodec != nil {
+			if res.Info, err = c.resourceInfoCodec.Unmarshal(sr.Info); err != nil {
+				return nil, fmt.Errorf("failed to unmarshal info of resource %s: %w", res.Ref.String(), err)
+			}
+		}
+		if sr.Manifest != nil {
+			// the owner references inferred from resources without cached manifest are recovered on the next relist
+			_, res.isInferredParentOf = c.resolveResourceReferences(sr.Manifest)
+			res.Resource = sr.Manifest
+		}
+		key := c.resourceWatchKey(res.ResourceKey())
+		restored.resources[key] = append(restored.resources[key], res)
+	}
+	c.log.Info("Restoring cluster cache snapshot", "resources", len(s.Resources), "watches", len(s.ResourceVersions))
+	return restored, nil
+}
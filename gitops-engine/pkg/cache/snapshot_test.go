@@ -0,0 +1,140 @@
+package cache
+
+import (
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/dynamic/fake"
+	testcore "k8s.io/client-go/testing"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube/kubetest"
+)
+
+type memorySnapshotStore struct {
+	lock sync.Mutex
+	data []byte
+}
+
+func (s *memorySnapshotStore) Load() ([]byte, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.data, nil
+}
+
+func (s *memorySnapshotStore) Save(data []byte) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.data = data
+	return nil
+}
+
+type stringInfoCodec struct{}
+
+func (stringInfoCodec) Marshal(info any) ([]byte, error) {
+	return json.Marshal(info)
+}
+
+func (stringInfoCodec) Unmarshal(data []byte) (any, error) {
+	var info string
+	err := json.Unmarshal(data, &info)
+	return info, err
+}
+
+func newSnapshotCluster(t *testing.T, store SnapshotStore, opts []UpdateSettingsFunc, objs ...runtime.Object) (*clusterCache, *atomic.Int32) {
+	t.Helper()
+	opts = append([]UpdateSettingsFunc{
+		SetSnapshotStore(store, 0, stringInfoCodec{}),
+		SetPopulateResourceInfoHandler(func(un *unstructured.Unstructured, _ bool) (any, bool) {
+			return "info of " + un.GetName(), un.GetKind() == kube.DeploymentKind
+		}),
+	}, opts...)
+	cluster := newClusterWithOptions(t, opts, objs...)
+	lists := &atomic.Int32{}
+	client := cluster.kubectl.(*kubetest.MockKubectlCmd).DynamicClient.(*fake.FakeDynamicClient)
+	client.PrependReactor("list", "*", func(_ testcore.Action) (bool, runtime.Object, error) {
+		lists.Add(1)
+		return false, nil, nil
+	})
+	return cluster, lists
+}
+
+func TestSnapshotRestore(t *testing.T) {
+	deploy := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: kube.DeploymentKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "helm-guestbook", Namespace: "default", UID: "1", ResourceVersion: "100"},
+	}
+	pod := &corev1.Pod{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: kube.PodKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "helm-guestbook-pod", Namespace: "default", UID: "2", ResourceVersion: "101"},
+	}
+	store := &memorySnapshotStore{}
+
+	cluster, lists := newSnapshotCluster(t, store, nil, deploy, pod)
+	require.NoError(t, cluster.EnsureSynced())
+	assert.Positive(t, lists.Load())
+	assert.Equal(t, "123", cluster.watchResourceVersions["apps/Deployment/"])
+	require.NoError(t, cluster.saveSnapshot(t.Context()))
+	require.NotNil(t, store.data)
+
+	t.Run("resources are restored without listing them", func(t *testing.T) {
+		restoredCluster, lists := newSnapshotCluster(t, store, nil)
+		require.NoError(t, restoredCluster.EnsureSynced())
+		assert.Zero(t, lists.Load())
+
+		restoredCluster.lock.RLock()
+		defer restoredCluster.lock.RUnlock()
+		require.Len(t, restoredCluster.resources, 2)
+		restoredDeploy := restoredCluster.resources[kube.GetResourceKey(mustToUnstructured(deploy))]
+		require.NotNil(t, restoredDeploy)
+		assert.Equal(t, "100", restoredDeploy.ResourceVersion)
+		assert.Equal(t, "info of helm-guestbook", restoredDeploy.Info)
+		require.NotNil(t, restoredDeploy.Resource)
+		assert.Equal(t, "helm-guestbook", restoredDeploy.Resource.GetName())
+		restoredPod := restoredCluster.resources[kube.GetResourceKey(mustToUnstructured(pod))]
+		require.NotNil(t, restoredPod)
+		assert.Nil(t, restoredPod.Resource)
+		assert.Equal(t, "123", restoredCluster.watchResourceVersions["/Pod/"])
+	})
+
+	t.Run("resources are listed when the cache is synced again", func(t *testing.T) {
+		restoredCluster, lists := newSnapshotCluster(t, store, nil)
+		require.NoError(t, restoredCluster.EnsureSynced())
+		restoredCluster.Invalidate()
+		require.NoError(t, restoredCluster.EnsureSynced())
+		assert.Positive(t, lists.Load())
+		assert.Empty(t, restoredCluster.FindResources(""))
+	})
+
+	t.Run("snapshot taken with other namespaces is ignored", func(t *testing.T) {
+		restoredCluster, lists := newSnapshotCluster(t, store, []UpdateSettingsFunc{SetNamespaces([]string{"default"})})
+		require.NoError(t, restoredCluster.EnsureSynced())
+		assert.Positive(t, lists.Load())
+		assert.Empty(t, restoredCluster.FindResources(""))
+	})
+}
+
+func TestSnapshotWatchResourceVersions(t *testing.T) {
+	cluster, _ := newSnapshotCluster(t, &memorySnapshotStore{}, []UpdateSettingsFunc{SetNamespaces([]string{"default"})})
+	require.NoError(t, cluster.EnsureSynced())
+	assert.Equal(t, "123", cluster.watchResourceVersions["/Pod/default"])
+
+	pod := mustToUnstructured(&corev1.Pod{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: kube.PodKind},
+		ObjectMeta: metav1.ObjectMeta{Name: "pod", Namespace: "default", ResourceVersion: "456"},
+	})
+	cluster.lock.Lock()
+	cluster.processEvent(kube.GetResourceKey(pod), eventMeta{event: watch.Added, un: pod})
+	cluster.lock.Unlock()
+	assert.Equal(t, "456", cluster.watchResourceVersions["/Pod/default"])
+}
@@ -0,0 +1,202 @@
+package diff
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/managedfields"
+	"sigs.k8s.io/structured-merge-diff/v6/fieldpath"
+	"sigs.k8s.io/structured-merge-diff/v6/typed"
+	"sigs.k8s.io/structured-merge-diff/v6/value"
+
+	gescheme "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube/scheme"
+)
+
+// ChangeOperation is the kind of change of a field between the live and the target state of a resource
+type ChangeOperation string
+
+const (
+	// ChangeOperationAdd means that the field is only present in the target state
+	ChangeOperationAdd ChangeOperation = "add"
+	// ChangeOperationRemove means that the field is only present in the live state
+	ChangeOperationRemove ChangeOperation = "remove"
+	// ChangeOperationReplace means that the value of the field differs between the live and the target state
+	ChangeOperationReplace ChangeOperation = "replace"
+)
+
+// Change is a change of a field between the live and the target state of a resource
+type Change struct {
+	Operation ChangeOperation `json:"op"`
+	// Path is the path of the field, e.g. .spec.template.spec.containers[name="nginx"].image. The items of the lists
+	// which have merge keys are identified by their keys, so the order of the items does not matter. The path is
+	// empty if the whole resource is added or removed.
+	Path string `json:"path"`
+	// Live is the value of the field in the live state, unless the field is added
+	Live any `json:"live,omitempty"`
+	// Target is the value of the field in the target state, unless the field is removed
+	Target any `json:"target,omitempty"`
+}
+
+// SemanticDiff returns the changes of the fields between the live and the target state of a resource, typically the
+// NormalizedLive and PredictedLive states of a DiffResult. The items of the lists are matched by the merge keys of the
+// schema of the resource, which is resolved with the given GvkParser, or from the schema of the k8s built in types if
+// the parser is nil. The lists of resources without schema are compared as a whole.
+func SemanticDiff(live, target *unstructured.Unstructured, gvkParser *managedfields.GvkParser) ([]Change, error) {
+	switch {
+	case live == nil && target == nil:
+		return nil, nil
+	case live == nil:
+		return []Change{{Operation: ChangeOperationAdd, Target: target.Object}}, nil
+	case target == nil:
+		return []Change{{Operation: ChangeOperationRemove, Live: live.Object}}, nil
+	}
+
+	comparison, err := compareTypedValues(live, target, resolveSemanticParseableType(target, gvkParser))
+	if err != nil {
+		// The resource does not match its schema, so fall back to compare its lists as a whole
+		comparison, err = compareTypedValues(live, target, &typed.DeducedParseableType)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var changes []Change
+	for _, path := range topLevelPaths(comparison.Added) {
+		changes = append(changes, Change{Operation: ChangeOperationAdd, Path: path.String(), Target: valueAt(target.Object, path)})
+	}
+	for _, path := range topLevelPaths(comparison.Removed) {
+		changes = append(changes, Change{Operation: ChangeOperationRemove, Path: path.String(), Live: valueAt(live.Object, path)})
+	}
+	for _, path := range topLevelPaths(comparison.Modified) {
+		changes = append(changes, Change{Operation: ChangeOperationReplace, Path: path.String(), Live: valueAt(live.Object, path), Target: valueAt(target.Object, path)})
+	}
+	sort.SliceStable(changes, func(i, j int) bool {
+		return changes[i].Path < changes[j].Path
+	})
+	return changes, nil
+}
+
+func resolveSemanticParseableType(obj *unstructured.Unstructured, gvkParser *managedfields.GvkParser) *typed.ParseableType {
+	gvk := obj.GroupVersionKind()
+	if gvkParser != nil {
+		if pt := gescheme.ResolveParseableType(gvk, gvkParser); pt != nil && pt.IsValid() {
+			return pt
+		}
+	}
+	if pt := gescheme.ResolveStaticParseableType(gvk); pt != nil {
+		return pt
+	}
+	return &typed.DeducedParseableType
+}
+
+func compareTypedValues(live, target *unstructured.Unstructured, pt *typed.ParseableType) (*typed.Comparison, error) {
+	tvLive, err := pt.FromUnstructured(live.Object)
+	if err != nil {
+		return nil, fmt.Errorf("error building typed value from live resource: %w", err)
+	}
+	tvTarget, err := pt.FromUnstructured(target.Object)
+	if err != nil {
+		return nil, fmt.Errorf("error building typed value from target resource: %w", err)
+	}
+	comparison, err := tvLive.Compare(tvTarget)
+	if err != nil {
+		return nil, fmt.Errorf("error comparing live and target resources: %w", err)
+	}
+	return comparison, nil
+}
+
+// topLevelPaths returns the paths of the set which are not children of another path of the set
+func topLevelPaths(set *fieldpath.Set) []fieldpath.Path {
+	var paths []fieldpath.Path
+	set.Iterate(func(path fieldpath.Path) {
+		for i := 1; i < len(path); i++ {
+			if set.Has(path[:i]) {
+				return
+			}
+		}
+		paths = append(paths, path.Copy())
+	})
+	return paths
+}
+
+// valueAt returns the value of the field at the given path of the object, or nil if there is no such field
+func valueAt(obj any, path fieldpath.Path) any {
+	current := obj
+	for _, element := range path {
+		switch {
+		case element.FieldName != nil:
+			fields, ok := current.(map[string]any)
+			if !ok {
+				return nil
+			}
+			current = fields[*element.FieldName]
+		case element.Key != nil:
+			current = listItemWithKey(current, *element.Key)
+		case element.Value != nil:
+			current = listItemWithValue(current, *element.Value)
+		case element.Index != nil:
+			items, ok := current.([]any)
+			if !ok || *element.Index >= len(items) {
+				return nil
+			}
+			current = items[*element.Index]
+		default:
+			return nil
+		}
+		if current == nil {
+			return nil
+		}
+	}
+	return current
+}
+
+// listItemWithKey returns the item of the list matching the given merge key. Key fields which are missing from an
+// item are assumed to have their default value, e.g. the protocol of the ports, if no item matches exactly.
+func listItemWithKey(list any, key value.FieldList) any {
+	items, ok := list.([]any)
+	if !ok {
+		return nil
+	}
+	for _, allowMissing := range []bool{false, true} {
+		for _, item := range items {
+			fields, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			matches := true
+			for _, keyField := range key {
+				fieldValue, ok := fields[keyField.Name]
+				if !ok {
+					if allowMissing {
+						continue
+					}
+					matches = false
+					break
+				}
+				if !value.Equals(value.NewValueInterface(fieldValue), keyField.Value) {
+					matches = false
+					break
+				}
+			}
+			if matches {
+				return item
+			}
+		}
+	}
+	return nil
+}
+
+// listItemWithValue returns the item of the set-like list which equals the given value
+func listItemWithValue(list any, v value.Value) any {
+	items, ok := list.([]any)
+	if !ok {
+		return nil
+	}
+	for _, item := range items {
+		if value.Equals(value.NewValueInterface(item), v) {
+			return item
+		}
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const semanticLiveDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+spec:
+  replicas: 1
+  template:
+    spec:
+      containers:
+      - name: guestbook
+        image: guestbook:v1
+        env:
+        - name: FOO
+          value: foo
+        - name: BAR
+          value: bar
+        ports:
+        - containerPort: 80
+      - name: sidecar
+        image: sidecar:v1
+`
+
+const semanticTargetDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+spec:
+  replicas: 3
+  template:
+    spec:
+      containers:
+      - name: proxy
+        image: proxy:v1
+      - name: guestbook
+        image: guestbook:v2
+        env:
+        - name: BAR
+          value: bar
+        - name: FOO
+          value: foo
+        ports:
+        - containerPort: 80
+`
+
+func TestSemanticDiff(t *testing.T) {
+	expected := []Change{{
+		Operation: ChangeOperationReplace,
+		Path:      ".spec.replicas",
+		Live:      float64(1),
+		Target:    float64(3),
+	}, {
+		Operation: ChangeOperationReplace,
+		Path:      `.spec.template.spec.containers[name="guestbook"].image`,
+		Live:      "guestbook:v1",
+		Target:    "guestbook:v2",
+	}, {
+		Operation: ChangeOperationAdd,
+		Path:      `.spec.template.spec.containers[name="proxy"]`,
+		Target:    map[string]any{"name": "proxy", "image": "proxy:v1"},
+	}, {
+		Operation: ChangeOperationRemove,
+		Path:      `.spec.template.spec.containers[name="sidecar"]`,
+		Live:      map[string]any{"name": "sidecar", "image": "sidecar:v1"},
+	}}
+
+	t.Run("lists are matched by the merge keys of the static schema", func(t *testing.T) {
+		changes, err := SemanticDiff(StrToUnstructured(semanticLiveDeployment), StrToUnstructured(semanticTargetDeployment), nil)
+		require.NoError(t, err)
+		assert.Equal(t, expected, changes)
+	})
+
+	t.Run("lists are matched by the merge keys of the cluster schema", func(t *testing.T) {
+		changes, err := SemanticDiff(StrToUnstructured(semanticLiveDeployment), StrToUnstructured(semanticTargetDeployment), buildGVKParser(t))
+		require.NoError(t, err)
+		assert.Equal(t, expected, changes)
+	})
+
+	t.Run("lists of resources without schema are compared as a whole", func(t *testing.T) {
+		live := StrToUnstructured(`
+apiVersion: example.com/v1
+kind: Unknown
+metadata:
+  name: my-resource
+spec:
+  items: [a, b]
+  size: 1
+`)
+		target := StrToUnstructured(`
+apiVersion: example.com/v1
+kind: Unknown
+metadata:
+  name: my-resource
+spec:
+  items: [b, a]
+  size: 1
+`)
+		changes, err := SemanticDiff(live, target, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []Change{{
+			Operation: ChangeOperationReplace,
+			Path:      ".spec.items",
+			Live:      []any{"a", "b"},
+			Target:    []any{"b", "a"},
+		}}, changes)
+	})
+
+	t.Run("merge keys with default values", func(t *testing.T) {
+		target := StrToUnstructured(semanticLiveDeployment)
+		containers, _, _ := unstructured.NestedSlice(target.Object, "spec", "template", "spec", "containers")
+		containers[0].(map[string]any)["ports"] = []any{map[string]any{"containerPort": int64(8080)}}
+		require.NoError(t, unstructured.SetNestedSlice(target.Object, containers, "spec", "template", "spec", "containers"))
+
+		changes, err := SemanticDiff(StrToUnstructured(semanticLiveDeployment), target, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []Change{{
+			Operation: ChangeOperationRemove,
+			Path:      `.spec.template.spec.containers[name="guestbook"].ports[containerPort=80,protocol="TCP"]`,
+			Live:      map[string]any{"containerPort": float64(80)},
+		}, {
+			Operation: ChangeOperationAdd,
+			Path:      `.spec.template.spec.containers[name="guestbook"].ports[containerPort=8080,protocol="TCP"]`,
+			Target:    map[string]any{"containerPort": int64(8080)},
+		}}, changes)
+	})
+
+	t.Run("added and removed resources", func(t *testing.T) {
+		obj := StrToUnstructured(semanticLiveDeployment)
+
+		changes, err := SemanticDiff(nil, obj, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []Change{{Operation: ChangeOperationAdd, Target: obj.Object}}, changes)
+
+		changes, err = SemanticDiff(obj, nil, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []Change{{Operation: ChangeOperationRemove, Live: obj.Object}}, changes)
+
+		changes, err = SemanticDiff(nil, nil, nil)
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+
+	t.Run("identical resources", func(t *testing.T) {
+		changes, err := SemanticDiff(StrToUnstructured(semanticLiveDeployment), StrToUnstructured(semanticLiveDeployment), nil)
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+	})
+}
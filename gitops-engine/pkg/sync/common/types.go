@@ -17,6 +17,14 @@ const (
 	// AnnotationKeyHookDeletePolicy is the policy of deleting a hook
 	AnnotationKeyHookDeletePolicy = "argocd.argoproj.io/hook-delete-policy"
 	AnnotationDeletionApproved    = "argocd.argoproj.io/deletion-approved"
+	// AnnotationSyncWaveTimeout is the maximum duration the sync waits for the resources and hooks of a wave to become
+	// healthy or complete, as a Go duration
+	AnnotationSyncWaveTimeout = "argocd.argoproj.io/sync-wave-timeout"
+	// AnnotationSyncWaveOnFailure is the policy applied when a task of a wave fails or times out
+	AnnotationSyncWaveOnFailure = "argocd.argoproj.io/sync-wave-on-failure"
+	// AnnotationSyncDependsOn is a comma-separated list of group/kind/namespace/name keys of the resources which must be
+	// synced and healthy before the resource is synced
+	AnnotationSyncDependsOn = "argocd.argoproj.io/sync-depends-on"
 
 	// Sync option that disables dry run in resource is missing in the cluster
 	SyncOptionSkipDryRunOnMissingResource = "SkipDryRunOnMissingResource=true"
@@ -50,6 +58,8 @@ const (
 	SyncOptionClientSideApplyMigration = "ClientSideApplyMigration=true"
 	// Sync option that disables client-side apply migration
 	SyncOptionDisableClientSideApplyMigration = "ClientSideApplyMigration=false"
+	// Sync option that sets the maximum number of resources of a wave which are applied concurrently
+	SyncOptionApplyConcurrency = "ApplyConcurrency"
 
 	// Default field manager for client-side apply migration
 	DefaultClientSideApplyMigrationManager = "kubectl-client-side-apply"
@@ -144,6 +154,25 @@ func NewHookDeletePolicy(p string) (HookDeletePolicy, bool) {
 			p == string(HookDeletePolicyBeforeHookCreation)
 }
 
+// SyncWaveFailurePolicy is the policy applied when a task of a sync wave fails or times out
+type SyncWaveFailurePolicy string
+
+const (
+	// SyncWaveFailurePolicySyncFail fails the sync after running the SyncFail hooks. This is the default policy.
+	SyncWaveFailurePolicySyncFail SyncWaveFailurePolicy = "SyncFail"
+	// SyncWaveFailurePolicyAbort fails the sync immediately, without running the SyncFail hooks
+	SyncWaveFailurePolicyAbort SyncWaveFailurePolicy = "Abort"
+	// SyncWaveFailurePolicyContinue ignores the failure and continues with the next waves
+	SyncWaveFailurePolicyContinue SyncWaveFailurePolicy = "Continue"
+)
+
+func NewSyncWaveFailurePolicy(p string) (SyncWaveFailurePolicy, bool) {
+	return SyncWaveFailurePolicy(p),
+		p == string(SyncWaveFailurePolicySyncFail) ||
+			p == string(SyncWaveFailurePolicyAbort) ||
+			p == string(SyncWaveFailurePolicyContinue)
+}
+
 type ResourceSyncResult struct {
 	// holds associated resource key
 	ResourceKey kube.ResourceKey
@@ -166,4 +195,6 @@ type ResourceSyncResult struct {
 	HookPhase OperationPhase
 	// indicates the particular phase of the sync that this is for
 	SyncPhase SyncPhase
+	// the time at which the resource or hook started running, used to enforce the timeout of its sync wave
+	StartedAt metav1.Time
 }
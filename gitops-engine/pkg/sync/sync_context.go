@@ -36,6 +36,7 @@ import (
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/hook"
 	resourceutil "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/resource"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/syncwaves"
 	kubeutil "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
 )
 
@@ -212,6 +213,15 @@ func WithReplace(replace bool) SyncOpt {
 	}
 }
 
+// WithApplyConcurrency sets the maximum number of resources of a wave which are applied concurrently. Namespaces and
+// CRDs are still applied before the other resources of the wave, but the other resources are no longer applied one kind
+// after the other. Any value less than 1 keeps the default behavior.
+func WithApplyConcurrency(applyConcurrency int) SyncOpt {
+	return func(ctx *syncContext) {
+		ctx.applyConcurrency = applyConcurrency
+	}
+}
+
 func WithSkipDryRunOnMissingResource(skipDryRunOnMissingResource bool) SyncOpt {
 	return func(ctx *syncContext) {
 		ctx.skipDryRunOnMissingResource = skipDryRunOnMissingResource
@@ -415,6 +425,7 @@ type syncContext struct {
 	defaultPruneOption              *string
 	clientSideApplyMigrationManager string
 	enableClientSideApplyMigration  bool
+	applyConcurrency                int
 
 	syncRes   map[string]common.ResourceSyncResult
 	startedAt time.Time
@@ -588,6 +599,19 @@ func (sc *syncContext) Sync(ctx context.Context) {
 		}
 	}
 
+	sc.failTimedOutTasks(ctx, tasks)
+
+	// if a task of a wave which aborts on failure is unsuccessful, then abort without waiting for the running tasks
+	failurePolicies := tasks.waveFailurePolicies()
+	abortedTasks := tasks.Filter(func(t *syncTask) bool {
+		return t.phase != common.SyncPhaseSyncFail && t.completed() && !t.successful() &&
+			failurePolicies.get(t.syncWave()) == common.SyncWaveFailurePolicyAbort
+	})
+	if abortedTasks.Len() > 0 {
+		sc.abortSync(ctx, tasks, abortedTasks, "one or more synchronization tasks completed unsuccessfully")
+		return
+	}
+
 	// if (a) we are multi-step and we have any running tasks,
 	// or (b) there are any running hooks,
 	// then wait...
@@ -610,6 +634,19 @@ func (sc *syncContext) Sync(ctx context.Context) {
 			}
 		}
 
+		// the tasks of the running wave whose dependencies completed do not wait for the other tasks of the wave
+		if readyTasks := sc.getReadyDependentTasks(tasks, runningTasks.syncWave(), failurePolicies); readyTasks.Len() > 0 {
+			sc.log.WithValues("tasks", readyTasks).V(1).Info("Wet-run of the tasks whose dependencies completed")
+			if sc.runTasks(ctx, readyTasks, false) != failed {
+				pendingTasks := sc.getPendingSyncTasks(tasks)
+				waveStarted := !pendingTasks.Any(func(t *syncTask) bool { return t.syncWave() == runningTasks.syncWave() })
+				if waveStarted && !sc.runSyncWaveHook(ctx, runningTasks.phase(), runningTasks.wave(), pendingTasks.Len() == 0, tasks) {
+					return
+				}
+			}
+			runningTasks = append(runningTasks, readyTasks...)
+		}
+
 		sc.setRunningPhase(runningTasks, false)
 		return
 	}
@@ -647,11 +684,16 @@ func (sc *syncContext) Sync(ctx context.Context) {
 	// syncFailTasks only run during failure, so separate them from regular tasks
 	syncFailTasks, tasks := tasks.Split(func(t *syncTask) bool { return t.phase == common.SyncPhaseSyncFail })
 
-	syncFailedTasks := tasks.Filter(func(t *syncTask) bool { return t.syncStatus == common.ResultCodeSyncFailed })
+	// the failures of the waves which continue on failure are ignored
+	failureIgnored := func(t *syncTask) bool {
+		return failurePolicies.get(t.syncWave()) == common.SyncWaveFailurePolicyContinue
+	}
+
+	syncFailedTasks := tasks.Filter(func(t *syncTask) bool { return t.syncStatus == common.ResultCodeSyncFailed && !failureIgnored(t) })
 
 	// if there are any completed but unsuccessful tasks, sync is a failure.
 	// we already know tasks do not contain running tasks
-	if tasks.Any(func(t *syncTask) bool { return t.completed() && !t.successful() }) {
+	if tasks.Any(func(t *syncTask) bool { return t.completed() && !t.successful() && !failureIgnored(t) }) {
 		sc.deleteHooks(ctx, hooksPendingDeletionFailed)
 		sc.executeSyncFailPhase(ctx, syncFailTasks, syncFailedTasks, "one or more synchronization tasks completed unsuccessfully")
 		return
@@ -676,26 +718,28 @@ func (sc *syncContext) Sync(ctx context.Context) {
 
 	phase := tasks.phase()
 	wave := tasks.wave()
-	finalWave := phase == tasks.lastPhase() && wave == tasks.lastWave()
 
 	sc.log.WithValues("phase", phase, "wave", wave, "tasks", tasks, "syncFailTasks", syncFailTasks).V(1).Info("Filtering tasks in correct phase and wave")
 	tasks, remainingTasks := tasks.Split(func(t *syncTask) bool { return t.phase == phase && t.wave() == wave })
 
+	// the tasks which depend on pending tasks of the wave wait for them, the others run concurrently
+	tasks, blockedTasks := tasks.Split(func(t *syncTask) bool { return !t.dependsOnAny(tasks) })
+	remainingTasks = append(blockedTasks, remainingTasks...)
+
 	sc.setOperationPhase(common.OperationRunning, "one or more tasks are running")
 
 	sc.log.WithValues("tasks", tasks).V(1).Info("Wet-run")
 	runState := sc.runTasks(ctx, tasks, false)
 
-	if sc.syncWaveHook != nil && runState != failed {
-		err := sc.syncWaveHook(phase, wave, finalWave)
-		if err != nil {
-			// Since this is an unexpected error and is not related to a specific task, terminate the sync with error
-			// without triggering the syncFailTasks
-			sc.terminateHooksPreemptively(ctx, tasks.Filter(func(task *syncTask) bool { return task.isHook() }))
-			sc.setOperationPhase(common.OperationError, fmt.Sprintf("SyncWaveHook failed: %v", err))
-			sc.log.Error(err, "SyncWaveHook failed")
-			return
-		}
+	waveFailurePolicy := failurePolicies.get(syncWave{phase: phase, wave: wave})
+	if runState == failed && waveFailurePolicy == common.SyncWaveFailurePolicyContinue {
+		sc.log.WithValues("phase", phase, "wave", wave).Info("Ignoring the failures of the sync wave as requested by its failure policy")
+		runState = successful
+	}
+
+	// the wave hook is called once all the tasks of the wave are started, the blocked tasks start in a later reconciliation
+	if runState != failed && blockedTasks.Len() == 0 && !sc.runSyncWaveHook(ctx, phase, wave, remainingTasks.Len() == 0, tasks) {
+		return
 	}
 
 	switch runState {
@@ -703,6 +747,10 @@ func (sc *syncContext) Sync(ctx context.Context) {
 		// If we failed to apply at least one resource, we need to start the syncFailTasks and wait
 		// for the completion of any running hooks. In this case, the operation should be running.
 		syncFailedTasks := tasks.Filter(func(t *syncTask) bool { return t.syncStatus == common.ResultCodeSyncFailed })
+		if waveFailurePolicy == common.SyncWaveFailurePolicyAbort {
+			sc.abortSync(ctx, tasks, syncFailedTasks, "one or more objects failed to apply")
+			return
+		}
 		runningHooks := tasks.Filter(func(t *syncTask) bool { return t.running() })
 		if len(runningHooks) > 0 {
 			if len(syncFailTasks) > 0 {
@@ -792,6 +840,47 @@ func (sc *syncContext) filterOutOfSyncTasks(tasks syncTasks) syncTasks {
 	})
 }
 
+// getPendingSyncTasks returns the tasks which are still to be run by the sync, excluding the syncFailTasks
+func (sc *syncContext) getPendingSyncTasks(tasks syncTasks) syncTasks {
+	pendingTasks := tasks.Filter(func(t *syncTask) bool { return t.phase != common.SyncPhaseSyncFail && t.pending() })
+	if sc.applyOutOfSyncOnly {
+		pendingTasks = sc.filterOutOfSyncTasks(pendingTasks)
+	}
+	return pendingTasks
+}
+
+// getReadyDependentTasks returns the pending tasks of the wave whose dependencies all completed, either successfully
+// or with a failure ignored by the failure policy of the wave
+func (sc *syncContext) getReadyDependentTasks(tasks syncTasks, wave syncWave, failurePolicies waveFailurePolicies) syncTasks {
+	return sc.getPendingSyncTasks(tasks).Filter(func(t *syncTask) bool {
+		if t.syncWave() != wave || len(t.dependencies) == 0 {
+			return false
+		}
+		for _, dep := range t.dependencies {
+			if !dep.completed() || (!dep.successful() && failurePolicies.get(wave) != common.SyncWaveFailurePolicyContinue) {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+// runSyncWaveHook calls the sync wave hook, and terminates the sync if it fails. It returns whether the hook succeeded.
+func (sc *syncContext) runSyncWaveHook(ctx context.Context, phase common.SyncPhase, wave int, finalWave bool, tasks syncTasks) bool {
+	if sc.syncWaveHook == nil {
+		return true
+	}
+	if err := sc.syncWaveHook(phase, wave, finalWave); err != nil {
+		// Since this is an unexpected error and is not related to a specific task, terminate the sync with error
+		// without triggering the syncFailTasks
+		sc.terminateHooksPreemptively(ctx, tasks.Filter(func(task *syncTask) bool { return task.isHook() }))
+		sc.setOperationPhase(common.OperationError, fmt.Sprintf("SyncWaveHook failed: %v", err))
+		sc.log.Error(err, "SyncWaveHook failed")
+		return false
+	}
+	return true
+}
+
 // getNamespaceCreationTask returns a task that will create the current namespace
 // or nil if the syncTasks does not contain one
 func (sc *syncContext) getNamespaceCreationTask(tasks syncTasks) *syncTask {
@@ -866,6 +955,44 @@ func (sc *syncContext) terminateHooksPreemptively(ctx context.Context, tasks syn
 	return terminateSuccessful
 }
 
+// failTimedOutTasks fails the running tasks which did not complete within the timeout of their sync wave. The hooks
+// which timed out are terminated.
+func (sc *syncContext) failTimedOutTasks(ctx context.Context, tasks syncTasks) {
+	timeouts := tasks.waveTimeouts()
+	for _, task := range tasks {
+		timeout, ok := timeouts[task.syncWave()]
+		if !ok || !task.running() || task.startedAt.IsZero() || time.Since(task.startedAt.Time) < timeout {
+			continue
+		}
+		waitingFor := "the resource to become healthy"
+		if task.isHook() {
+			waitingFor = "the hook to complete"
+			sc.terminateHooksPreemptively(ctx, syncTasks{task})
+			if task.operationState != common.OperationFailed || task.message != "Terminated" {
+				// the hook completed in the meantime, or could not be terminated
+				continue
+			}
+		}
+		sc.setResourceResult(task, task.syncStatus, common.OperationFailed, fmt.Sprintf("sync wave %d timed out after %s waiting for %s", task.wave(), timeout, waitingFor))
+	}
+}
+
+// abortSync fails the sync without running the SyncFail hooks, after terminating the running hooks
+func (sc *syncContext) abortSync(ctx context.Context, tasks, failedTasks syncTasks, message string) {
+	sc.terminateHooksPreemptively(ctx, tasks.Filter(func(task *syncTask) bool { return task.isHook() }))
+	sc.deleteHooks(ctx, tasks.Filter(func(task *syncTask) bool {
+		return task.isHook() && task.liveObj != nil && !task.running() && task.deleteOnPhaseFailed()
+	}))
+	messages := failedTasks.Map(func(task *syncTask) string {
+		return task.message
+	})
+	message += ", the sync was aborted by the failure policy of the sync wave"
+	if len(messages) > 0 {
+		message = fmt.Sprintf("%s, reason: %s", message, strings.Join(messages, ","))
+	}
+	sc.setOperationPhase(common.OperationFailed, message)
+}
+
 func (sc *syncContext) removeHookFinalizer(ctx context.Context, task *syncTask) error {
 	if task.liveObj == nil {
 		return nil
@@ -1119,6 +1246,19 @@ func (sc *syncContext) getSyncTasks(ctx context.Context) (_ syncTasks, successfu
 				successful = false
 			}
 		}
+
+		if _, err := syncwaves.Timeout(task.obj()); err != nil {
+			sc.setResourceResult(task, common.ResultCodeSyncFailed, "", err.Error())
+			successful = false
+		}
+		if _, err := syncwaves.FailurePolicy(task.obj()); err != nil {
+			sc.setResourceResult(task, common.ResultCodeSyncFailed, "", err.Error())
+			successful = false
+		}
+		if _, err := syncwaves.DependsOn(task.obj()); err != nil {
+			sc.setResourceResult(task, common.ResultCodeSyncFailed, "", err.Error())
+			successful = false
+		}
 	}
 
 	// for prune tasks, modify the waves for proper cleanup i.e reverse of sync wave (creation order)
@@ -1172,6 +1312,16 @@ func (sc *syncContext) getSyncTasks(ctx context.Context) (_ syncTasks, successfu
 
 	tasks.Sort()
 
+	// order the tasks of each wave by their dependencies
+	dependencyErrs := tasks.resolveDependencies()
+	for _, task := range tasks {
+		if err, ok := dependencyErrs[task]; ok {
+			sc.setResourceResult(task, common.ResultCodeSyncFailed, "", err.Error())
+			successful = false
+		}
+	}
+	tasks.sortByDependencies()
+
 	// finally enrich tasks with the result
 	for _, task := range tasks {
 		result, ok := sc.syncRes[task.resultKey()]
@@ -1179,6 +1329,7 @@ func (sc *syncContext) getSyncTasks(ctx context.Context) (_ syncTasks, successfu
 			task.syncStatus = result.Status
 			task.operationState = result.HookPhase
 			task.message = result.Message
+			task.startedAt = result.StartedAt
 		}
 	}
 
@@ -1735,6 +1886,17 @@ func (sc *syncContext) runTasks(ctx context.Context, tasks syncTasks, dryRun boo
 	}
 
 	// finally create resources
+	if sc.applyConcurrency > 0 {
+		// namespaces and CRDs are applied first, then the other resources are applied concurrently regardless of their kind
+		namespaceTasks, createTasks := createTasks.Split(func(t *syncTask) bool { return isNamespaceKind(t.targetObj) })
+		crdTasks, createTasks := createTasks.Split(func(t *syncTask) bool { return kubeutil.IsCRD(t.targetObj) })
+		for _, tasksGroup := range []syncTasks{namespaceTasks, crdTasks, createTasks} {
+			if len(tasksGroup) > 0 {
+				state = sc.processCreateTasks(ctx, state, tasksGroup, dryRun)
+			}
+		}
+		return state
+	}
 	var tasksGroup syncTasks
 	for _, task := range createTasks {
 		// Only wait if the type of the next task is different than the previous type
@@ -1752,7 +1914,7 @@ func (sc *syncContext) runTasks(ctx context.Context, tasks syncTasks, dryRun boo
 }
 
 func (sc *syncContext) processCreateTasks(ctx context.Context, state runState, tasks syncTasks, dryRun bool) runState {
-	ss := newStateSync(state)
+	ss := newBoundedStateSync(state, sc.applyConcurrency)
 	for _, task := range tasks {
 		if dryRun && task.skipDryRun {
 			continue
@@ -1790,6 +1952,9 @@ func (sc *syncContext) setResourceResult(task *syncTask, syncStatus common.Resul
 	if message != "" {
 		task.message = message
 	}
+	if operationState.Running() && task.startedAt.IsZero() {
+		task.startedAt = metav1.Now()
+	}
 
 	sc.lock.Lock()
 	defer sc.lock.Unlock()
@@ -1804,6 +1969,7 @@ func (sc *syncContext) setResourceResult(task *syncTask, syncStatus common.Resul
 		HookType:    task.hookType(),
 		HookPhase:   task.operationState,
 		SyncPhase:   task.phase,
+		StartedAt:   task.startedAt,
 	}
 
 	logCtx := sc.log.WithValues("namespace", task.namespace(), "kind", task.kind(), "name", task.name(), "phase", task.phase)
@@ -1819,6 +1985,9 @@ func (sc *syncContext) setResourceResult(task *syncTask, syncStatus common.Resul
 			existing.HookPhase = res.HookPhase
 			existing.Message = res.Message
 		}
+		if existing.StartedAt.IsZero() {
+			existing.StartedAt = res.StartedAt
+		}
 		sc.syncRes[task.resultKey()] = existing
 	} else {
 		logCtx.Info(fmt.Sprintf("Adding resource result, status: '%s', phase: '%s', message: '%s'", res.Status, res.HookPhase, res.Message))
@@ -1835,6 +2004,8 @@ type stateSync struct {
 	wg           sync.WaitGroup
 	results      chan runState
 	currentState runState
+	// slots bounds the number of functions which run concurrently, unbounded if nil
+	slots chan struct{}
 }
 
 func newStateSync(currentState runState) *stateSync {
@@ -1844,11 +2015,28 @@ func newStateSync(currentState runState) *stateSync {
 	}
 }
 
+// newBoundedStateSync returns a stateSync which runs at most the given number of functions concurrently, or an
+// unbounded number if it is less than 1
+func newBoundedStateSync(currentState runState, concurrency int) *stateSync {
+	s := newStateSync(currentState)
+	if concurrency > 0 {
+		s.slots = make(chan struct{}, concurrency)
+	}
+	return s
+}
+
 func (s *stateSync) Go(f func(runState) runState) {
 	s.wg.Add(1)
 	go func() {
 		defer s.wg.Done()
-		s.results <- f(s.currentState)
+		if s.slots != nil {
+			s.slots <- struct{}{}
+		}
+		result := f(s.currentState)
+		if s.slots != nil {
+			<-s.slots
+		}
+		s.results <- result
 	}()
 }
 
@@ -9,6 +9,7 @@ import (
 	"net/http/httptest"
 	"reflect"
 	"strings"
+	gosync "sync"
 	"testing"
 	"time"
 
@@ -28,6 +29,7 @@ import (
 	"k8s.io/client-go/rest"
 	testcore "k8s.io/client-go/testing"
 	"k8s.io/klog/v2/textlogger"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
 
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/diff"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
@@ -2269,6 +2271,459 @@ func TestSync_SyncWaveHookError(t *testing.T) {
 	assert.Equal(t, "Terminated", hookResult.Message)
 }
 
+func TestSync_SyncWaveTimeout(t *testing.T) {
+	startedAt := metav1.NewTime(time.Now().Add(-10 * time.Minute))
+
+	t.Run("hook", func(t *testing.T) {
+		syncHook := newHook("sync-hook", synccommon.HookTypePreSync, synccommon.HookDeletePolicyBeforeHookCreation)
+		testingutils.Annotate(syncHook, synccommon.AnnotationSyncWaveTimeout, "5m")
+
+		syncCtx := newTestSyncCtx(nil,
+			WithHealthOverride(resourceNameHealthOverride(map[string]health.HealthStatusCode{
+				syncHook.GetName(): health.HealthStatusProgressing,
+			})),
+			WithInitialState(synccommon.OperationRunning, "", []synccommon.ResourceSyncResult{{
+				ResourceKey: kube.GetResourceKey(syncHook),
+				HookPhase:   synccommon.OperationRunning,
+				Status:      synccommon.ResultCodeSynced,
+				SyncPhase:   synccommon.SyncPhasePreSync,
+				StartedAt:   startedAt,
+			}}, metav1.Now()))
+		syncCtx.dynamicIf = fake.NewSimpleDynamicClient(runtime.NewScheme(), syncHook)
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{syncHook},
+			Target: []*unstructured.Unstructured{nil},
+		})
+		syncCtx.hooks = []*unstructured.Unstructured{syncHook}
+
+		syncCtx.Sync(context.Background())
+		phase, _, results := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationFailed, phase)
+		hookResult := getResourceResult(results, kube.GetResourceKey(syncHook))
+		require.NotNil(t, hookResult)
+		assert.Equal(t, synccommon.OperationFailed, hookResult.HookPhase)
+		assert.Equal(t, "sync wave 0 timed out after 5m0s waiting for the hook to complete", hookResult.Message)
+		assert.Equal(t, startedAt, hookResult.StartedAt)
+
+		_, err := syncCtx.getResource(context.Background(), &syncTask{liveObj: syncHook})
+		assert.True(t, apierrors.IsNotFound(err), "Expected the hook to be deleted")
+	})
+
+	t.Run("resource", func(t *testing.T) {
+		pod1 := testingutils.NewPod()
+		pod1.SetName("pod-1")
+		pod1.SetNamespace(testingutils.FakeArgoCDNamespace)
+		pod1.SetAnnotations(map[string]string{synccommon.AnnotationSyncWaveTimeout: "5m"})
+		pod2 := testingutils.NewPod()
+		pod2.SetName("pod-2")
+		pod2.SetAnnotations(map[string]string{synccommon.AnnotationSyncWave: "1"})
+
+		syncCtx := newTestSyncCtx(nil,
+			WithHealthOverride(resourceNameHealthOverride(map[string]health.HealthStatusCode{
+				pod1.GetName(): health.HealthStatusProgressing,
+			})),
+			WithInitialState(synccommon.OperationRunning, "", []synccommon.ResourceSyncResult{{
+				ResourceKey: kube.GetResourceKey(pod1),
+				HookPhase:   synccommon.OperationRunning,
+				Status:      synccommon.ResultCodeSynced,
+				SyncPhase:   synccommon.SyncPhaseSync,
+				StartedAt:   startedAt,
+			}}, metav1.Now()))
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{pod1, nil},
+			Target: []*unstructured.Unstructured{pod1, pod2},
+		})
+
+		syncCtx.Sync(context.Background())
+		phase, _, results := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationFailed, phase)
+		require.Len(t, results, 1)
+		assert.Equal(t, synccommon.OperationFailed, results[0].HookPhase)
+		assert.Equal(t, "sync wave 0 timed out after 5m0s waiting for the resource to become healthy", results[0].Message)
+	})
+
+	t.Run("not timed out", func(t *testing.T) {
+		pod1 := testingutils.NewPod()
+		pod1.SetName("pod-1")
+		pod1.SetNamespace(testingutils.FakeArgoCDNamespace)
+		pod1.SetAnnotations(map[string]string{synccommon.AnnotationSyncWaveTimeout: "1h"})
+		pod2 := testingutils.NewPod()
+		pod2.SetName("pod-2")
+		pod2.SetAnnotations(map[string]string{synccommon.AnnotationSyncWave: "1"})
+
+		syncCtx := newTestSyncCtx(nil,
+			WithHealthOverride(resourceNameHealthOverride(map[string]health.HealthStatusCode{
+				pod1.GetName(): health.HealthStatusProgressing,
+			})),
+			WithInitialState(synccommon.OperationRunning, "", []synccommon.ResourceSyncResult{{
+				ResourceKey: kube.GetResourceKey(pod1),
+				HookPhase:   synccommon.OperationRunning,
+				Status:      synccommon.ResultCodeSynced,
+				SyncPhase:   synccommon.SyncPhaseSync,
+				StartedAt:   startedAt,
+			}}, metav1.Now()))
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{pod1, nil},
+			Target: []*unstructured.Unstructured{pod1, pod2},
+		})
+
+		syncCtx.Sync(context.Background())
+		phase, _, results := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationRunning, phase)
+		assert.Equal(t, synccommon.OperationRunning, results[0].HookPhase)
+	})
+
+	t.Run("start time is recorded", func(t *testing.T) {
+		pod1 := testingutils.NewPod()
+		pod1.SetName("pod-1")
+		syncCtx := newTestSyncCtx(nil)
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{nil},
+			Target: []*unstructured.Unstructured{pod1},
+		})
+
+		syncCtx.Sync(context.Background())
+		_, _, results := syncCtx.GetState()
+		require.Len(t, results, 1)
+		assert.False(t, results[0].StartedAt.IsZero())
+	})
+}
+
+func TestSync_SyncWaveOnFailure(t *testing.T) {
+	newSyncCtx := func(policy synccommon.SyncWaveFailurePolicy) (*syncContext, *unstructured.Unstructured, *unstructured.Unstructured) {
+		pod1 := testingutils.NewPod()
+		pod1.SetName("pod-1")
+		pod1.SetNamespace(testingutils.FakeArgoCDNamespace)
+		pod1.SetAnnotations(map[string]string{synccommon.AnnotationSyncWaveOnFailure: string(policy)})
+		pod2 := testingutils.NewPod()
+		pod2.SetName("pod-2")
+		pod2.SetNamespace(testingutils.FakeArgoCDNamespace)
+		pod2.SetAnnotations(map[string]string{synccommon.AnnotationSyncWave: "1"})
+		syncFailHook := newHook("sync-fail-hook", synccommon.HookTypeSyncFail, synccommon.HookDeletePolicyBeforeHookCreation)
+
+		syncCtx := newTestSyncCtx(nil,
+			WithHealthOverride(resourceNameHealthOverride(map[string]health.HealthStatusCode{
+				pod1.GetName(): health.HealthStatusDegraded,
+			})),
+			WithInitialState(synccommon.OperationRunning, "", []synccommon.ResourceSyncResult{{
+				ResourceKey: kube.GetResourceKey(pod1),
+				HookPhase:   synccommon.OperationRunning,
+				Status:      synccommon.ResultCodeSynced,
+				SyncPhase:   synccommon.SyncPhaseSync,
+			}}, metav1.Now()))
+		syncCtx.dynamicIf = fake.NewSimpleDynamicClient(runtime.NewScheme())
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{pod1, nil},
+			Target: []*unstructured.Unstructured{pod1, pod2},
+		})
+		syncCtx.hooks = []*unstructured.Unstructured{syncFailHook}
+		return syncCtx, pod2, syncFailHook
+	}
+
+	t.Run("SyncFail", func(t *testing.T) {
+		syncCtx, pod2, syncFailHook := newSyncCtx(synccommon.SyncWaveFailurePolicySyncFail)
+		syncCtx.Sync(context.Background())
+		phase, _, results := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationRunning, phase)
+		assert.Nil(t, getResourceResult(results, kube.GetResourceKey(pod2)))
+		assert.NotNil(t, getResourceResult(results, kube.GetResourceKey(syncFailHook)))
+	})
+
+	t.Run("Abort", func(t *testing.T) {
+		syncCtx, pod2, syncFailHook := newSyncCtx(synccommon.SyncWaveFailurePolicyAbort)
+		syncCtx.Sync(context.Background())
+		phase, message, results := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationFailed, phase)
+		assert.Equal(t, "one or more synchronization tasks completed unsuccessfully, the sync was aborted by the failure policy of the sync wave, reason: test", message)
+		assert.Nil(t, getResourceResult(results, kube.GetResourceKey(pod2)))
+		assert.Nil(t, getResourceResult(results, kube.GetResourceKey(syncFailHook)))
+	})
+
+	t.Run("Continue", func(t *testing.T) {
+		syncCtx, pod2, syncFailHook := newSyncCtx(synccommon.SyncWaveFailurePolicyContinue)
+		syncCtx.Sync(context.Background())
+		phase, message, results := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationSucceeded, phase)
+		assert.Equal(t, "successfully synced (all tasks run)", message)
+		assert.NotNil(t, getResourceResult(results, kube.GetResourceKey(pod2)))
+		assert.Nil(t, getResourceResult(results, kube.GetResourceKey(syncFailHook)))
+	})
+
+	t.Run("Continue on apply failure", func(t *testing.T) {
+		pod1 := testingutils.NewPod()
+		pod1.SetName("pod-1")
+		pod1.SetAnnotations(map[string]string{synccommon.AnnotationSyncWaveOnFailure: string(synccommon.SyncWaveFailurePolicyContinue)})
+		pod2 := testingutils.NewPod()
+		pod2.SetName("pod-2")
+		pod2.SetAnnotations(map[string]string{synccommon.AnnotationSyncWave: "1"})
+
+		syncCtx := newTestSyncCtx(nil)
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{nil, nil},
+			Target: []*unstructured.Unstructured{pod1, pod2},
+		})
+		syncCtx.kubectl = &kubetest.MockKubectlCmd{
+			Commands: map[string]kubetest.KubectlOutput{pod1.GetName(): {Err: errors.New("foo")}},
+		}
+		syncCtx.resourceOps = &kubetest.MockResourceOps{
+			Commands: map[string]kubetest.KubectlOutput{pod1.GetName(): {Err: errors.New("foo")}},
+		}
+
+		syncCtx.Sync(context.Background())
+		phase, _, results := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationRunning, phase)
+		require.Len(t, results, 1)
+		assert.Equal(t, synccommon.ResultCodeSyncFailed, results[0].Status)
+
+		syncCtx.Sync(context.Background())
+		phase, _, results = syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationSucceeded, phase)
+		assert.Len(t, results, 2)
+	})
+
+	t.Run("invalid policy", func(t *testing.T) {
+		pod1 := testingutils.NewPod()
+		pod1.SetName("pod-1")
+		pod1.SetAnnotations(map[string]string{synccommon.AnnotationSyncWaveOnFailure: "Retry"})
+		syncCtx := newTestSyncCtx(nil)
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{nil},
+			Target: []*unstructured.Unstructured{pod1},
+		})
+
+		syncCtx.Sync(context.Background())
+		phase, message, _ := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationFailed, phase)
+		assert.Contains(t, message, "invalid argocd.argoproj.io/sync-wave-on-failure annotation")
+	})
+}
+
+func TestSync_DependsOn(t *testing.T) {
+	newPod := func(name, dependsOn string) *unstructured.Unstructured {
+		pod := testingutils.NewPod()
+		pod.SetName(name)
+		pod.SetNamespace(testingutils.FakeArgoCDNamespace)
+		if dependsOn != "" {
+			pod.SetAnnotations(map[string]string{synccommon.AnnotationSyncDependsOn: dependsOn})
+		}
+		return pod
+	}
+
+	t.Run("dependent resources are synced after their dependencies", func(t *testing.T) {
+		database := newPod("database", "")
+		cache := newPod("cache", "")
+		api := newPod("api", "/Pod//database,/Pod//cache")
+		frontend := newPod("frontend", "/Pod//api")
+
+		healthStatuses := resourceNameHealthOverride{}
+		for _, name := range []string{"database", "cache", "api", "frontend"} {
+			healthStatuses[name] = health.HealthStatusProgressing
+		}
+		syncCtx := newTestSyncCtx(nil, WithHealthOverride(healthStatuses))
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{frontend, api, database, cache},
+			Target: []*unstructured.Unstructured{frontend, api, database, cache},
+		})
+		syncedResources := func() []string {
+			_, _, results := syncCtx.GetState()
+			var names []string
+			for _, res := range results {
+				names = append(names, res.ResourceKey.Name)
+			}
+			return names
+		}
+
+		syncCtx.Sync(context.Background())
+		phase, _, _ := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationRunning, phase)
+		assert.ElementsMatch(t, []string{"cache", "database"}, syncedResources())
+
+		// the api waits for both of its dependencies to be healthy
+		healthStatuses["database"] = health.HealthStatusHealthy
+		syncCtx.Sync(context.Background())
+		assert.ElementsMatch(t, []string{"cache", "database"}, syncedResources())
+
+		healthStatuses["cache"] = health.HealthStatusHealthy
+		syncCtx.Sync(context.Background())
+		phase, _, _ = syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationRunning, phase)
+		assert.ElementsMatch(t, []string{"cache", "database", "api"}, syncedResources())
+
+		healthStatuses["api"] = health.HealthStatusHealthy
+		syncCtx.Sync(context.Background())
+		assert.ElementsMatch(t, []string{"cache", "database", "api", "frontend"}, syncedResources())
+
+		healthStatuses["frontend"] = health.HealthStatusHealthy
+		syncCtx.Sync(context.Background())
+		phase, message, _ := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationSucceeded, phase)
+		assert.Equal(t, "successfully synced (no more tasks)", message)
+	})
+
+	t.Run("independent branches are synced concurrently", func(t *testing.T) {
+		database := newPod("database", "")
+		api := newPod("api", "/Pod//database")
+		queue := newPod("queue", "")
+		worker := newPod("worker", "/Pod//queue")
+
+		healthStatuses := resourceNameHealthOverride{}
+		for _, name := range []string{"database", "api", "queue", "worker"} {
+			healthStatuses[name] = health.HealthStatusProgressing
+		}
+		syncCtx := newTestSyncCtx(nil, WithHealthOverride(healthStatuses))
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{database, api, queue, worker},
+			Target: []*unstructured.Unstructured{database, api, queue, worker},
+		})
+		syncedResources := func() []string {
+			_, _, results := syncCtx.GetState()
+			var names []string
+			for _, res := range results {
+				names = append(names, res.ResourceKey.Name)
+			}
+			return names
+		}
+
+		syncCtx.Sync(context.Background())
+		assert.ElementsMatch(t, []string{"database", "queue"}, syncedResources())
+
+		// the api does not wait for the queue, which is still progressing
+		healthStatuses["database"] = health.HealthStatusHealthy
+		syncCtx.Sync(context.Background())
+		phase, _, _ := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationRunning, phase)
+		assert.ElementsMatch(t, []string{"database", "queue", "api"}, syncedResources())
+
+		healthStatuses["queue"] = health.HealthStatusHealthy
+		syncCtx.Sync(context.Background())
+		assert.ElementsMatch(t, []string{"database", "queue", "api", "worker"}, syncedResources())
+
+		healthStatuses["api"] = health.HealthStatusHealthy
+		healthStatuses["worker"] = health.HealthStatusHealthy
+		syncCtx.Sync(context.Background())
+		phase, _, _ = syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationSucceeded, phase)
+	})
+
+	t.Run("the wave hook is called once per wave", func(t *testing.T) {
+		database := newPod("database", "")
+		api := newPod("api", "/Pod//database")
+		frontend := newPod("frontend", "/Pod//api")
+		queue := newPod("queue", "")
+
+		healthStatuses := resourceNameHealthOverride{}
+		for _, name := range []string{"database", "api", "frontend", "queue"} {
+			healthStatuses[name] = health.HealthStatusProgressing
+		}
+		syncCtx := newTestSyncCtx(nil, WithHealthOverride(healthStatuses))
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{database, api, frontend, queue},
+			Target: []*unstructured.Unstructured{database, api, frontend, queue},
+		})
+		var calls []bool
+		syncCtx.syncWaveHook = func(phase synccommon.SyncPhase, wave int, final bool) error {
+			assert.Equal(t, synccommon.SyncPhaseSync, string(phase))
+			assert.Equal(t, 0, wave)
+			calls = append(calls, final)
+			return nil
+		}
+
+		syncCtx.Sync(context.Background())
+		assert.Empty(t, calls)
+
+		// the api starts while the queue is still progressing
+		healthStatuses["database"] = health.HealthStatusHealthy
+		syncCtx.Sync(context.Background())
+		assert.Empty(t, calls)
+
+		// the last task of the wave starts
+		healthStatuses["api"] = health.HealthStatusHealthy
+		syncCtx.Sync(context.Background())
+		assert.Equal(t, []bool{true}, calls)
+
+		healthStatuses["frontend"] = health.HealthStatusHealthy
+		healthStatuses["queue"] = health.HealthStatusHealthy
+		syncCtx.Sync(context.Background())
+		phase, _, _ := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationSucceeded, phase)
+		assert.Equal(t, []bool{true}, calls)
+	})
+
+	t.Run("dependency cycle", func(t *testing.T) {
+		syncCtx := newTestSyncCtx(nil)
+		syncCtx.resources = groupResources(ReconciliationResult{
+			Live:   []*unstructured.Unstructured{nil, nil},
+			Target: []*unstructured.Unstructured{newPod("a", "/Pod//b"), newPod("b", "/Pod//a")},
+		})
+
+		syncCtx.Sync(context.Background())
+		phase, message, _ := syncCtx.GetState()
+		assert.Equal(t, synccommon.OperationFailed, phase)
+		assert.Contains(t, message, "dependency cycle: /Pod/fake-argocd-ns/a -> /Pod/fake-argocd-ns/b -> /Pod/fake-argocd-ns/a")
+	})
+}
+
+// concurrencyTrackingResourceOps records the order in which the resources are applied and the maximum number of
+// concurrent applies
+type concurrencyTrackingResourceOps struct {
+	*kubetest.MockResourceOps
+	lock           gosync.Mutex
+	running        int
+	maxConcurrency int
+	applied        []string
+}
+
+func (r *concurrencyTrackingResourceOps) ApplyResource(ctx context.Context, obj *unstructured.Unstructured, dryRun cmdutil.DryRunStrategy, force, validate, serverSideApply bool, manager string) (string, error) {
+	if dryRun != cmdutil.DryRunNone {
+		return r.MockResourceOps.ApplyResource(ctx, obj, dryRun, force, validate, serverSideApply, manager)
+	}
+	r.lock.Lock()
+	r.running++
+	r.maxConcurrency = max(r.maxConcurrency, r.running)
+	r.applied = append(r.applied, obj.GetKind())
+	r.lock.Unlock()
+
+	time.Sleep(10 * time.Millisecond)
+
+	r.lock.Lock()
+	r.running--
+	r.lock.Unlock()
+	return r.MockResourceOps.ApplyResource(ctx, obj, dryRun, force, validate, serverSideApply, manager)
+}
+
+func TestSync_ApplyConcurrency(t *testing.T) {
+	ns := testingutils.NewNamespace()
+	ns.SetName("my-namespace")
+	targets := []*unstructured.Unstructured{ns}
+	for i := range 10 {
+		targets = append(targets, testingutils.NewPod(), testingutils.NewService())
+		targets[len(targets)-2].SetName(fmt.Sprintf("pod-%d", i))
+		targets[len(targets)-1].SetName(fmt.Sprintf("service-%d", i))
+	}
+
+	resourceOps := &concurrencyTrackingResourceOps{MockResourceOps: &kubetest.MockResourceOps{}}
+	syncCtx := newTestSyncCtx(nil, WithApplyConcurrency(4))
+	syncCtx.resourceOps = resourceOps
+	syncCtx.resources = groupResources(ReconciliationResult{
+		Live:   make([]*unstructured.Unstructured, len(targets)),
+		Target: targets,
+	})
+
+	syncCtx.Sync(context.Background())
+	phase, _, results := syncCtx.GetState()
+	assert.Equal(t, synccommon.OperationSucceeded, phase)
+	assert.Len(t, results, len(targets))
+	for _, res := range results {
+		assert.Equal(t, synccommon.ResultCodeSynced, res.Status)
+	}
+	assert.LessOrEqual(t, resourceOps.maxConcurrency, 4)
+	// the resources of different kinds are applied concurrently
+	assert.Greater(t, resourceOps.maxConcurrency, 1)
+	require.Len(t, resourceOps.applied, len(targets))
+	// namespaces are still applied before the other resources
+	assert.Equal(t, kube.NamespaceKind, resourceOps.applied[0])
+}
+
 func TestPruneLast(t *testing.T) {
 	syncCtx := newTestSyncCtx(nil)
 	syncCtx.pruneLast = true
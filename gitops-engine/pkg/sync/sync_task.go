@@ -3,6 +3,7 @@ package sync
 import (
 	"fmt"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
@@ -24,6 +25,10 @@ type syncTask struct {
 	operationState common.OperationPhase
 	message        string
 	waveOverride   *int
+	// startedAt is the time at which the task started running
+	startedAt metav1.Time
+	// dependencies are the tasks of the same phase and wave which must complete before this task is run
+	dependencies syncTasks
 }
 
 func ternary(val bool, a, b string) string {
@@ -63,6 +68,20 @@ func (t *syncTask) wave() int {
 	return syncwaves.Wave(t.obj())
 }
 
+func (t *syncTask) syncWave() syncWave {
+	return syncWave{phase: t.phase, wave: t.wave()}
+}
+
+// dependsOnAny returns whether the task depends on any of the given tasks
+func (t *syncTask) dependsOnAny(tasks syncTasks) bool {
+	for _, dep := range t.dependencies {
+		if tasks.Find(func(task *syncTask) bool { return task == dep }) != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func (t *syncTask) isHook() bool {
 	return hook.IsHook(t.obj())
 }
@@ -162,3 +181,9 @@ func (t *syncTask) resourceKey() kube.ResourceKey {
 	}
 	return resourceKey
 }
+
+// keyString returns the group/kind/namespace/name key of the resource of the task
+func (t *syncTask) keyString() string {
+	key := t.resourceKey()
+	return key.String()
+}
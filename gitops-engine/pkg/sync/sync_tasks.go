@@ -4,10 +4,12 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/syncwaves"
 	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
 )
 
@@ -257,6 +259,10 @@ func (s syncTasks) wave() int {
 	return 0
 }
 
+func (s syncTasks) syncWave() syncWave {
+	return syncWave{phase: s.phase(), wave: s.wave()}
+}
+
 func (s syncTasks) lastPhase() common.SyncPhase {
 	if len(s) > 0 {
 		return s[len(s)-1].phase
@@ -272,5 +278,174 @@ func (s syncTasks) lastWave() int {
 }
 
 func (s syncTasks) multiStep() bool {
-	return s.wave() != s.lastWave() || s.phase() != s.lastPhase()
+	return s.wave() != s.lastWave() || s.phase() != s.lastPhase() ||
+		s.Any(func(task *syncTask) bool { return len(task.dependencies) > 0 })
+}
+
+// syncWave identifies a wave of a sync phase
+type syncWave struct {
+	phase common.SyncPhase
+	wave  int
+}
+
+// before returns whether the wave is synced before the given wave
+func (w syncWave) before(other syncWave) bool {
+	if d := syncPhaseOrder[w.phase] - syncPhaseOrder[other.phase]; d != 0 {
+		return d < 0
+	}
+	return w.wave < other.wave
+}
+
+// waveTimeouts returns the timeout of the sync waves which set one, which is the shortest timeout set by their tasks
+func (s syncTasks) waveTimeouts() map[syncWave]time.Duration {
+	timeouts := map[syncWave]time.Duration{}
+	for _, task := range s {
+		timeout, err := syncwaves.Timeout(task.obj())
+		if err != nil || timeout == 0 {
+			continue
+		}
+		if existing, ok := timeouts[task.syncWave()]; !ok || timeout < existing {
+			timeouts[task.syncWave()] = timeout
+		}
+	}
+	return timeouts
+}
+
+var syncWaveFailurePolicyStrictness = map[common.SyncWaveFailurePolicy]int{
+	common.SyncWaveFailurePolicyContinue: 0,
+	common.SyncWaveFailurePolicySyncFail: 1,
+	common.SyncWaveFailurePolicyAbort:    2,
+}
+
+// waveFailurePolicies holds the failure policy of the sync waves which set one
+type waveFailurePolicies map[syncWave]common.SyncWaveFailurePolicy
+
+// get returns the failure policy of the given sync wave
+func (p waveFailurePolicies) get(wave syncWave) common.SyncWaveFailurePolicy {
+	if policy, ok := p[wave]; ok {
+		return policy
+	}
+	return common.SyncWaveFailurePolicySyncFail
+}
+
+// waveFailurePolicies returns the failure policy of the sync waves which set one, which is the strictest policy set
+// by their tasks
+func (s syncTasks) waveFailurePolicies() waveFailurePolicies {
+	policies := waveFailurePolicies{}
+	for _, task := range s {
+		policy, err := syncwaves.FailurePolicy(task.obj())
+		if err != nil || policy == "" {
+			continue
+		}
+		if existing, ok := policies[task.syncWave()]; !ok || syncWaveFailurePolicyStrictness[policy] > syncWaveFailurePolicyStrictness[existing] {
+			policies[task.syncWave()] = policy
+		}
+	}
+	return policies
+}
+
+// resolveDependencies sets the dependencies of the tasks on the tasks of their phase and wave, as declared by the
+// sync-depends-on annotation. Dependencies on tasks of earlier phases or waves are satisfied by the sync order, and
+// dependencies on resources which are not part of the sync are ignored. It returns an error for each task which
+// depends on a task of a later phase or wave, or which is part of a dependency cycle.
+func (s syncTasks) resolveDependencies() map[*syncTask]error {
+	type taskName struct {
+		group, kind, name string
+	}
+	tasksByName := map[taskName]syncTasks{}
+	for _, task := range s {
+		if !task.isPrune() {
+			name := taskName{task.group(), task.kind(), task.name()}
+			tasksByName[name] = append(tasksByName[name], task)
+		}
+	}
+
+	errs := map[*syncTask]error{}
+	for _, task := range s {
+		task.dependencies = nil
+		if task.isPrune() {
+			continue
+		}
+		// invalid annotations are reported by the validation of the tasks
+		keys, _ := syncwaves.DependsOn(task.obj())
+		for _, key := range keys {
+			for _, dep := range tasksByName[taskName{key.Group, key.Kind, key.Name}] {
+				if dep == task || (key.Namespace != "" && key.Namespace != dep.namespace()) {
+					continue
+				}
+				switch {
+				case dep.syncWave() == task.syncWave():
+					task.dependencies = append(task.dependencies, dep)
+				case task.syncWave().before(dep.syncWave()):
+					errs[task] = fmt.Errorf("depends on %s which is synced in a later phase or wave", dep.keyString())
+				}
+			}
+		}
+	}
+
+	// detect the cycles with a depth-first search of the dependencies
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := map[*syncTask]int{}
+	var path syncTasks
+	var visit func(task *syncTask)
+	visit = func(task *syncTask) {
+		state[task] = visiting
+		path = append(path, task)
+		for _, dep := range task.dependencies {
+			switch state[dep] {
+			case visiting:
+				var cycle syncTasks
+				for i := len(path) - 1; i >= 0; i-- {
+					cycle = append(syncTasks{path[i]}, cycle...)
+					if path[i] == dep {
+						break
+					}
+				}
+				var keys []string
+				for _, t := range cycle {
+					keys = append(keys, t.keyString())
+				}
+				err := fmt.Errorf("dependency cycle: %s -> %s", strings.Join(keys, " -> "), dep.keyString())
+				for _, t := range cycle {
+					errs[t] = err
+				}
+			case 0:
+				visit(dep)
+			}
+		}
+		path = path[:len(path)-1]
+		state[task] = visited
+	}
+	for _, task := range s {
+		if state[task] == 0 {
+			visit(task)
+		}
+	}
+	return errs
+}
+
+// sortByDependencies moves the tasks after the tasks they depend on, keeping the order of the other tasks
+func (s syncTasks) sortByDependencies() {
+	sorted := make(syncTasks, 0, len(s))
+	added := map[*syncTask]bool{}
+	visiting := map[*syncTask]bool{}
+	var add func(task *syncTask)
+	add = func(task *syncTask) {
+		if added[task] || visiting[task] {
+			return
+		}
+		visiting[task] = true
+		for _, dep := range task.dependencies {
+			add(dep)
+		}
+		added[task] = true
+		sorted = append(sorted, task)
+	}
+	for _, task := range s {
+		add(task)
+	}
+	copy(s, sorted)
 }
@@ -3,8 +3,10 @@ package sync
 import (
 	"sort"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
@@ -482,3 +484,82 @@ func Test_syncTasks_multiStep(t *testing.T) {
 		assert.True(t, tasks.multiStep())
 	})
 }
+
+func Test_syncTasks_waveTimeoutsAndFailurePolicies(t *testing.T) {
+	newTask := func(wave, timeout string, policy common.SyncWaveFailurePolicy) *syncTask {
+		annotations := map[string]string{common.AnnotationSyncWave: wave}
+		if timeout != "" {
+			annotations[common.AnnotationSyncWaveTimeout] = timeout
+		}
+		if policy != "" {
+			annotations[common.AnnotationSyncWaveOnFailure] = string(policy)
+		}
+		pod := testingutils.NewPod()
+		pod.SetAnnotations(annotations)
+		return &syncTask{phase: common.SyncPhaseSync, targetObj: pod}
+	}
+	tasks := syncTasks{
+		newTask("0", "10m", common.SyncWaveFailurePolicyContinue),
+		newTask("0", "5m", common.SyncWaveFailurePolicyAbort),
+		newTask("0", "", common.SyncWaveFailurePolicySyncFail),
+		newTask("1", "", common.SyncWaveFailurePolicyContinue),
+		newTask("2", "", ""),
+	}
+
+	assert.Equal(t, map[syncWave]time.Duration{{phase: common.SyncPhaseSync, wave: 0}: 5 * time.Minute}, tasks.waveTimeouts())
+
+	policies := tasks.waveFailurePolicies()
+	assert.Equal(t, common.SyncWaveFailurePolicyAbort, policies.get(syncWave{phase: common.SyncPhaseSync, wave: 0}))
+	assert.Equal(t, common.SyncWaveFailurePolicyContinue, policies.get(syncWave{phase: common.SyncPhaseSync, wave: 1}))
+	assert.Equal(t, common.SyncWaveFailurePolicySyncFail, policies.get(syncWave{phase: common.SyncPhaseSync, wave: 2}))
+}
+
+func Test_syncTasks_dependencies(t *testing.T) {
+	newTask := func(name, wave, dependsOn string) *syncTask {
+		pod := testingutils.NewPod()
+		pod.SetName(name)
+		pod.SetNamespace(testingutils.FakeArgoCDNamespace)
+		pod.SetAnnotations(map[string]string{common.AnnotationSyncWave: wave, common.AnnotationSyncDependsOn: dependsOn})
+		return &syncTask{phase: common.SyncPhaseSync, targetObj: pod}
+	}
+
+	t.Run("dependencies of the same wave", func(t *testing.T) {
+		a := newTask("a", "0", "/Pod//c")
+		b := newTask("b", "0", "")
+		c := newTask("c", "0", "/Pod/fake-argocd-ns/b,/Pod/other-ns/a")
+		d := newTask("d", "1", "/Pod//a,/Pod//missing")
+		tasks := syncTasks{a, b, c, d}
+
+		assert.Empty(t, tasks.resolveDependencies())
+		assert.Equal(t, syncTasks{c}, a.dependencies)
+		assert.Empty(t, b.dependencies)
+		assert.Equal(t, syncTasks{b}, c.dependencies)
+		assert.Empty(t, d.dependencies)
+
+		tasks.sortByDependencies()
+		assert.Equal(t, syncTasks{b, c, a, d}, tasks)
+	})
+
+	t.Run("dependency of a later wave", func(t *testing.T) {
+		a := newTask("a", "0", "/Pod//b")
+		b := newTask("b", "1", "")
+		errs := syncTasks{a, b}.resolveDependencies()
+		require.Len(t, errs, 1)
+		require.EqualError(t, errs[a], "depends on /Pod/fake-argocd-ns/b which is synced in a later phase or wave")
+	})
+
+	t.Run("dependency cycle", func(t *testing.T) {
+		a := newTask("a", "0", "/Pod//b")
+		b := newTask("b", "0", "/Pod//c")
+		c := newTask("c", "0", "/Pod//a")
+		d := newTask("d", "0", "/Pod//a")
+		tasks := syncTasks{a, b, c, d}
+		errs := tasks.resolveDependencies()
+		require.Len(t, errs, 3)
+		require.EqualError(t, errs[a], "dependency cycle: /Pod/fake-argocd-ns/a -> /Pod/fake-argocd-ns/b -> /Pod/fake-argocd-ns/c -> /Pod/fake-argocd-ns/a")
+		assert.Equal(t, errs[a], errs[c])
+
+		tasks.sortByDependencies()
+		assert.Len(t, tasks, 4)
+	})
+}
@@ -0,0 +1,26 @@
+package syncwaves
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
+	resourceutil "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/resource"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+)
+
+// DependsOn returns the keys of the resources the given object depends on. The group is empty for core resources and
+// the namespace is empty for cluster-scoped resources, or to match the resource in any namespace.
+func DependsOn(obj *unstructured.Unstructured) ([]kube.ResourceKey, error) {
+	var keys []kube.ResourceKey
+	for _, text := range resourceutil.GetAnnotationCSVs(obj, common.AnnotationSyncDependsOn) {
+		parts := strings.Split(text, "/")
+		if len(parts) != 4 || parts[1] == "" || parts[3] == "" {
+			return nil, fmt.Errorf("invalid %s annotation %q: must be a comma-separated list of group/kind/namespace/name", common.AnnotationSyncDependsOn, text)
+		}
+		keys = append(keys, kube.NewResourceKey(parts[0], parts[1], parts[2], parts[3]))
+	}
+	return keys, nil
+}
@@ -0,0 +1,31 @@
+package syncwaves
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	testingutils "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/testing"
+)
+
+func TestDependsOn(t *testing.T) {
+	t.Parallel()
+	keys, err := DependsOn(testingutils.NewPod())
+	require.NoError(t, err)
+	assert.Empty(t, keys)
+
+	keys, err = DependsOn(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/sync-depends-on", "/ConfigMap/default/my-config, apps/Deployment//my-deployment"))
+	require.NoError(t, err)
+	assert.Equal(t, []kube.ResourceKey{
+		kube.NewResourceKey("", "ConfigMap", "default", "my-config"),
+		kube.NewResourceKey("apps", "Deployment", "", "my-deployment"),
+	}, keys)
+
+	_, err = DependsOn(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/sync-depends-on", "ConfigMap/my-config"))
+	require.ErrorContains(t, err, "invalid argocd.argoproj.io/sync-depends-on annotation")
+
+	_, err = DependsOn(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/sync-depends-on", "apps/Deployment/default/"))
+	require.Error(t, err)
+}
@@ -1,7 +1,9 @@
 package syncwaves
 
 import (
+	"fmt"
 	"strconv"
+	"time"
 
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
@@ -19,3 +21,31 @@ func Wave(obj *unstructured.Unstructured) int {
 	}
 	return helmhook.Weight(obj)
 }
+
+// Timeout returns the timeout of the sync wave set by the given object, or zero if it does not set a timeout
+func Timeout(obj *unstructured.Unstructured) (time.Duration, error) {
+	text, ok := obj.GetAnnotations()[common.AnnotationSyncWaveTimeout]
+	if !ok {
+		return 0, nil
+	}
+	timeout, err := time.ParseDuration(text)
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("invalid %s annotation %q: must be a positive duration", common.AnnotationSyncWaveTimeout, text)
+	}
+	return timeout, nil
+}
+
+// FailurePolicy returns the failure policy of the sync wave set by the given object, or an empty policy if it does
+// not set one
+func FailurePolicy(obj *unstructured.Unstructured) (common.SyncWaveFailurePolicy, error) {
+	text, ok := obj.GetAnnotations()[common.AnnotationSyncWaveOnFailure]
+	if !ok {
+		return "", nil
+	}
+	policy, ok := common.NewSyncWaveFailurePolicy(text)
+	if !ok {
+		return "", fmt.Errorf("invalid %s annotation %q: must be one of %s, %s or %s", common.AnnotationSyncWaveOnFailure, text,
+			common.SyncWaveFailurePolicySyncFail, common.SyncWaveFailurePolicyAbort, common.SyncWaveFailurePolicyContinue)
+	}
+	return policy, nil
+}
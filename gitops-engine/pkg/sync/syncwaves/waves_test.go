@@ -2,8 +2,12 @@ package syncwaves
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/sync/common"
 
 	testingutils "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/testing"
 )
@@ -14,3 +18,34 @@ func TestWave(t *testing.T) {
 	assert.Equal(t, 1, Wave(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/sync-wave", "1")))
 	assert.Equal(t, 1, Wave(testingutils.Annotate(testingutils.NewPod(), "helm.sh/hook-weight", "1")))
 }
+
+func TestTimeout(t *testing.T) {
+	t.Parallel()
+	timeout, err := Timeout(testingutils.NewPod())
+	require.NoError(t, err)
+	assert.Zero(t, timeout)
+
+	timeout, err = Timeout(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/sync-wave-timeout", "5m"))
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Minute, timeout)
+
+	_, err = Timeout(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/sync-wave-timeout", "5"))
+	require.ErrorContains(t, err, "invalid argocd.argoproj.io/sync-wave-timeout annotation")
+
+	_, err = Timeout(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/sync-wave-timeout", "-1m"))
+	require.Error(t, err)
+}
+
+func TestFailurePolicy(t *testing.T) {
+	t.Parallel()
+	policy, err := FailurePolicy(testingutils.NewPod())
+	require.NoError(t, err)
+	assert.Empty(t, policy)
+
+	policy, err = FailurePolicy(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/sync-wave-on-failure", "Continue"))
+	require.NoError(t, err)
+	assert.Equal(t, common.SyncWaveFailurePolicyContinue, policy)
+
+	_, err = FailurePolicy(testingutils.Annotate(testingutils.NewPod(), "argocd.argoproj.io/sync-wave-on-failure", "Retry"))
+	require.ErrorContains(t, err, "invalid argocd.argoproj.io/sync-wave-on-failure annotation")
+}
@@ -15,8 +15,10 @@ import (
 	"k8s.io/apimachinery/pkg/util/managedfields"
 	"k8s.io/apimachinery/pkg/util/version"
 	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/discovery/cached/memory"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/kube-openapi/pkg/util/proto"
 	"k8s.io/kubectl/pkg/util/openapi"
@@ -42,6 +44,7 @@ type Kubectl interface {
 	GetAPIResources(config *rest.Config, preferred bool, resourceFilter ResourceFilter) ([]APIResourceInfo, error)
 	GetServerVersion(config *rest.Config) (string, error)
 	NewDynamicClient(config *rest.Config) (dynamic.Interface, error)
+	NewMetadataClient(config *rest.Config) (metadata.Interface, error)
 	SetOnKubectlRun(onKubectlRun OnKubectlRunFunc)
 }
 
@@ -49,6 +52,12 @@ type KubectlCmd struct {
 	Log          logr.Logger
 	Tracer       tracing.Tracer
 	OnKubectlRun OnKubectlRunFunc
+	// NativeResourceOperations makes ManageResources return resource operations which send the server-side applies,
+	// creates and replaces directly to the API server with the dynamic client instead of running them with kubectl
+	NativeResourceOperations bool
+	// FieldManager is the field manager of the requests sent by the native resource operations. The field managers of
+	// kubectl are used if empty. Server-side applies use the manager passed to ApplyResource if not empty.
+	FieldManager string
 }
 
 type APIResourceInfo struct {
@@ -293,7 +302,7 @@ func (k *KubectlCmd) ManageResources(config *rest.Config) (ResourceOperations, f
 	cleanup := func() {
 		utils.DeleteFile(f.Name())
 	}
-	return &kubectlResourceOperations{
+	kubectlOps := &kubectlResourceOperations{
 		config: config,
 		getClientFunc: func() (kubernetes.Interface, error) {
 			return kubernetes.NewForConfig(config)
@@ -305,6 +314,29 @@ func (k *KubectlCmd) ManageResources(config *rest.Config) (ResourceOperations, f
 			onKubectlRun: k.OnKubectlRun,
 		},
 		outputMode: outputModeLog,
+	}
+	if !k.NativeResourceOperations {
+		return kubectlOps, cleanup, nil
+	}
+	dynamicIf, err := dynamic.NewForConfig(config)
+	if err != nil {
+		cleanup()
+		return nil, nil, fmt.Errorf("failed to create dynamic client: %w", err)
+	}
+	disco, err := discovery.NewDiscoveryClientForConfig(config)
+	if err != nil {
+		cleanup()
+		return nil, nil, fmt.Errorf("failed to create discovery client: %w", err)
+	}
+	return &nativeResourceOperations{
+		config:       config,
+		dynamicIf:    dynamicIf,
+		disco:        memory.NewMemCacheClient(disco),
+		log:          k.Log,
+		tracer:       k.Tracer,
+		onKubectlRun: k.OnKubectlRun,
+		fieldManager: k.FieldManager,
+		kubectl:      kubectlOps,
 	}, cleanup, nil
 }
 
@@ -379,6 +411,11 @@ func (k *KubectlCmd) NewDynamicClient(config *rest.Config) (dynamic.Interface, e
 	return dynamic.NewForConfig(config)
 }
 
+func (k *KubectlCmd) NewMetadataClient(config *rest.Config) (metadata.Interface, error) {
+	//nolint:wrapcheck // wrapped error message would be the same as the caller's wrapped message
+	return metadata.NewForConfig(config)
+}
+
 func (k *KubectlCmd) SetOnKubectlRun(onKubectlRun OnKubectlRunFunc) {
 	k.OnKubectlRun = onKubectlRun
 }
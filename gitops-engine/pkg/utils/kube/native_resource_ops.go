@@ -0,0 +1,328 @@
+package kube
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/tracing"
+)
+
+const (
+	// The field managers used by kubectl, which are used by the native resource operations when no field manager is
+	// configured so that the ownership of the fields does not change when switching between both implementations
+	kubectlApplyFieldManager   = "kubectl"
+	kubectlCreateFieldManager  = "kubectl-create"
+	kubectlReplaceFieldManager = "kubectl-replace"
+
+	// forceReplaceTimeout is the maximum duration to wait for the deletion of a resource which is force replaced
+	forceReplaceTimeout = 5 * time.Minute
+)
+
+// conflictManagerRegexp matches the message of the causes of the server-side apply conflicts, e.g.
+// `conflict with "kubectl-client-side-apply" using apps/v1`
+var conflictManagerRegexp = regexp.MustCompile(`^conflict with ("(?:[^"\\]|\\.)*")`)
+
+// ApplyConflict is a field of a resource which could not be server-side applied because it is managed by another
+// field manager
+type ApplyConflict struct {
+	// Manager is the field manager which manages the field
+	Manager string
+	// Field is the path of the field, e.g. .spec.replicas
+	Field string
+	// Message is the message of the conflict returned by the API server
+	Message string
+}
+
+// ApplyConflictError is returned by the native resource operations when a server-side apply conflicts with fields
+// managed by other field managers. The conflicts can be resolved by forcing the apply.
+type ApplyConflictError struct {
+	// Resource identifies the applied resource, e.g. deployment.apps/guestbook-ui
+	Resource  string
+	Conflicts []ApplyConflict
+	err       error
+}
+
+func (e *ApplyConflictError) Error() string {
+	return fmt.Sprintf("error applying %s: %v", e.Resource, e.err)
+}
+
+func (e *ApplyConflictError) Unwrap() error {
+	return e.err
+}
+
+// newApplyConflictError returns an ApplyConflictError if the given error is a server-side apply conflict, or nil
+// otherwise
+func newApplyConflictError(obj *unstructured.Unstructured, err error) *ApplyConflictError {
+	var statusErr apierrors.APIStatus
+	if !apierrors.IsConflict(err) || !errors.As(err, &statusErr) || statusErr.Status().Details == nil {
+		return nil
+	}
+	var conflicts []ApplyConflict
+	for _, cause := range statusErr.Status().Details.Causes {
+		if cause.Type != metav1.CauseTypeFieldManagerConflict {
+			continue
+		}
+		conflict := ApplyConflict{Field: cause.Field, Message: cause.Message}
+		if match := conflictManagerRegexp.FindStringSubmatch(cause.Message); match != nil {
+			if manager, err := strconv.Unquote(match[1]); err == nil {
+				conflict.Manager = manager
+			}
+		}
+		conflicts = append(conflicts, conflict)
+	}
+	if len(conflicts) == 0 {
+		return nil
+	}
+	return &ApplyConflictError{Resource: resourceName(obj), Conflicts: conflicts, err: err}
+}
+
+// resourceName returns the name of the resource as printed by kubectl, e.g. deployment.apps/guestbook-ui
+func resourceName(obj *unstructured.Unstructured) string {
+	gk := obj.GroupVersionKind().GroupKind()
+	kind := strings.ToLower(gk.Kind)
+	if gk.Group != "" {
+		kind += "." + gk.Group
+	}
+	return kind + "/" + obj.GetName()
+}
+
+// operationMessage returns the message of an operation in the same format as kubectl, e.g.
+// deployment.apps/guestbook-ui created (server dry run)
+func operationMessage(obj *unstructured.Unstructured, operation string, dryRunStrategy cmdutil.DryRunStrategy) string {
+	message := fmt.Sprintf("%s %s", resourceName(obj), operation)
+	if dryRunStrategy == cmdutil.DryRunServer {
+		message += " (server dry run)"
+	}
+	return message
+}
+
+// nativeResourceOperations implements the ResourceOperations interface by sending the requests directly to the API
+// server with the dynamic client. Client-side applies and client dry runs, which require the three-way merge and the
+// printing logic of kubectl, are delegated to the kubectl resource operations.
+type nativeResourceOperations struct {
+	config       *rest.Config
+	dynamicIf    dynamic.Interface
+	disco        discovery.DiscoveryInterface
+	log          logr.Logger
+	tracer       tracing.Tracer
+	onKubectlRun OnKubectlRunFunc
+	// fieldManager is the field manager of the requests, the field managers of kubectl are used if empty
+	fieldManager string
+	kubectl      ResourceOperations
+}
+
+func (n *nativeResourceOperations) processRun(command string) (CleanupFunc, error) {
+	if n.onKubectlRun != nil {
+		return n.onKubectlRun(command)
+	}
+	return func() {}, nil
+}
+
+func (n *nativeResourceOperations) getFieldManager(defaultFieldManager string) string {
+	if n.fieldManager != "" {
+		return n.fieldManager
+	}
+	return defaultFieldManager
+}
+
+func (n *nativeResourceOperations) resourceInterface(obj *unstructured.Unstructured, verb string) (dynamic.ResourceInterface, error) {
+	gvk := obj.GroupVersionKind()
+	apiResource, err := ServerResourceForGroupVersionKind(n.disco, gvk, verb)
+	if err != nil {
+		return nil, fmt.Errorf("error getting server resource for %s: %w", gvk, err)
+	}
+	resource := gvk.GroupVersion().WithResource(apiResource.Name)
+	return ToResourceInterface(n.dynamicIf, apiResource, resource, obj.GetNamespace()), nil
+}
+
+func dryRunOption(dryRunStrategy cmdutil.DryRunStrategy) []string {
+	if dryRunStrategy == cmdutil.DryRunServer {
+		return []string{metav1.DryRunAll}
+	}
+	return nil
+}
+
+func fieldValidationOption(validate bool) string {
+	if validate {
+		return metav1.FieldValidationStrict
+	}
+	return metav1.FieldValidationIgnore
+}
+
+// ApplyResource server-side applies the resource with the dynamic client. Client-side applies are delegated to kubectl.
+func (n *nativeResourceOperations) ApplyResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, force, validate, serverSideApply bool, manager string) (string, error) {
+	if !serverSideApply || dryRunStrategy == cmdutil.DryRunClient {
+		return n.kubectl.ApplyResource(ctx, obj, dryRunStrategy, force, validate, serverSideApply, manager)
+	}
+	span := n.tracer.StartSpan("ApplyResource")
+	span.SetBaggageItem("kind", obj.GetKind())
+	span.SetBaggageItem("name", obj.GetName())
+	defer span.Finish()
+
+	if manager == "" {
+		manager = n.getFieldManager(kubectlApplyFieldManager)
+	}
+	logWithLevel := n.log.V(0)
+	if dryRunStrategy != cmdutil.DryRunNone {
+		logWithLevel = logWithLevel.V(1)
+	}
+	logWithLevel.WithValues(
+		"dry-run", [...]string{"none", "client", "server"}[dryRunStrategy],
+		"manager", manager,
+		"serverSideApply", serverSideApply).Info(fmt.Sprintf("Applying resource %s/%s in cluster: %s, namespace: %s", obj.GetKind(), obj.GetName(), n.config.Host, obj.GetNamespace()))
+
+	resourceIf, err := n.resourceInterface(obj, "patch")
+	if err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return "", fmt.Errorf("error marshaling resource: %w", err)
+	}
+	cleanup, err := n.processRun("apply")
+	if err != nil {
+		return "", err
+	}
+	defer cleanup()
+	_, err = resourceIf.Patch(ctx, obj.GetName(), types.ApplyPatchType, data, metav1.PatchOptions{
+		DryRun:          dryRunOption(dryRunStrategy),
+		Force:           &force,
+		FieldManager:    manager,
+		FieldValidation: fieldValidationOption(validate),
+	})
+	if err != nil {
+		if conflictErr := newApplyConflictError(obj, err); conflictErr != nil {
+			return "", conflictErr
+		}
+		return "", fmt.Errorf("error applying %s: %w", resourceName(obj), err)
+	}
+	return operationMessage(obj, "serverside-applied", dryRunStrategy), nil
+}
+
+// CreateResource creates the resource with the dynamic client. Client dry runs are delegated to kubectl.
+func (n *nativeResourceOperations) CreateResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, validate bool) (string, error) {
+	if dryRunStrategy == cmdutil.DryRunClient {
+		return n.kubectl.CreateResource(ctx, obj, dryRunStrategy, validate)
+	}
+	span := n.tracer.StartSpan("CreateResource")
+	span.SetBaggageItem("kind", obj.GetKind())
+	span.SetBaggageItem("name", obj.GetName())
+	defer span.Finish()
+
+	resourceIf, err := n.resourceInterface(obj, "create")
+	if err != nil {
+		return "", err
+	}
+	cleanup, err := n.processRun("create")
+	if err != nil {
+		return "", err
+	}
+	defer cleanup()
+	_, err = resourceIf.Create(ctx, obj, metav1.CreateOptions{
+		DryRun:          dryRunOption(dryRunStrategy),
+		FieldManager:    n.getFieldManager(kubectlCreateFieldManager),
+		FieldValidation: fieldValidationOption(validate),
+	})
+	if err != nil {
+		return "", fmt.Errorf("error creating %s: %w", resourceName(obj), err)
+	}
+	return operationMessage(obj, "created", dryRunStrategy), nil
+}
+
+// ReplaceResource replaces the resource with the dynamic client. If force is true, the resource is deleted and
+// created again once the deletion completed. Client dry runs are delegated to kubectl.
+func (n *nativeResourceOperations) ReplaceResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy, force bool) (string, error) {
+	if dryRunStrategy == cmdutil.DryRunClient {
+		return n.kubectl.ReplaceResource(ctx, obj, dryRunStrategy, force)
+	}
+	span := n.tracer.StartSpan("ReplaceResource")
+	span.SetBaggageItem("kind", obj.GetKind())
+	span.SetBaggageItem("name", obj.GetName())
+	defer span.Finish()
+	n.log.Info(fmt.Sprintf("Replacing resource %s/%s in cluster: %s, namespace: %s", obj.GetKind(), obj.GetName(), n.config.Host, obj.GetNamespace()))
+
+	resourceIf, err := n.resourceInterface(obj, "update")
+	if err != nil {
+		return "", err
+	}
+	cleanup, err := n.processRun("replace")
+	if err != nil {
+		return "", err
+	}
+	defer cleanup()
+
+	if force && dryRunStrategy == cmdutil.DryRunNone {
+		return n.forceReplace(ctx, resourceIf, obj)
+	}
+	_, err = resourceIf.Update(ctx, obj, metav1.UpdateOptions{
+		DryRun:       dryRunOption(dryRunStrategy),
+		FieldManager: n.getFieldManager(kubectlReplaceFieldManager),
+	})
+	if err != nil {
+		return "", fmt.Errorf("error replacing %s: %w", resourceName(obj), err)
+	}
+	return operationMessage(obj, "replaced", dryRunStrategy), nil
+}
+
+// forceReplace deletes the resource, waits for its deletion and creates it again
+func (n *nativeResourceOperations) forceReplace(ctx context.Context, resourceIf dynamic.ResourceInterface, obj *unstructured.Unstructured) (string, error) {
+	gracePeriod := int64(0)
+	err := resourceIf.Delete(ctx, obj.GetName(), metav1.DeleteOptions{GracePeriodSeconds: &gracePeriod})
+	if err != nil && !apierrors.IsNotFound(err) {
+		return "", fmt.Errorf("error deleting %s: %w", resourceName(obj), err)
+	}
+	err = wait.PollUntilContextTimeout(ctx, time.Second, forceReplaceTimeout, true, func(ctx context.Context) (bool, error) {
+		_, err := resourceIf.Get(ctx, obj.GetName(), metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	})
+	if err != nil {
+		return "", fmt.Errorf("error waiting for the deletion of %s: %w", resourceName(obj), err)
+	}
+	replacement := obj.DeepCopy()
+	replacement.SetResourceVersion("")
+	_, err = resourceIf.Create(ctx, replacement, metav1.CreateOptions{FieldManager: n.getFieldManager(kubectlReplaceFieldManager)})
+	if err != nil {
+		return "", fmt.Errorf("error creating %s: %w", resourceName(obj), err)
+	}
+	return operationMessage(obj, "replaced", cmdutil.DryRunNone), nil
+}
+
+// UpdateResource updates the resource with the dynamic client
+func (n *nativeResourceOperations) UpdateResource(ctx context.Context, obj *unstructured.Unstructured, dryRunStrategy cmdutil.DryRunStrategy) (*unstructured.Unstructured, error) {
+	span := n.tracer.StartSpan("UpdateResource")
+	span.SetBaggageItem("kind", obj.GetKind())
+	span.SetBaggageItem("name", obj.GetName())
+	defer span.Finish()
+
+	resourceIf, err := n.resourceInterface(obj, "update")
+	if err != nil {
+		return nil, err
+	}
+	updateOptions := metav1.UpdateOptions{FieldManager: n.fieldManager}
+	switch dryRunStrategy {
+	case cmdutil.DryRunClient, cmdutil.DryRunServer:
+		updateOptions.DryRun = []string{metav1.DryRunAll}
+	}
+	//nolint:wrapcheck // wrapped error message would be same as caller's wrapped message
+	return resourceIf.Update(ctx, obj, updateOptions)
+}
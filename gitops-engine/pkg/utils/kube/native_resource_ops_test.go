@@ -0,0 +1,285 @@
+package kube
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	fakedisco "k8s.io/client-go/discovery/fake"
+	fakedynamic "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/rest"
+	testcore "k8s.io/client-go/testing"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+
+	testingutils "github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/testing"
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/tracing"
+)
+
+var deploymentGVR = schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+
+// fakeKubectlResourceOperations records the operations delegated to kubectl
+type fakeKubectlResourceOperations struct {
+	ResourceOperations
+	calls []string
+}
+
+func (f *fakeKubectlResourceOperations) ApplyResource(_ context.Context, _ *unstructured.Unstructured, _ cmdutil.DryRunStrategy, _, _, _ bool, _ string) (string, error) {
+	f.calls = append(f.calls, "apply")
+	return "applied by kubectl", nil
+}
+
+func (f *fakeKubectlResourceOperations) CreateResource(_ context.Context, _ *unstructured.Unstructured, _ cmdutil.DryRunStrategy, _ bool) (string, error) {
+	f.calls = append(f.calls, "create")
+	return "created by kubectl", nil
+}
+
+func newTestNativeResourceOperations(t *testing.T, fieldManager string, objs ...runtime.Object) (*nativeResourceOperations, *fakedynamic.FakeDynamicClient, *fakeKubectlResourceOperations) {
+	t.Helper()
+	dynamicIf := fakedynamic.NewSimpleDynamicClient(runtime.NewScheme(), objs...)
+	disco := &fakedisco.FakeDiscovery{Fake: &testcore.Fake{Resources: []*metav1.APIResourceList{{
+		GroupVersion: "v1",
+		APIResources: []metav1.APIResource{{Name: "pods", Kind: "Pod", Namespaced: true, Verbs: []string{"create", "update", "patch", "delete", "get"}}},
+	}, {
+		GroupVersion: "apps/v1",
+		APIResources: []metav1.APIResource{{Name: "deployments", Kind: "Deployment", Namespaced: true, Verbs: []string{"create", "update", "patch", "delete", "get"}}},
+	}}}}
+	kubectl := &fakeKubectlResourceOperations{}
+	return &nativeResourceOperations{
+		config:       &rest.Config{},
+		dynamicIf:    dynamicIf,
+		disco:        disco,
+		log:          logr.Discard(),
+		tracer:       &tracing.NopTracer{},
+		fieldManager: fieldManager,
+		kubectl:      kubectl,
+	}, dynamicIf, kubectl
+}
+
+func newTestDeployment() *unstructured.Unstructured {
+	return testingutils.Unstructured(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-deployment
+  namespace: default
+spec:
+  replicas: 1
+`)
+}
+
+func newTestNamespacedPod() *unstructured.Unstructured {
+	pod := testingutils.NewPod()
+	pod.SetNamespace("default")
+	return pod
+}
+
+func TestNativeResourceOperations_ApplyResource(t *testing.T) {
+	t.Run("server-side apply", func(t *testing.T) {
+		ops, dynamicIf, kubectl := newTestNativeResourceOperations(t, "")
+		dynamicIf.PrependReactor("patch", "deployments", func(action testcore.Action) (bool, runtime.Object, error) {
+			return true, newTestDeployment(), nil
+		})
+		var commands []string
+		ops.onKubectlRun = func(command string) (CleanupFunc, error) {
+			commands = append(commands, command)
+			return func() {}, nil
+		}
+
+		message, err := ops.ApplyResource(t.Context(), newTestDeployment(), cmdutil.DryRunNone, true, true, true, "argocd-controller")
+		require.NoError(t, err)
+		assert.Equal(t, "deployment.apps/my-deployment serverside-applied", message)
+		assert.Equal(t, []string{"apply"}, commands)
+		assert.Empty(t, kubectl.calls)
+
+		actions := dynamicIf.Actions()
+		require.Len(t, actions, 1)
+		patch := actions[0].(testcore.PatchActionImpl)
+		assert.Equal(t, deploymentGVR, patch.GetResource())
+		assert.Equal(t, "default", patch.GetNamespace())
+		assert.Equal(t, types.ApplyPatchType, patch.GetPatchType())
+		assert.Equal(t, "argocd-controller", patch.PatchOptions.FieldManager)
+		assert.True(t, *patch.PatchOptions.Force)
+		assert.Equal(t, metav1.FieldValidationStrict, patch.PatchOptions.FieldValidation)
+		assert.Empty(t, patch.PatchOptions.DryRun)
+	})
+
+	t.Run("server dry run with default field manager", func(t *testing.T) {
+		ops, dynamicIf, _ := newTestNativeResourceOperations(t, "my-manager")
+		dynamicIf.PrependReactor("patch", "deployments", func(action testcore.Action) (bool, runtime.Object, error) {
+			return true, newTestDeployment(), nil
+		})
+
+		message, err := ops.ApplyResource(t.Context(), newTestDeployment(), cmdutil.DryRunServer, false, false, true, "")
+		require.NoError(t, err)
+		assert.Equal(t, "deployment.apps/my-deployment serverside-applied (server dry run)", message)
+
+		patch := dynamicIf.Actions()[0].(testcore.PatchActionImpl)
+		assert.Equal(t, "my-manager", patch.PatchOptions.FieldManager)
+		assert.False(t, *patch.PatchOptions.Force)
+		assert.Equal(t, metav1.FieldValidationIgnore, patch.PatchOptions.FieldValidation)
+		assert.Equal(t, []string{metav1.DryRunAll}, patch.PatchOptions.DryRun)
+	})
+
+	t.Run("conflicts", func(t *testing.T) {
+		ops, dynamicIf, _ := newTestNativeResourceOperations(t, "")
+		dynamicIf.PrependReactor("patch", "deployments", func(action testcore.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewApplyConflict([]metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldManagerConflict,
+				Message: `conflict with "kubectl-client-side-apply" using apps/v1`,
+				Field:   ".spec.replicas",
+			}, {
+				Type:    metav1.CauseTypeFieldManagerConflict,
+				Message: `conflict with "hpa-controller"`,
+				Field:   ".spec.template.spec.containers[name=\"nginx\"].image",
+			}}, "Apply failed with 2 conflicts")
+		})
+
+		_, err := ops.ApplyResource(t.Context(), newTestDeployment(), cmdutil.DryRunNone, false, false, true, "argocd-controller")
+		var conflictErr *ApplyConflictError
+		require.ErrorAs(t, err, &conflictErr)
+		assert.True(t, apierrors.IsConflict(err))
+		assert.Equal(t, "deployment.apps/my-deployment", conflictErr.Resource)
+		assert.Equal(t, []ApplyConflict{{
+			Manager: "kubectl-client-side-apply",
+			Field:   ".spec.replicas",
+			Message: `conflict with "kubectl-client-side-apply" using apps/v1`,
+		}, {
+			Manager: "hpa-controller",
+			Field:   ".spec.template.spec.containers[name=\"nginx\"].image",
+			Message: `conflict with "hpa-controller"`,
+		}}, conflictErr.Conflicts)
+		assert.Equal(t, "error applying deployment.apps/my-deployment: Apply failed with 2 conflicts", err.Error())
+	})
+
+	t.Run("other errors are not conflicts", func(t *testing.T) {
+		ops, dynamicIf, _ := newTestNativeResourceOperations(t, "")
+		dynamicIf.PrependReactor("patch", "deployments", func(action testcore.Action) (bool, runtime.Object, error) {
+			return true, nil, apierrors.NewForbidden(deploymentGVR.GroupResource(), "my-deployment", assert.AnError)
+		})
+
+		_, err := ops.ApplyResource(t.Context(), newTestDeployment(), cmdutil.DryRunNone, false, false, true, "argocd-controller")
+		require.Error(t, err)
+		var conflictErr *ApplyConflictError
+		assert.NotErrorAs(t, err, &conflictErr)
+		assert.True(t, apierrors.IsForbidden(err))
+	})
+
+	t.Run("unknown kind", func(t *testing.T) {
+		ops, _, _ := newTestNativeResourceOperations(t, "")
+		obj := newTestDeployment()
+		obj.SetKind("Unknown")
+
+		_, err := ops.ApplyResource(t.Context(), obj, cmdutil.DryRunNone, false, false, true, "argocd-controller")
+		require.Error(t, err)
+		assert.True(t, apierrors.IsNotFound(err))
+	})
+
+	t.Run("client-side apply is delegated to kubectl", func(t *testing.T) {
+		ops, dynamicIf, kubectl := newTestNativeResourceOperations(t, "")
+
+		message, err := ops.ApplyResource(t.Context(), newTestDeployment(), cmdutil.DryRunNone, false, false, false, "")
+		require.NoError(t, err)
+		assert.Equal(t, "applied by kubectl", message)
+
+		message, err = ops.ApplyResource(t.Context(), newTestDeployment(), cmdutil.DryRunClient, false, false, true, "argocd-controller")
+		require.NoError(t, err)
+		assert.Equal(t, "applied by kubectl", message)
+		assert.Equal(t, []string{"apply", "apply"}, kubectl.calls)
+		assert.Empty(t, dynamicIf.Actions())
+	})
+}
+
+func TestNativeResourceOperations_CreateResource(t *testing.T) {
+	t.Run("create", func(t *testing.T) {
+		ops, dynamicIf, _ := newTestNativeResourceOperations(t, "")
+
+		message, err := ops.CreateResource(t.Context(), newTestNamespacedPod(), cmdutil.DryRunNone, true)
+		require.NoError(t, err)
+		assert.Equal(t, "pod/my-pod created", message)
+
+		create := dynamicIf.Actions()[0].(testcore.CreateActionImpl)
+		assert.Equal(t, "kubectl-create", create.CreateOptions.FieldManager)
+		assert.Equal(t, metav1.FieldValidationStrict, create.CreateOptions.FieldValidation)
+		_, err = dynamicIf.Resource(schema.GroupVersionResource{Version: "v1", Resource: "pods"}).Namespace("default").Get(t.Context(), "my-pod", metav1.GetOptions{})
+		require.NoError(t, err)
+	})
+
+	t.Run("already exists", func(t *testing.T) {
+		ops, _, _ := newTestNativeResourceOperations(t, "my-manager", newTestNamespacedPod())
+
+		_, err := ops.CreateResource(t.Context(), newTestNamespacedPod(), cmdutil.DryRunNone, false)
+		require.Error(t, err)
+		assert.True(t, apierrors.IsAlreadyExists(err))
+	})
+
+	t.Run("client dry run is delegated to kubectl", func(t *testing.T) {
+		ops, dynamicIf, kubectl := newTestNativeResourceOperations(t, "")
+
+		message, err := ops.CreateResource(t.Context(), newTestNamespacedPod(), cmdutil.DryRunClient, false)
+		require.NoError(t, err)
+		assert.Equal(t, "created by kubectl", message)
+		assert.Equal(t, []string{"create"}, kubectl.calls)
+		assert.Empty(t, dynamicIf.Actions())
+	})
+}
+
+func TestNativeResourceOperations_ReplaceResource(t *testing.T) {
+	t.Run("replace", func(t *testing.T) {
+		ops, dynamicIf, _ := newTestNativeResourceOperations(t, "my-manager", newTestNamespacedPod())
+
+		message, err := ops.ReplaceResource(t.Context(), newTestNamespacedPod(), cmdutil.DryRunServer, false)
+		require.NoError(t, err)
+		assert.Equal(t, "pod/my-pod replaced (server dry run)", message)
+
+		update := dynamicIf.Actions()[0].(testcore.UpdateActionImpl)
+		assert.Equal(t, "my-manager", update.UpdateOptions.FieldManager)
+		assert.Equal(t, []string{metav1.DryRunAll}, update.UpdateOptions.DryRun)
+	})
+
+	t.Run("force replace deletes and creates the resource", func(t *testing.T) {
+		live := newTestNamespacedPod()
+		live.SetUID("live-uid")
+		ops, dynamicIf, _ := newTestNativeResourceOperations(t, "", live)
+		target := newTestNamespacedPod()
+		target.SetResourceVersion("1")
+
+		message, err := ops.ReplaceResource(t.Context(), target, cmdutil.DryRunNone, true)
+		require.NoError(t, err)
+		assert.Equal(t, "pod/my-pod replaced", message)
+
+		var verbs []string
+		for _, action := range dynamicIf.Actions() {
+			verbs = append(verbs, action.GetVerb())
+		}
+		assert.Equal(t, []string{"delete", "get", "create"}, verbs)
+		create := dynamicIf.Actions()[2].(testcore.CreateActionImpl)
+		assert.Equal(t, "kubectl-replace", create.CreateOptions.FieldManager)
+		assert.Empty(t, create.GetObject().(*unstructured.Unstructured).GetResourceVersion())
+	})
+}
+
+func TestManageResources_NativeResourceOperations(t *testing.T) {
+	kubectl := kubectlCmd()
+	resourceOps, cleanup, err := kubectl.ManageResources(mockConfig("https://localhost"))
+	require.NoError(t, err)
+	cleanup()
+	assert.IsType(t, &kubectlResourceOperations{}, resourceOps)
+
+	kubectl.NativeResourceOperations = true
+	kubectl.FieldManager = "my-manager"
+	resourceOps, cleanup, err = kubectl.ManageResources(mockConfig("https://localhost"))
+	require.NoError(t, err)
+	defer cleanup()
+	require.IsType(t, &nativeResourceOperations{}, resourceOps)
+	nativeOps := resourceOps.(*nativeResourceOperations)
+	assert.Equal(t, "my-manager", nativeOps.fieldManager)
+	assert.IsType(t, &kubectlResourceOperations{}, nativeOps.kubectl)
+}
@@ -2,6 +2,7 @@ package scheme
 
 import (
 	"reflect"
+	"strings"
 	"sync"
 
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -25,6 +26,25 @@ func ResolveParseableType(gvk schema.GroupVersionKind, parser *managedfields.Gvk
 	return pt
 }
 
+// ResolveStaticParseableType returns the ParseableType of the given gvk from the
+// statically defined schema of the k8s built in types, or nil if the gvk is not
+// a built in type. It can be used when no GvkParser is available.
+func ResolveStaticParseableType(gvk schema.GroupVersionKind) *typed.ParseableType {
+	name := getStaticGvkMap()[gvk]
+	if name == "" {
+		return nil
+	}
+	p := StaticParser()
+	if p == nil {
+		return nil
+	}
+	pt := p.Type(name)
+	if pt.IsValid() {
+		return &pt
+	}
+	return nil
+}
+
 func resolveFromStaticParser(gvk schema.GroupVersionKind, parser *managedfields.GvkParser) *typed.ParseableType {
 	gvkNameMap := getGvkMap(parser)
 	name := gvkNameMap[gvk]
@@ -48,6 +68,26 @@ var (
 	extractOnce sync.Once
 )
 
+var (
+	staticGvkMap     map[schema.GroupVersionKind]string
+	staticExtractOnce sync.Once
+)
+
+// getStaticGvkMap returns the names of the built in types in the static schema
+// by gvk, which are derived from the Go types registered in the Scheme, e.g.
+// io.k8s.api.rbac.v1.Role for rbac.authorization.k8s.io/v1 Role.
+func getStaticGvkMap() map[schema.GroupVersionKind]string {
+	staticExtractOnce.Do(func() {
+		staticGvkMap = make(map[schema.GroupVersionKind]string)
+		for gvk, t := range Scheme.AllKnownTypes() {
+			if pkg, ok := strings.CutPrefix(t.PkgPath(), "k8s.io/api/"); ok {
+				staticGvkMap[gvk] = "io.k8s.api." + strings.ReplaceAll(pkg, "/", ".") + "." + t.Name()
+			}
+		}
+	})
+	return staticGvkMap
+}
+
 func getGvkMap(parser *managedfields.GvkParser) map[schema.GroupVersionKind]string {
 	extractOnce.Do(func() {
 		gvkMap = extractGvkMap(parser)
@@ -0,0 +1,24 @@
+package scheme
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestResolveStaticParseableType(t *testing.T) {
+	for _, gvk := range []schema.GroupVersionKind{
+		{Version: "v1", Kind: "Pod"},
+		{Group: "apps", Version: "v1", Kind: "Deployment"},
+		{Group: "rbac.authorization.k8s.io", Version: "v1", Kind: "Role"},
+		{Group: "networking.k8s.io", Version: "v1", Kind: "Ingress"},
+	} {
+		pt := ResolveStaticParseableType(gvk)
+		require.NotNil(t, pt, gvk.String())
+		assert.True(t, pt.IsValid(), gvk.String())
+	}
+	assert.Nil(t, ResolveStaticParseableType(schema.GroupVersionKind{Group: "argoproj.io", Version: "v1alpha1", Kind: "Application"}))
+	assert.Nil(t, ResolveStaticParseableType(schema.GroupVersionKind{Group: "apps", Version: "v1", Kind: "Unknown"}))
+}
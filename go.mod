@@ -317,13 +317,17 @@ require (
 
 require (
 	github.com/go-openapi/runtime/server-middleware v0.32.6
+	github.com/google/cel-go v0.27.0
 	k8s.io/streaming v0.36.1
 )
 
 require (
+	cel.dev/expr v0.25.1 // indirect
+	github.com/antlr4-go/antlr/v4 v4.13.1 // indirect
 	github.com/go-openapi/swag/pools v0.27.3 // indirect
 	github.com/google/go-github/v88 v88.0.0 // indirect
 	github.com/klauspost/cpuid/v2 v2.3.0 // indirect
+	golang.org/x/exp v0.0.0-20260410095643-746e56fc9e2f // indirect
 )
 
 replace (
@@ -0,0 +1,203 @@
+package healthplugin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	gocache "github.com/patrickmn/go-cache"
+	log "github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/healthplugin/apiclient"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	argoglob "github.com/argoproj/argo-cd/v3/util/glob"
+	"github.com/argoproj/argo-cd/v3/util/lua"
+)
+
+// defaultTimeout is the maximum duration of a health assessment by a plugin which does not configure a timeout
+const defaultTimeout = 5 * time.Second
+
+var (
+	// healthCache caches the health of the resources assessed by the plugins which configure a cache TTL
+	healthCache = gocache.New(time.Minute, time.Minute)
+
+	// clients holds the client of each health plugin by address, which is kept for the lifetime of the process
+	clients     = map[string]apiclient.HealthPluginServiceClient{}
+	clientsLock sync.Mutex
+
+	healthStatusCodes = []health.HealthStatusCode{
+		health.HealthStatusHealthy,
+		health.HealthStatusSuspended,
+		health.HealthStatusProgressing,
+		health.HealthStatusMissing,
+		health.HealthStatusDegraded,
+		health.HealthStatusUnknown,
+	}
+)
+
+// ResourceHealthOverrides assesses the health of resources with the health plugins configured in the resource
+// overrides. The health of a resource is assessed by its Lua health check if it has no health plugin, if the plugin
+// fails or if the plugin does not assess its health.
+type ResourceHealthOverrides struct {
+	Overrides map[string]appv1.ResourceOverride
+	// Server is the URL of the API server of the cluster of the resources, which is passed to the plugins
+	Server string
+}
+
+func (o ResourceHealthOverrides) GetResourceHealth(obj *unstructured.Unstructured) (*health.HealthStatus, error) {
+	if plugin := getHealthPlugin(o.Overrides, obj.GroupVersionKind()); plugin != nil {
+		healthStatus, err := getPluginResourceHealth(plugin, obj, o.Server)
+		if err != nil {
+			logPluginError(plugin, obj, err)
+		} else if healthStatus != nil {
+			return healthStatus, nil
+		}
+	}
+	return lua.ResourceHealthOverrides(o.Overrides).GetResourceHealth(obj)
+}
+
+// GetResourceHealthAsync is like GetResourceHealth, but does not wait for the health plugin of the resource: unless the
+// health of this version of the resource is cached, the resource health is assessed by the fallback health checks and
+// the plugin assesses it in the background. onAssessed is called with the health assessed by the plugin, if any.
+func (o ResourceHealthOverrides) GetResourceHealthAsync(obj *unstructured.Unstructured, onAssessed func(*health.HealthStatus)) (*health.HealthStatus, error) {
+	if plugin := getHealthPlugin(o.Overrides, obj.GroupVersionKind()); plugin != nil {
+		if healthStatus, ok := getCachedPluginResourceHealth(plugin, obj, o.Server); ok && healthStatus != nil {
+			return healthStatus, nil
+		} else if !ok {
+			// the resource is serialized right away since the caller may modify it
+			manifest, err := json.Marshal(obj)
+			if err != nil {
+				logPluginError(plugin, obj, err)
+			} else {
+				key := getCacheKey(plugin, obj, o.Server)
+				go func() {
+					healthStatus, err := assessPluginResourceHealth(plugin, manifest, o.Server, key)
+					if err != nil {
+						logPluginError(plugin, obj, err)
+					} else if healthStatus != nil {
+						onAssessed(healthStatus)
+					}
+				}()
+			}
+		}
+	}
+	return lua.ResourceHealthOverrides(o.Overrides).GetResourceHealth(obj)
+}
+
+// HasHealthCheck returns true if the resources of the given group kind have a health plugin, or a CEL or Lua health
+// check.
+func (o ResourceHealthOverrides) HasHealthCheck(gk schema.GroupKind) bool {
+	return getHealthPlugin(o.Overrides, gk.WithVersion("")) != nil || lua.ResourceHealthOverrides(o.Overrides).HasHealthCheck(gk)
+}
+
+func logPluginError(plugin *appv1.HealthPlugin, obj *unstructured.Unstructured, err error) {
+	log.Warnf("Failed to assess the health of %s %s/%s with health plugin %s, falling back to the Lua or built-in health check: %v", obj.GetKind(), obj.GetNamespace(), obj.GetName(), plugin.Name, err)
+}
+
+// getHealthPlugin returns the health plugin configured for the given GVK, either for the GVK itself or for the first
+// wildcard in lexical order which matches it.
+func getHealthPlugin(overrides map[string]appv1.ResourceOverride, gvk schema.GroupVersionKind) *appv1.HealthPlugin {
+	key := lua.GetConfigMapKey(gvk)
+	if override, ok := overrides[key]; ok && override.HealthPlugin != nil {
+		return override.HealthPlugin
+	}
+	for _, wildcard := range slices.Sorted(maps.Keys(overrides)) {
+		if override := overrides[wildcard]; override.HealthPlugin != nil && argoglob.Match(wildcard, key) {
+			return override.HealthPlugin
+		}
+	}
+	return nil
+}
+
+// getCacheKey returns the key of the health of the resource assessed by the given plugin in the health cache, or an
+// empty string if the plugin does not cache the health of the resource
+func getCacheKey(plugin *appv1.HealthPlugin, obj *unstructured.Unstructured, server string) string {
+	if plugin.CacheTTL == nil || plugin.CacheTTL.Duration <= 0 || obj.GetUID() == "" || obj.GetResourceVersion() == "" {
+		return ""
+	}
+	return fmt.Sprintf("%s|%s|%s|%s", plugin.Name, server, obj.GetUID(), obj.GetResourceVersion())
+}
+
+// getCachedPluginResourceHealth returns the cached health of the resource assessed by the given plugin, if any
+func getCachedPluginResourceHealth(plugin *appv1.HealthPlugin, obj *unstructured.Unstructured, server string) (*health.HealthStatus, bool) {
+	key := getCacheKey(plugin, obj, server)
+	if key == "" {
+		return nil, false
+	}
+	cached, ok := healthCache.Get(key)
+	if !ok {
+		return nil, false
+	}
+	return cached.(*health.HealthStatus), true
+}
+
+// getPluginResourceHealth returns the health of the resource assessed by the given plugin, or nil if the plugin does
+// not assess the health of the resource
+func getPluginResourceHealth(plugin *appv1.HealthPlugin, obj *unstructured.Unstructured, server string) (*health.HealthStatus, error) {
+	if healthStatus, ok := getCachedPluginResourceHealth(plugin, obj, server); ok {
+		return healthStatus, nil
+	}
+	manifest, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal resource: %w", err)
+	}
+	return assessPluginResourceHealth(plugin, manifest, server, getCacheKey(plugin, obj, server))
+}
+
+// assessPluginResourceHealth calls the given plugin to assess the health of the resource with the given manifest, and
+// caches the result with the given key if it is not empty
+func assessPluginResourceHealth(plugin *appv1.HealthPlugin, manifest []byte, server string, cacheKey string) (*health.HealthStatus, error) {
+	timeout := defaultTimeout
+	if plugin.Timeout != nil && plugin.Timeout.Duration > 0 {
+		timeout = plugin.Timeout.Duration
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	client, err := getClient(filepath.Join(common.GetHealthPluginSockFilePath(), plugin.Name+".sock"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to health plugin: %w", err)
+	}
+	res, err := client.GetHealth(ctx, &apiclient.HealthRequest{Manifest: string(manifest), Server: server})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get health from health plugin: %w", err)
+	}
+	var healthStatus *health.HealthStatus
+	if res.Status != "" {
+		status := health.HealthStatusCode(res.Status)
+		if !slices.Contains(healthStatusCodes, status) {
+			return nil, fmt.Errorf("health plugin returned invalid health status %q", res.Status)
+		}
+		healthStatus = &health.HealthStatus{Status: status, Message: res.Message}
+	}
+
+	if cacheKey != "" {
+		healthCache.Set(cacheKey, healthStatus, plugin.CacheTTL.Duration)
+	}
+	return healthStatus, nil
+}
+
+// getClient returns the client of the health plugin listening on the given address
+func getClient(address string) (apiclient.HealthPluginServiceClient, error) {
+	clientsLock.Lock()
+	defer clientsLock.Unlock()
+	if client, ok := clients[address]; ok {
+		return client, nil
+	}
+	conn, err := apiclient.NewConnection(address)
+	if err != nil {
+		return nil, err
+	}
+	client := apiclient.NewHealthPluginServiceClient(conn)
+	clients[address] = client
+	return client, nil
+}
@@ -0,0 +1,233 @@
+package healthplugin
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/healthplugin/apiclient"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+type fakeHealthPlugin struct {
+	apiclient.UnimplementedHealthPluginServiceServer
+	calls    atomic.Int32
+	response func(obj *unstructured.Unstructured, server string) *apiclient.HealthResponse
+}
+
+func (p *fakeHealthPlugin) GetHealth(_ context.Context, req *apiclient.HealthRequest) (*apiclient.HealthResponse, error) {
+	p.calls.Add(1)
+	obj := &unstructured.Unstructured{}
+	if err := json.Unmarshal([]byte(req.Manifest), obj); err != nil {
+		return nil, err
+	}
+	return p.response(obj, req.Server), nil
+}
+
+// startHealthPlugin serves the given plugin on the Unix socket of the plugin with the given name
+func startHealthPlugin(t *testing.T, name string, plugin *fakeHealthPlugin) {
+	t.Helper()
+	listener, err := net.Listen("unix", filepath.Join(common.GetHealthPluginSockFilePath(), name+".sock"))
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	apiclient.RegisterHealthPluginServiceServer(server, plugin)
+	go func() {
+		_ = server.Serve(listener)
+	}()
+	t.Cleanup(server.Stop)
+}
+
+func newTestResource(resourceVersion string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetAPIVersion("example.com/v1")
+	obj.SetKind("Database")
+	obj.SetName("db")
+	obj.SetNamespace("default")
+	obj.SetUID("uid")
+	obj.SetResourceVersion(resourceVersion)
+	return obj
+}
+
+const testHealthLua = `
+hs = {}
+hs.status = "Degraded"
+hs.message = "from lua"
+return hs`
+
+func TestResourceHealthOverrides(t *testing.T) {
+	t.Setenv(common.EnvHealthPluginSockFilePath, t.TempDir())
+
+	plugin := &fakeHealthPlugin{response: func(obj *unstructured.Unstructured, server string) *apiclient.HealthResponse {
+		switch obj.GetResourceVersion() {
+		case "empty":
+			return &apiclient.HealthResponse{}
+		case "invalid":
+			return &apiclient.HealthResponse{Status: "Broken"}
+		}
+		return &apiclient.HealthResponse{Status: string(health.HealthStatusProgressing), Message: obj.GetName() + " on " + server}
+	}}
+	startHealthPlugin(t, "databases", plugin)
+
+	newOverrides := func(key string, healthPlugin *appv1.HealthPlugin) ResourceHealthOverrides {
+		return ResourceHealthOverrides{
+			Overrides: map[string]appv1.ResourceOverride{key: {HealthPlugin: healthPlugin, HealthLua: testHealthLua}},
+			Server:    "https://my-cluster",
+		}
+	}
+
+	t.Run("health is assessed by the plugin", func(t *testing.T) {
+		healthStatus, err := newOverrides("example.com/Database", &appv1.HealthPlugin{Name: "databases"}).GetResourceHealth(newTestResource("1"))
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusProgressing, Message: "db on https://my-cluster"}, healthStatus)
+	})
+
+	t.Run("plugin matching a wildcard", func(t *testing.T) {
+		healthStatus, err := newOverrides("example.com/*", &appv1.HealthPlugin{Name: "databases"}).GetResourceHealth(newTestResource("1"))
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusProgressing, healthStatus.Status)
+	})
+
+	t.Run("health is cached per resource version", func(t *testing.T) {
+		overrides := newOverrides("example.com/Database", &appv1.HealthPlugin{Name: "databases", CacheTTL: &metav1.Duration{Duration: time.Minute}})
+		calls := plugin.calls.Load()
+		for range 3 {
+			_, err := overrides.GetResourceHealth(newTestResource("cached"))
+			require.NoError(t, err)
+		}
+		assert.Equal(t, calls+1, plugin.calls.Load())
+
+		_, err := overrides.GetResourceHealth(newTestResource("updated"))
+		require.NoError(t, err)
+		assert.Equal(t, calls+2, plugin.calls.Load())
+	})
+
+	t.Run("falls back to Lua if the plugin does not assess the health", func(t *testing.T) {
+		healthStatus, err := newOverrides("example.com/Database", &appv1.HealthPlugin{Name: "databases"}).GetResourceHealth(newTestResource("empty"))
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusDegraded, Message: "from lua"}, healthStatus)
+	})
+
+	t.Run("falls back to Lua if the plugin returns an invalid status", func(t *testing.T) {
+		healthStatus, err := newOverrides("example.com/Database", &appv1.HealthPlugin{Name: "databases"}).GetResourceHealth(newTestResource("invalid"))
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
+	})
+
+	t.Run("falls back to Lua if the plugin is not running", func(t *testing.T) {
+		healthStatus, err := newOverrides("example.com/Database", &appv1.HealthPlugin{Name: "missing", Timeout: &metav1.Duration{Duration: 100 * time.Millisecond}}).GetResourceHealth(newTestResource("1"))
+		require.NoError(t, err)
+		assert.Equal(t, health.HealthStatusDegraded, healthStatus.Status)
+	})
+
+	t.Run("resources without plugin", func(t *testing.T) {
+		calls := plugin.calls.Load()
+		healthStatus, err := newOverrides("example.com/Other", &appv1.HealthPlugin{Name: "databases"}).GetResourceHealth(newTestResource("1"))
+		require.NoError(t, err)
+		assert.Nil(t, healthStatus)
+		assert.Equal(t, calls, plugin.calls.Load())
+	})
+}
+
+func TestResourceHealthOverrides_GetResourceHealthAsync(t *testing.T) {
+	t.Setenv(common.EnvHealthPluginSockFilePath, t.TempDir())
+	healthCache.Flush()
+
+	plugin := &fakeHealthPlugin{response: func(obj *unstructured.Unstructured, _ string) *apiclient.HealthResponse {
+		if obj.GetResourceVersion() == "empty" {
+			return &apiclient.HealthResponse{}
+		}
+		return &apiclient.HealthResponse{Status: string(health.HealthStatusProgressing), Message: obj.GetName()}
+	}}
+	startHealthPlugin(t, "databases", plugin)
+	overrides := ResourceHealthOverrides{
+		Overrides: map[string]appv1.ResourceOverride{"example.com/Database": {
+			HealthPlugin: &appv1.HealthPlugin{Name: "databases", CacheTTL: &metav1.Duration{Duration: time.Minute}},
+			HealthLua:    testHealthLua,
+		}},
+		Server: "https://my-cluster",
+	}
+
+	t.Run("health is assessed in the background", func(t *testing.T) {
+		assessed := make(chan *health.HealthStatus, 1)
+		healthStatus, err := overrides.GetResourceHealthAsync(newTestResource("async"), func(healthStatus *health.HealthStatus) {
+			assessed <- healthStatus
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusDegraded, Message: "from lua"}, healthStatus)
+		select {
+		case healthStatus = <-assessed:
+			assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusProgressing, Message: "db"}, healthStatus)
+		case <-time.After(5 * time.Second):
+			t.Fatal("health was not assessed by the plugin")
+		}
+
+		// the health assessed by the plugin is cached
+		calls := plugin.calls.Load()
+		healthStatus, err = overrides.GetResourceHealthAsync(newTestResource("async"), func(*health.HealthStatus) {
+			t.Error("health was assessed again")
+		})
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusProgressing, Message: "db"}, healthStatus)
+		assert.Equal(t, calls, plugin.calls.Load())
+	})
+
+	t.Run("onAssessed is not called if the plugin does not assess the health", func(t *testing.T) {
+		_, err := overrides.GetResourceHealthAsync(newTestResource("empty"), func(*health.HealthStatus) {
+			t.Error("onAssessed was called")
+		})
+		require.NoError(t, err)
+		require.Eventually(t, func() bool {
+			_, ok := getCachedPluginResourceHealth(overrides.Overrides["example.com/Database"].HealthPlugin, newTestResource("empty"), overrides.Server)
+			return ok
+		}, 5*time.Second, 10*time.Millisecond)
+	})
+}
+
+func TestGetClient(t *testing.T) {
+	address := filepath.Join(t.TempDir(), "plugin.sock")
+	client, err := getClient(address)
+	require.NoError(t, err)
+	other, err := getClient(address)
+	require.NoError(t, err)
+	assert.Same(t, client, other)
+}
+
+func TestGetHealthPlugin(t *testing.T) {
+	overrides := map[string]appv1.ResourceOverride{
+		"example.com/*":        {HealthPlugin: &appv1.HealthPlugin{Name: "group"}},
+		"*/Database":           {HealthPlugin: &appv1.HealthPlugin{Name: "kind"}},
+		"example.com/Database": {HealthLua: testHealthLua},
+		"example.com/Cache":    {HealthPlugin: &appv1.HealthPlugin{Name: "cache"}},
+	}
+	// the first matching wildcard in lexical order is used
+	for range 10 {
+		assert.Equal(t, "kind", getHealthPlugin(overrides, newTestResource("1").GroupVersionKind()).Name)
+	}
+	assert.Equal(t, "cache", getHealthPlugin(overrides, schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Cache"}).Name)
+	assert.Equal(t, "group", getHealthPlugin(overrides, schema.GroupVersionKind{Group: "example.com", Version: "v1", Kind: "Queue"}).Name)
+	assert.Nil(t, getHealthPlugin(overrides, schema.GroupVersionKind{Group: "other.com", Version: "v1", Kind: "Queue"}))
+}
+
+func TestResourceHealthOverrides_HasHealthCheck(t *testing.T) {
+	overrides := ResourceHealthOverrides{Overrides: map[string]appv1.ResourceOverride{
+		"example.com/*":   {HealthPlugin: &appv1.HealthPlugin{Name: "example"}},
+		"other.com/Lua":   {HealthLua: "return {}"},
+		"other.com/Other": {IgnoreDifferences: appv1.OverrideIgnoreDiff{JSONPointers: []string{"/spec"}}},
+	}}
+	assert.True(t, overrides.HasHealthCheck(schema.GroupKind{Group: "example.com", Kind: "Foo"}))
+	assert.True(t, overrides.HasHealthCheck(schema.GroupKind{Group: "other.com", Kind: "Lua"}))
+	assert.False(t, overrides.HasHealthCheck(schema.GroupKind{Group: "other.com", Kind: "Other"}))
+}
@@ -60,6 +60,7 @@ type notificationController struct {
 	appProjInformer   cache.SharedIndexInformer
 	secretInformer    cache.SharedIndexInformer
 	configMapInformer cache.SharedIndexInformer
+	deliveries        *deliveryTracker
 }
 
 func NewController(
@@ -99,7 +100,9 @@ func NewController(
 		configMapInformer: configMapInformer,
 		appInformer:       appInformer,
 		appProjInformer:   appProjInformer,
+		deliveries:        newDeliveryTracker(namespaceableAppClient, appInformer, apiFactory, namespace, selfServiceNotificationEnabled, registry),
 	}
+	recordingAPIFactory := &recordingFactory{Factory: apiFactory, tracker: res.deliveries}
 	skipProcessingOpt := controller.WithSkipProcessing(func(obj metav1.Object) (bool, string) {
 		app, ok := (obj).(*unstructured.Unstructured)
 		if !ok {
@@ -114,12 +117,12 @@ func NewController(
 	alterDestinationsOpt := controller.WithAlterDestinations(res.alterDestinations)
 
 	if !selfServiceNotificationEnabled {
-		res.ctrl = controller.NewController(namespaceableAppClient, appInformer, apiFactory,
+		res.ctrl = controller.NewController(namespaceableAppClient, appInformer, recordingAPIFactory,
 			skipProcessingOpt,
 			metricsRegistryOpt,
 			alterDestinationsOpt)
 	} else {
-		res.ctrl = controller.NewControllerWithNamespaceSupport(namespaceableAppClient, appInformer, apiFactory,
+		res.ctrl = controller.NewControllerWithNamespaceSupport(namespaceableAppClient, appInformer, recordingAPIFactory,
 			skipProcessingOpt,
 			metricsRegistryOpt,
 			alterDestinationsOpt)
@@ -195,6 +198,7 @@ func (c *notificationController) Init(ctx context.Context) error {
 }
 
 func (c *notificationController) Run(ctx context.Context, processors int) {
+	go c.deliveries.run(ctx)
 	c.ctrl.Run(processors, ctx.Done())
 }
 
@@ -0,0 +1,390 @@
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/controller"
+	"github.com/argoproj/notifications-engine/pkg/services"
+	log "github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/notification/delivery"
+)
+
+const (
+	// deliveryRetryInitialDelay is the delay before the first retry of a failed notification delivery
+	deliveryRetryInitialDelay = 10 * time.Second
+	// deliveryRetryMaxDelay caps the exponential backoff between the retries of a failed notification delivery
+	deliveryRetryMaxDelay = 10 * time.Minute
+	// deliveryMaxAttempts is the number of attempts after which a failed notification delivery is no longer retried
+	deliveryMaxAttempts = 8
+	// deliveryMessageMaxLength limits the length of the errors stored in the status of the applications
+	deliveryMessageMaxLength = 1024
+)
+
+// deliveryKey identifies a notification delivery in the retry queue
+type deliveryKey struct {
+	app string
+	id  int64
+}
+
+// deliveryTracker records the notifications sent about the applications in their status, and retries the deliveries
+// which failed with an exponential backoff. Pending deliveries are persisted in the status of the applications, so
+// they survive restarts of the controller and can be requested by the API server to redeliver a notification.
+type deliveryTracker struct {
+	appClient                      dynamic.NamespaceableResourceInterface
+	appInformer                    cache.SharedIndexInformer
+	apiFactory                     api.Factory
+	namespace                      string
+	selfServiceNotificationEnabled bool
+	metricsRegistry                *controller.MetricsRegistry
+	queue                          workqueue.TypedDelayingInterface[deliveryKey]
+
+	initialDelay time.Duration
+	maxDelay     time.Duration
+	maxAttempts  int64
+	now          func() time.Time
+}
+
+func newDeliveryTracker(
+	appClient dynamic.NamespaceableResourceInterface,
+	appInformer cache.SharedIndexInformer,
+	apiFactory api.Factory,
+	namespace string,
+	selfServiceNotificationEnabled bool,
+	metricsRegistry *controller.MetricsRegistry,
+) *deliveryTracker {
+	t := &deliveryTracker{
+		appClient:                      appClient,
+		appInformer:                    appInformer,
+		apiFactory:                     apiFactory,
+		namespace:                      namespace,
+		selfServiceNotificationEnabled: selfServiceNotificationEnabled,
+		metricsRegistry:                metricsRegistry,
+		queue:                          workqueue.NewTypedDelayingQueueWithConfig(workqueue.TypedDelayingQueueConfig[deliveryKey]{Name: "notification_delivery_queue"}),
+		initialDelay:                   deliveryRetryInitialDelay,
+		maxDelay:                       deliveryRetryMaxDelay,
+		maxAttempts:                    deliveryMaxAttempts,
+		now:                            time.Now,
+	}
+	_, _ = appInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: t.enqueuePending,
+		UpdateFunc: func(_, newObj any) {
+			t.enqueuePending(newObj)
+		},
+	})
+	return t
+}
+
+// recordingFactory wraps the API factory used by the notifications engine, so that the notifications it sends are
+// recorded by the delivery tracker
+type recordingFactory struct {
+	api.Factory
+	tracker *deliveryTracker
+}
+
+func (f *recordingFactory) GetAPI() (api.API, error) {
+	a, err := f.Factory.GetAPI()
+	if err != nil {
+		return nil, err
+	}
+	return &recordingAPI{API: a, tracker: f.tracker}, nil
+}
+
+func (f *recordingFactory) GetAPIsFromNamespace(namespace string) (map[string]api.API, error) {
+	apis, err := f.Factory.GetAPIsFromNamespace(namespace)
+	res := make(map[string]api.API, len(apis))
+	for apiNamespace, a := range apis {
+		res[apiNamespace] = &recordingAPI{API: a, tracker: f.tracker}
+	}
+	return res, err
+}
+
+type recordingAPI struct {
+	api.API
+	tracker *deliveryTracker
+}
+
+func (a *recordingAPI) Send(obj map[string]any, templates []string, dest services.Destination) error {
+	return a.tracker.recordSend(a.API, obj, templates, dest, a.API.Send(obj, templates, dest))
+}
+
+// recordSend records the first attempt to deliver a notification sent by the notifications engine. A failed delivery
+// which can be retried is handed over to the retry queue and reported as sent to the notifications engine, so that
+// the notification is not sent again by both of them.
+func (t *deliveryTracker) recordSend(a api.API, obj map[string]any, templates []string, dest services.Destination, sendErr error) error {
+	app := &unstructured.Unstructured{Object: obj}
+	logCtx := log.WithFields(log.Fields{"application": app.GetName(), "namespace": app.GetNamespace(), "service": dest.Service, "recipient": dest.Recipient})
+	now := t.now()
+	d := v1alpha1.NotificationDelivery{
+		Trigger:   getTrigger(a.GetConfig(), templates),
+		Templates: slices.Clone(templates),
+		Service:   dest.Service,
+		Recipient: dest.Recipient,
+		CreatedAt: metav1.NewTime(now),
+	}
+	t.setAttemptResult(&d, sendErr, now, logCtx)
+
+	err := t.updateDeliveries(context.Background(), app.GetNamespace(), app.GetName(), func(deliveries v1alpha1.NotificationDeliveries) (v1alpha1.NotificationDeliveries, bool) {
+		deliveries, d = delivery.Add(deliveries, d)
+		return deliveries, true
+	})
+	if err != nil {
+		logCtx.Errorf("Failed to record notification delivery: %v", err)
+		return sendErr
+	}
+	if d.Phase != v1alpha1.NotificationDeliveryPhasePending {
+		return sendErr
+	}
+	logCtx.Warnf("Failed to deliver notification %d, retrying at %s: %v", d.ID, d.NextAttemptAt.Format(time.RFC3339), sendErr)
+	if t.metricsRegistry != nil {
+		t.metricsRegistry.IncDeliveriesCounter(d.Trigger, d.Service, false)
+	}
+	key, err := cache.MetaNamespaceKeyFunc(app)
+	if err == nil {
+		t.enqueue(key, d)
+	}
+	return nil
+}
+
+// setAttemptResult updates the delivery with the result of an attempt to deliver the notification
+func (t *deliveryTracker) setAttemptResult(d *v1alpha1.NotificationDelivery, sendErr error, now time.Time, logCtx *log.Entry) {
+	d.Attempts++
+	d.LastAttemptAt = &metav1.Time{Time: now}
+	d.NextAttemptAt = nil
+	d.Message = ""
+	if sendErr == nil {
+		d.Phase = v1alpha1.NotificationDeliveryPhaseSucceeded
+		return
+	}
+	d.Message = truncateMessage(sendErr.Error())
+	if d.Attempts >= t.maxAttempts || !services.HandleSendError(sendErr, logCtx) {
+		d.Phase = v1alpha1.NotificationDeliveryPhaseFailed
+		return
+	}
+	d.Phase = v1alpha1.NotificationDeliveryPhasePending
+	d.NextAttemptAt = &metav1.Time{Time: now.Add(t.backoff(d.Attempts))}
+}
+
+// backoff returns the delay before retrying a delivery after the given number of attempts
+func (t *deliveryTracker) backoff(attempts int64) time.Duration {
+	delay := t.initialDelay
+	for i := int64(1); i < attempts && delay < t.maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, t.maxDelay)
+}
+
+// updateDeliveries updates the notification deliveries in the status of the application, retrying on conflicts
+func (t *deliveryTracker) updateDeliveries(ctx context.Context, namespace, name string, update func(v1alpha1.NotificationDeliveries) (v1alpha1.NotificationDeliveries, bool)) error {
+	appClient := t.appClient.Namespace(namespace)
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		app, err := appClient.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		deliveries, err := getDeliveries(app)
+		if err != nil {
+			return err
+		}
+		deliveries, changed := update(deliveries)
+		if !changed {
+			return nil
+		}
+		patch, err := delivery.NewPatch(app.GetResourceVersion(), deliveries)
+		if err != nil {
+			return fmt.Errorf("error marshaling notification deliveries patch: %w", err)
+		}
+		_, err = appClient.Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+		return err
+	})
+}
+
+// enqueuePending adds the pending deliveries of an application to the retry queue
+func (t *deliveryTracker) enqueuePending(obj any) {
+	app, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	key, err := cache.MetaNamespaceKeyFunc(app)
+	if err != nil {
+		return
+	}
+	deliveries, err := getDeliveries(app)
+	if err != nil {
+		log.WithField("application", key).Warnf("Failed to get notification deliveries: %v", err)
+		return
+	}
+	for _, d := range deliveries {
+		if d.Phase == v1alpha1.NotificationDeliveryPhasePending {
+			t.enqueue(key, d)
+		}
+	}
+}
+
+func (t *deliveryTracker) enqueue(appKey string, d v1alpha1.NotificationDelivery) {
+	var delay time.Duration
+	if d.NextAttemptAt != nil {
+		delay = d.NextAttemptAt.Sub(t.now())
+	}
+	t.queue.AddAfter(deliveryKey{app: appKey, id: d.ID}, delay)
+}
+
+func (t *deliveryTracker) run(ctx context.Context) {
+	defer t.queue.ShutDown()
+	go wait.Until(func() {
+		for t.processNextItem(ctx) {
+		}
+	}, time.Second, ctx.Done())
+	<-ctx.Done()
+}
+
+func (t *deliveryTracker) processNextItem(ctx context.Context) bool {
+	key, shutdown := t.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer t.queue.Done(key)
+	if err := t.retry(ctx, key); err != nil {
+		log.WithField("application", key.app).Errorf("Failed to retry notification delivery %d: %v", key.id, err)
+		t.queue.AddAfter(key, t.initialDelay)
+	}
+	return true
+}
+
+// retry makes a new attempt to deliver a pending notification
+func (t *deliveryTracker) retry(ctx context.Context, key deliveryKey) error {
+	namespace, name, err := cache.SplitMetaNamespaceKey(key.app)
+	if err != nil {
+		return err
+	}
+	// the application is not taken from the informer, which may not contain the result of the previous attempt yet
+	app, err := t.appClient.Namespace(namespace).Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+	deliveries, err := getDeliveries(app)
+	if err != nil {
+		return err
+	}
+	d := deliveries.Find(key.id)
+	if d == nil || d.Phase != v1alpha1.NotificationDeliveryPhasePending {
+		return nil
+	}
+	if d.NextAttemptAt != nil && d.NextAttemptAt.After(t.now()) {
+		t.enqueue(key.app, *d)
+		return nil
+	}
+
+	dest := services.Destination{Service: d.Service, Recipient: d.Recipient}
+	logCtx := log.WithFields(log.Fields{"application": name, "namespace": namespace, "service": dest.Service, "recipient": dest.Recipient})
+	a, sendErr := t.getAPI(app, d.Service)
+	if sendErr == nil {
+		sendErr = a.Send(app.Object, d.Templates, dest)
+	}
+	now := t.now()
+	var updated v1alpha1.NotificationDelivery
+	err = t.updateDeliveries(ctx, namespace, name, func(deliveries v1alpha1.NotificationDeliveries) (v1alpha1.NotificationDeliveries, bool) {
+		d := deliveries.Find(key.id)
+		if d == nil || d.Phase != v1alpha1.NotificationDeliveryPhasePending {
+			return deliveries, false
+		}
+		t.setAttemptResult(d, sendErr, now, logCtx)
+		updated = *d
+		return deliveries, true
+	})
+	if err != nil {
+		return fmt.Errorf("error recording notification delivery: %w", err)
+	}
+	if t.metricsRegistry != nil {
+		t.metricsRegistry.IncDeliveriesCounter(updated.Trigger, updated.Service, sendErr == nil)
+	}
+	switch updated.Phase {
+	case v1alpha1.NotificationDeliveryPhaseSucceeded:
+		logCtx.Infof("Delivered notification %d after %d attempts", updated.ID, updated.Attempts)
+	case v1alpha1.NotificationDeliveryPhasePending:
+		logCtx.Warnf("Failed to deliver notification %d, retrying at %s: %v", updated.ID, updated.NextAttemptAt.Format(time.RFC3339), sendErr)
+		t.enqueue(key.app, updated)
+	case v1alpha1.NotificationDeliveryPhaseFailed:
+		logCtx.Errorf("Failed to deliver notification %d after %d attempts: %v", updated.ID, updated.Attempts, sendErr)
+	}
+	return nil
+}
+
+// getAPI returns the API to deliver a notification about the application with the given service
+func (t *deliveryTracker) getAPI(app *unstructured.Unstructured, service string) (api.API, error) {
+	if !t.selfServiceNotificationEnabled {
+		return t.apiFactory.GetAPI()
+	}
+	apis, err := t.apiFactory.GetAPIsFromNamespace(app.GetNamespace())
+	// prefer the configuration in the namespace of the application to the default one
+	for _, namespace := range []string{app.GetNamespace(), t.namespace} {
+		if a, ok := apis[namespace]; ok {
+			if _, ok := a.GetNotificationServices()[service]; ok {
+				return a, nil
+			}
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return nil, fmt.Errorf("notification service '%s' is not supported", service)
+}
+
+// getDeliveries returns the notification deliveries in the status of the application
+func getDeliveries(app *unstructured.Unstructured) (v1alpha1.NotificationDeliveries, error) {
+	raw, ok, err := unstructured.NestedFieldNoCopy(app.Object, "status", "notificationDeliveries")
+	if err != nil || !ok {
+		return nil, err
+	}
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, err
+	}
+	var deliveries v1alpha1.NotificationDeliveries
+	if err := json.Unmarshal(data, &deliveries); err != nil {
+		return nil, fmt.Errorf("error unmarshaling notification deliveries: %w", err)
+	}
+	return deliveries, nil
+}
+
+// getTrigger returns the names of the triggers sending the given templates. The notifications engine does not pass the
+// trigger to the API, so it is looked up in the configuration.
+func getTrigger(cfg api.Config, templates []string) string {
+	var names []string
+	for name, conditions := range cfg.Triggers {
+		for _, condition := range conditions {
+			if slices.Equal(condition.Send, templates) {
+				names = append(names, name)
+				break
+			}
+		}
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+func truncateMessage(message string) string {
+	if runes := []rune(message); len(runes) > deliveryMessageMaxLength {
+		return string(runes[:deliveryMessageMaxLength]) + "..."
+	}
+	return message
+}
@@ -0,0 +1,212 @@
+package controller
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/argoproj/notifications-engine/pkg/services"
+	"github.com/argoproj/notifications-engine/pkg/triggers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+type fakeAPI struct {
+	api.API
+	sendErr error
+	sent    int
+}
+
+func (a *fakeAPI) Send(_ map[string]any, _ []string, _ services.Destination) error {
+	a.sent++
+	return a.sendErr
+}
+
+func (a *fakeAPI) GetConfig() api.Config {
+	return api.Config{Triggers: map[string][]triggers.Condition{
+		"on-sync-failed":    {{Send: []string{"app-sync-failed"}}},
+		"on-sync-succeeded": {{Send: []string{"app-sync-succeeded"}}},
+	}}
+}
+
+type fakeFactory struct {
+	api *fakeAPI
+}
+
+func (f *fakeFactory) GetAPI() (api.API, error) {
+	return f.api, nil
+}
+
+func (f *fakeFactory) GetAPIsFromNamespace(namespace string) (map[string]api.API, error) {
+	return map[string]api.API{namespace: f.api}, nil
+}
+
+func newTestDeliveryTracker(t *testing.T, a *fakeAPI) (*deliveryTracker, *unstructured.Unstructured, *time.Time) {
+	t.Helper()
+	scheme := runtime.NewScheme()
+	require.NoError(t, v1alpha1.SchemeBuilder.AddToScheme(scheme))
+	app := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "argoproj.io/v1alpha1",
+		"kind":       "Application",
+		"metadata":   map[string]any{"name": "guestbook", "namespace": "argocd"},
+		"spec":       map[string]any{"project": "default"},
+	}}
+	dynamicClient := fake.NewSimpleDynamicClient(scheme, app)
+	appClient := dynamicClient.Resource(applications)
+	tracker := newDeliveryTracker(appClient, newInformer(appClient.Namespace("argocd"), "argocd", nil, ""), &fakeFactory{api: a}, "argocd", false, nil)
+	t.Cleanup(tracker.queue.ShutDown)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.Local)
+	tracker.now = func() time.Time {
+		return now
+	}
+	return tracker, app, &now
+}
+
+func getTestDeliveries(t *testing.T, tracker *deliveryTracker) v1alpha1.NotificationDeliveries {
+	t.Helper()
+	app, err := tracker.appClient.Namespace("argocd").Get(t.Context(), "guestbook", metav1.GetOptions{})
+	require.NoError(t, err)
+	deliveries, err := getDeliveries(app)
+	require.NoError(t, err)
+	return deliveries
+}
+
+func TestRecordSend(t *testing.T) {
+	dest := services.Destination{Service: "slack", Recipient: "alerts"}
+
+	t.Run("Succeeded", func(t *testing.T) {
+		a := &fakeAPI{}
+		tracker, app, now := newTestDeliveryTracker(t, a)
+		recordingAPI := &recordingAPI{API: a, tracker: tracker}
+
+		require.NoError(t, recordingAPI.Send(app.Object, []string{"app-sync-succeeded"}, dest))
+		assert.Equal(t, v1alpha1.NotificationDeliveries{{
+			ID:            0,
+			Trigger:       "on-sync-succeeded",
+			Templates:     []string{"app-sync-succeeded"},
+			Service:       "slack",
+			Recipient:     "alerts",
+			Phase:         v1alpha1.NotificationDeliveryPhaseSucceeded,
+			Attempts:      1,
+			CreatedAt:     metav1.NewTime(*now),
+			LastAttemptAt: &metav1.Time{Time: *now},
+		}}, getTestDeliveries(t, tracker))
+	})
+
+	t.Run("Failed", func(t *testing.T) {
+		a := &fakeAPI{sendErr: errors.New("slack is down")}
+		tracker, app, now := newTestDeliveryTracker(t, a)
+		recordingAPI := &recordingAPI{API: a, tracker: tracker}
+
+		// the failed delivery is handed over to the retry queue
+		require.NoError(t, recordingAPI.Send(app.Object, []string{"app-sync-failed"}, dest))
+		deliveries := getTestDeliveries(t, tracker)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, "on-sync-failed", deliveries[0].Trigger)
+		assert.Equal(t, v1alpha1.NotificationDeliveryPhasePending, deliveries[0].Phase)
+		assert.Equal(t, "slack is down", deliveries[0].Message)
+		assert.Equal(t, now.Add(deliveryRetryInitialDelay), deliveries[0].NextAttemptAt.Time)
+	})
+
+	t.Run("NotRetried", func(t *testing.T) {
+		a := &fakeAPI{sendErr: &services.TooManyGitHubCommitStatusesError{Sha: "abc", Context: "argocd"}}
+		tracker, app, _ := newTestDeliveryTracker(t, a)
+		recordingAPI := &recordingAPI{API: a, tracker: tracker}
+
+		// errors which cannot be retried are returned to the notifications engine
+		require.Error(t, recordingAPI.Send(app.Object, []string{"app-sync-failed"}, dest))
+		deliveries := getTestDeliveries(t, tracker)
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, v1alpha1.NotificationDeliveryPhaseFailed, deliveries[0].Phase)
+		assert.Nil(t, deliveries[0].NextAttemptAt)
+	})
+}
+
+func TestRetry(t *testing.T) {
+	a := &fakeAPI{sendErr: errors.New("slack is down")}
+	tracker, app, now := newTestDeliveryTracker(t, a)
+	tracker.maxAttempts = 3
+	recordingAPI := &recordingAPI{API: a, tracker: tracker}
+	key := deliveryKey{app: "argocd/guestbook", id: 0}
+	require.NoError(t, recordingAPI.Send(app.Object, []string{"app-sync-failed"}, services.Destination{Service: "slack", Recipient: "alerts"}))
+
+	// the delivery is not retried before the next attempt time
+	require.NoError(t, tracker.retry(t.Context(), key))
+	assert.Equal(t, 1, a.sent)
+
+	*now = now.Add(deliveryRetryInitialDelay)
+	require.NoError(t, tracker.retry(t.Context(), key))
+	assert.Equal(t, 2, a.sent)
+	deliveries := getTestDeliveries(t, tracker)
+	assert.Equal(t, v1alpha1.NotificationDeliveryPhasePending, deliveries[0].Phase)
+	assert.Equal(t, int64(2), deliveries[0].Attempts)
+	assert.Equal(t, now.Add(2*deliveryRetryInitialDelay), deliveries[0].NextAttemptAt.Time)
+
+	// the delivery fails once the maximum number of attempts is reached
+	*now = now.Add(2 * deliveryRetryInitialDelay)
+	require.NoError(t, tracker.retry(t.Context(), key))
+	deliveries = getTestDeliveries(t, tracker)
+	assert.Equal(t, v1alpha1.NotificationDeliveryPhaseFailed, deliveries[0].Phase)
+	assert.Equal(t, int64(3), deliveries[0].Attempts)
+	assert.Nil(t, deliveries[0].NextAttemptAt)
+
+	// failed deliveries are not retried
+	require.NoError(t, tracker.retry(t.Context(), key))
+	assert.Equal(t, 3, a.sent)
+}
+
+func TestRetrySucceeded(t *testing.T) {
+	a := &fakeAPI{sendErr: errors.New("slack is down")}
+	tracker, app, now := newTestDeliveryTracker(t, a)
+	recordingAPI := &recordingAPI{API: a, tracker: tracker}
+	require.NoError(t, recordingAPI.Send(app.Object, []string{"app-sync-failed"}, services.Destination{Service: "slack", Recipient: "alerts"}))
+
+	a.sendErr = nil
+	*now = now.Add(deliveryRetryInitialDelay)
+	require.NoError(t, tracker.retry(t.Context(), deliveryKey{app: "argocd/guestbook", id: 0}))
+	deliveries := getTestDeliveries(t, tracker)
+	assert.Equal(t, v1alpha1.NotificationDeliveryPhaseSucceeded, deliveries[0].Phase)
+	assert.Equal(t, int64(2), deliveries[0].Attempts)
+	assert.Empty(t, deliveries[0].Message)
+	assert.Nil(t, deliveries[0].NextAttemptAt)
+}
+
+func TestEnqueuePending(t *testing.T) {
+	a := &fakeAPI{}
+	tracker, app, _ := newTestDeliveryTracker(t, a)
+	require.NoError(t, unstructured.SetNestedSlice(app.Object, []any{
+		map[string]any{"id": int64(0), "service": "slack", "phase": "Succeeded"},
+		map[string]any{"id": int64(1), "service": "slack", "phase": "Pending"},
+	}, "status", "notificationDeliveries"))
+
+	tracker.enqueuePending(app)
+	require.Equal(t, 1, tracker.queue.Len())
+	key, _ := tracker.queue.Get()
+	assert.Equal(t, deliveryKey{app: "argocd/guestbook", id: 1}, key)
+	tracker.queue.Done(key)
+}
+
+func TestDeliveryBackoff(t *testing.T) {
+	tracker := &deliveryTracker{initialDelay: 10 * time.Second, maxDelay: time.Minute}
+	assert.Equal(t, 10*time.Second, tracker.backoff(1))
+	assert.Equal(t, 20*time.Second, tracker.backoff(2))
+	assert.Equal(t, 40*time.Second, tracker.backoff(3))
+	assert.Equal(t, time.Minute, tracker.backoff(4))
+	assert.Equal(t, time.Minute, tracker.backoff(100))
+}
+
+func TestGetTrigger(t *testing.T) {
+	cfg := api.Config{Triggers: map[string][]triggers.Condition{
+		"on-deployed":       {{Send: []string{"app-deployed"}}},
+		"on-sync-succeeded": {{Send: []string{"app-sync-succeeded"}}, {Send: []string{"app-deployed"}}},
+	}}
+	assert.Equal(t, "on-deployed,on-sync-succeeded", getTrigger(cfg, []string{"app-deployed"}))
+	assert.Empty(t, getTrigger(cfg, []string{"app-created"}))
+}
@@ -36,6 +36,7 @@ import (
 	applicationsetpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
 	certificatepkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/certificate"
 	clusterpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
+	cosignkeypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/cosignkey"
 	gpgkeypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/gpgkey"
 	notificationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/notification"
 	projectpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
@@ -43,6 +44,7 @@ import (
 	repositorypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
 	sessionpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/session"
 	settingspkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/settings"
+	sshsignerpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/sshsigner"
 	versionpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/version"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/argo"
@@ -82,6 +84,10 @@ type Client interface {
 	NewClusterClientOrDie() (io.Closer, clusterpkg.ClusterServiceClient)
 	NewGPGKeyClient() (io.Closer, gpgkeypkg.GPGKeyServiceClient, error)
 	NewGPGKeyClientOrDie() (io.Closer, gpgkeypkg.GPGKeyServiceClient)
+	NewSSHSignerClient() (io.Closer, sshsignerpkg.SSHSignerServiceClient, error)
+	NewSSHSignerClientOrDie() (io.Closer, sshsignerpkg.SSHSignerServiceClient)
+	NewCosignKeyClient() (io.Closer, cosignkeypkg.CosignKeyServiceClient, error)
+	NewCosignKeyClientOrDie() (io.Closer, cosignkeypkg.CosignKeyServiceClient)
 	NewApplicationClient() (io.Closer, applicationpkg.ApplicationServiceClient, error)
 	NewApplicationSetClient() (io.Closer, applicationsetpkg.ApplicationSetServiceClient, error)
 	NewApplicationClientOrDie() (io.Closer, applicationpkg.ApplicationServiceClient)
@@ -671,6 +677,40 @@ func (c *client) NewGPGKeyClientOrDie() (io.Closer, gpgkeypkg.GPGKeyServiceClien
 	return conn, gpgkeyIf
 }
 
+func (c *client) NewSSHSignerClient() (io.Closer, sshsignerpkg.SSHSignerServiceClient, error) {
+	conn, closer, err := c.newConn(context.Background())
+	if err != nil {
+		return nil, nil, err
+	}
+	sshSignerIf := sshsignerpkg.NewSSHSignerServiceClient(conn)
+	return closer, sshSignerIf, nil
+}
+
+func (c *client) NewSSHSignerClientOrDie() (io.Closer, sshsignerpkg.SSHSignerServiceClient) {
+	conn, sshSignerIf, err := c.NewSSHSignerClient()
+	if err != nil {
+		log.Fatalf("Failed to establish connection to %s: %v", c.ServerAddr, err)
+	}
+	return conn, sshSignerIf
+}
+
+func (c *client) NewCosignKeyClient() (io.Closer, cosignkeypkg.CosignKeyServiceClient, error) {
+	conn, closer, err := c.newConn(context.Background())
+	if err != nil {
+		return nil, nil, err
+	}
+	cosignKeyIf := cosignkeypkg.NewCosignKeyServiceClient(conn)
+	return closer, cosignKeyIf, nil
+}
+
+func (c *client) NewCosignKeyClientOrDie() (io.Closer, cosignkeypkg.CosignKeyServiceClient) {
+	conn, cosignKeyIf, err := c.NewCosignKeyClient()
+	if err != nil {
+		log.Fatalf("Failed to establish connection to %s: %v", c.ServerAddr, err)
+	}
+	return conn, cosignKeyIf
+}
+
 func (c *client) NewApplicationClient() (io.Closer, applicationpkg.ApplicationServiceClient, error) {
 	conn, closer, err := c.newConn(context.Background())
 	if err != nil {
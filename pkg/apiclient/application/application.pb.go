@@ -2946,6 +2946,154 @@ func (m *ApplicationServerSideDiffResponse) GetModified() bool {
 	return false
 }
 
+// ApplicationHydrateDryRunQuery is a query to preview the hydrated commit of an application using the source hydrator
+type ApplicationHydrateDryRunQuery struct {
+	Name         *string `protobuf:"bytes,1,req,name=name" json:"name,omitempty"`
+	AppNamespace *string `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project      *string `protobuf:"bytes,3,opt,name=project" json:"project,omitempty"`
+	// Revision of the dry source to hydrate, defaults to the target revision of the dry source
+	Revision             *string  `protobuf:"bytes,4,opt,name=revision" json:"revision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationHydrateDryRunQuery) Reset()         { *m = ApplicationHydrateDryRunQuery{} }
+func (m *ApplicationHydrateDryRunQuery) String() string { return proto.CompactTextString(m) }
+func (*ApplicationHydrateDryRunQuery) ProtoMessage()    {}
+func (*ApplicationHydrateDryRunQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{36}
+}
+func (m *ApplicationHydrateDryRunQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationHydrateDryRunQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationHydrateDryRunQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationHydrateDryRunQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationHydrateDryRunQuery.Merge(m, src)
+}
+func (m *ApplicationHydrateDryRunQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationHydrateDryRunQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationHydrateDryRunQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationHydrateDryRunQuery proto.InternalMessageInfo
+
+func (m *ApplicationHydrateDryRunQuery) GetName() string {
+	if m != nil && m.Name != nil {
+		return *m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationHydrateDryRunQuery) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationHydrateDryRunQuery) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
+	}
+	return ""
+}
+
+func (m *ApplicationHydrateDryRunQuery) GetRevision() string {
+	if m != nil && m.Revision != nil {
+		return *m.Revision
+	}
+	return ""
+}
+
+type ApplicationHydrateDryRunResponse struct {
+	// DrySha is the resolved revision of the dry source
+	DrySha *string `protobuf:"bytes,1,req,name=drySha" json:"drySha,omitempty"`
+	// TargetBranch is the branch the hydrator would commit to
+	TargetBranch *string `protobuf:"bytes,2,req,name=targetBranch" json:"targetBranch,omitempty"`
+	// HydratedSha is the tip of the target branch the diff is computed against
+	HydratedSha *string `protobuf:"bytes,3,req,name=hydratedSha" json:"hydratedSha,omitempty"`
+	// Diff is the unified diff of the changes the hydrator would commit, empty if nothing would be committed
+	Diff                 *string  `protobuf:"bytes,4,req,name=diff" json:"diff,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationHydrateDryRunResponse) Reset()         { *m = ApplicationHydrateDryRunResponse{} }
+func (m *ApplicationHydrateDryRunResponse) String() string { return proto.CompactTextString(m) }
+func (*ApplicationHydrateDryRunResponse) ProtoMessage()    {}
+func (*ApplicationHydrateDryRunResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_df6e82b174b5eaec, []int{37}
+}
+func (m *ApplicationHydrateDryRunResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationHydrateDryRunResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationHydrateDryRunResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationHydrateDryRunResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationHydrateDryRunResponse.Merge(m, src)
+}
+func (m *ApplicationHydrateDryRunResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationHydrateDryRunResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationHydrateDryRunResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationHydrateDryRunResponse proto.InternalMessageInfo
+
+func (m *ApplicationHydrateDryRunResponse) GetDrySha() string {
+	if m != nil && m.DrySha != nil {
+		return *m.DrySha
+	}
+	return ""
+}
+
+func (m *ApplicationHydrateDryRunResponse) GetTargetBranch() string {
+	if m != nil && m.TargetBranch != nil {
+		return *m.TargetBranch
+	}
+	return ""
+}
+
+func (m *ApplicationHydrateDryRunResponse) GetHydratedSha() string {
+	if m != nil && m.HydratedSha != nil {
+		return *m.HydratedSha
+	}
+	return ""
+}
+
+func (m *ApplicationHydrateDryRunResponse) GetDiff() string {
+	if m != nil && m.Diff != nil {
+		return *m.Diff
+	}
+	return ""
+}
+
 type LinkInfo struct {
 	Title                *string  `protobuf:"bytes,1,req,name=title" json:"title,omitempty"`
 	Url                  *string  `protobuf:"bytes,2,req,name=url" json:"url,omitempty"`
@@ -2960,7 +3108,7 @@ func (m *LinkInfo) Reset()         { *m = LinkInfo{} }
 func (m *LinkInfo) String() string { return proto.CompactTextString(m) }
 func (*LinkInfo) ProtoMessage()    {}
 func (*LinkInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{36}
+	return fileDescriptor_df6e82b174b5eaec, []int{38}
 }
 func (m *LinkInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3028,7 +3176,7 @@ func (m *LinksResponse) Reset()         { *m = LinksResponse{} }
 func (m *LinksResponse) String() string { return proto.CompactTextString(m) }
 func (*LinksResponse) ProtoMessage()    {}
 func (*LinksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{37}
+	return fileDescriptor_df6e82b174b5eaec, []int{39}
 }
 func (m *LinksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3077,7 +3225,7 @@ func (m *ListAppLinksRequest) Reset()         { *m = ListAppLinksRequest{} }
 func (m *ListAppLinksRequest) String() string { return proto.CompactTextString(m) }
 func (*ListAppLinksRequest) ProtoMessage()    {}
 func (*ListAppLinksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_df6e82b174b5eaec, []int{38}
+	return fileDescriptor_df6e82b174b5eaec, []int{40}
 }
 func (m *ListAppLinksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -3164,6 +3312,8 @@ func init() {
 	proto.RegisterType((*ManagedResourcesResponse)(nil), "application.ManagedResourcesResponse")
 	proto.RegisterType((*ApplicationServerSideDiffQuery)(nil), "application.ApplicationServerSideDiffQuery")
 	proto.RegisterType((*ApplicationServerSideDiffResponse)(nil), "application.ApplicationServerSideDiffResponse")
+	proto.RegisterType((*ApplicationHydrateDryRunQuery)(nil), "application.ApplicationHydrateDryRunQuery")
+	proto.RegisterType((*ApplicationHydrateDryRunResponse)(nil), "application.ApplicationHydrateDryRunResponse")
 	proto.RegisterType((*LinkInfo)(nil), "application.LinkInfo")
 	proto.RegisterType((*LinksResponse)(nil), "application.LinksResponse")
 	proto.RegisterType((*ListAppLinksRequest)(nil), "application.ListAppLinksRequest")
@@ -3174,196 +3324,203 @@ func init() {
 }
 
 var fileDescriptor_df6e82b174b5eaec = []byte{
-	// 3018 bytes of a gzipped FileDescriptorProto
+	// 3136 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5b, 0xcf, 0x8f, 0x1c, 0x47,
 	0xf5, 0xff, 0xd6, 0xcc, 0xce, 0xee, 0xec, 0x1b, 0xaf, 0x7f, 0x54, 0x6c, 0x7f, 0x3b, 0xe3, 0x8d,
-	0xd9, 0xb4, 0xed, 0x78, 0xb3, 0xf6, 0xce, 0xd8, 0x13, 0x03, 0xc9, 0x26, 0x21, 0x38, 0x6b, 0xc7,
-	0x59, 0x58, 0x3b, 0xa6, 0xd7, 0x89, 0x51, 0x38, 0x40, 0xa5, 0xbb, 0x66, 0xa6, 0xd9, 0x9e, 0xee,
-	0x76, 0x77, 0xcf, 0x84, 0x55, 0x88, 0x84, 0x82, 0x90, 0x38, 0xa0, 0x20, 0x20, 0x07, 0x0e, 0xfc,
-	0x4c, 0x14, 0x84, 0x10, 0x88, 0x0b, 0x42, 0x48, 0x08, 0x09, 0x0e, 0x41, 0x70, 0x40, 0x42, 0xf0,
-	0x0f, 0x20, 0x0b, 0x71, 0xe0, 0x40, 0x2e, 0x39, 0x23, 0x54, 0xd5, 0x55, 0xdd, 0x5d, 0xf3, 0xa3,
-	0x67, 0x96, 0x19, 0x88, 0x25, 0x4e, 0xee, 0x57, 0xd3, 0xfd, 0xde, 0xe7, 0xbd, 0x7a, 0xef, 0xd5,
-	0xab, 0xf7, 0xd6, 0x70, 0x3a, 0xa4, 0x41, 0x8f, 0x06, 0x75, 0xe2, 0xfb, 0x8e, 0x6d, 0x92, 0xc8,
-	0xf6, 0xdc, 0xec, 0x73, 0xcd, 0x0f, 0xbc, 0xc8, 0xc3, 0x95, 0xcc, 0x52, 0x75, 0xb9, 0xe5, 0x79,
-	0x2d, 0x87, 0xd6, 0x89, 0x6f, 0xd7, 0x89, 0xeb, 0x7a, 0x11, 0x5f, 0x0e, 0xe3, 0x57, 0xab, 0x97,
-	0x76, 0x1f, 0x0d, 0x6b, 0xb6, 0xc7, 0x7e, 0xed, 0x10, 0xb3, 0x6d, 0xbb, 0x34, 0xd8, 0xab, 0xfb,
-	0xbb, 0x2d, 0xb6, 0x10, 0xd6, 0x3b, 0x34, 0x22, 0xf5, 0xde, 0xc5, 0x7a, 0x8b, 0xba, 0x34, 0x20,
-	0x11, 0xb5, 0xc4, 0x57, 0xdb, 0x2d, 0x3b, 0x6a, 0x77, 0x5f, 0xaa, 0x99, 0x5e, 0xa7, 0x4e, 0x82,
-	0x96, 0xe7, 0x07, 0xde, 0x67, 0xf9, 0xc3, 0xba, 0x69, 0xd5, 0x7b, 0x8f, 0xa4, 0x0c, 0xb2, 0x38,
-	0x7b, 0x17, 0x89, 0xe3, 0xb7, 0xc9, 0x20, 0xb7, 0xab, 0x63, 0xb8, 0x05, 0xd4, 0xf7, 0x84, 0xde,
-	0xfc, 0xd1, 0x8e, 0xbc, 0x60, 0x2f, 0xf3, 0x28, 0xd8, 0x3c, 0x36, 0x86, 0x8d, 0x60, 0x41, 0x7b,
-	0xd4, 0x8d, 0x42, 0xf1, 0x4f, 0xfc, 0xa9, 0xfe, 0x1e, 0x82, 0xc3, 0x97, 0x53, 0xa8, 0x9f, 0xe8,
-	0xd2, 0x60, 0x0f, 0x63, 0x98, 0x73, 0x49, 0x87, 0x6a, 0x68, 0x05, 0xad, 0x2e, 0x1a, 0xfc, 0x19,
-	0x6b, 0xb0, 0x10, 0xd0, 0x66, 0x40, 0xc3, 0xb6, 0x56, 0xe0, 0xcb, 0x92, 0xc4, 0x55, 0x28, 0x33,
-	0x81, 0xd4, 0x8c, 0x42, 0xad, 0xb8, 0x52, 0x5c, 0x5d, 0x34, 0x12, 0x1a, 0xaf, 0xc2, 0xa1, 0x80,
-	0x86, 0x5e, 0x37, 0x30, 0xe9, 0x0b, 0x34, 0x08, 0x6d, 0xcf, 0xd5, 0xe6, 0xf8, 0xd7, 0xfd, 0xcb,
-	0x8c, 0x4b, 0x48, 0x1d, 0x6a, 0x46, 0x5e, 0xa0, 0x95, 0xf8, 0x2b, 0x09, 0xcd, 0xf0, 0x30, 0x9d,
-	0xb5, 0xf9, 0x18, 0x0f, 0x7b, 0xc6, 0x3a, 0x1c, 0x20, 0xbe, 0x7f, 0x83, 0x74, 0x68, 0xe8, 0x13,
-	0x93, 0x6a, 0x0b, 0xfc, 0x37, 0x65, 0x8d, 0x61, 0x16, 0x48, 0xb4, 0x32, 0x07, 0x26, 0x49, 0x7d,
-	0x13, 0x16, 0x6f, 0x78, 0x16, 0x1d, 0xad, 0x6e, 0x3f, 0xfb, 0xc2, 0x20, 0x7b, 0xfd, 0x1d, 0x04,
-	0xc7, 0x0c, 0xda, 0xb3, 0x19, 0xfe, 0xeb, 0x34, 0x22, 0x16, 0x89, 0x48, 0x3f, 0xc7, 0x42, 0xc2,
-	0xb1, 0x0a, 0xe5, 0x40, 0xbc, 0xac, 0x15, 0xf8, 0x7a, 0x42, 0x0f, 0x48, 0x2b, 0xe6, 0x2b, 0x13,
-	0x9b, 0x50, 0x92, 0x78, 0x05, 0x2a, 0xb1, 0x2d, 0xb7, 0x5c, 0x8b, 0x7e, 0x8e, 0x5b, 0xaf, 0x64,
-	0x64, 0x97, 0xf0, 0x32, 0x2c, 0xf6, 0x62, 0x3b, 0x6f, 0x59, 0xdc, 0x8a, 0x25, 0x23, 0x5d, 0xd0,
-	0xff, 0x86, 0xe0, 0x64, 0xc6, 0x07, 0x0c, 0xb1, 0x33, 0x57, 0xb9, 0x9f, 0x8c, 0x56, 0xe8, 0x3c,
-	0x1c, 0x91, 0x9b, 0xd8, 0x6f, 0xa7, 0xc1, 0x1f, 0x98, 0x8a, 0xd9, 0x45, 0xa9, 0x62, 0x76, 0x8d,
-	0x29, 0x22, 0xe9, 0xe7, 0xb7, 0xae, 0x08, 0x35, 0xb3, 0x4b, 0x03, 0x86, 0x2a, 0xe5, 0x1b, 0x6a,
-	0x5e, 0x31, 0x94, 0xfe, 0x77, 0x04, 0x5a, 0x46, 0xd1, 0xeb, 0xc4, 0xb5, 0x9b, 0x34, 0x8c, 0x26,
-	0xdd, 0x33, 0x34, 0xc3, 0x3d, 0x5b, 0x85, 0x43, 0xb1, 0x56, 0x37, 0x59, 0x28, 0xb3, 0xb4, 0xa4,
-	0x95, 0x56, 0x8a, 0xab, 0x45, 0xa3, 0x7f, 0x99, 0xed, 0x9d, 0x94, 0x19, 0x6a, 0xf3, 0xdc, 0x8d,
-	0xd3, 0x05, 0x26, 0xc1, 0xf5, 0x36, 0x89, 0xd9, 0x8e, 0x23, 0xa0, 0x6c, 0x48, 0x52, 0x7f, 0x10,
-	0x16, 0x9f, 0xb1, 0x1d, 0xba, 0xd9, 0xee, 0xba, 0xbb, 0xf8, 0x28, 0x94, 0x4c, 0xf6, 0xc0, 0xb5,
-	0x3b, 0x60, 0xc4, 0x84, 0xfe, 0x35, 0x04, 0x0f, 0x8e, 0xb2, 0xc7, 0x6d, 0x3b, 0x6a, 0xb3, 0xef,
-	0xc3, 0x51, 0x86, 0x31, 0xdb, 0xd4, 0xdc, 0x0d, 0xbb, 0x1d, 0xe9, 0xcc, 0x92, 0x9e, 0xce, 0x30,
-	0xfa, 0x8f, 0x10, 0xac, 0x8e, 0xc5, 0x74, 0x3b, 0x20, 0xbe, 0x4f, 0x03, 0xfc, 0x0c, 0x94, 0xee,
-	0xb0, 0x1f, 0x78, 0xe8, 0x56, 0x1a, 0xb5, 0x5a, 0xf6, 0x44, 0x18, 0xcb, 0xe5, 0xd9, 0xff, 0x33,
-	0xe2, 0xcf, 0x71, 0x4d, 0x9a, 0xa7, 0xc0, 0xf9, 0x1c, 0x57, 0xf8, 0x24, 0x56, 0x64, 0xef, 0xf3,
-	0xd7, 0x9e, 0x9e, 0x87, 0x39, 0x9f, 0x04, 0x91, 0x7e, 0x0c, 0xee, 0x53, 0x03, 0xc7, 0xf7, 0xdc,
-	0x90, 0xea, 0xbf, 0x54, 0xfd, 0x6c, 0x33, 0xa0, 0x24, 0xa2, 0x06, 0xbd, 0xd3, 0xa5, 0x61, 0x84,
-	0x77, 0x21, 0x7b, 0x48, 0x71, 0xab, 0x56, 0x1a, 0x5b, 0xb5, 0x34, 0x85, 0xd7, 0x64, 0x0a, 0xe7,
-	0x0f, 0x9f, 0x36, 0xad, 0x5a, 0xef, 0x91, 0x9a, 0xbf, 0xdb, 0xaa, 0xb1, 0x73, 0x45, 0x41, 0x26,
-	0xcf, 0x95, 0xac, 0xaa, 0x46, 0x96, 0x3b, 0x3e, 0x0e, 0xf3, 0x5d, 0x3f, 0xa4, 0x41, 0xc4, 0x35,
-	0x2b, 0x1b, 0x82, 0x62, 0xfb, 0xd7, 0x23, 0x8e, 0x6d, 0x91, 0x28, 0xde, 0x9f, 0xb2, 0x91, 0xd0,
-	0xfa, 0xaf, 0x54, 0xf4, 0xcf, 0xfb, 0xd6, 0xfb, 0x85, 0x3e, 0x8b, 0xb2, 0xa0, 0xa2, 0xcc, 0x7a,
-	0x50, 0x51, 0xf5, 0xa0, 0x9f, 0xa9, 0xf8, 0xaf, 0x50, 0x87, 0xa6, 0xf8, 0x87, 0x39, 0xb3, 0x06,
-	0x0b, 0x26, 0x09, 0x4d, 0x62, 0x49, 0x29, 0x92, 0x64, 0x29, 0xce, 0x0f, 0x3c, 0x9f, 0xb4, 0x38,
-	0xa7, 0x9b, 0x9e, 0x63, 0x9b, 0x7b, 0x42, 0xdc, 0xe0, 0x0f, 0x03, 0x8e, 0x3f, 0x97, 0xef, 0xf8,
-	0x25, 0x15, 0xf6, 0x29, 0xa8, 0xec, 0xec, 0xb9, 0xe6, 0x73, 0x7e, 0x1c, 0xf6, 0x47, 0xa1, 0x64,
-	0x47, 0xb4, 0x13, 0x6a, 0x88, 0x87, 0x7c, 0x4c, 0xe8, 0xff, 0x2c, 0xc1, 0xf1, 0x8c, 0x6e, 0xec,
-	0x83, 0x3c, 0xcd, 0xf2, 0xf2, 0xd7, 0x71, 0x98, 0xb7, 0x82, 0x3d, 0xa3, 0xeb, 0x0a, 0x07, 0x10,
-	0x14, 0x13, 0xec, 0x07, 0x5d, 0x37, 0x86, 0x5f, 0x36, 0x62, 0x02, 0x37, 0xa1, 0x1c, 0x46, 0xac,
-	0x74, 0x69, 0xed, 0x71, 0xe0, 0x95, 0xc6, 0xc7, 0xa6, 0xdb, 0x74, 0x06, 0x7d, 0x47, 0x70, 0x34,
-	0x12, 0xde, 0xf8, 0x0e, 0xcb, 0x76, 0x71, 0x0a, 0x0c, 0xb5, 0x85, 0x95, 0xe2, 0x6a, 0xa5, 0xb1,
-	0x33, 0xbd, 0xa0, 0xe7, 0x7c, 0x56, 0x76, 0x65, 0xce, 0x36, 0x23, 0x95, 0xc2, 0x12, 0x6c, 0x47,
-	0xe4, 0x87, 0x50, 0xd4, 0x09, 0xe9, 0x02, 0xfe, 0x24, 0x94, 0x6c, 0xb7, 0xe9, 0x85, 0xda, 0x22,
-	0x07, 0xf3, 0xf4, 0x74, 0x60, 0xb6, 0xdc, 0xa6, 0x67, 0xc4, 0x0c, 0xf1, 0x1d, 0x58, 0x0a, 0x68,
-	0x14, 0xec, 0x49, 0x2b, 0x68, 0xc0, 0xed, 0xfa, 0xf1, 0xe9, 0x24, 0x18, 0x59, 0x96, 0x86, 0x2a,
-	0x01, 0x6f, 0x40, 0x25, 0x4c, 0x7d, 0x4c, 0xab, 0x70, 0x81, 0x9a, 0xc2, 0x28, 0xe3, 0x83, 0x46,
-	0xf6, 0xe5, 0x01, 0xef, 0x3e, 0x90, 0xef, 0xdd, 0x4b, 0x63, 0xcf, 0xbb, 0x83, 0x13, 0x9c, 0x77,
-	0x87, 0xfa, 0xce, 0x3b, 0xfd, 0x5d, 0x04, 0xcb, 0x03, 0xc9, 0x69, 0xc7, 0xa7, 0xb9, 0x61, 0x40,
-	0x60, 0x2e, 0xf4, 0xa9, 0xc9, 0x4f, 0xaa, 0x4a, 0xe3, 0xfa, 0xcc, 0xb2, 0x15, 0x97, 0xcb, 0x59,
-	0xe7, 0x25, 0xd4, 0x29, 0xf3, 0xc2, 0x77, 0x11, 0xfc, 0x7f, 0x46, 0xe6, 0x4d, 0x12, 0x99, 0xed,
-	0x3c, 0x65, 0x59, 0xfc, 0xb2, 0x77, 0xc4, 0xb9, 0x1c, 0x13, 0xcc, 0xaa, 0xfc, 0xe1, 0xd6, 0x9e,
-	0xcf, 0x00, 0xb2, 0x5f, 0xd2, 0x85, 0x29, 0xcb, 0xaa, 0x1f, 0x23, 0xa8, 0x66, 0x73, 0xb8, 0xe7,
-	0x38, 0x2f, 0x11, 0x73, 0x37, 0x0f, 0xe4, 0x41, 0x28, 0xd8, 0x16, 0x47, 0x58, 0x34, 0x0a, 0xb6,
-	0xb5, 0xcf, 0x64, 0xd4, 0x0f, 0x77, 0x3e, 0x1f, 0xee, 0x82, 0x0a, 0xf7, 0xbd, 0x3e, 0xb8, 0x32,
-	0x25, 0xe4, 0xc0, 0x5d, 0x86, 0x45, 0xb7, 0xaf, 0xc4, 0x4d, 0x17, 0x86, 0x94, 0xb6, 0x85, 0x81,
-	0xd2, 0x56, 0x83, 0x85, 0x5e, 0x72, 0x01, 0x62, 0x3f, 0x4b, 0x92, 0xa9, 0xd8, 0x0a, 0xbc, 0xae,
-	0x2f, 0x8c, 0x1e, 0x13, 0x0c, 0xc5, 0xae, 0xed, 0xb2, 0x62, 0x9d, 0xa3, 0x60, 0xcf, 0xfb, 0xbf,
-	0xf2, 0x28, 0x6a, 0xff, 0xa4, 0x00, 0x1f, 0x18, 0xa2, 0xf6, 0x58, 0x7f, 0xba, 0x37, 0x74, 0x4f,
-	0xbc, 0x7a, 0x61, 0xa4, 0x57, 0x97, 0xc7, 0x79, 0xf5, 0x62, 0xbe, 0xbd, 0x40, 0xb5, 0xd7, 0x0f,
-	0x0b, 0xb0, 0x32, 0xc4, 0x5e, 0xe3, 0xcb, 0x89, 0x7b, 0xc6, 0x60, 0x4d, 0x2f, 0x30, 0xe5, 0xb5,
-	0x20, 0x26, 0x58, 0x9c, 0x79, 0x81, 0xdf, 0x26, 0x2e, 0xf7, 0x8e, 0xb2, 0x21, 0xa8, 0x29, 0x4d,
-	0x75, 0x05, 0x34, 0x69, 0x9e, 0xcb, 0x66, 0x9c, 0xa4, 0x02, 0xd2, 0xa1, 0x11, 0x0d, 0xc2, 0x51,
-	0x29, 0xaa, 0x47, 0x9c, 0x2e, 0x95, 0x29, 0x8a, 0x13, 0xfa, 0xeb, 0x85, 0x7e, 0x36, 0x46, 0xd7,
-	0xbd, 0xf7, 0x0d, 0x7d, 0x1c, 0xe6, 0x09, 0x47, 0x2b, 0x5c, 0x53, 0x50, 0x03, 0x26, 0x2d, 0xe7,
-	0x9b, 0x74, 0x51, 0x31, 0xe9, 0x46, 0x41, 0x43, 0xfa, 0xbb, 0x05, 0xa8, 0x8e, 0x32, 0xc8, 0x0b,
-	0x8d, 0xff, 0x35, 0x93, 0x60, 0x02, 0x5a, 0x30, 0xc2, 0xcb, 0x34, 0xe0, 0xc5, 0xd9, 0x19, 0xe5,
-	0xc4, 0x1e, 0xe5, 0x92, 0xc6, 0x48, 0x36, 0xfa, 0x97, 0x10, 0x9c, 0x50, 0x3f, 0x0b, 0xb7, 0xed,
-	0x30, 0x92, 0x17, 0x3b, 0xdc, 0x84, 0x85, 0x58, 0x95, 0xb8, 0x2c, 0xaf, 0x34, 0xb6, 0xa7, 0x2d,
-	0xd6, 0x94, 0xdd, 0x95, 0xcc, 0xf5, 0xc7, 0xe0, 0xc4, 0xd0, 0x13, 0x4a, 0xc0, 0xa8, 0x42, 0x59,
-	0x16, 0xa8, 0x62, 0xf7, 0x13, 0x5a, 0x7f, 0x6b, 0x4e, 0x2d, 0x17, 0x3c, 0x6b, 0xdb, 0x6b, 0xe5,
-	0x74, 0x71, 0xf2, 0x3d, 0x86, 0xed, 0x86, 0x67, 0x65, 0x1a, 0x36, 0x92, 0x64, 0xdf, 0x99, 0x9e,
-	0x1b, 0x11, 0xdb, 0xa5, 0x81, 0xa8, 0x68, 0xd2, 0x05, 0xb6, 0xd3, 0xa1, 0xed, 0x9a, 0x74, 0x87,
-	0x9a, 0x9e, 0x6b, 0x85, 0xdc, 0x65, 0x8a, 0x86, 0xb2, 0x86, 0x9f, 0x85, 0x45, 0x4e, 0xdf, 0xb2,
-	0x3b, 0xf1, 0x11, 0x5e, 0x69, 0xac, 0xd5, 0xe2, 0xa6, 0x6c, 0x2d, 0xdb, 0x94, 0x4d, 0x6d, 0xd8,
-	0xa1, 0x11, 0xa9, 0xf5, 0x2e, 0xd6, 0xd8, 0x17, 0x46, 0xfa, 0x31, 0xc3, 0x12, 0x11, 0xdb, 0xd9,
-	0xb6, 0x5d, 0x7e, 0x69, 0x60, 0xa2, 0xd2, 0x05, 0xe6, 0x8d, 0x4d, 0xcf, 0x71, 0xbc, 0x97, 0x65,
-	0xce, 0x8b, 0x29, 0xf6, 0x55, 0xd7, 0x8d, 0x6c, 0x87, 0xcb, 0x8f, 0x7d, 0x2d, 0x5d, 0xe0, 0x5f,
-	0xd9, 0x4e, 0x44, 0x03, 0x91, 0xec, 0x04, 0x95, 0xf8, 0x7b, 0x25, 0x6e, 0x16, 0xca, 0x5c, 0x1b,
-	0x47, 0xc6, 0x81, 0x6c, 0x64, 0xf4, 0x47, 0xdb, 0xd2, 0x90, 0x8e, 0x17, 0xef, 0x9d, 0xd2, 0x9e,
-	0xed, 0x75, 0x59, 0x3d, 0xcc, 0xcb, 0x46, 0x49, 0x0f, 0x44, 0xcb, 0xa1, 0xfc, 0x68, 0x39, 0xac,
-	0x46, 0x0b, 0xbf, 0xd5, 0x44, 0x66, 0x7b, 0x93, 0x84, 0x54, 0x3b, 0xc2, 0x59, 0xa7, 0x0b, 0xfa,
-	0xaf, 0x11, 0x94, 0xb7, 0xbd, 0xd6, 0x55, 0x37, 0x0a, 0xf6, 0xf8, 0xfd, 0xd7, 0x73, 0x23, 0xea,
-	0x4a, 0x6f, 0x92, 0x24, 0xdb, 0xa2, 0xc8, 0xee, 0xd0, 0x9d, 0x88, 0x74, 0x7c, 0x51, 0x3d, 0xef,
-	0x6b, 0x8b, 0x92, 0x8f, 0x99, 0xd9, 0x1c, 0x12, 0x46, 0x3c, 0xe5, 0x94, 0x0d, 0xfe, 0xcc, 0x14,
-	0x4c, 0x5e, 0xd8, 0x89, 0x02, 0x91, 0x6f, 0x94, 0xb5, 0xac, 0x03, 0x96, 0x62, 0x6c, 0x82, 0xd4,
-	0x3b, 0x70, 0x7f, 0x72, 0xad, 0xbb, 0x45, 0x83, 0x8e, 0xed, 0x92, 0xfc, 0x73, 0x79, 0x82, 0x96,
-	0x6e, 0x4e, 0x57, 0xc1, 0x53, 0x42, 0x92, 0xdd, 0x92, 0x6e, 0xdb, 0xae, 0xe5, 0xbd, 0x9c, 0x13,
-	0x5a, 0xd3, 0x09, 0xfc, 0x93, 0xda, 0x95, 0xcd, 0x48, 0x4c, 0xf2, 0xc0, 0xb3, 0xb0, 0xc4, 0x32,
-	0x46, 0x8f, 0x8a, 0x1f, 0x44, 0x52, 0xd2, 0x47, 0xb5, 0xc1, 0x52, 0x1e, 0x86, 0xfa, 0x21, 0xde,
-	0x86, 0x43, 0x24, 0x0c, 0xed, 0x96, 0x4b, 0x2d, 0xc9, 0xab, 0x30, 0x31, 0xaf, 0xfe, 0x4f, 0xe3,
-	0x86, 0x0a, 0x7f, 0x43, 0xec, 0xb7, 0x24, 0xf5, 0x2f, 0x22, 0x38, 0x36, 0x94, 0x49, 0x12, 0x57,
-	0x28, 0x73, 0x8e, 0x54, 0xa1, 0x1c, 0x9a, 0x6d, 0x6a, 0x75, 0x1d, 0x59, 0x2a, 0x24, 0x34, 0xfb,
-	0xcd, 0xea, 0xc6, 0xbb, 0x2f, 0xce, 0xb1, 0x84, 0xc6, 0x27, 0x01, 0x3a, 0xc4, 0xed, 0x12, 0x87,
-	0x43, 0x98, 0xe3, 0x10, 0x32, 0x2b, 0xfa, 0x32, 0x54, 0x87, 0xb9, 0x8e, 0xe8, 0xde, 0xfd, 0x03,
-	0xc1, 0x41, 0x99, 0x72, 0xc5, 0xee, 0xae, 0xc2, 0xa1, 0x8c, 0x19, 0x6e, 0xa4, 0x1b, 0xdd, 0xbf,
-	0x3c, 0x26, 0x9d, 0x4a, 0x2f, 0x29, 0xaa, 0x83, 0x95, 0x9e, 0x32, 0x1a, 0x99, 0xf8, 0xc0, 0x45,
-	0x33, 0xba, 0x19, 0x7c, 0x1e, 0xb4, 0xeb, 0xc4, 0x25, 0x2d, 0x6a, 0x25, 0x6a, 0x27, 0x2e, 0xf6,
-	0x99, 0x6c, 0x1b, 0x6a, 0xea, 0xa6, 0x4f, 0x52, 0x44, 0xdb, 0xcd, 0xa6, 0x6c, 0x69, 0xbd, 0x51,
-	0x50, 0xfd, 0x9c, 0xcf, 0xaa, 0x76, 0x6c, 0x8b, 0xbf, 0x14, 0x9b, 0x5f, 0x83, 0x05, 0xa1, 0x8a,
-	0x4c, 0x50, 0x82, 0x9c, 0x2e, 0xc4, 0xb0, 0x0f, 0x4b, 0x8e, 0xdd, 0xa3, 0x89, 0xd6, 0xda, 0xdc,
-	0xcc, 0x95, 0x54, 0x05, 0x30, 0x47, 0x8a, 0x48, 0xd0, 0xa2, 0xd1, 0xf5, 0xa4, 0xe3, 0x54, 0xe2,
-	0x2d, 0x8e, 0xfe, 0x65, 0xfd, 0xfb, 0x6a, 0x6f, 0x5e, 0x35, 0xcb, 0x7f, 0x6f, 0x7b, 0x78, 0xad,
-	0xe1, 0x59, 0x76, 0xd3, 0xa6, 0xf1, 0x7d, 0xbd, 0x6c, 0x24, 0xb4, 0x1e, 0x40, 0x79, 0xdb, 0x76,
-	0x77, 0xb7, 0xdc, 0xa6, 0xc7, 0x9c, 0x35, 0xb2, 0x23, 0x47, 0xee, 0x50, 0x4c, 0xe0, 0xc3, 0x50,
-	0xec, 0x06, 0x8e, 0x08, 0x5e, 0xf6, 0x88, 0x57, 0xa0, 0x62, 0xd1, 0xd0, 0x0c, 0x6c, 0x5f, 0x84,
-	0x2e, 0x9f, 0xf1, 0x64, 0x96, 0x58, 0x08, 0xd9, 0xa6, 0xe7, 0x6e, 0x3a, 0x24, 0x0c, 0x65, 0x65,
-	0x91, 0x2c, 0xe8, 0x4f, 0xc0, 0x12, 0x93, 0x99, 0x7a, 0xe8, 0x39, 0xd5, 0x04, 0xc7, 0x14, 0xd5,
-	0x24, 0x3c, 0xe9, 0x6c, 0x04, 0xee, 0x63, 0x05, 0xdd, 0x65, 0xdf, 0x17, 0x4c, 0x26, 0xbc, 0x5d,
-	0x14, 0x87, 0x15, 0x46, 0x43, 0x07, 0x18, 0x8d, 0xbb, 0x67, 0x01, 0xf7, 0x6d, 0x9c, 0x6d, 0x52,
-	0xfc, 0x75, 0x04, 0x73, 0x4c, 0x34, 0x7e, 0x60, 0x54, 0x46, 0xe5, 0xbe, 0x5e, 0x9d, 0x5d, 0x77,
-	0x8a, 0x49, 0xd3, 0x97, 0x5f, 0xfb, 0xf3, 0x5f, 0xbf, 0x51, 0x38, 0x8e, 0x8f, 0xf2, 0x29, 0x78,
-	0xef, 0x62, 0x76, 0x2e, 0x1d, 0xe2, 0x2f, 0x20, 0xc0, 0xa2, 0xc0, 0xcd, 0x8c, 0xfc, 0xf0, 0xb9,
-	0x51, 0x10, 0x87, 0x8c, 0x06, 0xab, 0x47, 0x6a, 0x62, 0xa0, 0xcc, 0x17, 0xb9, 0xd0, 0x35, 0x2e,
-	0xf4, 0x34, 0xd6, 0x87, 0x09, 0xad, 0xbf, 0xc2, 0xac, 0xf8, 0xaa, 0x18, 0x43, 0xe3, 0x37, 0x11,
-	0x94, 0x6e, 0xf3, 0xcb, 0xfc, 0x18, 0xc3, 0xec, 0xcc, 0xcc, 0x30, 0x5c, 0x1c, 0x47, 0xab, 0x9f,
-	0xe2, 0x48, 0x1f, 0xc0, 0x27, 0x24, 0xd2, 0x30, 0x0a, 0x28, 0xe9, 0x28, 0x80, 0x2f, 0x20, 0xfc,
-	0x36, 0x82, 0xf9, 0x78, 0x8a, 0x83, 0xcf, 0x8c, 0x42, 0xa9, 0x4c, 0x79, 0xaa, 0xb3, 0x1b, 0x89,
-	0xe8, 0x0f, 0x73, 0x8c, 0xa7, 0xf4, 0xa1, 0x5b, 0xb8, 0xa1, 0x0c, 0x4c, 0xde, 0x40, 0x50, 0xbc,
-	0x46, 0xc7, 0xfa, 0xd8, 0x0c, 0xc1, 0x0d, 0x18, 0x70, 0xc8, 0x56, 0xe3, 0xb7, 0x10, 0xdc, 0x7f,
-	0x8d, 0x46, 0xc3, 0xab, 0x19, 0xbc, 0x3a, 0xbe, 0xc4, 0x10, 0xae, 0x76, 0x6e, 0x82, 0x37, 0x93,
-	0x63, 0xbc, 0xce, 0x91, 0x3d, 0x8c, 0xcf, 0xe6, 0x39, 0x61, 0xb8, 0xe7, 0x9a, 0x2f, 0x0b, 0x1c,
-	0xbf, 0x47, 0x70, 0xb8, 0x7f, 0x9c, 0x8f, 0xf5, 0xbe, 0x2b, 0xe5, 0x90, 0x69, 0x7f, 0xf5, 0xc6,
-	0xb4, 0x59, 0x57, 0x65, 0xaa, 0x5f, 0xe6, 0xc8, 0x1f, 0xc7, 0x8f, 0xe5, 0x21, 0x4f, 0x5a, 0xe2,
-	0xf5, 0x57, 0xe4, 0xe3, 0xab, 0xfc, 0xaf, 0x56, 0x38, 0xec, 0x3f, 0x20, 0x38, 0x2a, 0xf9, 0x6e,
-	0xb6, 0x49, 0x10, 0x5d, 0xa1, 0xec, 0x42, 0x14, 0x4e, 0xa4, 0xcf, 0x94, 0xa7, 0x48, 0x56, 0x9e,
-	0x7e, 0x95, 0xeb, 0xf2, 0x14, 0x7e, 0x72, 0xdf, 0xba, 0x98, 0x8c, 0x8d, 0x25, 0x60, 0xbf, 0x83,
-	0xe0, 0xe0, 0x35, 0x1a, 0x3d, 0xb7, 0xb9, 0xb5, 0xaf, 0x9d, 0x99, 0xd2, 0xd1, 0x33, 0xe2, 0xf4,
-	0x2b, 0x5c, 0x91, 0x8f, 0xe0, 0x27, 0xf6, 0xad, 0x88, 0x67, 0xda, 0xc9, 0xbe, 0xbc, 0x86, 0xe0,
-	0xc0, 0xb5, 0xcc, 0x31, 0x3f, 0x3a, 0x9d, 0x28, 0x23, 0xeb, 0xea, 0x72, 0x2d, 0xf3, 0x47, 0x3f,
-	0xf2, 0xa7, 0xc4, 0xd5, 0xd7, 0x39, 0xb6, 0xb3, 0xf8, 0x4c, 0x1e, 0xb6, 0x74, 0xa4, 0xf5, 0x26,
-	0x82, 0x63, 0x59, 0x10, 0xe9, 0xa8, 0xff, 0x83, 0xfb, 0x1b, 0xa0, 0x8b, 0x31, 0xfc, 0x18, 0x74,
-	0x0d, 0x8e, 0xee, 0xbc, 0x3e, 0x3c, 0x10, 0x3b, 0x03, 0x28, 0x36, 0xd0, 0xda, 0x2a, 0xc2, 0xbf,
-	0x41, 0x30, 0x1f, 0x4f, 0x77, 0x46, 0xdb, 0x48, 0x19, 0x4d, 0xcf, 0x32, 0xab, 0x09, 0xaf, 0xad,
-	0x5e, 0x18, 0x6e, 0xd0, 0xec, 0xf7, 0x72, 0x6b, 0x6b, 0xdc, 0xca, 0x6a, 0x3a, 0xfe, 0x39, 0x02,
-	0x48, 0x27, 0x54, 0xf8, 0xe1, 0x7c, 0x3d, 0x32, 0x53, 0xac, 0xea, 0x6c, 0x67, 0x54, 0x7a, 0x8d,
-	0xeb, 0xb3, 0x5a, 0x5d, 0xc9, 0xcd, 0x85, 0x3e, 0x35, 0x37, 0xe2, 0x69, 0xd6, 0xf7, 0x10, 0x94,
-	0xf8, 0x60, 0x00, 0x9f, 0x1e, 0x85, 0x39, 0x3b, 0x37, 0x98, 0xa5, 0xe9, 0x1f, 0xe2, 0x50, 0x57,
-	0x1a, 0x79, 0x07, 0xca, 0x06, 0x5a, 0xc3, 0x3d, 0x98, 0x8f, 0x5b, 0xf1, 0xa3, 0xdd, 0x43, 0x69,
-	0xd5, 0x57, 0x57, 0x72, 0x8a, 0x9a, 0xd8, 0x51, 0xc5, 0x59, 0xb6, 0x36, 0xee, 0x2c, 0x9b, 0x63,
-	0xc7, 0x0d, 0x3e, 0x95, 0x77, 0x18, 0xfd, 0x07, 0x0c, 0x73, 0x8e, 0xa3, 0x3b, 0xa3, 0xaf, 0x8c,
-	0x3b, 0xcf, 0x98, 0x75, 0xbe, 0x89, 0xe0, 0x70, 0xff, 0x9d, 0x0e, 0x9f, 0x18, 0xda, 0x1e, 0x15,
-	0x67, 0xab, 0x6a, 0xc5, 0x51, 0xf7, 0x41, 0xfd, 0xa3, 0x1c, 0xc5, 0x06, 0x7e, 0x74, 0x6c, 0x64,
-	0xdc, 0x90, 0x59, 0x87, 0x31, 0x5a, 0x4f, 0xc7, 0xed, 0x3f, 0x40, 0x70, 0x50, 0xbd, 0xcd, 0x8c,
-	0xae, 0x37, 0x87, 0x5c, 0x06, 0xab, 0xb5, 0xc9, 0x5e, 0x4e, 0x10, 0x7f, 0x98, 0x23, 0xbe, 0x88,
-	0xeb, 0x23, 0x11, 0xc7, 0x48, 0xe3, 0x3f, 0x92, 0x5c, 0x0f, 0x6d, 0x8b, 0xae, 0x5b, 0x0c, 0xd5,
-	0x2f, 0x10, 0x1c, 0x90, 0x06, 0xb8, 0x15, 0x50, 0x9a, 0x6f, 0xbf, 0xd9, 0x45, 0x2c, 0x93, 0xa5,
-	0x3f, 0xc1, 0x51, 0x7f, 0x08, 0x5f, 0x9a, 0xd0, 0xce, 0xd2, 0xbe, 0xeb, 0x11, 0x43, 0xfa, 0x5b,
-	0x04, 0x47, 0x6e, 0xc7, 0x01, 0xfa, 0x3e, 0xe1, 0xdf, 0xe4, 0xf8, 0x9f, 0xc4, 0x8f, 0xe7, 0x14,
-	0xd6, 0xe3, 0xd4, 0xb8, 0x80, 0xf0, 0x4f, 0x11, 0x94, 0xe5, 0x3c, 0x19, 0x9f, 0x1d, 0x19, 0xc1,
-	0xea, 0xc4, 0x79, 0x96, 0x51, 0x27, 0xaa, 0x48, 0xfd, 0x74, 0xee, 0xb1, 0x2f, 0xe4, 0xb3, 0xc8,
-	0x7b, 0x03, 0x01, 0x4e, 0x7a, 0x4a, 0x49, 0x97, 0x09, 0x3f, 0xa4, 0x88, 0x1a, 0xd9, 0xb8, 0xac,
-	0x9e, 0x1d, 0xfb, 0x9e, 0x7a, 0xe6, 0xaf, 0xe5, 0x9e, 0xf9, 0x5e, 0x22, 0xff, 0x75, 0x04, 0x95,
-	0x6b, 0x34, 0xb9, 0xe8, 0xe5, 0xd8, 0x52, 0x1d, 0x87, 0x57, 0x57, 0xc7, 0xbf, 0x28, 0x10, 0x9d,
-	0xe7, 0x88, 0x1e, 0xc2, 0xf9, 0xa6, 0x92, 0x00, 0xbe, 0x85, 0x60, 0xe9, 0x66, 0xd6, 0x45, 0xf1,
-	0xf9, 0x71, 0x92, 0x94, 0x23, 0x67, 0x72, 0x5c, 0x8f, 0x70, 0x5c, 0xeb, 0xfa, 0x44, 0xb8, 0x36,
-	0xc4, 0x64, 0xf9, 0x3b, 0x28, 0xee, 0x14, 0xf4, 0x4d, 0x83, 0xfe, 0x5d, 0xbb, 0xe5, 0x0c, 0x95,
-	0xf4, 0x4b, 0x1c, 0x5f, 0x0d, 0x9f, 0x9f, 0x04, 0x5f, 0x5d, 0x8c, 0x88, 0xf0, 0xb7, 0x11, 0x1c,
-	0xe1, 0xe3, 0xc0, 0x2c, 0x63, 0x9c, 0x37, 0x01, 0x4b, 0x87, 0x87, 0x13, 0x9c, 0x85, 0x4f, 0xc5,
-	0xf9, 0x47, 0xdf, 0x17, 0xa8, 0x0d, 0x31, 0xe8, 0xfb, 0x72, 0x01, 0xb1, 0xfd, 0xbd, 0x6f, 0x00,
-	0xdf, 0x0b, 0x8d, 0x3e, 0x03, 0x8e, 0x1e, 0x6f, 0x4e, 0x80, 0x71, 0x83, 0x63, 0xbc, 0xa4, 0xd7,
-	0xf7, 0x83, 0xb1, 0xde, 0x6b, 0xb0, 0x30, 0xfd, 0x2a, 0x82, 0x83, 0xb2, 0x3e, 0x10, 0xfe, 0xb7,
-	0x3e, 0x6e, 0x6b, 0xf7, 0x5b, 0x4f, 0x88, 0x80, 0x58, 0x9b, 0x2c, 0x20, 0xde, 0x46, 0xb0, 0x20,
-	0xa6, 0x75, 0x39, 0x55, 0x57, 0x66, 0x9c, 0x57, 0xed, 0x6b, 0x75, 0x89, 0x71, 0x8e, 0xfe, 0x29,
-	0x2e, 0xf6, 0x79, 0x9c, 0x6b, 0x16, 0xdf, 0xb3, 0xc2, 0xfa, 0x2b, 0x62, 0x96, 0xf2, 0x6a, 0xdd,
-	0xf1, 0x5a, 0xe1, 0x8b, 0x3a, 0xce, 0xad, 0x2d, 0xd8, 0x3b, 0x17, 0x10, 0x8e, 0x60, 0x91, 0xb9,
-	0x2f, 0xef, 0x9f, 0xe1, 0x95, 0xbe, 0x6e, 0xdb, 0x40, 0x6b, 0xad, 0x5a, 0x1d, 0xe8, 0xc7, 0xa5,
-	0xc5, 0x84, 0xe8, 0x6c, 0xe0, 0x07, 0x73, 0xc5, 0x72, 0x41, 0x5f, 0x41, 0x70, 0x24, 0x1b, 0x8f,
-	0xb1, 0xf8, 0x89, 0xa3, 0x31, 0x0f, 0x85, 0xb8, 0x9f, 0xe0, 0xb5, 0x89, 0xdc, 0x88, 0xc3, 0x79,
-	0xfa, 0x99, 0xdf, 0xdd, 0x3d, 0x89, 0xfe, 0x78, 0xf7, 0x24, 0xfa, 0xcb, 0xdd, 0x93, 0xe8, 0xc5,
-	0x47, 0x27, 0xfb, 0x4f, 0x21, 0xa6, 0x63, 0x53, 0x37, 0xca, 0xb2, 0xff, 0x57, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x64, 0xec, 0x77, 0x47, 0xd6, 0x32, 0x00, 0x00,
+	0xd9, 0xb4, 0xed, 0x78, 0xbd, 0xf6, 0xce, 0xd8, 0x13, 0x03, 0xc9, 0x26, 0x21, 0xd8, 0x6b, 0xc7,
+	0x5e, 0x58, 0x3b, 0xa6, 0xd7, 0x89, 0x51, 0x38, 0x40, 0xa5, 0xbb, 0x66, 0xa6, 0xd9, 0x99, 0xee,
+	0x76, 0x77, 0xcf, 0x84, 0x51, 0x88, 0x84, 0x02, 0x48, 0x1c, 0x20, 0x08, 0xf0, 0x81, 0x03, 0x10,
+	0x48, 0x14, 0x84, 0x10, 0x88, 0x0b, 0x42, 0x48, 0x08, 0x09, 0x0e, 0x41, 0x70, 0x40, 0x42, 0xf0,
+	0x0f, 0xa0, 0x08, 0x71, 0xe0, 0x40, 0x2e, 0x39, 0x23, 0x54, 0xd5, 0x55, 0xdd, 0x5d, 0xf3, 0xa3,
+	0x67, 0x96, 0x99, 0x90, 0x48, 0x9c, 0xb6, 0x5f, 0x4d, 0xd7, 0x7b, 0x9f, 0xf7, 0xea, 0xd5, 0xab,
+	0x57, 0xef, 0xf5, 0xc2, 0xc9, 0x80, 0xfa, 0x5d, 0xea, 0x57, 0x89, 0xe7, 0xb5, 0x6c, 0x93, 0x84,
+	0xb6, 0xeb, 0xa4, 0x9f, 0x2b, 0x9e, 0xef, 0x86, 0x2e, 0x2e, 0xa5, 0x86, 0xca, 0xcb, 0x0d, 0xd7,
+	0x6d, 0xb4, 0x68, 0x95, 0x78, 0x76, 0x95, 0x38, 0x8e, 0x1b, 0xf2, 0xe1, 0x20, 0x7a, 0xb5, 0x7c,
+	0x71, 0xf7, 0x91, 0xa0, 0x62, 0xbb, 0xec, 0xd7, 0x36, 0x31, 0x9b, 0xb6, 0x43, 0xfd, 0x5e, 0xd5,
+	0xdb, 0x6d, 0xb0, 0x81, 0xa0, 0xda, 0xa6, 0x21, 0xa9, 0x76, 0x2f, 0x54, 0x1b, 0xd4, 0xa1, 0x3e,
+	0x09, 0xa9, 0x25, 0x66, 0x6d, 0x37, 0xec, 0xb0, 0xd9, 0x79, 0xbe, 0x62, 0xba, 0xed, 0x2a, 0xf1,
+	0x1b, 0xae, 0xe7, 0xbb, 0x9f, 0xe5, 0x0f, 0xeb, 0xa6, 0x55, 0xed, 0x3e, 0x9c, 0x30, 0x48, 0xe3,
+	0xec, 0x5e, 0x20, 0x2d, 0xaf, 0x49, 0x06, 0xb9, 0x5d, 0x1d, 0xc3, 0xcd, 0xa7, 0x9e, 0x2b, 0xf4,
+	0xe6, 0x8f, 0x76, 0xe8, 0xfa, 0xbd, 0xd4, 0xa3, 0x60, 0xf3, 0xe8, 0x18, 0x36, 0x82, 0x05, 0xed,
+	0x52, 0x27, 0x0c, 0xc4, 0x9f, 0x68, 0xaa, 0xfe, 0x0e, 0x82, 0x83, 0x97, 0x12, 0xa8, 0x9f, 0xe8,
+	0x50, 0xbf, 0x87, 0x31, 0xcc, 0x39, 0xa4, 0x4d, 0x35, 0xb4, 0x82, 0x56, 0x17, 0x0d, 0xfe, 0x8c,
+	0x35, 0x58, 0xf0, 0x69, 0xdd, 0xa7, 0x41, 0x53, 0xcb, 0xf1, 0x61, 0x49, 0xe2, 0x32, 0x14, 0x99,
+	0x40, 0x6a, 0x86, 0x81, 0x96, 0x5f, 0xc9, 0xaf, 0x2e, 0x1a, 0x31, 0x8d, 0x57, 0xe1, 0x80, 0x4f,
+	0x03, 0xb7, 0xe3, 0x9b, 0xf4, 0x59, 0xea, 0x07, 0xb6, 0xeb, 0x68, 0x73, 0x7c, 0x76, 0xff, 0x30,
+	0xe3, 0x12, 0xd0, 0x16, 0x35, 0x43, 0xd7, 0xd7, 0x0a, 0xfc, 0x95, 0x98, 0x66, 0x78, 0x98, 0xce,
+	0xda, 0x7c, 0x84, 0x87, 0x3d, 0x63, 0x1d, 0xf6, 0x11, 0xcf, 0xbb, 0x49, 0xda, 0x34, 0xf0, 0x88,
+	0x49, 0xb5, 0x05, 0xfe, 0x9b, 0x32, 0xc6, 0x30, 0x0b, 0x24, 0x5a, 0x91, 0x03, 0x93, 0xa4, 0xbe,
+	0x09, 0x8b, 0x37, 0x5d, 0x8b, 0x8e, 0x56, 0xb7, 0x9f, 0x7d, 0x6e, 0x90, 0xbd, 0xfe, 0x26, 0x82,
+	0x23, 0x06, 0xed, 0xda, 0x0c, 0xff, 0x0d, 0x1a, 0x12, 0x8b, 0x84, 0xa4, 0x9f, 0x63, 0x2e, 0xe6,
+	0x58, 0x86, 0xa2, 0x2f, 0x5e, 0xd6, 0x72, 0x7c, 0x3c, 0xa6, 0x07, 0xa4, 0xe5, 0xb3, 0x95, 0x89,
+	0x4c, 0x28, 0x49, 0xbc, 0x02, 0xa5, 0xc8, 0x96, 0x5b, 0x8e, 0x45, 0x3f, 0xc7, 0xad, 0x57, 0x30,
+	0xd2, 0x43, 0x78, 0x19, 0x16, 0xbb, 0x91, 0x9d, 0xb7, 0x2c, 0x6e, 0xc5, 0x82, 0x91, 0x0c, 0xe8,
+	0x7f, 0x47, 0x70, 0x3c, 0xe5, 0x03, 0x86, 0x58, 0x99, 0xab, 0xdc, 0x4f, 0x46, 0x2b, 0x74, 0x0e,
+	0x0e, 0xc9, 0x45, 0xec, 0xb7, 0xd3, 0xe0, 0x0f, 0x4c, 0xc5, 0xf4, 0xa0, 0x54, 0x31, 0x3d, 0xc6,
+	0x14, 0x91, 0xf4, 0x33, 0x5b, 0x57, 0x84, 0x9a, 0xe9, 0xa1, 0x01, 0x43, 0x15, 0xb2, 0x0d, 0x35,
+	0xaf, 0x18, 0x4a, 0xff, 0x07, 0x02, 0x2d, 0xa5, 0xe8, 0x0d, 0xe2, 0xd8, 0x75, 0x1a, 0x84, 0x93,
+	0xae, 0x19, 0x9a, 0xe1, 0x9a, 0xad, 0xc2, 0x81, 0x48, 0xab, 0x5b, 0x6c, 0x2b, 0xb3, 0xb0, 0xa4,
+	0x15, 0x56, 0xf2, 0xab, 0x79, 0xa3, 0x7f, 0x98, 0xad, 0x9d, 0x94, 0x19, 0x68, 0xf3, 0xdc, 0x8d,
+	0x93, 0x01, 0x26, 0xc1, 0x71, 0x37, 0x89, 0xd9, 0x8c, 0x76, 0x40, 0xd1, 0x90, 0xa4, 0xfe, 0x20,
+	0x2c, 0x3e, 0x65, 0xb7, 0xe8, 0x66, 0xb3, 0xe3, 0xec, 0xe2, 0xc3, 0x50, 0x30, 0xd9, 0x03, 0xd7,
+	0x6e, 0x9f, 0x11, 0x11, 0xfa, 0x37, 0x10, 0x3c, 0x38, 0xca, 0x1e, 0x77, 0xec, 0xb0, 0xc9, 0xe6,
+	0x07, 0xa3, 0x0c, 0x63, 0x36, 0xa9, 0xb9, 0x1b, 0x74, 0xda, 0xd2, 0x99, 0x25, 0x3d, 0x9d, 0x61,
+	0xf4, 0x1f, 0x23, 0x58, 0x1d, 0x8b, 0xe9, 0x8e, 0x4f, 0x3c, 0x8f, 0xfa, 0xf8, 0x29, 0x28, 0xdc,
+	0x65, 0x3f, 0xf0, 0xad, 0x5b, 0xaa, 0x55, 0x2a, 0xe9, 0x13, 0x61, 0x2c, 0x97, 0xeb, 0xff, 0x67,
+	0x44, 0xd3, 0x71, 0x45, 0x9a, 0x27, 0xc7, 0xf9, 0x1c, 0x55, 0xf8, 0xc4, 0x56, 0x64, 0xef, 0xf3,
+	0xd7, 0x2e, 0xcf, 0xc3, 0x9c, 0x47, 0xfc, 0x50, 0x3f, 0x02, 0xf7, 0xa9, 0x1b, 0xc7, 0x73, 0x9d,
+	0x80, 0xea, 0xbf, 0x52, 0xfd, 0x6c, 0xd3, 0xa7, 0x24, 0xa4, 0x06, 0xbd, 0xdb, 0xa1, 0x41, 0x88,
+	0x77, 0x21, 0x7d, 0x48, 0x71, 0xab, 0x96, 0x6a, 0x5b, 0x95, 0x24, 0x84, 0x57, 0x64, 0x08, 0xe7,
+	0x0f, 0x9f, 0x36, 0xad, 0x4a, 0xf7, 0xe1, 0x8a, 0xb7, 0xdb, 0xa8, 0xb0, 0x73, 0x45, 0x41, 0x26,
+	0xcf, 0x95, 0xb4, 0xaa, 0x46, 0x9a, 0x3b, 0x3e, 0x0a, 0xf3, 0x1d, 0x2f, 0xa0, 0x7e, 0xc8, 0x35,
+	0x2b, 0x1a, 0x82, 0x62, 0xeb, 0xd7, 0x25, 0x2d, 0xdb, 0x22, 0x61, 0xb4, 0x3e, 0x45, 0x23, 0xa6,
+	0xf5, 0x5f, 0xab, 0xe8, 0x9f, 0xf1, 0xac, 0xf7, 0x0a, 0x7d, 0x1a, 0x65, 0x4e, 0x45, 0x99, 0xf6,
+	0xa0, 0xbc, 0xea, 0x41, 0x3f, 0x57, 0xf1, 0x5f, 0xa1, 0x2d, 0x9a, 0xe0, 0x1f, 0xe6, 0xcc, 0x1a,
+	0x2c, 0x98, 0x24, 0x30, 0x89, 0x25, 0xa5, 0x48, 0x92, 0x85, 0x38, 0xcf, 0x77, 0x3d, 0xd2, 0xe0,
+	0x9c, 0x6e, 0xb9, 0x2d, 0xdb, 0xec, 0x09, 0x71, 0x83, 0x3f, 0x0c, 0x38, 0xfe, 0x5c, 0xb6, 0xe3,
+	0x17, 0x54, 0xd8, 0x27, 0xa0, 0xb4, 0xd3, 0x73, 0xcc, 0xa7, 0xbd, 0x68, 0xdb, 0x1f, 0x86, 0x82,
+	0x1d, 0xd2, 0x76, 0xa0, 0x21, 0xbe, 0xe5, 0x23, 0x42, 0xff, 0x57, 0x01, 0x8e, 0xa6, 0x74, 0x63,
+	0x13, 0xb2, 0x34, 0xcb, 0x8a, 0x5f, 0x47, 0x61, 0xde, 0xf2, 0x7b, 0x46, 0xc7, 0x11, 0x0e, 0x20,
+	0x28, 0x26, 0xd8, 0xf3, 0x3b, 0x4e, 0x04, 0xbf, 0x68, 0x44, 0x04, 0xae, 0x43, 0x31, 0x08, 0x59,
+	0xea, 0xd2, 0xe8, 0x71, 0xe0, 0xa5, 0xda, 0xc7, 0xa6, 0x5b, 0x74, 0x06, 0x7d, 0x47, 0x70, 0x34,
+	0x62, 0xde, 0xf8, 0x2e, 0x8b, 0x76, 0x51, 0x08, 0x0c, 0xb4, 0x85, 0x95, 0xfc, 0x6a, 0xa9, 0xb6,
+	0x33, 0xbd, 0xa0, 0xa7, 0x3d, 0x96, 0x76, 0xa5, 0xce, 0x36, 0x23, 0x91, 0xc2, 0x02, 0x6c, 0x5b,
+	0xc4, 0x87, 0x40, 0xe4, 0x09, 0xc9, 0x00, 0xfe, 0x24, 0x14, 0x6c, 0xa7, 0xee, 0x06, 0xda, 0x22,
+	0x07, 0x73, 0x79, 0x3a, 0x30, 0x5b, 0x4e, 0xdd, 0x35, 0x22, 0x86, 0xf8, 0x2e, 0x2c, 0xf9, 0x34,
+	0xf4, 0x7b, 0xd2, 0x0a, 0x1a, 0x70, 0xbb, 0x7e, 0x7c, 0x3a, 0x09, 0x46, 0x9a, 0xa5, 0xa1, 0x4a,
+	0xc0, 0x1b, 0x50, 0x0a, 0x12, 0x1f, 0xd3, 0x4a, 0x5c, 0xa0, 0xa6, 0x30, 0x4a, 0xf9, 0xa0, 0x91,
+	0x7e, 0x79, 0xc0, 0xbb, 0xf7, 0x65, 0x7b, 0xf7, 0xd2, 0xd8, 0xf3, 0x6e, 0xff, 0x04, 0xe7, 0xdd,
+	0x81, 0xbe, 0xf3, 0x4e, 0x7f, 0x1b, 0xc1, 0xf2, 0x40, 0x70, 0xda, 0xf1, 0x68, 0xe6, 0x36, 0x20,
+	0x30, 0x17, 0x78, 0xd4, 0xe4, 0x27, 0x55, 0xa9, 0x76, 0x63, 0x66, 0xd1, 0x8a, 0xcb, 0xe5, 0xac,
+	0xb3, 0x02, 0xea, 0x94, 0x71, 0xe1, 0x55, 0x04, 0xff, 0x9f, 0x92, 0x79, 0x8b, 0x84, 0x66, 0x33,
+	0x4b, 0x59, 0xb6, 0x7f, 0xd9, 0x3b, 0xe2, 0x5c, 0x8e, 0x08, 0x66, 0x55, 0xfe, 0x70, 0xbb, 0xe7,
+	0x31, 0x80, 0xec, 0x97, 0x64, 0x60, 0xca, 0xb4, 0xea, 0x27, 0x08, 0xca, 0xe9, 0x18, 0xee, 0xb6,
+	0x5a, 0xcf, 0x13, 0x73, 0x37, 0x0b, 0xe4, 0x7e, 0xc8, 0xd9, 0x16, 0x47, 0x98, 0x37, 0x72, 0xb6,
+	0xb5, 0xc7, 0x60, 0xd4, 0x0f, 0x77, 0x3e, 0x1b, 0xee, 0x82, 0x0a, 0xf7, 0x9d, 0x3e, 0xb8, 0x32,
+	0x24, 0x64, 0xc0, 0x5d, 0x86, 0x45, 0xa7, 0x2f, 0xc5, 0x4d, 0x06, 0x86, 0xa4, 0xb6, 0xb9, 0x81,
+	0xd4, 0x56, 0x83, 0x85, 0x6e, 0x7c, 0x01, 0x62, 0x3f, 0x4b, 0x92, 0xa9, 0xd8, 0xf0, 0xdd, 0x8e,
+	0x27, 0x8c, 0x1e, 0x11, 0x0c, 0xc5, 0xae, 0xed, 0xb0, 0x64, 0x9d, 0xa3, 0x60, 0xcf, 0x7b, 0xbf,
+	0xf2, 0x28, 0x6a, 0xff, 0x34, 0x07, 0x1f, 0x18, 0xa2, 0xf6, 0x58, 0x7f, 0x7a, 0x7f, 0xe8, 0x1e,
+	0x7b, 0xf5, 0xc2, 0x48, 0xaf, 0x2e, 0x8e, 0xf3, 0xea, 0xc5, 0x6c, 0x7b, 0x81, 0x6a, 0xaf, 0x1f,
+	0xe5, 0x60, 0x65, 0x88, 0xbd, 0xc6, 0xa7, 0x13, 0xef, 0x1b, 0x83, 0xd5, 0x5d, 0xdf, 0x94, 0xd7,
+	0x82, 0x88, 0x60, 0xfb, 0xcc, 0xf5, 0xbd, 0x26, 0x71, 0xb8, 0x77, 0x14, 0x0d, 0x41, 0x4d, 0x69,
+	0xaa, 0x2b, 0xa0, 0x49, 0xf3, 0x5c, 0x32, 0xa3, 0x20, 0xe5, 0x93, 0x36, 0x0d, 0xa9, 0x1f, 0x8c,
+	0x0a, 0x51, 0x5d, 0xd2, 0xea, 0x50, 0x19, 0xa2, 0x38, 0xa1, 0xbf, 0x92, 0xeb, 0x67, 0x63, 0x74,
+	0x9c, 0xf7, 0xbf, 0xa1, 0x8f, 0xc2, 0x3c, 0xe1, 0x68, 0x85, 0x6b, 0x0a, 0x6a, 0xc0, 0xa4, 0xc5,
+	0x6c, 0x93, 0x2e, 0x2a, 0x26, 0xdd, 0xc8, 0x69, 0x48, 0x7f, 0x3b, 0x07, 0xe5, 0x51, 0x06, 0x79,
+	0xb6, 0xf6, 0xbf, 0x66, 0x12, 0x4c, 0x40, 0xf3, 0x47, 0x78, 0x99, 0x06, 0x3c, 0x39, 0x3b, 0xa5,
+	0x9c, 0xd8, 0xa3, 0x5c, 0xd2, 0x18, 0xc9, 0x46, 0xff, 0x32, 0x82, 0x63, 0xea, 0xb4, 0x60, 0xdb,
+	0x0e, 0x42, 0x79, 0xb1, 0xc3, 0x75, 0x58, 0x88, 0x54, 0x89, 0xd2, 0xf2, 0x52, 0x6d, 0x7b, 0xda,
+	0x64, 0x4d, 0x59, 0x5d, 0xc9, 0x5c, 0x7f, 0x14, 0x8e, 0x0d, 0x3d, 0xa1, 0x04, 0x8c, 0x32, 0x14,
+	0x65, 0x82, 0x2a, 0x56, 0x3f, 0xa6, 0xf5, 0xd7, 0xe7, 0xd4, 0x74, 0xc1, 0xb5, 0xb6, 0xdd, 0x46,
+	0x46, 0x15, 0x27, 0xdb, 0x63, 0xd8, 0x6a, 0xb8, 0x56, 0xaa, 0x60, 0x23, 0x49, 0x36, 0xcf, 0x74,
+	0x9d, 0x90, 0xd8, 0x0e, 0xf5, 0x45, 0x46, 0x93, 0x0c, 0xb0, 0x95, 0x0e, 0x6c, 0xc7, 0xa4, 0x3b,
+	0xd4, 0x74, 0x1d, 0x2b, 0xe0, 0x2e, 0x93, 0x37, 0x94, 0x31, 0x7c, 0x1d, 0x16, 0x39, 0x7d, 0xdb,
+	0x6e, 0x47, 0x47, 0x78, 0xa9, 0xb6, 0x56, 0x89, 0x8a, 0xb2, 0x95, 0x74, 0x51, 0x36, 0xb1, 0x61,
+	0x9b, 0x86, 0xa4, 0xd2, 0xbd, 0x50, 0x61, 0x33, 0x8c, 0x64, 0x32, 0xc3, 0x12, 0x12, 0xbb, 0xb5,
+	0x6d, 0x3b, 0xfc, 0xd2, 0xc0, 0x44, 0x25, 0x03, 0xcc, 0x1b, 0xeb, 0x6e, 0xab, 0xe5, 0xbe, 0x20,
+	0x63, 0x5e, 0x44, 0xb1, 0x59, 0x1d, 0x27, 0xb4, 0x5b, 0x5c, 0x7e, 0xe4, 0x6b, 0xc9, 0x00, 0x9f,
+	0x65, 0xb7, 0x42, 0xea, 0x8b, 0x60, 0x27, 0xa8, 0xd8, 0xdf, 0x4b, 0x51, 0xb1, 0x50, 0xc6, 0xda,
+	0x68, 0x67, 0xec, 0x4b, 0xef, 0x8c, 0xfe, 0xdd, 0xb6, 0x34, 0xa4, 0xe2, 0xc5, 0x6b, 0xa7, 0xb4,
+	0x6b, 0xbb, 0x1d, 0x96, 0x0f, 0xf3, 0xb4, 0x51, 0xd2, 0x03, 0xbb, 0xe5, 0x40, 0xf6, 0x6e, 0x39,
+	0xa8, 0xee, 0x16, 0x7e, 0xab, 0x09, 0xcd, 0xe6, 0x26, 0x09, 0xa8, 0x76, 0x88, 0xb3, 0x4e, 0x06,
+	0xf4, 0xdf, 0x20, 0x28, 0x6e, 0xbb, 0x8d, 0xab, 0x4e, 0xe8, 0xf7, 0xf8, 0xfd, 0xd7, 0x75, 0x42,
+	0xea, 0x48, 0x6f, 0x92, 0x24, 0x5b, 0xa2, 0xd0, 0x6e, 0xd3, 0x9d, 0x90, 0xb4, 0x3d, 0x91, 0x3d,
+	0xef, 0x69, 0x89, 0xe2, 0xc9, 0xcc, 0x6c, 0x2d, 0x12, 0x84, 0x3c, 0xe4, 0x14, 0x0d, 0xfe, 0xcc,
+	0x14, 0x8c, 0x5f, 0xd8, 0x09, 0x7d, 0x11, 0x6f, 0x94, 0xb1, 0xb4, 0x03, 0x16, 0x22, 0x6c, 0x82,
+	0xd4, 0xdb, 0x70, 0x7f, 0x7c, 0xad, 0xbb, 0x4d, 0xfd, 0xb6, 0xed, 0x90, 0xec, 0x73, 0x79, 0x82,
+	0x92, 0x6e, 0x46, 0x55, 0xc1, 0x55, 0xb6, 0x24, 0xbb, 0x25, 0xdd, 0xb1, 0x1d, 0xcb, 0x7d, 0x21,
+	0x63, 0x6b, 0x4d, 0x27, 0xf0, 0xcf, 0x6a, 0x55, 0x36, 0x25, 0x31, 0x8e, 0x03, 0xd7, 0x61, 0x89,
+	0x45, 0x8c, 0x2e, 0x15, 0x3f, 0x88, 0xa0, 0xa4, 0x8f, 0x2a, 0x83, 0x25, 0x3c, 0x0c, 0x75, 0x22,
+	0xde, 0x86, 0x03, 0x24, 0x08, 0xec, 0x86, 0x43, 0x2d, 0xc9, 0x2b, 0x37, 0x31, 0xaf, 0xfe, 0xa9,
+	0x51, 0x41, 0x85, 0xbf, 0x21, 0xd6, 0x5b, 0x92, 0xfa, 0x17, 0x11, 0x1c, 0x19, 0xca, 0x24, 0xde,
+	0x57, 0x28, 0x75, 0x8e, 0x94, 0xa1, 0x18, 0x98, 0x4d, 0x6a, 0x75, 0x5a, 0x32, 0x55, 0x88, 0x69,
+	0xf6, 0x9b, 0xd5, 0x89, 0x56, 0x5f, 0x9c, 0x63, 0x31, 0x8d, 0x8f, 0x03, 0xb4, 0x89, 0xd3, 0x21,
+	0x2d, 0x0e, 0x61, 0x8e, 0x43, 0x48, 0x8d, 0xe8, 0xcb, 0x50, 0x1e, 0xe6, 0x3a, 0xa2, 0x7a, 0xf7,
+	0x4f, 0x04, 0xfb, 0x65, 0xc8, 0x15, 0xab, 0xbb, 0x0a, 0x07, 0x52, 0x66, 0xb8, 0x99, 0x2c, 0x74,
+	0xff, 0xf0, 0x98, 0x70, 0x2a, 0xbd, 0x24, 0xaf, 0x36, 0x56, 0xba, 0x4a, 0x6b, 0x64, 0xe2, 0x03,
+	0x17, 0xcd, 0xe8, 0x66, 0xf0, 0x79, 0xd0, 0x6e, 0x10, 0x87, 0x34, 0xa8, 0x15, 0xab, 0x1d, 0xbb,
+	0xd8, 0x67, 0xd2, 0x65, 0xa8, 0xa9, 0x8b, 0x3e, 0x71, 0x12, 0x6d, 0xd7, 0xeb, 0xb2, 0xa4, 0x75,
+	0x2f, 0xa7, 0xfa, 0x39, 0xef, 0x55, 0xed, 0xd8, 0x16, 0x7f, 0x29, 0x32, 0xbf, 0x06, 0x0b, 0x42,
+	0x15, 0x19, 0xa0, 0x04, 0x39, 0xdd, 0x16, 0xc3, 0x1e, 0x2c, 0xb5, 0xec, 0x2e, 0x8d, 0xb5, 0xd6,
+	0xe6, 0x66, 0xae, 0xa4, 0x2a, 0x80, 0x39, 0x52, 0x48, 0xfc, 0x06, 0x0d, 0x6f, 0xc4, 0x15, 0xa7,
+	0x02, 0x2f, 0x71, 0xf4, 0x0f, 0xeb, 0x3f, 0x50, 0x6b, 0xf3, 0xaa, 0x59, 0xfe, 0x7b, 0xcb, 0xc3,
+	0x73, 0x0d, 0xd7, 0xb2, 0xeb, 0x36, 0x8d, 0xee, 0xeb, 0x45, 0x23, 0xa6, 0xf5, 0xaf, 0x21, 0x78,
+	0x20, 0x85, 0xf1, 0x7a, 0xcf, 0xf2, 0x49, 0x48, 0xaf, 0xf0, 0xab, 0xfb, 0xbb, 0x14, 0x16, 0x95,
+	0x92, 0xe6, 0x9c, 0x5a, 0xd2, 0xd4, 0xef, 0x21, 0xe5, 0xca, 0xa6, 0xe0, 0x89, 0x4d, 0x16, 0x95,
+	0x1a, 0x76, 0x9a, 0x44, 0x80, 0x12, 0x14, 0x3f, 0x8d, 0xf8, 0x1a, 0x5c, 0xf6, 0x89, 0x13, 0x97,
+	0x4f, 0x94, 0x31, 0xbc, 0x02, 0xa5, 0x66, 0xc4, 0xd4, 0x62, 0x0c, 0xa2, 0xb8, 0x93, 0x1e, 0x62,
+	0x0a, 0x5b, 0x76, 0xbd, 0x2e, 0xce, 0x32, 0xfe, 0xac, 0xfb, 0x50, 0xdc, 0xb6, 0x9d, 0xdd, 0x2d,
+	0xa7, 0xee, 0xb2, 0x3d, 0x1d, 0xda, 0x61, 0x4b, 0x5a, 0x24, 0x22, 0xf0, 0x41, 0xc8, 0x77, 0xfc,
+	0x96, 0x10, 0xc9, 0x1e, 0x99, 0x24, 0x8b, 0x06, 0xa6, 0x6f, 0x7b, 0x22, 0xc2, 0xf1, 0x56, 0x58,
+	0x6a, 0x88, 0x45, 0x1a, 0xdb, 0x74, 0x9d, 0xcd, 0x16, 0x09, 0x02, 0x99, 0x80, 0xc5, 0x03, 0xfa,
+	0xe3, 0xb0, 0xc4, 0x64, 0x26, 0x1b, 0xf9, 0xac, 0xea, 0x29, 0x47, 0x14, 0x0f, 0x90, 0xf0, 0xe4,
+	0x9e, 0x24, 0x70, 0x1f, 0xcb, 0x7b, 0x2f, 0x79, 0x9e, 0x60, 0x32, 0xe1, 0x25, 0x2c, 0x3f, 0x2c,
+	0x7f, 0x1c, 0xda, 0xe7, 0xa9, 0x7d, 0xe9, 0x0c, 0xe0, 0x3e, 0xff, 0xb6, 0x4d, 0x8a, 0xbf, 0x89,
+	0x60, 0x8e, 0x89, 0xc6, 0x0f, 0x8c, 0x3a, 0x78, 0xb8, 0x63, 0x95, 0x67, 0x57, 0xc4, 0x63, 0xd2,
+	0xf4, 0xe5, 0x97, 0xff, 0xf2, 0xb7, 0x6f, 0xe5, 0x8e, 0xe2, 0xc3, 0xfc, 0x63, 0x81, 0xee, 0x85,
+	0x74, 0xfb, 0x3e, 0xc0, 0x5f, 0x40, 0x80, 0xc5, 0x3d, 0x20, 0xd5, 0x19, 0xc5, 0x67, 0x47, 0x41,
+	0x1c, 0xd2, 0x41, 0x2d, 0x1f, 0xaa, 0x88, 0xbe, 0x3b, 0x1f, 0xe4, 0x42, 0xd7, 0xb8, 0xd0, 0x93,
+	0x58, 0x1f, 0x26, 0xb4, 0xfa, 0x22, 0xb3, 0xe2, 0x4b, 0xa2, 0x5b, 0x8f, 0x5f, 0x43, 0x50, 0xb8,
+	0xc3, 0x6b, 0x1e, 0x63, 0x0c, 0xb3, 0x33, 0x33, 0xc3, 0x70, 0x71, 0x1c, 0xad, 0x7e, 0x82, 0x23,
+	0x7d, 0x00, 0x1f, 0x93, 0x48, 0x83, 0xd0, 0xa7, 0xa4, 0xad, 0x00, 0x3e, 0x8f, 0xf0, 0x1b, 0x08,
+	0xe6, 0xa3, 0x66, 0x17, 0x3e, 0x35, 0x0a, 0xa5, 0xd2, 0x0c, 0x2b, 0xcf, 0xae, 0x73, 0xa4, 0x9f,
+	0xe1, 0x18, 0x4f, 0xe8, 0x43, 0x97, 0x70, 0x43, 0xe9, 0x2b, 0xdd, 0x43, 0x90, 0xbf, 0x46, 0xc7,
+	0xfa, 0xd8, 0x0c, 0xc1, 0x0d, 0x18, 0x70, 0xc8, 0x52, 0xe3, 0xd7, 0x11, 0xdc, 0x7f, 0x8d, 0x86,
+	0xc3, 0x93, 0x3e, 0xbc, 0x3a, 0x3e, 0x13, 0x13, 0xae, 0x76, 0x76, 0x82, 0x37, 0xe3, 0x6c, 0xa7,
+	0xca, 0x91, 0x9d, 0xc1, 0xa7, 0xb3, 0x9c, 0x30, 0xe8, 0x39, 0xe6, 0x0b, 0x02, 0xc7, 0x1f, 0x10,
+	0x1c, 0xec, 0xff, 0xea, 0x01, 0xeb, 0x7d, 0x37, 0xef, 0x21, 0x1f, 0x45, 0x94, 0x6f, 0x4e, 0x7b,
+	0x38, 0xa9, 0x4c, 0xf5, 0x4b, 0x1c, 0xf9, 0x63, 0xf8, 0xd1, 0x2c, 0xe4, 0x71, 0xe7, 0xa0, 0xfa,
+	0xa2, 0x7c, 0x7c, 0x89, 0x7f, 0xdc, 0xc3, 0x61, 0xff, 0x11, 0xc1, 0x61, 0xc9, 0x77, 0xb3, 0x49,
+	0xfc, 0xf0, 0x0a, 0x65, 0xf7, 0xc6, 0x60, 0x22, 0x7d, 0xa6, 0x3c, 0x6c, 0xd3, 0xf2, 0xf4, 0xab,
+	0x5c, 0x97, 0x27, 0xf1, 0x13, 0x7b, 0xd6, 0xc5, 0x64, 0x6c, 0x2c, 0x01, 0xfb, 0x4d, 0x04, 0xfb,
+	0xaf, 0xd1, 0xf0, 0xe9, 0xcd, 0xad, 0x3d, 0xad, 0xcc, 0x94, 0x8e, 0x9e, 0x12, 0xa7, 0x5f, 0xe1,
+	0x8a, 0x7c, 0x04, 0x3f, 0xbe, 0x67, 0x45, 0x5c, 0xd3, 0x8e, 0xd7, 0xe5, 0x65, 0x04, 0xfb, 0xae,
+	0xa5, 0xb2, 0xa1, 0xd1, 0xe1, 0x44, 0xe9, 0xec, 0x97, 0x97, 0x2b, 0xa9, 0x6f, 0xa3, 0xe4, 0x4f,
+	0xb1, 0xab, 0xaf, 0x73, 0x6c, 0xa7, 0xf1, 0xa9, 0x2c, 0x6c, 0x49, 0xe7, 0xef, 0x35, 0x04, 0x47,
+	0xd2, 0x20, 0x92, 0x2f, 0x22, 0x3e, 0xb8, 0xb7, 0xef, 0x0c, 0xc4, 0xd7, 0x0a, 0x63, 0xd0, 0xd5,
+	0x38, 0xba, 0x73, 0xfa, 0xf0, 0x8d, 0xd8, 0x1e, 0x40, 0xb1, 0x81, 0xd6, 0x56, 0x11, 0xfe, 0x2d,
+	0x82, 0xf9, 0xa8, 0x09, 0x36, 0xda, 0x46, 0x4a, 0x07, 0x7f, 0x96, 0x51, 0x4d, 0x78, 0x6d, 0xf9,
+	0xfc, 0x70, 0x83, 0xa6, 0xe7, 0xcb, 0xa5, 0xad, 0x70, 0x2b, 0xab, 0xe1, 0xf8, 0x17, 0x08, 0x20,
+	0x69, 0xe4, 0xe1, 0x33, 0xd9, 0x7a, 0xa4, 0x9a, 0x7d, 0xe5, 0xd9, 0xb6, 0xf2, 0xf4, 0x0a, 0xd7,
+	0x67, 0xb5, 0xbc, 0x92, 0x19, 0x0b, 0x3d, 0x6a, 0x6e, 0x44, 0x4d, 0xbf, 0xef, 0x23, 0x28, 0xf0,
+	0xfe, 0x09, 0x3e, 0x39, 0x0a, 0x73, 0xba, 0xbd, 0x32, 0x4b, 0xd3, 0x3f, 0xc4, 0xa1, 0xae, 0xd4,
+	0xb2, 0x0e, 0x94, 0x0d, 0xb4, 0x86, 0xbb, 0x30, 0x1f, 0x75, 0x2c, 0x46, 0xbb, 0x87, 0xd2, 0xd1,
+	0x28, 0xaf, 0x64, 0x24, 0x35, 0x91, 0xa3, 0x8a, 0xb3, 0x6c, 0x6d, 0xdc, 0x59, 0x36, 0xc7, 0x8e,
+	0x1b, 0x7c, 0x22, 0xeb, 0x30, 0x7a, 0x17, 0x0c, 0x73, 0x96, 0xa3, 0x3b, 0xa5, 0xaf, 0x8c, 0x3b,
+	0xcf, 0x98, 0x75, 0xbe, 0x8d, 0xe0, 0x60, 0xff, 0xd5, 0x17, 0x1f, 0x1b, 0x5a, 0x45, 0x16, 0x67,
+	0xab, 0x6a, 0xc5, 0x51, 0xd7, 0x66, 0xfd, 0xa3, 0x1c, 0xc5, 0x06, 0x7e, 0x64, 0xec, 0xce, 0xb8,
+	0x29, 0xa3, 0x0e, 0x63, 0xb4, 0x9e, 0x7c, 0x95, 0xf0, 0x43, 0x04, 0xfb, 0xd5, 0x4b, 0xdf, 0xe8,
+	0x7c, 0x73, 0xc8, 0x9d, 0xb9, 0x5c, 0x99, 0xec, 0xe5, 0x18, 0xf1, 0x87, 0x39, 0xe2, 0x0b, 0xb8,
+	0x3a, 0x12, 0x71, 0x84, 0x34, 0xfa, 0x96, 0x74, 0x3d, 0xb0, 0x2d, 0xba, 0xce, 0x6e, 0x37, 0xf8,
+	0x55, 0x04, 0x4b, 0xca, 0x4d, 0x0b, 0xaf, 0x8d, 0x12, 0x3d, 0x78, 0x41, 0x2c, 0xaf, 0x4f, 0xf4,
+	0x6e, 0x8c, 0xf2, 0x61, 0x8e, 0x72, 0x1d, 0x9f, 0xcd, 0x5a, 0x5d, 0x71, 0x1f, 0xab, 0x5a, 0x7e,
+	0x6f, 0xdd, 0xef, 0x38, 0xf8, 0x97, 0x08, 0xf6, 0xc9, 0x25, 0xba, 0xed, 0x53, 0x9a, 0xbd, 0xc2,
+	0xb3, 0x8b, 0x29, 0x4c, 0x96, 0xfe, 0x38, 0x47, 0xfc, 0x21, 0x7c, 0x71, 0x42, 0x4f, 0x90, 0x1e,
+	0xb0, 0x1e, 0x32, 0xa4, 0xbf, 0x43, 0x70, 0xe8, 0x4e, 0x14, 0x42, 0xde, 0x23, 0xfc, 0x9b, 0x1c,
+	0xff, 0x13, 0xf8, 0xb1, 0x8c, 0xd4, 0x7f, 0x9c, 0x1a, 0xe7, 0x11, 0xfe, 0x19, 0x82, 0xa2, 0xfc,
+	0x30, 0x00, 0x9f, 0x1e, 0x19, 0x63, 0xd4, 0x4f, 0x07, 0x66, 0x19, 0x17, 0x44, 0x9e, 0xab, 0x9f,
+	0xcc, 0x4c, 0x4c, 0x84, 0x7c, 0x16, 0x1b, 0xee, 0x21, 0xc0, 0x71, 0x71, 0x30, 0x2e, 0x17, 0xe2,
+	0x87, 0x14, 0x51, 0x23, 0x2b, 0xd0, 0xe5, 0xd3, 0x63, 0xdf, 0x53, 0xb3, 0x92, 0xb5, 0xcc, 0xac,
+	0xc4, 0x8d, 0xe5, 0xbf, 0x82, 0xa0, 0x74, 0x8d, 0xc6, 0x57, 0xd1, 0x0c, 0x5b, 0xaa, 0xdf, 0x35,
+	0x94, 0x57, 0xc7, 0xbf, 0x28, 0x10, 0x9d, 0xe3, 0x88, 0x1e, 0xc2, 0xd9, 0xa6, 0x92, 0x00, 0xbe,
+	0x83, 0x60, 0xe9, 0x56, 0xda, 0x45, 0xf1, 0xb9, 0x71, 0x92, 0x94, 0x43, 0x71, 0x72, 0x5c, 0x62,
+	0xf3, 0xeb, 0x13, 0xe1, 0xda, 0x10, 0x9f, 0x08, 0x7c, 0x0f, 0x45, 0xb5, 0x8c, 0xbe, 0xb6, 0xde,
+	0x7f, 0x6a, 0xb7, 0x8c, 0xee, 0xa0, 0x7e, 0x91, 0xe3, 0xab, 0xe0, 0x73, 0x93, 0xe0, 0xab, 0x8a,
+	0x5e, 0x1f, 0xfe, 0x2e, 0x82, 0x43, 0x51, 0x88, 0x4b, 0x31, 0xc6, 0x59, 0xad, 0xcc, 0xa4, 0x0b,
+	0x3c, 0xc1, 0x69, 0xfd, 0x64, 0x14, 0x7f, 0xf4, 0x3d, 0x81, 0xda, 0x10, 0x1d, 0xdb, 0xaf, 0xe4,
+	0x10, 0x5b, 0xdf, 0xfb, 0x06, 0xf0, 0x3d, 0x5b, 0xeb, 0x33, 0xe0, 0xe8, 0x3e, 0xf5, 0x04, 0x18,
+	0x37, 0x38, 0xc6, 0x8b, 0x7a, 0x75, 0x2f, 0x18, 0xab, 0xdd, 0x1a, 0xdb, 0xa6, 0x5f, 0x47, 0xb0,
+	0x5f, 0x66, 0x30, 0xc2, 0xff, 0xd6, 0xc7, 0x2d, 0xed, 0x5e, 0x33, 0x1e, 0xb1, 0x21, 0xd6, 0x26,
+	0xdb, 0x10, 0x6f, 0x20, 0x58, 0x10, 0x6d, 0xd7, 0x8c, 0xbc, 0x30, 0xd5, 0x97, 0x2d, 0xf7, 0x15,
+	0xe3, 0x44, 0x5f, 0x4e, 0xff, 0x14, 0x17, 0xfb, 0x0c, 0xce, 0x34, 0x8b, 0xe7, 0x5a, 0x41, 0xf5,
+	0x45, 0xd1, 0x14, 0x7b, 0xa9, 0xda, 0x72, 0x1b, 0xc1, 0x73, 0x3a, 0xce, 0xcc, 0x7e, 0xd8, 0x3b,
+	0xe7, 0x11, 0x0e, 0x61, 0x91, 0xb9, 0x2f, 0xaf, 0xf0, 0xe1, 0x95, 0xbe, 0x7a, 0xe0, 0x40, 0xf1,
+	0xaf, 0x5c, 0x1e, 0xa8, 0x18, 0x26, 0xe9, 0x8e, 0xa8, 0xbd, 0xe0, 0x07, 0x33, 0xc5, 0x72, 0x41,
+	0x5f, 0x45, 0x70, 0x28, 0xbd, 0x1f, 0x23, 0xf1, 0x13, 0xef, 0xc6, 0x2c, 0x14, 0xe2, 0x06, 0x85,
+	0xd7, 0x26, 0x72, 0x23, 0x0e, 0xe7, 0xf2, 0x53, 0xbf, 0x7f, 0xeb, 0x38, 0xfa, 0xd3, 0x5b, 0xc7,
+	0xd1, 0x5f, 0xdf, 0x3a, 0x8e, 0x9e, 0x7b, 0x64, 0xb2, 0xff, 0xee, 0x31, 0x5b, 0x36, 0x75, 0xc2,
+	0x34, 0xfb, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x7d, 0x2b, 0xd2, 0x8c, 0x9f, 0x34, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -3414,6 +3571,8 @@ type ApplicationServiceClient interface {
 	ManagedResources(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*ManagedResourcesResponse, error)
 	// ServerSideDiff performs server-side diff calculation using dry-run apply
 	ServerSideDiff(ctx context.Context, in *ApplicationServerSideDiffQuery, opts ...grpc.CallOption) (*ApplicationServerSideDiffResponse, error)
+	// HydrateDryRun returns the changes the source hydrator would commit for an application, without committing them
+	HydrateDryRun(ctx context.Context, in *ApplicationHydrateDryRunQuery, opts ...grpc.CallOption) (*ApplicationHydrateDryRunResponse, error)
 	// ResourceTree returns resource tree
 	ResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error)
 	// Watch returns stream of application resource tree
@@ -3663,6 +3822,15 @@ func (c *applicationServiceClient) ServerSideDiff(ctx context.Context, in *Appli
 	return out, nil
 }
 
+func (c *applicationServiceClient) HydrateDryRun(ctx context.Context, in *ApplicationHydrateDryRunQuery, opts ...grpc.CallOption) (*ApplicationHydrateDryRunResponse, error) {
+	out := new(ApplicationHydrateDryRunResponse)
+	err := c.cc.Invoke(ctx, "/application.ApplicationService/HydrateDryRun", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationServiceClient) ResourceTree(ctx context.Context, in *ResourcesQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationTree, error) {
 	out := new(v1alpha1.ApplicationTree)
 	err := c.cc.Invoke(ctx, "/application.ApplicationService/ResourceTree", in, out, opts...)
@@ -3865,6 +4033,8 @@ type ApplicationServiceServer interface {
 	ManagedResources(context.Context, *ResourcesQuery) (*ManagedResourcesResponse, error)
 	// ServerSideDiff performs server-side diff calculation using dry-run apply
 	ServerSideDiff(context.Context, *ApplicationServerSideDiffQuery) (*ApplicationServerSideDiffResponse, error)
+	// HydrateDryRun returns the changes the source hydrator would commit for an application, without committing them
+	HydrateDryRun(context.Context, *ApplicationHydrateDryRunQuery) (*ApplicationHydrateDryRunResponse, error)
 	// ResourceTree returns resource tree
 	ResourceTree(context.Context, *ResourcesQuery) (*v1alpha1.ApplicationTree, error)
 	// Watch returns stream of application resource tree
@@ -3954,6 +4124,9 @@ func (*UnimplementedApplicationServiceServer) ManagedResources(ctx context.Conte
 func (*UnimplementedApplicationServiceServer) ServerSideDiff(ctx context.Context, req *ApplicationServerSideDiffQuery) (*ApplicationServerSideDiffResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ServerSideDiff not implemented")
 }
+func (*UnimplementedApplicationServiceServer) HydrateDryRun(ctx context.Context, req *ApplicationHydrateDryRunQuery) (*ApplicationHydrateDryRunResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HydrateDryRun not implemented")
+}
 func (*UnimplementedApplicationServiceServer) ResourceTree(ctx context.Context, req *ResourcesQuery) (*v1alpha1.ApplicationTree, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResourceTree not implemented")
 }
@@ -4333,6 +4506,24 @@ func _ApplicationService_ServerSideDiff_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationService_HydrateDryRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationHydrateDryRunQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).HydrateDryRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/application.ApplicationService/HydrateDryRun",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).HydrateDryRun(ctx, req.(*ApplicationHydrateDryRunQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationService_ResourceTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ResourcesQuery)
 	if err := dec(in); err != nil {
@@ -4641,6 +4832,10 @@ var _ApplicationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ServerSideDiff",
 			Handler:    _ApplicationService_ServerSideDiff_Handler,
 		},
+		{
+			MethodName: "HydrateDryRun",
+			Handler:    _ApplicationService_HydrateDryRun_Handler,
+		},
 		{
 			MethodName: "ResourceTree",
 			Handler:    _ApplicationService_ResourceTree_Handler,
@@ -7270,6 +7465,126 @@ func (m *ApplicationServerSideDiffResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationHydrateDryRunQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationHydrateDryRunQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationHydrateDryRunQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Revision != nil {
+		i -= len(*m.Revision)
+		copy(dAtA[i:], *m.Revision)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Revision)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Name == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	} else {
+		i -= len(*m.Name)
+		copy(dAtA[i:], *m.Name)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ApplicationHydrateDryRunResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationHydrateDryRunResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationHydrateDryRunResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Diff == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("diff")
+	} else {
+		i -= len(*m.Diff)
+		copy(dAtA[i:], *m.Diff)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.Diff)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.HydratedSha == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("hydratedSha")
+	} else {
+		i -= len(*m.HydratedSha)
+		copy(dAtA[i:], *m.HydratedSha)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.HydratedSha)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.TargetBranch == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("targetBranch")
+	} else {
+		i -= len(*m.TargetBranch)
+		copy(dAtA[i:], *m.TargetBranch)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.TargetBranch)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DrySha == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("drySha")
+	} else {
+		i -= len(*m.DrySha)
+		copy(dAtA[i:], *m.DrySha)
+		i = encodeVarintApplication(dAtA, i, uint64(len(*m.DrySha)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LinkInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -8612,6 +8927,62 @@ func (m *ApplicationServerSideDiffResponse) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationHydrateDryRunQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Name != nil {
+		l = len(*m.Name)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Revision != nil {
+		l = len(*m.Revision)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ApplicationHydrateDryRunResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DrySha != nil {
+		l = len(*m.DrySha)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.TargetBranch != nil {
+		l = len(*m.TargetBranch)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.HydratedSha != nil {
+		l = len(*m.HydratedSha)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.Diff != nil {
+		l = len(*m.Diff)
+		n += 1 + l + sovApplication(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func (m *LinkInfo) Size() (n int) {
 	if m == nil {
 		return 0
@@ -16560,6 +16931,394 @@ func (m *ApplicationServerSideDiffResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationHydrateDryRunQuery) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationHydrateDryRunQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationHydrateDryRunQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Revision = &s
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApplicationHydrateDryRunResponse) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplication
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationHydrateDryRunResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationHydrateDryRunResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DrySha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.DrySha = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetBranch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.TargetBranch = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000002)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HydratedSha", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.HydratedSha = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000004)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Diff", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplication
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplication
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Diff = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000008)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplication(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplication
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("drySha")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("targetBranch")
+	}
+	if hasFields[0]&uint64(0x00000004) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("hydratedSha")
+	}
+	if hasFields[0]&uint64(0x00000008) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("diff")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *LinkInfo) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
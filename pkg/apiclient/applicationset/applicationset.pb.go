@@ -564,6 +564,73 @@ func (m *ApplicationSetGenerateResponse) GetApplications() []*v1alpha1.Applicati
 	return nil
 }
 
+// ApplicationSetRolloutStepRequest is a request to approve or reject a RollingSync step of an applicationset
+type ApplicationSetRolloutStepRequest struct {
+	// the applicationset's name
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The application set namespace. Default empty is argocd control plane namespace
+	AppsetNamespace string `protobuf:"bytes,2,opt,name=appsetNamespace,proto3" json:"appsetNamespace,omitempty"`
+	// the 1-based index of the RollingSync step
+	Step                 int32    `protobuf:"varint,3,opt,name=step,proto3" json:"step,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationSetRolloutStepRequest) Reset()         { *m = ApplicationSetRolloutStepRequest{} }
+func (m *ApplicationSetRolloutStepRequest) String() string { return proto.CompactTextString(m) }
+func (*ApplicationSetRolloutStepRequest) ProtoMessage()    {}
+func (*ApplicationSetRolloutStepRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_eacb9df0ce5738fa, []int{9}
+}
+func (m *ApplicationSetRolloutStepRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ApplicationSetRolloutStepRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ApplicationSetRolloutStepRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ApplicationSetRolloutStepRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationSetRolloutStepRequest.Merge(m, src)
+}
+func (m *ApplicationSetRolloutStepRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ApplicationSetRolloutStepRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationSetRolloutStepRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationSetRolloutStepRequest proto.InternalMessageInfo
+
+func (m *ApplicationSetRolloutStepRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApplicationSetRolloutStepRequest) GetAppsetNamespace() string {
+	if m != nil {
+		return m.AppsetNamespace
+	}
+	return ""
+}
+
+func (m *ApplicationSetRolloutStepRequest) GetStep() int32 {
+	if m != nil {
+		return m.Step
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*ApplicationSetGetQuery)(nil), "applicationset.ApplicationSetGetQuery")
 	proto.RegisterType((*ApplicationSetListQuery)(nil), "applicationset.ApplicationSetListQuery")
@@ -574,6 +641,7 @@ func init() {
 	proto.RegisterType((*ApplicationSetTreeQuery)(nil), "applicationset.ApplicationSetTreeQuery")
 	proto.RegisterType((*ApplicationSetGenerateRequest)(nil), "applicationset.ApplicationSetGenerateRequest")
 	proto.RegisterType((*ApplicationSetGenerateResponse)(nil), "applicationset.ApplicationSetGenerateResponse")
+	proto.RegisterType((*ApplicationSetRolloutStepRequest)(nil), "applicationset.ApplicationSetRolloutStepRequest")
 }
 
 func init() {
@@ -581,57 +649,63 @@ func init() {
 }
 
 var fileDescriptor_eacb9df0ce5738fa = []byte{
-	// 792 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x96, 0xcd, 0x6e, 0x13, 0x3b,
-	0x14, 0xc7, 0xe5, 0xa6, 0xcd, 0x4d, 0xdd, 0xea, 0x5e, 0x5d, 0x4b, 0xb7, 0xcd, 0x1d, 0x20, 0x44,
-	0x23, 0xb5, 0x0d, 0x2d, 0xf1, 0xd0, 0x96, 0x0d, 0x65, 0xc5, 0x97, 0xaa, 0x4a, 0x15, 0x82, 0x09,
-	0x6a, 0x25, 0x58, 0x20, 0x77, 0x72, 0x94, 0x0e, 0x4d, 0x66, 0x06, 0xdb, 0x89, 0x54, 0x55, 0xb0,
-	0x40, 0x62, 0xcd, 0x02, 0xc1, 0x03, 0xc0, 0x86, 0x35, 0xb0, 0x62, 0xc3, 0x82, 0x0d, 0x4b, 0x24,
-	0x5e, 0x00, 0x55, 0x3c, 0x08, 0xb2, 0x67, 0xf2, 0x31, 0x26, 0x1f, 0x95, 0x08, 0xac, 0x12, 0x7b,
-	0xec, 0x73, 0x7e, 0x3e, 0xe7, 0xf8, 0xef, 0x83, 0x97, 0x05, 0xf0, 0x16, 0x70, 0x87, 0x45, 0x51,
-	0xdd, 0xf7, 0x98, 0xf4, 0xc3, 0x40, 0x80, 0x34, 0x86, 0x34, 0xe2, 0xa1, 0x0c, 0xc9, 0xdf, 0xe9,
-	0x59, 0xeb, 0x74, 0x2d, 0x0c, 0x6b, 0x75, 0x70, 0x58, 0xe4, 0x3b, 0x2c, 0x08, 0x42, 0x19, 0x7f,
-	0x89, 0x57, 0x5b, 0xdb, 0x35, 0x5f, 0xee, 0x37, 0xf7, 0xa8, 0x17, 0x36, 0x1c, 0xc6, 0x6b, 0x61,
-	0xc4, 0xc3, 0x07, 0xfa, 0x4f, 0xd9, 0xab, 0x3a, 0xad, 0x75, 0x27, 0x3a, 0xa8, 0xa9, 0x9d, 0xa2,
-	0xd7, 0x97, 0xd3, 0x5a, 0x65, 0xf5, 0x68, 0x9f, 0xad, 0x3a, 0x35, 0x08, 0x80, 0x33, 0x09, 0xd5,
-	0xc4, 0xda, 0xa5, 0x11, 0xd6, 0x92, 0x63, 0x40, 0x0b, 0x02, 0x29, 0x92, 0x9f, 0x78, 0xab, 0xbd,
-	0x83, 0xe7, 0xae, 0x74, 0x5d, 0x54, 0x40, 0x6e, 0x82, 0xbc, 0xdd, 0x04, 0x7e, 0x48, 0x08, 0x9e,
-	0x0c, 0x58, 0x03, 0xf2, 0xa8, 0x88, 0x4a, 0xd3, 0xae, 0xfe, 0x4f, 0x4a, 0xf8, 0x1f, 0x16, 0x45,
-	0x02, 0xe4, 0x4d, 0xd6, 0x00, 0x11, 0x31, 0x0f, 0xf2, 0x13, 0xfa, 0xb3, 0x39, 0x6d, 0x1f, 0xe1,
-	0xf9, 0xb4, 0xdd, 0x6d, 0x5f, 0x24, 0x86, 0x2d, 0x9c, 0x53, 0x80, 0xe0, 0x49, 0x91, 0x47, 0xc5,
-	0x4c, 0x69, 0xda, 0xed, 0x8c, 0xd5, 0x37, 0x01, 0x75, 0xf0, 0x64, 0xc8, 0x13, 0xcb, 0x9d, 0x71,
-	0x3f, 0xe7, 0x99, 0xfe, 0xce, 0x3f, 0x20, 0x9c, 0x4f, 0x7b, 0xdf, 0x65, 0xd2, 0xdb, 0x1f, 0x7c,
-	0xae, 0x5e, 0xa4, 0x89, 0x21, 0x48, 0x99, 0xbe, 0x48, 0x95, 0x5e, 0xa4, 0xc9, 0x0e, 0x52, 0xef,
-	0xb4, 0x5a, 0xc9, 0x41, 0x84, 0x4d, 0xee, 0xc1, 0x0e, 0x70, 0xe1, 0x87, 0x41, 0x7e, 0x2a, 0x5e,
-	0x69, 0x4c, 0xdb, 0x6f, 0x90, 0x99, 0x12, 0x17, 0x44, 0xa4, 0x8a, 0x8a, 0xe4, 0xf1, 0x5f, 0x09,
-	0x56, 0x42, 0xdf, 0x1e, 0x12, 0x89, 0x8d, 0xfa, 0xd3, 0xd1, 0x9b, 0x59, 0xdb, 0xa6, 0xdd, 0xd2,
-	0xa0, 0xed, 0xd2, 0xd0, 0x7f, 0xee, 0x7b, 0x55, 0xda, 0x5a, 0xa7, 0xd1, 0x41, 0x8d, 0xaa, 0x42,
-	0xa3, 0x3d, 0xdb, 0x69, 0xbb, 0xd0, 0xa8, 0xc1, 0x61, 0xf8, 0xb0, 0x3f, 0x21, 0x7c, 0x2a, 0xbd,
-	0xe4, 0x1a, 0x07, 0x26, 0xc1, 0x85, 0x87, 0x4d, 0x10, 0xfd, 0xa8, 0xd0, 0xef, 0xa7, 0x22, 0x73,
-	0x38, 0xdb, 0x8c, 0x04, 0xf0, 0x38, 0x06, 0x39, 0x37, 0x19, 0xa9, 0xf9, 0x2a, 0x3f, 0x74, 0x9b,
-	0x81, 0x4e, 0x63, 0xce, 0x4d, 0x46, 0xf6, 0x3d, 0xf3, 0x10, 0xd7, 0xa1, 0x0e, 0xdd, 0x43, 0xfc,
-	0xda, 0x3d, 0xd8, 0x35, 0xef, 0xc1, 0x1d, 0x0e, 0x30, 0x8e, 0x0b, 0xf6, 0x02, 0xe1, 0x33, 0xe6,
-	0xcd, 0x8d, 0x55, 0xa1, 0x7f, 0xf4, 0x2b, 0x7f, 0x20, 0xfa, 0x15, 0x90, 0xf6, 0x33, 0x84, 0x0b,
-	0x83, 0xb8, 0x92, 0x32, 0x6e, 0xe0, 0xd9, 0xde, 0x94, 0x69, 0x11, 0x98, 0x59, 0xdb, 0x1a, 0x1b,
-	0x96, 0x9b, 0x32, 0xbf, 0xf6, 0x16, 0xe3, 0xff, 0xd2, 0x44, 0x15, 0xe0, 0x2d, 0xdf, 0x03, 0xf2,
-	0x1a, 0xe1, 0xcc, 0x26, 0x48, 0xb2, 0x48, 0x0d, 0x49, 0xef, 0x2f, 0x89, 0xd6, 0x58, 0x23, 0x67,
-	0x2f, 0x3e, 0xf9, 0xfa, 0xfd, 0xf9, 0x44, 0x91, 0x14, 0xf4, 0x1b, 0xd1, 0x5a, 0x35, 0xde, 0x15,
-	0xe1, 0x1c, 0xa9, 0x92, 0x78, 0x44, 0x5e, 0x22, 0x9c, 0x6b, 0xc7, 0x90, 0x94, 0x47, 0xa1, 0xa6,
-	0x6a, 0xc0, 0xa2, 0x27, 0x5d, 0x1e, 0xa7, 0xc6, 0x5e, 0xd1, 0x4c, 0x0b, 0x76, 0x71, 0x10, 0x53,
-	0xfb, 0xe9, 0xd9, 0x40, 0xcb, 0xe4, 0x15, 0xc2, 0x93, 0x4a, 0xd6, 0xc9, 0xd2, 0x70, 0x2f, 0x1d,
-	0xe9, 0xb7, 0x6e, 0x8d, 0x33, 0x80, 0xca, 0xac, 0x7d, 0x56, 0x03, 0xff, 0x4f, 0xe6, 0x07, 0x00,
-	0x93, 0xf7, 0x08, 0x67, 0x63, 0x55, 0x22, 0x2b, 0xc3, 0x31, 0x53, 0xda, 0x35, 0xe6, 0x5c, 0x3b,
-	0x1a, 0xf3, 0x9c, 0x3d, 0x08, 0x73, 0xc3, 0x14, 0xb1, 0xa7, 0x08, 0x67, 0x63, 0x1d, 0x1a, 0x85,
-	0x9d, 0x52, 0x2b, 0x6b, 0x44, 0x29, 0x77, 0x12, 0x9d, 0x14, 0xdf, 0xf2, 0xa8, 0xe2, 0xfb, 0x88,
-	0xf0, 0xac, 0x9b, 0xbc, 0x50, 0x4a, 0xba, 0x46, 0xe5, 0xba, 0x23, 0x6f, 0xe3, 0xcd, 0xb5, 0x32,
-	0x6b, 0x5f, 0xd4, 0xcc, 0x94, 0x9c, 0x1f, 0xce, 0xec, 0xb4, 0x5f, 0xd4, 0xb2, 0x54, 0xc0, 0x8f,
-	0x31, 0x51, 0x95, 0xd2, 0x3e, 0xc4, 0x0d, 0xdd, 0xfd, 0x9c, 0xf8, 0xca, 0xff, 0x4b, 0x93, 0x76,
-	0x49, 0xef, 0xd3, 0x25, 0x57, 0xd6, 0x18, 0x4b, 0x64, 0x61, 0x04, 0x46, 0xbc, 0x91, 0xbc, 0x43,
-	0x78, 0x4a, 0xb7, 0x1f, 0xa4, 0x34, 0xdc, 0x67, 0xb7, 0x47, 0xb1, 0x76, 0xc6, 0x19, 0x3b, 0x6d,
-	0x57, 0xe3, 0xff, 0x2c, 0x39, 0x42, 0x72, 0x60, 0x0d, 0xf3, 0x04, 0x17, 0xd0, 0xd5, 0xad, 0xcf,
-	0xc7, 0x05, 0xf4, 0xe5, 0xb8, 0x80, 0xbe, 0x1d, 0x17, 0xd0, 0xdd, 0xcb, 0x27, 0x6b, 0x57, 0xbd,
-	0xba, 0x0f, 0x81, 0xd9, 0x1f, 0xef, 0x65, 0x75, 0xa7, 0xb9, 0xfe, 0x23, 0x00, 0x00, 0xff, 0xff,
-	0x10, 0xae, 0xd0, 0xd1, 0x4e, 0x0b, 0x00, 0x00,
+	// 889 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x97, 0xcf, 0x6f, 0xf3, 0x34,
+	0x18, 0xc7, 0xe5, 0x75, 0x2b, 0x9d, 0x37, 0x40, 0x58, 0x62, 0x2b, 0x01, 0x4a, 0x15, 0x69, 0x5b,
+	0xd9, 0x68, 0xb2, 0x1f, 0x5c, 0xd8, 0x0e, 0x30, 0x60, 0x4c, 0x93, 0x26, 0x04, 0x29, 0xda, 0x24,
+	0x38, 0xa0, 0x2c, 0x7d, 0xd4, 0x85, 0xa5, 0x89, 0xb1, 0xdd, 0x48, 0xd3, 0x34, 0x0e, 0x48, 0x9c,
+	0x39, 0x20, 0xf8, 0x03, 0xe0, 0xc2, 0x1d, 0x4e, 0x5c, 0x38, 0x70, 0xe1, 0x88, 0x34, 0x71, 0x9f,
+	0x26, 0xfe, 0x10, 0x64, 0x27, 0xfd, 0x11, 0xaf, 0x6d, 0xa6, 0xf7, 0xcd, 0xfb, 0xea, 0x3d, 0x25,
+	0x76, 0xec, 0xc7, 0x9f, 0xc7, 0xcf, 0xd7, 0xcf, 0xe3, 0xe0, 0x75, 0x0e, 0x2c, 0x06, 0x66, 0xbb,
+	0x94, 0x06, 0xbe, 0xe7, 0x0a, 0x3f, 0x0a, 0x39, 0x08, 0xad, 0x69, 0x51, 0x16, 0x89, 0x88, 0xbc,
+	0x90, 0xed, 0x35, 0x5e, 0xeb, 0x44, 0x51, 0x27, 0x00, 0xdb, 0xa5, 0xbe, 0xed, 0x86, 0x61, 0x24,
+	0x92, 0x2f, 0xc9, 0x68, 0xe3, 0xb8, 0xe3, 0x8b, 0xf3, 0xde, 0x99, 0xe5, 0x45, 0x5d, 0xdb, 0x65,
+	0x9d, 0x88, 0xb2, 0xe8, 0x2b, 0xf5, 0xd2, 0xf4, 0xda, 0x76, 0xbc, 0x63, 0xd3, 0x8b, 0x8e, 0x9c,
+	0xc9, 0x47, 0xd7, 0xb2, 0xe3, 0x2d, 0x37, 0xa0, 0xe7, 0xee, 0x96, 0xdd, 0x81, 0x10, 0x98, 0x2b,
+	0xa0, 0x9d, 0x5a, 0x7b, 0x27, 0xc7, 0x5a, 0xea, 0x06, 0xc4, 0x10, 0x0a, 0x9e, 0x3e, 0x92, 0xa9,
+	0xe6, 0x09, 0x5e, 0xda, 0x1f, 0x2e, 0xd1, 0x02, 0x71, 0x08, 0xe2, 0xd3, 0x1e, 0xb0, 0x4b, 0x42,
+	0xf0, 0x6c, 0xe8, 0x76, 0xa1, 0x8a, 0xea, 0xa8, 0x31, 0xef, 0xa8, 0x77, 0xd2, 0xc0, 0x2f, 0xba,
+	0x94, 0x72, 0x10, 0x1f, 0xbb, 0x5d, 0xe0, 0xd4, 0xf5, 0xa0, 0x3a, 0xa3, 0x3e, 0xeb, 0xdd, 0xe6,
+	0x15, 0x5e, 0xce, 0xda, 0x3d, 0xf6, 0x79, 0x6a, 0xd8, 0xc0, 0x15, 0x09, 0x08, 0x9e, 0xe0, 0x55,
+	0x54, 0x2f, 0x35, 0xe6, 0x9d, 0x41, 0x5b, 0x7e, 0xe3, 0x10, 0x80, 0x27, 0x22, 0x96, 0x5a, 0x1e,
+	0xb4, 0xc7, 0x2d, 0x5e, 0x1a, 0xbf, 0xf8, 0x1f, 0x08, 0x57, 0xb3, 0xab, 0x9f, 0xba, 0xc2, 0x3b,
+	0x9f, 0xec, 0xd7, 0x28, 0xd2, 0xcc, 0x14, 0xa4, 0xd2, 0x58, 0xa4, 0xd6, 0x28, 0xd2, 0xec, 0x00,
+	0x69, 0xb4, 0x5b, 0x8e, 0x64, 0xc0, 0xa3, 0x1e, 0xf3, 0xe0, 0x04, 0x18, 0xf7, 0xa3, 0xb0, 0x3a,
+	0x97, 0x8c, 0xd4, 0xba, 0xcd, 0x5f, 0x91, 0x1e, 0x12, 0x07, 0x38, 0x95, 0xa2, 0x22, 0x55, 0xfc,
+	0x5c, 0x8a, 0x95, 0xd2, 0xf7, 0x9b, 0x44, 0x60, 0x4d, 0x7f, 0x6a, 0xf7, 0x16, 0xb6, 0x8f, 0xad,
+	0xa1, 0x34, 0xac, 0xbe, 0x34, 0xd4, 0xcb, 0x97, 0x5e, 0xdb, 0x8a, 0x77, 0x2c, 0x7a, 0xd1, 0xb1,
+	0xa4, 0xd0, 0xac, 0x91, 0xe9, 0x56, 0x5f, 0x68, 0x96, 0xc6, 0xa1, 0xad, 0x61, 0xfe, 0x85, 0xf0,
+	0xab, 0xd9, 0x21, 0x1f, 0x30, 0x70, 0x05, 0x38, 0xf0, 0x75, 0x0f, 0xf8, 0x38, 0x2a, 0xf4, 0xe4,
+	0xa9, 0xc8, 0x12, 0x2e, 0xf7, 0x28, 0x07, 0x96, 0xec, 0x41, 0xc5, 0x49, 0x5b, 0xb2, 0xbf, 0xcd,
+	0x2e, 0x9d, 0x5e, 0xa8, 0xc2, 0x58, 0x71, 0xd2, 0x96, 0xf9, 0x85, 0xee, 0xc4, 0x87, 0x10, 0xc0,
+	0xd0, 0x89, 0xc7, 0x3b, 0x07, 0xa7, 0xfa, 0x39, 0xf8, 0x8c, 0x01, 0x14, 0x71, 0xc0, 0x7e, 0x44,
+	0xf8, 0x75, 0xfd, 0xe4, 0x26, 0x59, 0x61, 0xfc, 0xee, 0xb7, 0x9e, 0xc2, 0xee, 0xb7, 0x40, 0x98,
+	0xdf, 0x23, 0x5c, 0x9b, 0xc4, 0x95, 0xca, 0xb8, 0x8b, 0x17, 0x47, 0x43, 0xa6, 0x92, 0xc0, 0xc2,
+	0xf6, 0x51, 0x61, 0x58, 0x4e, 0xc6, 0xbc, 0x29, 0x70, 0x5d, 0x63, 0x8e, 0x82, 0x20, 0xea, 0x89,
+	0x96, 0x00, 0x5a, 0x48, 0x90, 0xe5, 0x6c, 0x2e, 0x80, 0x2a, 0x5d, 0xcd, 0x39, 0xea, 0x7d, 0xfb,
+	0xf6, 0x79, 0xfc, 0x72, 0x76, 0xd9, 0x16, 0xb0, 0xd8, 0xf7, 0x80, 0xfc, 0x82, 0x70, 0xe9, 0x10,
+	0x04, 0x59, 0xb5, 0xb4, 0x42, 0x32, 0x3e, 0x11, 0x1b, 0x85, 0xc6, 0xcb, 0x5c, 0xfd, 0xf6, 0xe6,
+	0xbf, 0x1f, 0x66, 0xea, 0xa4, 0xa6, 0x2a, 0x53, 0xbc, 0xa5, 0x55, 0x33, 0x6e, 0x5f, 0x49, 0xe7,
+	0xaf, 0xc9, 0x4f, 0x08, 0x57, 0xfa, 0x91, 0x23, 0xcd, 0x3c, 0xd4, 0x8c, 0xf2, 0x0c, 0xeb, 0xa1,
+	0xc3, 0x13, 0x41, 0x98, 0x1b, 0x8a, 0x69, 0xc5, 0xac, 0x4f, 0x62, 0xea, 0x17, 0xbc, 0x5d, 0xb4,
+	0x4e, 0x7e, 0x46, 0x78, 0x56, 0x16, 0x13, 0xb2, 0x36, 0x7d, 0x95, 0x41, 0xc1, 0x31, 0x3e, 0x29,
+	0x72, 0x03, 0xa5, 0x59, 0xf3, 0x0d, 0x05, 0xfc, 0x0a, 0x59, 0x9e, 0x00, 0x4c, 0x7e, 0x47, 0xb8,
+	0x9c, 0xe4, 0x42, 0xb2, 0x31, 0x1d, 0x33, 0x93, 0x31, 0x0b, 0x8e, 0xb5, 0xad, 0x30, 0xdf, 0x34,
+	0x27, 0x61, 0xee, 0xea, 0xa9, 0xf3, 0x3b, 0x84, 0xcb, 0x49, 0xf6, 0xcb, 0xc3, 0xce, 0xe4, 0x48,
+	0x23, 0x47, 0xca, 0x83, 0x40, 0xa7, 0xe2, 0x5b, 0xcf, 0x13, 0xdf, 0x9f, 0x08, 0x2f, 0x3a, 0x69,
+	0x5d, 0x94, 0x09, 0x33, 0x2f, 0xd6, 0x83, 0xa4, 0x5a, 0x6c, 0xac, 0xa5, 0x59, 0xf3, 0x6d, 0xc5,
+	0x6c, 0x91, 0xb7, 0xa6, 0x33, 0xdb, 0xfd, 0x3a, 0xde, 0x14, 0x12, 0xf8, 0x1b, 0x4c, 0xa4, 0x52,
+	0xfa, 0x4e, 0x1c, 0xa8, 0x3b, 0xd7, 0x83, 0x8f, 0xfc, 0x4b, 0x56, 0x7a, 0x49, 0x53, 0xf3, 0x94,
+	0xe4, 0x9a, 0x0a, 0x63, 0x8d, 0xac, 0xe4, 0x60, 0x24, 0x13, 0xc9, 0xbf, 0x08, 0x2f, 0xec, 0x53,
+	0xca, 0xa2, 0x18, 0x64, 0x9e, 0x23, 0x9b, 0x39, 0x11, 0xba, 0x97, 0x12, 0x0b, 0x96, 0xe2, 0x47,
+	0x0a, 0xff, 0x3d, 0x73, 0x2f, 0x6f, 0x17, 0x13, 0x10, 0x5b, 0xe6, 0x50, 0x6e, 0x5f, 0xc9, 0xc7,
+	0xb5, 0x1c, 0x2b, 0x1d, 0x91, 0xa7, 0xff, 0x06, 0x61, 0xec, 0x80, 0xbc, 0xf3, 0x3c, 0x13, 0x6e,
+	0x1d, 0x28, 0xb7, 0xde, 0x35, 0x77, 0x1f, 0xc5, 0x2d, 0xa6, 0xfc, 0x90, 0x5e, 0xfd, 0x86, 0xf0,
+	0x9c, 0xba, 0xa2, 0x92, 0xc6, 0x74, 0x87, 0x86, 0xf7, 0x58, 0xe3, 0xa4, 0x48, 0x47, 0x94, 0x5d,
+	0x25, 0xb6, 0xfb, 0x05, 0x82, 0x0b, 0x06, 0x6e, 0x57, 0xf7, 0x6c, 0x13, 0xbd, 0x7f, 0xf4, 0xf7,
+	0x5d, 0x0d, 0xfd, 0x73, 0x57, 0x43, 0xb7, 0x77, 0x35, 0xf4, 0xf9, 0xde, 0xc3, 0x7e, 0x69, 0xbc,
+	0xc0, 0x87, 0x50, 0xff, 0x87, 0x3a, 0x2b, 0xab, 0xbf, 0x91, 0x9d, 0xff, 0x03, 0x00, 0x00, 0xff,
+	0xff, 0x6b, 0x2e, 0x23, 0xe4, 0x72, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -660,6 +734,10 @@ type ApplicationSetServiceClient interface {
 	ResourceTree(ctx context.Context, in *ApplicationSetTreeQuery, opts ...grpc.CallOption) (*v1alpha1.ApplicationSetTree, error)
 	// ListResourceEvents returns a list of event resources
 	ListResourceEvents(ctx context.Context, in *ApplicationSetGetQuery, opts ...grpc.CallOption) (*events.EventList, error)
+	// ApproveStep approves a RollingSync step of an applicationset which is waiting for approval
+	ApproveStep(ctx context.Context, in *ApplicationSetRolloutStepRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error)
+	// RejectStep rejects a gated RollingSync step of an applicationset, which fails the step
+	RejectStep(ctx context.Context, in *ApplicationSetRolloutStepRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error)
 	Watch(ctx context.Context, in *ApplicationSetWatchQuery, opts ...grpc.CallOption) (ApplicationSetService_WatchClient, error)
 }
 
@@ -734,6 +812,24 @@ func (c *applicationSetServiceClient) ListResourceEvents(ctx context.Context, in
 	return out, nil
 }
 
+func (c *applicationSetServiceClient) ApproveStep(ctx context.Context, in *ApplicationSetRolloutStepRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error) {
+	out := new(v1alpha1.ApplicationSet)
+	err := c.cc.Invoke(ctx, "/applicationset.ApplicationSetService/ApproveStep", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationSetServiceClient) RejectStep(ctx context.Context, in *ApplicationSetRolloutStepRequest, opts ...grpc.CallOption) (*v1alpha1.ApplicationSet, error) {
+	out := new(v1alpha1.ApplicationSet)
+	err := c.cc.Invoke(ctx, "/applicationset.ApplicationSetService/RejectStep", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *applicationSetServiceClient) Watch(ctx context.Context, in *ApplicationSetWatchQuery, opts ...grpc.CallOption) (ApplicationSetService_WatchClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_ApplicationSetService_serviceDesc.Streams[0], "/applicationset.ApplicationSetService/Watch", opts...)
 	if err != nil {
@@ -782,6 +878,10 @@ type ApplicationSetServiceServer interface {
 	ResourceTree(context.Context, *ApplicationSetTreeQuery) (*v1alpha1.ApplicationSetTree, error)
 	// ListResourceEvents returns a list of event resources
 	ListResourceEvents(context.Context, *ApplicationSetGetQuery) (*events.EventList, error)
+	// ApproveStep approves a RollingSync step of an applicationset which is waiting for approval
+	ApproveStep(context.Context, *ApplicationSetRolloutStepRequest) (*v1alpha1.ApplicationSet, error)
+	// RejectStep rejects a gated RollingSync step of an applicationset, which fails the step
+	RejectStep(context.Context, *ApplicationSetRolloutStepRequest) (*v1alpha1.ApplicationSet, error)
 	Watch(*ApplicationSetWatchQuery, ApplicationSetService_WatchServer) error
 }
 
@@ -810,6 +910,12 @@ func (*UnimplementedApplicationSetServiceServer) ResourceTree(ctx context.Contex
 func (*UnimplementedApplicationSetServiceServer) ListResourceEvents(ctx context.Context, req *ApplicationSetGetQuery) (*events.EventList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListResourceEvents not implemented")
 }
+func (*UnimplementedApplicationSetServiceServer) ApproveStep(ctx context.Context, req *ApplicationSetRolloutStepRequest) (*v1alpha1.ApplicationSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveStep not implemented")
+}
+func (*UnimplementedApplicationSetServiceServer) RejectStep(ctx context.Context, req *ApplicationSetRolloutStepRequest) (*v1alpha1.ApplicationSet, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RejectStep not implemented")
+}
 func (*UnimplementedApplicationSetServiceServer) Watch(req *ApplicationSetWatchQuery, srv ApplicationSetService_WatchServer) error {
 	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
 }
@@ -944,6 +1050,42 @@ func _ApplicationSetService_ListResourceEvents_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationSetService_ApproveStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSetRolloutStepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationSetServiceServer).ApproveStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/applicationset.ApplicationSetService/ApproveStep",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationSetServiceServer).ApproveStep(ctx, req.(*ApplicationSetRolloutStepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationSetService_RejectStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationSetRolloutStepRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationSetServiceServer).RejectStep(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/applicationset.ApplicationSetService/RejectStep",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationSetServiceServer).RejectStep(ctx, req.(*ApplicationSetRolloutStepRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ApplicationSetService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(ApplicationSetWatchQuery)
 	if err := stream.RecvMsg(m); err != nil {
@@ -997,6 +1139,14 @@ var _ApplicationSetService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListResourceEvents",
 			Handler:    _ApplicationSetService_ListResourceEvents_Handler,
 		},
+		{
+			MethodName: "ApproveStep",
+			Handler:    _ApplicationSetService_ApproveStep_Handler,
+		},
+		{
+			MethodName: "RejectStep",
+			Handler:    _ApplicationSetService_RejectStep_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1430,6 +1580,52 @@ func (m *ApplicationSetGenerateResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *ApplicationSetRolloutStepRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ApplicationSetRolloutStepRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ApplicationSetRolloutStepRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Step != 0 {
+		i = encodeVarintApplicationset(dAtA, i, uint64(m.Step))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.AppsetNamespace) > 0 {
+		i -= len(m.AppsetNamespace)
+		copy(dAtA[i:], m.AppsetNamespace)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.AppsetNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintApplicationset(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintApplicationset(dAtA []byte, offset int, v uint64) int {
 	offset -= sovApplicationset(v)
 	base := offset
@@ -1637,6 +1833,29 @@ func (m *ApplicationSetGenerateResponse) Size() (n int) {
 	return n
 }
 
+func (m *ApplicationSetRolloutStepRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	l = len(m.AppsetNamespace)
+	if l > 0 {
+		n += 1 + l + sovApplicationset(uint64(l))
+	}
+	if m.Step != 0 {
+		n += 1 + sovApplicationset(uint64(m.Step))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovApplicationset(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2764,6 +2983,140 @@ func (m *ApplicationSetGenerateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ApplicationSetRolloutStepRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowApplicationset
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApplicationSetRolloutStepRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApplicationSetRolloutStepRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppsetNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AppsetNamespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			m.Step = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowApplicationset
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Step |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipApplicationset(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthApplicationset
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipApplicationset(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
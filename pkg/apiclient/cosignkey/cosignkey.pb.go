@@ -0,0 +1,916 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: server/cosignkey/cosignkey.proto
+
+// Cosign public key service
+//
+// Cosign public key API performs CRUD actions against CosignPublicKey resources
+
+package cosignkey
+
+import (
+	context "context"
+	fmt "fmt"
+	v1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Message to query the server for configured cosign public keys
+type CosignKeyQuery struct {
+	// The name of the key to query for
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CosignKeyQuery) Reset()         { *m = CosignKeyQuery{} }
+func (m *CosignKeyQuery) String() string { return proto.CompactTextString(m) }
+func (*CosignKeyQuery) ProtoMessage()    {}
+func (*CosignKeyQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e4461cd1ea8c9c, []int{0}
+}
+func (m *CosignKeyQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CosignKeyQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CosignKeyQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CosignKeyQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CosignKeyQuery.Merge(m, src)
+}
+func (m *CosignKeyQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *CosignKeyQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_CosignKeyQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CosignKeyQuery proto.InternalMessageInfo
+
+func (m *CosignKeyQuery) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// Request to create a cosign public key on the server
+type CosignKeyCreateRequest struct {
+	// Name and PEM data of the key to create
+	Publickey *v1alpha1.CosignPublicKey `protobuf:"bytes,1,opt,name=publickey,proto3" json:"publickey,omitempty"`
+	// Whether to replace the data of an already existing key
+	Upsert               bool     `protobuf:"varint,2,opt,name=upsert,proto3" json:"upsert,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CosignKeyCreateRequest) Reset()         { *m = CosignKeyCreateRequest{} }
+func (m *CosignKeyCreateRequest) String() string { return proto.CompactTextString(m) }
+func (*CosignKeyCreateRequest) ProtoMessage()    {}
+func (*CosignKeyCreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e4461cd1ea8c9c, []int{1}
+}
+func (m *CosignKeyCreateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CosignKeyCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CosignKeyCreateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CosignKeyCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CosignKeyCreateRequest.Merge(m, src)
+}
+func (m *CosignKeyCreateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CosignKeyCreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CosignKeyCreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CosignKeyCreateRequest proto.InternalMessageInfo
+
+func (m *CosignKeyCreateRequest) GetPublickey() *v1alpha1.CosignPublicKey {
+	if m != nil {
+		return m.Publickey
+	}
+	return nil
+}
+
+func (m *CosignKeyCreateRequest) GetUpsert() bool {
+	if m != nil {
+		return m.Upsert
+	}
+	return false
+}
+
+// Generic (empty) response for cosign public key CRUD requests
+type CosignKeyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CosignKeyResponse) Reset()         { *m = CosignKeyResponse{} }
+func (m *CosignKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*CosignKeyResponse) ProtoMessage()    {}
+func (*CosignKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32e4461cd1ea8c9c, []int{2}
+}
+func (m *CosignKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CosignKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CosignKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CosignKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CosignKeyResponse.Merge(m, src)
+}
+func (m *CosignKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CosignKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CosignKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CosignKeyResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*CosignKeyQuery)(nil), "cosignkey.CosignKeyQuery")
+	proto.RegisterType((*CosignKeyCreateRequest)(nil), "cosignkey.CosignKeyCreateRequest")
+	proto.RegisterType((*CosignKeyResponse)(nil), "cosignkey.CosignKeyResponse")
+}
+
+func init() { proto.RegisterFile("server/cosignkey/cosignkey.proto", fileDescriptor_32e4461cd1ea8c9c) }
+
+var fileDescriptor_32e4461cd1ea8c9c = []byte{
+	// 443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x41, 0x6b, 0x14, 0x31,
+	0x14, 0xc7, 0x49, 0x5d, 0x16, 0x37, 0x82, 0x68, 0x94, 0xd2, 0xae, 0x65, 0xdd, 0x0e, 0x0a, 0x45,
+	0x30, 0x61, 0xbb, 0xe0, 0xc1, 0xa3, 0x2d, 0x78, 0x68, 0x05, 0x3b, 0xde, 0xbc, 0x48, 0x36, 0xfb,
+	0x48, 0xe3, 0x4c, 0x93, 0x98, 0x64, 0x06, 0x06, 0xf1, 0xe2, 0x27, 0x10, 0x3d, 0x7b, 0xf0, 0xbb,
+	0x78, 0xf0, 0x28, 0xf8, 0x05, 0x64, 0xf1, 0x83, 0xc8, 0x66, 0x9c, 0x99, 0x16, 0xd7, 0xe2, 0x61,
+	0x6f, 0x6f, 0x32, 0x79, 0xff, 0xf7, 0xe3, 0xff, 0x7f, 0xc1, 0x63, 0x0f, 0xae, 0x04, 0xc7, 0x84,
+	0xf1, 0x4a, 0xea, 0x0c, 0xaa, 0xae, 0xa2, 0xd6, 0x99, 0x60, 0xc8, 0xa0, 0x3d, 0x18, 0xee, 0x48,
+	0x63, 0x64, 0x0e, 0x8c, 0x5b, 0xc5, 0xb8, 0xd6, 0x26, 0xf0, 0xa0, 0x8c, 0xf6, 0xf5, 0xc5, 0xe1,
+	0xb1, 0x54, 0xe1, 0xb4, 0x98, 0x51, 0x61, 0xce, 0x18, 0x77, 0xd2, 0x58, 0x67, 0x5e, 0xc7, 0xe2,
+	0xa1, 0x98, 0xb3, 0x72, 0xca, 0x6c, 0x26, 0x97, 0x9d, 0x9e, 0x71, 0x6b, 0x73, 0x25, 0x62, 0x2f,
+	0x2b, 0x27, 0x3c, 0xb7, 0xa7, 0x7c, 0xc2, 0x24, 0x68, 0x70, 0x3c, 0xc0, 0xbc, 0x56, 0x4b, 0xee,
+	0xe1, 0xeb, 0x07, 0x71, 0xf0, 0x11, 0x54, 0x27, 0x05, 0xb8, 0x8a, 0x10, 0xdc, 0xd3, 0xfc, 0x0c,
+	0xb6, 0xd0, 0x18, 0xed, 0x0d, 0xd2, 0x58, 0x27, 0x9f, 0x11, 0xde, 0x6c, 0xaf, 0x1d, 0x38, 0xe0,
+	0x01, 0x52, 0x78, 0x53, 0x80, 0x0f, 0x24, 0xc3, 0x03, 0x5b, 0xcc, 0x72, 0x25, 0x32, 0xa8, 0x62,
+	0xcf, 0xb5, 0xfd, 0x67, 0xb4, 0x43, 0xa4, 0x0d, 0x62, 0x2c, 0x5e, 0x89, 0x39, 0x2d, 0xa7, 0xd4,
+	0x66, 0x92, 0x2e, 0x11, 0xe9, 0x39, 0x44, 0xda, 0x20, 0xd2, 0x7a, 0xd0, 0xf3, 0x28, 0x7a, 0x04,
+	0x55, 0xda, 0xe9, 0x93, 0x4d, 0xdc, 0x2f, 0xac, 0x07, 0x17, 0xb6, 0x36, 0xc6, 0x68, 0xef, 0x6a,
+	0xfa, 0xe7, 0x2b, 0xb9, 0x85, 0x6f, 0xb6, 0x78, 0x29, 0x78, 0x6b, 0xb4, 0x87, 0xfd, 0xaf, 0x3d,
+	0x7c, 0xa3, 0x3d, 0x7d, 0x01, 0xae, 0x54, 0x02, 0xc8, 0x07, 0x84, 0x7b, 0xc7, 0xca, 0x07, 0xb2,
+	0x4d, 0xbb, 0x04, 0x2e, 0x3a, 0x30, 0x3c, 0x59, 0x2b, 0xff, 0x72, 0x5a, 0x32, 0x7c, 0xff, 0xe3,
+	0xd7, 0xa7, 0x8d, 0xdb, 0x84, 0xc4, 0x50, 0xcb, 0x49, 0x17, 0xbf, 0x27, 0x1f, 0x11, 0xbe, 0xf2,
+	0x14, 0x2e, 0x25, 0x5a, 0xaf, 0xa3, 0xc9, 0x6e, 0xa4, 0xb9, 0x43, 0xb6, 0xff, 0xa6, 0x61, 0x6f,
+	0x97, 0x81, 0xbf, 0x23, 0x5f, 0x10, 0xee, 0xd7, 0x41, 0x93, 0xdd, 0x55, 0x5c, 0x17, 0x96, 0x60,
+	0xdd, 0x7c, 0xf7, 0x23, 0xdf, 0xdd, 0x64, 0x85, 0x5b, 0x8f, 0xcf, 0x6d, 0xc3, 0x1c, 0xf7, 0x0f,
+	0x21, 0x87, 0x00, 0x97, 0x59, 0xb7, 0xb3, 0xea, 0x57, 0xb3, 0x23, 0x8d, 0x13, 0x0f, 0xfe, 0xed,
+	0xc4, 0x93, 0xc3, 0x6f, 0x8b, 0x11, 0xfa, 0xbe, 0x18, 0xa1, 0x9f, 0x8b, 0x11, 0x7a, 0xf9, 0xe8,
+	0xff, 0x5e, 0x9f, 0xc8, 0x15, 0xe8, 0xd0, 0xa9, 0xcd, 0xfa, 0xf1, 0xb9, 0x4d, 0x7f, 0x07, 0x00,
+	0x00, 0xff, 0xff, 0x1d, 0x74, 0xdb, 0x1f, 0x09, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CosignKeyServiceClient is the client API for CosignKeyService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CosignKeyServiceClient interface {
+	// List all configured cosign public keys
+	List(ctx context.Context, in *CosignKeyQuery, opts ...grpc.CallOption) (*v1alpha1.CosignPublicKeyList, error)
+	// Get information about specified cosign public key from the server
+	Get(ctx context.Context, in *CosignKeyQuery, opts ...grpc.CallOption) (*v1alpha1.CosignPublicKey, error)
+	// Create a cosign public key in the server's configuration
+	Create(ctx context.Context, in *CosignKeyCreateRequest, opts ...grpc.CallOption) (*v1alpha1.CosignPublicKey, error)
+	// Delete specified cosign public key from the server's configuration
+	Delete(ctx context.Context, in *CosignKeyQuery, opts ...grpc.CallOption) (*CosignKeyResponse, error)
+}
+
+type cosignKeyServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCosignKeyServiceClient(cc *grpc.ClientConn) CosignKeyServiceClient {
+	return &cosignKeyServiceClient{cc}
+}
+
+func (c *cosignKeyServiceClient) List(ctx context.Context, in *CosignKeyQuery, opts ...grpc.CallOption) (*v1alpha1.CosignPublicKeyList, error) {
+	out := new(v1alpha1.CosignPublicKeyList)
+	err := c.cc.Invoke(ctx, "/cosignkey.CosignKeyService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cosignKeyServiceClient) Get(ctx context.Context, in *CosignKeyQuery, opts ...grpc.CallOption) (*v1alpha1.CosignPublicKey, error) {
+	out := new(v1alpha1.CosignPublicKey)
+	err := c.cc.Invoke(ctx, "/cosignkey.CosignKeyService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cosignKeyServiceClient) Create(ctx context.Context, in *CosignKeyCreateRequest, opts ...grpc.CallOption) (*v1alpha1.CosignPublicKey, error) {
+	out := new(v1alpha1.CosignPublicKey)
+	err := c.cc.Invoke(ctx, "/cosignkey.CosignKeyService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cosignKeyServiceClient) Delete(ctx context.Context, in *CosignKeyQuery, opts ...grpc.CallOption) (*CosignKeyResponse, error) {
+	out := new(CosignKeyResponse)
+	err := c.cc.Invoke(ctx, "/cosignkey.CosignKeyService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CosignKeyServiceServer is the server API for CosignKeyService service.
+type CosignKeyServiceServer interface {
+	// List all configured cosign public keys
+	List(context.Context, *CosignKeyQuery) (*v1alpha1.CosignPublicKeyList, error)
+	// Get information about specified cosign public key from the server
+	Get(context.Context, *CosignKeyQuery) (*v1alpha1.CosignPublicKey, error)
+	// Create a cosign public key in the server's configuration
+	Create(context.Context, *CosignKeyCreateRequest) (*v1alpha1.CosignPublicKey, error)
+	// Delete specified cosign public key from the server's configuration
+	Delete(context.Context, *CosignKeyQuery) (*CosignKeyResponse, error)
+}
+
+// UnimplementedCosignKeyServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedCosignKeyServiceServer struct {
+}
+
+func (*UnimplementedCosignKeyServiceServer) List(ctx context.Context, req *CosignKeyQuery) (*v1alpha1.CosignPublicKeyList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedCosignKeyServiceServer) Get(ctx context.Context, req *CosignKeyQuery) (*v1alpha1.CosignPublicKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedCosignKeyServiceServer) Create(ctx context.Context, req *CosignKeyCreateRequest) (*v1alpha1.CosignPublicKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedCosignKeyServiceServer) Delete(ctx context.Context, req *CosignKeyQuery) (*CosignKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+
+func RegisterCosignKeyServiceServer(s *grpc.Server, srv CosignKeyServiceServer) {
+	s.RegisterService(&_CosignKeyService_serviceDesc, srv)
+}
+
+func _CosignKeyService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CosignKeyQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CosignKeyServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosignkey.CosignKeyService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignKeyServiceServer).List(ctx, req.(*CosignKeyQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CosignKeyService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CosignKeyQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CosignKeyServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosignkey.CosignKeyService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignKeyServiceServer).Get(ctx, req.(*CosignKeyQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CosignKeyService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CosignKeyCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CosignKeyServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosignkey.CosignKeyService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignKeyServiceServer).Create(ctx, req.(*CosignKeyCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CosignKeyService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CosignKeyQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CosignKeyServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosignkey.CosignKeyService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CosignKeyServiceServer).Delete(ctx, req.(*CosignKeyQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _CosignKeyService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosignkey.CosignKeyService",
+	HandlerType: (*CosignKeyServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _CosignKeyService_List_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _CosignKeyService_Get_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _CosignKeyService_Create_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _CosignKeyService_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "server/cosignkey/cosignkey.proto",
+}
+
+func (m *CosignKeyQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CosignKeyQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CosignKeyQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCosignkey(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CosignKeyCreateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CosignKeyCreateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CosignKeyCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Upsert {
+		i--
+		if m.Upsert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Publickey != nil {
+		{
+			size, err := m.Publickey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCosignkey(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CosignKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CosignKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CosignKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCosignkey(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCosignkey(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CosignKeyQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCosignkey(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CosignKeyCreateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Publickey != nil {
+		l = m.Publickey.Size()
+		n += 1 + l + sovCosignkey(uint64(l))
+	}
+	if m.Upsert {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CosignKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovCosignkey(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCosignkey(x uint64) (n int) {
+	return sovCosignkey(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CosignKeyQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosignkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosignKeyQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosignKeyQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosignkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCosignkey
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosignkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosignkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCosignkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CosignKeyCreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosignkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosignKeyCreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosignKeyCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Publickey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosignkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCosignkey
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCosignkey
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Publickey == nil {
+				m.Publickey = &v1alpha1.CosignPublicKey{}
+			}
+			if err := m.Publickey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCosignkey
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Upsert = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosignkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCosignkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CosignKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCosignkey
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosignKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosignKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCosignkey(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCosignkey
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipCosignkey(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowCosignkey
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCosignkey
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowCosignkey
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthCosignkey
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupCosignkey
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthCosignkey
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthCosignkey        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowCosignkey          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupCosignkey = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,389 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/cosignkey"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	mock "github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+)
+
+// NewCosignKeyServiceClient creates a new instance of CosignKeyServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCosignKeyServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CosignKeyServiceClient {
+	mock := &CosignKeyServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// CosignKeyServiceClient is an autogenerated mock type for the CosignKeyServiceClient type
+type CosignKeyServiceClient struct {
+	mock.Mock
+}
+
+type CosignKeyServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *CosignKeyServiceClient) EXPECT() *CosignKeyServiceClient_Expecter {
+	return &CosignKeyServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type CosignKeyServiceClient
+func (_mock *CosignKeyServiceClient) Create(ctx context.Context, in *cosignkey.CosignKeyCreateRequest, opts ...grpc.CallOption) (*v1alpha1.CosignPublicKey, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *v1alpha1.CosignPublicKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cosignkey.CosignKeyCreateRequest, ...grpc.CallOption) (*v1alpha1.CosignPublicKey, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cosignkey.CosignKeyCreateRequest, ...grpc.CallOption) *v1alpha1.CosignPublicKey); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.CosignPublicKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *cosignkey.CosignKeyCreateRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// CosignKeyServiceClient_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type CosignKeyServiceClient_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *cosignkey.CosignKeyCreateRequest
+//   - opts ...grpc.CallOption
+func (_e *CosignKeyServiceClient_Expecter) Create(ctx any, in any, opts ...any) *CosignKeyServiceClient_Create_Call {
+	return &CosignKeyServiceClient_Create_Call{Call: _e.mock.On("Create",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *CosignKeyServiceClient_Create_Call) Run(run func(ctx context.Context, in *cosignkey.CosignKeyCreateRequest, opts ...grpc.CallOption)) *CosignKeyServiceClient_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *cosignkey.CosignKeyCreateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*cosignkey.CosignKeyCreateRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *CosignKeyServiceClient_Create_Call) Return(cosignPublicKey *v1alpha1.CosignPublicKey, err error) *CosignKeyServiceClient_Create_Call {
+	_c.Call.Return(cosignPublicKey, err)
+	return _c
+}
+
+func (_c *CosignKeyServiceClient_Create_Call) RunAndReturn(run func(ctx context.Context, in *cosignkey.CosignKeyCreateRequest, opts ...grpc.CallOption) (*v1alpha1.CosignPublicKey, error)) *CosignKeyServiceClient_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type CosignKeyServiceClient
+func (_mock *CosignKeyServiceClient) Delete(ctx context.Context, in *cosignkey.CosignKeyQuery, opts ...grpc.CallOption) (*cosignkey.CosignKeyResponse, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *cosignkey.CosignKeyResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cosignkey.CosignKeyQuery, ...grpc.CallOption) (*cosignkey.CosignKeyResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cosignkey.CosignKeyQuery, ...grpc.CallOption) *cosignkey.CosignKeyResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cosignkey.CosignKeyResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *cosignkey.CosignKeyQuery, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// CosignKeyServiceClient_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type CosignKeyServiceClient_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *cosignkey.CosignKeyQuery
+//   - opts ...grpc.CallOption
+func (_e *CosignKeyServiceClient_Expecter) Delete(ctx any, in any, opts ...any) *CosignKeyServiceClient_Delete_Call {
+	return &CosignKeyServiceClient_Delete_Call{Call: _e.mock.On("Delete",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *CosignKeyServiceClient_Delete_Call) Run(run func(ctx context.Context, in *cosignkey.CosignKeyQuery, opts ...grpc.CallOption)) *CosignKeyServiceClient_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *cosignkey.CosignKeyQuery
+		if args[1] != nil {
+			arg1 = args[1].(*cosignkey.CosignKeyQuery)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *CosignKeyServiceClient_Delete_Call) Return(cosignKeyResponse *cosignkey.CosignKeyResponse, err error) *CosignKeyServiceClient_Delete_Call {
+	_c.Call.Return(cosignKeyResponse, err)
+	return _c
+}
+
+func (_c *CosignKeyServiceClient_Delete_Call) RunAndReturn(run func(ctx context.Context, in *cosignkey.CosignKeyQuery, opts ...grpc.CallOption) (*cosignkey.CosignKeyResponse, error)) *CosignKeyServiceClient_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type CosignKeyServiceClient
+func (_mock *CosignKeyServiceClient) Get(ctx context.Context, in *cosignkey.CosignKeyQuery, opts ...grpc.CallOption) (*v1alpha1.CosignPublicKey, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *v1alpha1.CosignPublicKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cosignkey.CosignKeyQuery, ...grpc.CallOption) (*v1alpha1.CosignPublicKey, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cosignkey.CosignKeyQuery, ...grpc.CallOption) *v1alpha1.CosignPublicKey); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.CosignPublicKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *cosignkey.CosignKeyQuery, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// CosignKeyServiceClient_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type CosignKeyServiceClient_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *cosignkey.CosignKeyQuery
+//   - opts ...grpc.CallOption
+func (_e *CosignKeyServiceClient_Expecter) Get(ctx any, in any, opts ...any) *CosignKeyServiceClient_Get_Call {
+	return &CosignKeyServiceClient_Get_Call{Call: _e.mock.On("Get",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *CosignKeyServiceClient_Get_Call) Run(run func(ctx context.Context, in *cosignkey.CosignKeyQuery, opts ...grpc.CallOption)) *CosignKeyServiceClient_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *cosignkey.CosignKeyQuery
+		if args[1] != nil {
+			arg1 = args[1].(*cosignkey.CosignKeyQuery)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *CosignKeyServiceClient_Get_Call) Return(cosignPublicKey *v1alpha1.CosignPublicKey, err error) *CosignKeyServiceClient_Get_Call {
+	_c.Call.Return(cosignPublicKey, err)
+	return _c
+}
+
+func (_c *CosignKeyServiceClient_Get_Call) RunAndReturn(run func(ctx context.Context, in *cosignkey.CosignKeyQuery, opts ...grpc.CallOption) (*v1alpha1.CosignPublicKey, error)) *CosignKeyServiceClient_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type CosignKeyServiceClient
+func (_mock *CosignKeyServiceClient) List(ctx context.Context, in *cosignkey.CosignKeyQuery, opts ...grpc.CallOption) (*v1alpha1.CosignPublicKeyList, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *v1alpha1.CosignPublicKeyList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cosignkey.CosignKeyQuery, ...grpc.CallOption) (*v1alpha1.CosignPublicKeyList, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *cosignkey.CosignKeyQuery, ...grpc.CallOption) *v1alpha1.CosignPublicKeyList); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.CosignPublicKeyList)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *cosignkey.CosignKeyQuery, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// CosignKeyServiceClient_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type CosignKeyServiceClient_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *cosignkey.CosignKeyQuery
+//   - opts ...grpc.CallOption
+func (_e *CosignKeyServiceClient_Expecter) List(ctx any, in any, opts ...any) *CosignKeyServiceClient_List_Call {
+	return &CosignKeyServiceClient_List_Call{Call: _e.mock.On("List",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *CosignKeyServiceClient_List_Call) Run(run func(ctx context.Context, in *cosignkey.CosignKeyQuery, opts ...grpc.CallOption)) *CosignKeyServiceClient_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *cosignkey.CosignKeyQuery
+		if args[1] != nil {
+			arg1 = args[1].(*cosignkey.CosignKeyQuery)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *CosignKeyServiceClient_List_Call) Return(cosignPublicKeyList *v1alpha1.CosignPublicKeyList, err error) *CosignKeyServiceClient_List_Call {
+	_c.Call.Return(cosignPublicKeyList, err)
+	return _c
+}
+
+func (_c *CosignKeyServiceClient_List_Call) RunAndReturn(run func(ctx context.Context, in *cosignkey.CosignKeyQuery, opts ...grpc.CallOption) (*v1alpha1.CosignPublicKeyList, error)) *CosignKeyServiceClient_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
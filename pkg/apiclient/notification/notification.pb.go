@@ -10,6 +10,7 @@ package notification
 import (
 	context "context"
 	fmt "fmt"
+	v1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	github_com_gogo_protobuf_proto "github.com/gogo/protobuf/proto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
@@ -431,6 +432,187 @@ func (m *TemplatesListRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_TemplatesListRequest proto.InternalMessageInfo
 
+type DeliveriesListRequest struct {
+	AppName              *string  `protobuf:"bytes,1,req,name=appName" json:"appName,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project              *string  `protobuf:"bytes,3,opt,name=project" json:"project,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeliveriesListRequest) Reset()         { *m = DeliveriesListRequest{} }
+func (m *DeliveriesListRequest) String() string { return proto.CompactTextString(m) }
+func (*DeliveriesListRequest) ProtoMessage()    {}
+func (*DeliveriesListRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1dead44d55a8ff4, []int{9}
+}
+func (m *DeliveriesListRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeliveriesListRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeliveriesListRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeliveriesListRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliveriesListRequest.Merge(m, src)
+}
+func (m *DeliveriesListRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeliveriesListRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliveriesListRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliveriesListRequest proto.InternalMessageInfo
+
+func (m *DeliveriesListRequest) GetAppName() string {
+	if m != nil && m.AppName != nil {
+		return *m.AppName
+	}
+	return ""
+}
+
+func (m *DeliveriesListRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
+func (m *DeliveriesListRequest) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
+	}
+	return ""
+}
+
+type DeliveryList struct {
+	Items                []*v1alpha1.NotificationDelivery `protobuf:"bytes,1,rep,name=items" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                         `json:"-"`
+	XXX_unrecognized     []byte                           `json:"-"`
+	XXX_sizecache        int32                            `json:"-"`
+}
+
+func (m *DeliveryList) Reset()         { *m = DeliveryList{} }
+func (m *DeliveryList) String() string { return proto.CompactTextString(m) }
+func (*DeliveryList) ProtoMessage()    {}
+func (*DeliveryList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1dead44d55a8ff4, []int{10}
+}
+func (m *DeliveryList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeliveryList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeliveryList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeliveryList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeliveryList.Merge(m, src)
+}
+func (m *DeliveryList) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeliveryList) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeliveryList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeliveryList proto.InternalMessageInfo
+
+func (m *DeliveryList) GetItems() []*v1alpha1.NotificationDelivery {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type RedeliverRequest struct {
+	AppName              *string  `protobuf:"bytes,1,req,name=appName" json:"appName,omitempty"`
+	AppNamespace         *string  `protobuf:"bytes,2,opt,name=appNamespace" json:"appNamespace,omitempty"`
+	Project              *string  `protobuf:"bytes,3,opt,name=project" json:"project,omitempty"`
+	Id                   *int64   `protobuf:"varint,4,req,name=id" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RedeliverRequest) Reset()         { *m = RedeliverRequest{} }
+func (m *RedeliverRequest) String() string { return proto.CompactTextString(m) }
+func (*RedeliverRequest) ProtoMessage()    {}
+func (*RedeliverRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e1dead44d55a8ff4, []int{11}
+}
+func (m *RedeliverRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RedeliverRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RedeliverRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RedeliverRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedeliverRequest.Merge(m, src)
+}
+func (m *RedeliverRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RedeliverRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedeliverRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedeliverRequest proto.InternalMessageInfo
+
+func (m *RedeliverRequest) GetAppName() string {
+	if m != nil && m.AppName != nil {
+		return *m.AppName
+	}
+	return ""
+}
+
+func (m *RedeliverRequest) GetAppNamespace() string {
+	if m != nil && m.AppNamespace != nil {
+		return *m.AppNamespace
+	}
+	return ""
+}
+
+func (m *RedeliverRequest) GetProject() string {
+	if m != nil && m.Project != nil {
+		return *m.Project
+	}
+	return ""
+}
+
+func (m *RedeliverRequest) GetId() int64 {
+	if m != nil && m.Id != nil {
+		return *m.Id
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Trigger)(nil), "notification.Trigger")
 	proto.RegisterType((*TriggerList)(nil), "notification.TriggerList")
@@ -441,6 +623,9 @@ func init() {
 	proto.RegisterType((*Template)(nil), "notification.Template")
 	proto.RegisterType((*TemplateList)(nil), "notification.TemplateList")
 	proto.RegisterType((*TemplatesListRequest)(nil), "notification.TemplatesListRequest")
+	proto.RegisterType((*DeliveriesListRequest)(nil), "notification.DeliveriesListRequest")
+	proto.RegisterType((*DeliveryList)(nil), "notification.DeliveryList")
+	proto.RegisterType((*RedeliverRequest)(nil), "notification.RedeliverRequest")
 }
 
 func init() {
@@ -448,30 +633,44 @@ func init() {
 }
 
 var fileDescriptor_e1dead44d55a8ff4 = []byte{
-	// 370 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x31, 0x4e, 0xc3, 0x30,
-	0x14, 0x86, 0x95, 0x02, 0x02, 0xdc, 0xb2, 0xb8, 0xb4, 0x2a, 0x15, 0x84, 0x36, 0x43, 0xa9, 0x04,
-	0xd4, 0xa2, 0x4c, 0x20, 0x26, 0x16, 0x16, 0xc4, 0x50, 0x3a, 0xb1, 0x85, 0x60, 0x8c, 0xa1, 0x89,
-	0x83, 0xed, 0x46, 0xcc, 0x5c, 0x81, 0x4b, 0x31, 0x22, 0x71, 0x01, 0x54, 0x71, 0x0b, 0x16, 0x14,
-	0xd7, 0x96, 0xe2, 0xc8, 0x15, 0x6c, 0xce, 0x7b, 0x9f, 0xdf, 0xa7, 0xf7, 0x3b, 0xa0, 0x27, 0x30,
-	0xcf, 0x30, 0x47, 0x09, 0x93, 0xf4, 0x9e, 0x46, 0xa1, 0xa4, 0x2c, 0xb1, 0x3e, 0x06, 0x29, 0x67,
-	0x92, 0xc1, 0x5a, 0xb1, 0xd6, 0xde, 0x26, 0x8c, 0x91, 0x09, 0x46, 0x61, 0x4a, 0x51, 0x98, 0x24,
-	0x4c, 0xaa, 0xb2, 0x98, 0xb3, 0xc1, 0x0e, 0x58, 0x1d, 0x73, 0x4a, 0x08, 0xe6, 0x10, 0x82, 0xe5,
-	0x24, 0x8c, 0x71, 0xcb, 0xeb, 0x54, 0xfa, 0xeb, 0x23, 0x75, 0x0e, 0x4e, 0x41, 0x55, 0xb7, 0x2f,
-	0xa9, 0x90, 0x70, 0x1f, 0xac, 0x50, 0x89, 0x63, 0xd1, 0xf2, 0x3a, 0x4b, 0xfd, 0xea, 0xb0, 0x31,
-	0xb0, 0xec, 0x9a, 0x1c, 0xcd, 0x99, 0xa0, 0x01, 0xea, 0xba, 0x22, 0xf2, 0xcb, 0x23, 0xfc, 0x3c,
-	0xc5, 0x42, 0xe6, 0xc6, 0x6b, 0xcc, 0x33, 0x1a, 0xe1, 0x45, 0x46, 0xdd, 0xfe, 0x87, 0x51, 0x93,
-	0x05, 0xa3, 0xae, 0x58, 0x46, 0x1f, 0xac, 0x8d, 0x71, 0x9c, 0x4e, 0x42, 0xe9, 0x56, 0x9e, 0x81,
-	0x9a, 0xe9, 0x2b, 0xe7, 0x81, 0xed, 0x6c, 0x96, 0xb6, 0xd4, 0xa8, 0x91, 0x36, 0xc1, 0xa6, 0x29,
-	0x15, 0xad, 0xc3, 0x9f, 0x0a, 0xa8, 0x5f, 0x15, 0x2e, 0x9a, 0xa5, 0x25, 0xa8, 0xe5, 0x98, 0x89,
-	0x06, 0x76, 0x9d, 0x21, 0x16, 0x47, 0xb5, 0xb7, 0x9c, 0x48, 0x4e, 0x04, 0xbd, 0xd7, 0xcf, 0xef,
-	0xb7, 0x4a, 0x07, 0xfa, 0xea, 0x7d, 0xb3, 0x23, 0xeb, 0x7f, 0x10, 0x48, 0x1a, 0x8b, 0xb6, 0x9a,
-	0x78, 0xca, 0x56, 0x47, 0x6c, 0x65, 0x6b, 0xe1, 0x55, 0xfe, 0xb2, 0x0a, 0x63, 0x79, 0x01, 0x1b,
-	0x6a, 0x57, 0x93, 0x0f, 0x0c, 0xdc, 0x59, 0x5a, 0xde, 0xb6, 0x9b, 0x51, 0xe2, 0x3d, 0x25, 0xee,
-	0xc2, 0xdd, 0x05, 0xeb, 0x9a, 0x79, 0xe7, 0x17, 0xef, 0x33, 0xdf, 0xfb, 0x98, 0xf9, 0xde, 0xd7,
-	0xcc, 0xf7, 0x6e, 0x4e, 0x08, 0x95, 0x0f, 0xd3, 0xdb, 0x41, 0xc4, 0x62, 0x14, 0x72, 0xc2, 0x52,
-	0xce, 0x1e, 0xd5, 0xe1, 0x30, 0xba, 0x43, 0xd9, 0x31, 0x4a, 0x9f, 0x48, 0x3e, 0x30, 0x9a, 0x50,
-	0x9c, 0x48, 0x6b, 0xe6, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb0, 0xe4, 0x3d, 0x23, 0x75, 0x03,
+	// 594 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xc1, 0x6e, 0xd3, 0x3e,
+	0x18, 0xc0, 0x95, 0x6c, 0xd3, 0xfe, 0xf5, 0xfa, 0x9f, 0x90, 0x47, 0xa7, 0x50, 0x41, 0xe8, 0x82,
+	0x34, 0xaa, 0x31, 0x62, 0xb5, 0x3b, 0x6d, 0x42, 0x1c, 0xd0, 0x24, 0x2e, 0xd3, 0x0e, 0x61, 0x27,
+	0x2e, 0xc8, 0x24, 0x26, 0x35, 0x4b, 0x63, 0xe3, 0x78, 0xd1, 0x60, 0xda, 0x05, 0x89, 0x27, 0xe0,
+	0x99, 0x90, 0x90, 0xb8, 0x20, 0xf1, 0x02, 0xa8, 0xe2, 0x41, 0x50, 0x5c, 0xbb, 0x8d, 0xab, 0x4c,
+	0xf4, 0xb2, 0x9b, 0xfd, 0xf9, 0xb3, 0x7f, 0xdf, 0xe7, 0xfc, 0x1c, 0xb0, 0x5b, 0x10, 0x51, 0x12,
+	0x81, 0x72, 0x26, 0xe9, 0x3b, 0x1a, 0x63, 0x49, 0x59, 0x6e, 0x4d, 0x42, 0x2e, 0x98, 0x64, 0xb0,
+	0x5d, 0x8f, 0x75, 0xef, 0xa7, 0x8c, 0xa5, 0x19, 0x41, 0x98, 0x53, 0x84, 0xf3, 0x9c, 0x49, 0x15,
+	0x2e, 0xa6, 0xb9, 0xdd, 0x93, 0x94, 0xca, 0xd1, 0xc5, 0xdb, 0x30, 0x66, 0x63, 0x84, 0x45, 0xca,
+	0xb8, 0x60, 0xef, 0xd5, 0xe0, 0x69, 0x9c, 0xa0, 0xf2, 0x00, 0xf1, 0xf3, 0xb4, 0xda, 0x59, 0x20,
+	0xcc, 0x79, 0x66, 0x98, 0xe5, 0x00, 0x67, 0x7c, 0x84, 0x07, 0x28, 0x25, 0x39, 0x11, 0x58, 0x92,
+	0x64, 0x7a, 0x5a, 0xf0, 0x00, 0xac, 0x9f, 0x09, 0x9a, 0xa6, 0x44, 0x40, 0x08, 0x56, 0x73, 0x3c,
+	0x26, 0x9e, 0xd3, 0x73, 0xfb, 0xad, 0x48, 0x8d, 0x83, 0x23, 0xb0, 0xa1, 0x97, 0x4f, 0x68, 0x21,
+	0xe1, 0x13, 0xb0, 0x46, 0x25, 0x19, 0x17, 0x9e, 0xd3, 0x5b, 0xe9, 0x6f, 0x0c, 0x3b, 0xa1, 0xd5,
+	0x8b, 0xce, 0x8c, 0xa6, 0x39, 0x41, 0x07, 0x6c, 0xe9, 0x48, 0x51, 0x6d, 0x8e, 0xc8, 0x87, 0x0b,
+	0x52, 0xc8, 0x8a, 0xf8, 0x8a, 0x88, 0x92, 0xc6, 0xe4, 0x26, 0xa2, 0x5e, 0x5e, 0x82, 0xa8, 0x33,
+	0x6b, 0x44, 0x1d, 0xb1, 0x88, 0x3e, 0xf8, 0xef, 0x8c, 0x8c, 0x79, 0x86, 0x65, 0x33, 0xf2, 0x19,
+	0x68, 0x9b, 0x75, 0xc5, 0xdc, 0xb7, 0x99, 0xdb, 0x0b, 0x5d, 0xea, 0x54, 0x03, 0xdd, 0x06, 0x77,
+	0x4d, 0xc8, 0xa2, 0x32, 0xd0, 0x39, 0x26, 0x19, 0x2d, 0x89, 0xa0, 0xd6, 0x02, 0xf4, 0xc0, 0x3a,
+	0xe6, 0xfc, 0x74, 0x5e, 0x85, 0x99, 0xc2, 0x00, 0xb4, 0xf5, 0xb0, 0xe0, 0x38, 0x26, 0x9e, 0xdb,
+	0x73, 0xfa, 0xad, 0xc8, 0x8a, 0x55, 0xbb, 0xab, 0x2f, 0x4e, 0x62, 0xe9, 0xad, 0xa8, 0x65, 0x33,
+	0x0d, 0x2e, 0x41, 0x5b, 0x03, 0x3f, 0xaa, 0x36, 0x46, 0x76, 0x1b, 0x51, 0x38, 0x17, 0x27, 0x34,
+	0xe2, 0xa8, 0xc1, 0x9b, 0x38, 0x09, 0xcb, 0x83, 0x90, 0x9f, 0xa7, 0x61, 0x25, 0x4e, 0x58, 0x13,
+	0x27, 0x34, 0xe2, 0x84, 0xa7, 0xb5, 0x3b, 0x30, 0x18, 0x73, 0x05, 0x9f, 0xc0, 0x9d, 0x88, 0x24,
+	0xd3, 0xe0, 0x2d, 0x77, 0x09, 0x37, 0x81, 0x4b, 0x13, 0x6f, 0xb5, 0xe7, 0xf6, 0x57, 0x22, 0x97,
+	0x26, 0xc3, 0x1f, 0x6b, 0x60, 0xab, 0x5e, 0x9b, 0x71, 0x4b, 0x82, 0x76, 0x75, 0x0b, 0xc6, 0x40,
+	0xb8, 0xd3, 0xe8, 0x6a, 0xfd, 0xc3, 0x74, 0xef, 0x35, 0xa6, 0x54, 0x19, 0xc1, 0xee, 0xe7, 0x5f,
+	0x7f, 0xbe, 0xba, 0x3d, 0xe8, 0xab, 0x47, 0x59, 0x0e, 0xac, 0x47, 0x5c, 0x20, 0x69, 0x28, 0x9a,
+	0x6a, 0x2c, 0x5c, 0xa4, 0x36, 0xd8, 0xb9, 0x48, 0xad, 0xc9, 0xff, 0x2f, 0x6a, 0x61, 0x28, 0x97,
+	0xe0, 0x7f, 0xd5, 0xab, 0xd1, 0x10, 0x06, 0xcd, 0xca, 0x5a, 0xdc, 0x6e, 0x73, 0x8e, 0x02, 0x3f,
+	0x56, 0xe0, 0x1d, 0xf8, 0xf0, 0x86, 0x76, 0x67, 0xa0, 0x2f, 0x0e, 0xd8, 0xac, 0x76, 0xcc, 0x4d,
+	0x87, 0x8f, 0xec, 0x73, 0x1b, 0xdf, 0xc0, 0x22, 0xbc, 0xee, 0x6d, 0x30, 0x54, 0xf0, 0x7d, 0xb8,
+	0xd7, 0x0c, 0x4f, 0x66, 0x07, 0xa2, 0x2b, 0xed, 0xcc, 0x35, 0xfc, 0xe6, 0x80, 0xd6, 0x4c, 0x41,
+	0xe8, 0xdb, 0xa7, 0x2f, 0xba, 0xd9, 0xbd, 0x85, 0xa7, 0x10, 0x1c, 0xab, 0xaa, 0x9f, 0x07, 0x87,
+	0xcb, 0x57, 0x8d, 0xae, 0x68, 0x72, 0x8d, 0x84, 0xa9, 0xee, 0xc8, 0xd9, 0x7b, 0xf1, 0xf2, 0xfb,
+	0xc4, 0x77, 0x7e, 0x4e, 0x7c, 0xe7, 0xf7, 0xc4, 0x77, 0x5e, 0x1f, 0x2e, 0xf7, 0xab, 0x8f, 0x33,
+	0x4a, 0x72, 0x69, 0x01, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x8a, 0xa1, 0xbf, 0xcd, 0x7a, 0x06,
 	0x00, 0x00,
 }
 
@@ -493,6 +692,10 @@ type NotificationServiceClient interface {
 	ListServices(ctx context.Context, in *ServicesListRequest, opts ...grpc.CallOption) (*ServiceList, error)
 	// List returns list of templates
 	ListTemplates(ctx context.Context, in *TemplatesListRequest, opts ...grpc.CallOption) (*TemplateList, error)
+	// ListDeliveries returns the history of the notifications delivered about an application
+	ListDeliveries(ctx context.Context, in *DeliveriesListRequest, opts ...grpc.CallOption) (*DeliveryList, error)
+	// Redeliver sends a notification about an application again
+	Redeliver(ctx context.Context, in *RedeliverRequest, opts ...grpc.CallOption) (*v1alpha1.NotificationDelivery, error)
 }
 
 type notificationServiceClient struct {
@@ -530,6 +733,24 @@ func (c *notificationServiceClient) ListTemplates(ctx context.Context, in *Templ
 	return out, nil
 }
 
+func (c *notificationServiceClient) ListDeliveries(ctx context.Context, in *DeliveriesListRequest, opts ...grpc.CallOption) (*DeliveryList, error) {
+	out := new(DeliveryList)
+	err := c.cc.Invoke(ctx, "/notification.NotificationService/ListDeliveries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) Redeliver(ctx context.Context, in *RedeliverRequest, opts ...grpc.CallOption) (*v1alpha1.NotificationDelivery, error) {
+	out := new(v1alpha1.NotificationDelivery)
+	err := c.cc.Invoke(ctx, "/notification.NotificationService/Redeliver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationServiceServer is the server API for NotificationService service.
 type NotificationServiceServer interface {
 	// List returns list of triggers
@@ -538,6 +759,10 @@ type NotificationServiceServer interface {
 	ListServices(context.Context, *ServicesListRequest) (*ServiceList, error)
 	// List returns list of templates
 	ListTemplates(context.Context, *TemplatesListRequest) (*TemplateList, error)
+	// ListDeliveries returns the history of the notifications delivered about an application
+	ListDeliveries(context.Context, *DeliveriesListRequest) (*DeliveryList, error)
+	// Redeliver sends a notification about an application again
+	Redeliver(context.Context, *RedeliverRequest) (*v1alpha1.NotificationDelivery, error)
 }
 
 // UnimplementedNotificationServiceServer can be embedded to have forward compatible implementations.
@@ -553,6 +778,12 @@ func (*UnimplementedNotificationServiceServer) ListServices(ctx context.Context,
 func (*UnimplementedNotificationServiceServer) ListTemplates(ctx context.Context, req *TemplatesListRequest) (*TemplateList, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTemplates not implemented")
 }
+func (*UnimplementedNotificationServiceServer) ListDeliveries(ctx context.Context, req *DeliveriesListRequest) (*DeliveryList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeliveries not implemented")
+}
+func (*UnimplementedNotificationServiceServer) Redeliver(ctx context.Context, req *RedeliverRequest) (*v1alpha1.NotificationDelivery, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Redeliver not implemented")
+}
 
 func RegisterNotificationServiceServer(s *grpc.Server, srv NotificationServiceServer) {
 	s.RegisterService(&_NotificationService_serviceDesc, srv)
@@ -612,6 +843,42 @@ func _NotificationService_ListTemplates_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_ListDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeliveriesListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).ListDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notification.NotificationService/ListDeliveries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).ListDeliveries(ctx, req.(*DeliveriesListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_Redeliver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).Redeliver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/notification.NotificationService/Redeliver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).Redeliver(ctx, req.(*RedeliverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _NotificationService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "notification.NotificationService",
 	HandlerType: (*NotificationServiceServer)(nil),
@@ -628,6 +895,14 @@ var _NotificationService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ListTemplates",
 			Handler:    _NotificationService_ListTemplates_Handler,
 		},
+		{
+			MethodName: "ListDeliveries",
+			Handler:    _NotificationService_ListDeliveries_Handler,
+		},
+		{
+			MethodName: "Redeliver",
+			Handler:    _NotificationService_Redeliver_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "server/notification/notification.proto",
@@ -945,6 +1220,154 @@ func (m *TemplatesListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DeliveriesListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeliveriesListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeliveriesListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AppName == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("appName")
+	} else {
+		i -= len(*m.AppName)
+		copy(dAtA[i:], *m.AppName)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.AppName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DeliveryList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DeliveryList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DeliveryList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintNotification(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RedeliverRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedeliverRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedeliverRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Id == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
+	} else {
+		i = encodeVarintNotification(dAtA, i, uint64(*m.Id))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Project != nil {
+		i -= len(*m.Project)
+		copy(dAtA[i:], *m.Project)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.Project)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AppNamespace != nil {
+		i -= len(*m.AppNamespace)
+		copy(dAtA[i:], *m.AppNamespace)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.AppNamespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.AppName == nil {
+		return 0, github_com_gogo_protobuf_proto.NewRequiredNotSetError("appName")
+	} else {
+		i -= len(*m.AppName)
+		copy(dAtA[i:], *m.AppName)
+		i = encodeVarintNotification(dAtA, i, uint64(len(*m.AppName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNotification(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNotification(v)
 	base := offset
@@ -1076,31 +1499,325 @@ func (m *TemplateList) Size() (n int) {
 			n += 1 + l + sovNotification(uint64(l))
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TemplatesListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeliveriesListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppName != nil {
+		l = len(*m.AppName)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DeliveryList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovNotification(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RedeliverRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AppName != nil {
+		l = len(*m.AppName)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.AppNamespace != nil {
+		l = len(*m.AppNamespace)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.Project != nil {
+		l = len(*m.Project)
+		n += 1 + l + sovNotification(uint64(l))
+	}
+	if m.Id != nil {
+		n += 1 + sovNotification(uint64(*m.Id))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovNotification(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozNotification(x uint64) (n int) {
+	return sovNotification(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Trigger) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNotification
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Trigger: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Trigger: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Name = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNotification(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TriggerList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNotification
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggerList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggerList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &Trigger{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNotification(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TriggersListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNotification
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TriggersListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TriggersListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNotification(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
 
-func (m *TemplatesListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovNotification(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozNotification(x uint64) (n int) {
-	return sovNotification(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Trigger) Unmarshal(dAtA []byte) error {
+func (m *Service) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -1124,10 +1841,10 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Trigger: wiretype end group for non-group")
+			return fmt.Errorf("proto: Service: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Trigger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1189,7 +1906,7 @@ func (m *Trigger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TriggerList) Unmarshal(dAtA []byte) error {
+func (m *ServiceList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1212,10 +1929,10 @@ func (m *TriggerList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TriggerList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ServiceList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TriggerList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ServiceList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1247,7 +1964,7 @@ func (m *TriggerList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &Trigger{})
+			m.Items = append(m.Items, &Service{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1274,7 +1991,7 @@ func (m *TriggerList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TriggersListRequest) Unmarshal(dAtA []byte) error {
+func (m *ServicesListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1297,10 +2014,10 @@ func (m *TriggersListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TriggersListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ServicesListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TriggersListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ServicesListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1325,7 +2042,7 @@ func (m *TriggersListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Service) Unmarshal(dAtA []byte) error {
+func (m *Template) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -1349,10 +2066,10 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Service: wiretype end group for non-group")
+			return fmt.Errorf("proto: Template: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Service: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Template: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1414,7 +2131,7 @@ func (m *Service) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ServiceList) Unmarshal(dAtA []byte) error {
+func (m *TemplateList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1437,10 +2154,10 @@ func (m *ServiceList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ServiceList: wiretype end group for non-group")
+			return fmt.Errorf("proto: TemplateList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServiceList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1472,7 +2189,7 @@ func (m *ServiceList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &Service{})
+			m.Items = append(m.Items, &Template{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1499,7 +2216,7 @@ func (m *ServiceList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ServicesListRequest) Unmarshal(dAtA []byte) error {
+func (m *TemplatesListRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1522,10 +2239,10 @@ func (m *ServicesListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ServicesListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TemplatesListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ServicesListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TemplatesListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1550,7 +2267,7 @@ func (m *ServicesListRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Template) Unmarshal(dAtA []byte) error {
+func (m *DeliveriesListRequest) Unmarshal(dAtA []byte) error {
 	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
@@ -1574,15 +2291,15 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Template: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeliveriesListRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Template: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeliveriesListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AppName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1611,9 +2328,75 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			s := string(dAtA[iNdEx:postIndex])
-			m.Name = &s
+			m.AppName = &s
 			iNdEx = postIndex
 			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNotification(dAtA[iNdEx:])
@@ -1631,7 +2414,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 		}
 	}
 	if hasFields[0]&uint64(0x00000001) == 0 {
-		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("name")
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("appName")
 	}
 
 	if iNdEx > l {
@@ -1639,7 +2422,7 @@ func (m *Template) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TemplateList) Unmarshal(dAtA []byte) error {
+func (m *DeliveryList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1662,10 +2445,10 @@ func (m *TemplateList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TemplateList: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeliveryList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TemplateList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeliveryList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1697,7 +2480,7 @@ func (m *TemplateList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &Template{})
+			m.Items = append(m.Items, &v1alpha1.NotificationDelivery{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -1724,7 +2507,8 @@ func (m *TemplateList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TemplatesListRequest) Unmarshal(dAtA []byte) error {
+func (m *RedeliverRequest) Unmarshal(dAtA []byte) error {
+	var hasFields [1]uint64
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1747,12 +2531,133 @@ func (m *TemplatesListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TemplatesListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RedeliverRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TemplatesListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RedeliverRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppName = &s
+			iNdEx = postIndex
+			hasFields[0] |= uint64(0x00000001)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AppNamespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.AppNamespace = &s
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Project", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthNotification
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNotification
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			s := string(dAtA[iNdEx:postIndex])
+			m.Project = &s
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var v int64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNotification
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Id = &v
+			hasFields[0] |= uint64(0x00000002)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipNotification(dAtA[iNdEx:])
@@ -1769,6 +2674,12 @@ func (m *TemplatesListRequest) Unmarshal(dAtA []byte) error {
 			iNdEx += skippy
 		}
 	}
+	if hasFields[0]&uint64(0x00000001) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("appName")
+	}
+	if hasFields[0]&uint64(0x00000002) == 0 {
+		return github_com_gogo_protobuf_proto.NewRequiredNotSetError("id")
+	}
 
 	if iNdEx > l {
 		return io.ErrUnexpectedEOF
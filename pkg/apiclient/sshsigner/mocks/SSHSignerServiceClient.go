@@ -0,0 +1,389 @@
+// Code generated by mockery; DO NOT EDIT.
+// github.com/vektra/mockery
+// template: testify
+
+package mocks
+
+import (
+	"context"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/sshsigner"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	mock "github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
+)
+
+// NewSSHSignerServiceClient creates a new instance of SSHSignerServiceClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSSHSignerServiceClient(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SSHSignerServiceClient {
+	mock := &SSHSignerServiceClient{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// SSHSignerServiceClient is an autogenerated mock type for the SSHSignerServiceClient type
+type SSHSignerServiceClient struct {
+	mock.Mock
+}
+
+type SSHSignerServiceClient_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *SSHSignerServiceClient) EXPECT() *SSHSignerServiceClient_Expecter {
+	return &SSHSignerServiceClient_Expecter{mock: &_m.Mock}
+}
+
+// Create provides a mock function for the type SSHSignerServiceClient
+func (_mock *SSHSignerServiceClient) Create(ctx context.Context, in *sshsigner.SSHSignerCreateRequest, opts ...grpc.CallOption) (*v1alpha1.SSHSigner, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Create")
+	}
+
+	var r0 *v1alpha1.SSHSigner
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sshsigner.SSHSignerCreateRequest, ...grpc.CallOption) (*v1alpha1.SSHSigner, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sshsigner.SSHSignerCreateRequest, ...grpc.CallOption) *v1alpha1.SSHSigner); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.SSHSigner)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sshsigner.SSHSignerCreateRequest, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SSHSignerServiceClient_Create_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Create'
+type SSHSignerServiceClient_Create_Call struct {
+	*mock.Call
+}
+
+// Create is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *sshsigner.SSHSignerCreateRequest
+//   - opts ...grpc.CallOption
+func (_e *SSHSignerServiceClient_Expecter) Create(ctx any, in any, opts ...any) *SSHSignerServiceClient_Create_Call {
+	return &SSHSignerServiceClient_Create_Call{Call: _e.mock.On("Create",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *SSHSignerServiceClient_Create_Call) Run(run func(ctx context.Context, in *sshsigner.SSHSignerCreateRequest, opts ...grpc.CallOption)) *SSHSignerServiceClient_Create_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sshsigner.SSHSignerCreateRequest
+		if args[1] != nil {
+			arg1 = args[1].(*sshsigner.SSHSignerCreateRequest)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *SSHSignerServiceClient_Create_Call) Return(sSHSigner *v1alpha1.SSHSigner, err error) *SSHSignerServiceClient_Create_Call {
+	_c.Call.Return(sSHSigner, err)
+	return _c
+}
+
+func (_c *SSHSignerServiceClient_Create_Call) RunAndReturn(run func(ctx context.Context, in *sshsigner.SSHSignerCreateRequest, opts ...grpc.CallOption) (*v1alpha1.SSHSigner, error)) *SSHSignerServiceClient_Create_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Delete provides a mock function for the type SSHSignerServiceClient
+func (_mock *SSHSignerServiceClient) Delete(ctx context.Context, in *sshsigner.SSHSignerQuery, opts ...grpc.CallOption) (*sshsigner.SSHSignerResponse, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *sshsigner.SSHSignerResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sshsigner.SSHSignerQuery, ...grpc.CallOption) (*sshsigner.SSHSignerResponse, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sshsigner.SSHSignerQuery, ...grpc.CallOption) *sshsigner.SSHSignerResponse); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sshsigner.SSHSignerResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sshsigner.SSHSignerQuery, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SSHSignerServiceClient_Delete_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Delete'
+type SSHSignerServiceClient_Delete_Call struct {
+	*mock.Call
+}
+
+// Delete is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *sshsigner.SSHSignerQuery
+//   - opts ...grpc.CallOption
+func (_e *SSHSignerServiceClient_Expecter) Delete(ctx any, in any, opts ...any) *SSHSignerServiceClient_Delete_Call {
+	return &SSHSignerServiceClient_Delete_Call{Call: _e.mock.On("Delete",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *SSHSignerServiceClient_Delete_Call) Run(run func(ctx context.Context, in *sshsigner.SSHSignerQuery, opts ...grpc.CallOption)) *SSHSignerServiceClient_Delete_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sshsigner.SSHSignerQuery
+		if args[1] != nil {
+			arg1 = args[1].(*sshsigner.SSHSignerQuery)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *SSHSignerServiceClient_Delete_Call) Return(sSHSignerResponse *sshsigner.SSHSignerResponse, err error) *SSHSignerServiceClient_Delete_Call {
+	_c.Call.Return(sSHSignerResponse, err)
+	return _c
+}
+
+func (_c *SSHSignerServiceClient_Delete_Call) RunAndReturn(run func(ctx context.Context, in *sshsigner.SSHSignerQuery, opts ...grpc.CallOption) (*sshsigner.SSHSignerResponse, error)) *SSHSignerServiceClient_Delete_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Get provides a mock function for the type SSHSignerServiceClient
+func (_mock *SSHSignerServiceClient) Get(ctx context.Context, in *sshsigner.SSHSignerQuery, opts ...grpc.CallOption) (*v1alpha1.SSHSigner, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Get")
+	}
+
+	var r0 *v1alpha1.SSHSigner
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sshsigner.SSHSignerQuery, ...grpc.CallOption) (*v1alpha1.SSHSigner, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sshsigner.SSHSignerQuery, ...grpc.CallOption) *v1alpha1.SSHSigner); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.SSHSigner)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sshsigner.SSHSignerQuery, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SSHSignerServiceClient_Get_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Get'
+type SSHSignerServiceClient_Get_Call struct {
+	*mock.Call
+}
+
+// Get is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *sshsigner.SSHSignerQuery
+//   - opts ...grpc.CallOption
+func (_e *SSHSignerServiceClient_Expecter) Get(ctx any, in any, opts ...any) *SSHSignerServiceClient_Get_Call {
+	return &SSHSignerServiceClient_Get_Call{Call: _e.mock.On("Get",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *SSHSignerServiceClient_Get_Call) Run(run func(ctx context.Context, in *sshsigner.SSHSignerQuery, opts ...grpc.CallOption)) *SSHSignerServiceClient_Get_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sshsigner.SSHSignerQuery
+		if args[1] != nil {
+			arg1 = args[1].(*sshsigner.SSHSignerQuery)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *SSHSignerServiceClient_Get_Call) Return(sSHSigner *v1alpha1.SSHSigner, err error) *SSHSignerServiceClient_Get_Call {
+	_c.Call.Return(sSHSigner, err)
+	return _c
+}
+
+func (_c *SSHSignerServiceClient_Get_Call) RunAndReturn(run func(ctx context.Context, in *sshsigner.SSHSignerQuery, opts ...grpc.CallOption) (*v1alpha1.SSHSigner, error)) *SSHSignerServiceClient_Get_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// List provides a mock function for the type SSHSignerServiceClient
+func (_mock *SSHSignerServiceClient) List(ctx context.Context, in *sshsigner.SSHSignerQuery, opts ...grpc.CallOption) (*v1alpha1.SSHSignerList, error) {
+	// grpc.CallOption
+	_va := make([]any, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []any
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _mock.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for List")
+	}
+
+	var r0 *v1alpha1.SSHSignerList
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sshsigner.SSHSignerQuery, ...grpc.CallOption) (*v1alpha1.SSHSignerList, error)); ok {
+		return returnFunc(ctx, in, opts...)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *sshsigner.SSHSignerQuery, ...grpc.CallOption) *v1alpha1.SSHSignerList); ok {
+		r0 = returnFunc(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.SSHSignerList)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *sshsigner.SSHSignerQuery, ...grpc.CallOption) error); ok {
+		r1 = returnFunc(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// SSHSignerServiceClient_List_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'List'
+type SSHSignerServiceClient_List_Call struct {
+	*mock.Call
+}
+
+// List is a helper method to define mock.On call
+//   - ctx context.Context
+//   - in *sshsigner.SSHSignerQuery
+//   - opts ...grpc.CallOption
+func (_e *SSHSignerServiceClient_Expecter) List(ctx any, in any, opts ...any) *SSHSignerServiceClient_List_Call {
+	return &SSHSignerServiceClient_List_Call{Call: _e.mock.On("List",
+		append([]any{ctx, in}, opts...)...)}
+}
+
+func (_c *SSHSignerServiceClient_List_Call) Run(run func(ctx context.Context, in *sshsigner.SSHSignerQuery, opts ...grpc.CallOption)) *SSHSignerServiceClient_List_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *sshsigner.SSHSignerQuery
+		if args[1] != nil {
+			arg1 = args[1].(*sshsigner.SSHSignerQuery)
+		}
+		var arg2 []grpc.CallOption
+		variadicArgs := make([]grpc.CallOption, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(grpc.CallOption)
+			}
+		}
+		arg2 = variadicArgs
+		run(
+			arg0,
+			arg1,
+			arg2...,
+		)
+	})
+	return _c
+}
+
+func (_c *SSHSignerServiceClient_List_Call) Return(sSHSignerList *v1alpha1.SSHSignerList, err error) *SSHSignerServiceClient_List_Call {
+	_c.Call.Return(sSHSignerList, err)
+	return _c
+}
+
+func (_c *SSHSignerServiceClient_List_Call) RunAndReturn(run func(ctx context.Context, in *sshsigner.SSHSignerQuery, opts ...grpc.CallOption) (*v1alpha1.SSHSignerList, error)) *SSHSignerServiceClient_List_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -0,0 +1,915 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: server/sshsigner/sshsigner.proto
+
+// SSH signer service
+//
+// SSH signer API performs CRUD actions against SSHSigner resources
+
+package sshsigner
+
+import (
+	context "context"
+	fmt "fmt"
+	v1alpha1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Message to query the server for configured SSH signers
+type SSHSignerQuery struct {
+	// The name of the signer to query for
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SSHSignerQuery) Reset()         { *m = SSHSignerQuery{} }
+func (m *SSHSignerQuery) String() string { return proto.CompactTextString(m) }
+func (*SSHSignerQuery) ProtoMessage()    {}
+func (*SSHSignerQuery) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ec097ab9736f1b0, []int{0}
+}
+func (m *SSHSignerQuery) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SSHSignerQuery) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SSHSignerQuery.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SSHSignerQuery) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSHSignerQuery.Merge(m, src)
+}
+func (m *SSHSignerQuery) XXX_Size() int {
+	return m.Size()
+}
+func (m *SSHSignerQuery) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSHSignerQuery.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SSHSignerQuery proto.InternalMessageInfo
+
+func (m *SSHSignerQuery) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// Request to create an SSH signer on the server
+type SSHSignerCreateRequest struct {
+	// Name and public keys of the signer to create
+	Signer *v1alpha1.SSHSigner `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Whether to replace the public keys of an already existing signer
+	Upsert               bool     `protobuf:"varint,2,opt,name=upsert,proto3" json:"upsert,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SSHSignerCreateRequest) Reset()         { *m = SSHSignerCreateRequest{} }
+func (m *SSHSignerCreateRequest) String() string { return proto.CompactTextString(m) }
+func (*SSHSignerCreateRequest) ProtoMessage()    {}
+func (*SSHSignerCreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ec097ab9736f1b0, []int{1}
+}
+func (m *SSHSignerCreateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SSHSignerCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SSHSignerCreateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SSHSignerCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSHSignerCreateRequest.Merge(m, src)
+}
+func (m *SSHSignerCreateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SSHSignerCreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSHSignerCreateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SSHSignerCreateRequest proto.InternalMessageInfo
+
+func (m *SSHSignerCreateRequest) GetSigner() *v1alpha1.SSHSigner {
+	if m != nil {
+		return m.Signer
+	}
+	return nil
+}
+
+func (m *SSHSignerCreateRequest) GetUpsert() bool {
+	if m != nil {
+		return m.Upsert
+	}
+	return false
+}
+
+// Generic (empty) response for SSH signer CRUD requests
+type SSHSignerResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SSHSignerResponse) Reset()         { *m = SSHSignerResponse{} }
+func (m *SSHSignerResponse) String() string { return proto.CompactTextString(m) }
+func (*SSHSignerResponse) ProtoMessage()    {}
+func (*SSHSignerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4ec097ab9736f1b0, []int{2}
+}
+func (m *SSHSignerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SSHSignerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SSHSignerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SSHSignerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSHSignerResponse.Merge(m, src)
+}
+func (m *SSHSignerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SSHSignerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSHSignerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SSHSignerResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SSHSignerQuery)(nil), "sshsigner.SSHSignerQuery")
+	proto.RegisterType((*SSHSignerCreateRequest)(nil), "sshsigner.SSHSignerCreateRequest")
+	proto.RegisterType((*SSHSignerResponse)(nil), "sshsigner.SSHSignerResponse")
+}
+
+func init() { proto.RegisterFile("server/sshsigner/sshsigner.proto", fileDescriptor_4ec097ab9736f1b0) }
+
+var fileDescriptor_4ec097ab9736f1b0 = []byte{
+	// 430 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0x4f, 0x6b, 0xd4, 0x40,
+	0x18, 0xc6, 0x99, 0xba, 0x04, 0x3b, 0x82, 0xe8, 0x28, 0xa5, 0x8d, 0xcb, 0xb2, 0x0d, 0x1e, 0x8a,
+	0xe0, 0x0c, 0xdb, 0x05, 0x0f, 0x1e, 0xb5, 0x50, 0xc1, 0x5e, 0x4c, 0x6e, 0x5e, 0xca, 0x34, 0x79,
+	0x99, 0x1d, 0x9b, 0xce, 0x8c, 0x33, 0x93, 0x80, 0x88, 0x17, 0x8f, 0xe2, 0x45, 0xbc, 0xf8, 0x2d,
+	0xfc, 0x1a, 0x1e, 0x05, 0xbf, 0x80, 0x2c, 0x7e, 0x10, 0xc9, 0xa4, 0x49, 0x5a, 0x5c, 0x4b, 0x0f,
+	0x7b, 0x7b, 0x32, 0x79, 0xff, 0xfc, 0xf2, 0x3c, 0x19, 0x3c, 0x75, 0x60, 0x6b, 0xb0, 0xcc, 0xb9,
+	0x85, 0x93, 0x42, 0x5d, 0x54, 0xd4, 0x58, 0xed, 0x35, 0xd9, 0xec, 0x0f, 0xe2, 0xb1, 0xd0, 0x5a,
+	0x94, 0xc0, 0xb8, 0x91, 0x8c, 0x2b, 0xa5, 0x3d, 0xf7, 0x52, 0x2b, 0xd7, 0x16, 0xc6, 0x47, 0x42,
+	0xfa, 0x45, 0x75, 0x42, 0x73, 0x7d, 0xc6, 0xb8, 0x15, 0xda, 0x58, 0xfd, 0x26, 0x88, 0xc7, 0x79,
+	0xc1, 0xea, 0x39, 0x33, 0xa7, 0xa2, 0xe9, 0x74, 0x8c, 0x1b, 0x53, 0xca, 0x3c, 0xf4, 0xb2, 0x7a,
+	0xc6, 0x4b, 0xb3, 0xe0, 0x33, 0x26, 0x40, 0x81, 0xe5, 0x1e, 0x8a, 0x76, 0x5a, 0xf2, 0x10, 0xdf,
+	0xce, 0xb2, 0x17, 0x59, 0x58, 0xfc, 0xaa, 0x02, 0xfb, 0x8e, 0x10, 0x3c, 0x52, 0xfc, 0x0c, 0xb6,
+	0xd1, 0x14, 0xed, 0x6d, 0xa6, 0x41, 0x27, 0x5f, 0x10, 0xde, 0xea, 0xcb, 0x9e, 0x5b, 0xe0, 0x1e,
+	0x52, 0x78, 0x5b, 0x81, 0xf3, 0xe4, 0x18, 0x47, 0x2d, 0x76, 0x68, 0xb8, 0xb5, 0x7f, 0x48, 0x07,
+	0x3e, 0xda, 0xf1, 0x05, 0x71, 0x9c, 0x17, 0xb4, 0x9e, 0x53, 0x73, 0x2a, 0x68, 0xc3, 0x47, 0x2f,
+	0xf0, 0xd1, 0x8e, 0x8f, 0xf6, 0x5b, 0xd2, 0xf3, 0xb1, 0x64, 0x0b, 0x47, 0x95, 0x71, 0x60, 0xfd,
+	0xf6, 0xc6, 0x14, 0xed, 0xdd, 0x4c, 0xcf, 0x9f, 0x92, 0x7b, 0xf8, 0xee, 0x50, 0x0c, 0xce, 0x68,
+	0xe5, 0x60, 0xff, 0xfb, 0x08, 0xdf, 0xe9, 0x4f, 0x33, 0xb0, 0xb5, 0xcc, 0x81, 0x7c, 0x42, 0x78,
+	0x74, 0x24, 0x9d, 0x27, 0x3b, 0x74, 0x70, 0xfd, 0xf2, 0x57, 0xc7, 0x2f, 0xd7, 0x84, 0xdd, 0xec,
+	0x49, 0xe2, 0x8f, 0xbf, 0xfe, 0x7c, 0xdd, 0xb8, 0x4f, 0x48, 0x88, 0xb0, 0x9e, 0x0d, 0x61, 0x3b,
+	0xf2, 0x19, 0xe1, 0x1b, 0x87, 0x70, 0x25, 0xcb, 0xba, 0x2c, 0x4c, 0x76, 0x03, 0xc7, 0x03, 0xb2,
+	0xf3, 0x2f, 0x07, 0x7b, 0xdf, 0x04, 0xfb, 0x81, 0x7c, 0x43, 0x38, 0x6a, 0x03, 0x25, 0xbb, 0xab,
+	0x88, 0x2e, 0x85, 0xbd, 0x3e, 0xb2, 0x24, 0x90, 0x8d, 0x93, 0x15, 0x0e, 0x3d, 0xed, 0x82, 0x2f,
+	0x70, 0x74, 0x00, 0x25, 0x78, 0xb8, 0xca, 0xab, 0xf1, 0xaa, 0x57, 0xdd, 0xef, 0xd0, 0x19, 0xf0,
+	0xe8, 0xff, 0x06, 0x3c, 0x3b, 0xf8, 0xb1, 0x9c, 0xa0, 0x9f, 0xcb, 0x09, 0xfa, 0xbd, 0x9c, 0xa0,
+	0xd7, 0x4f, 0xae, 0x77, 0xb9, 0xf2, 0x52, 0x82, 0xf2, 0xc3, 0xb4, 0x93, 0x28, 0xdc, 0xa6, 0xf9,
+	0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x26, 0xef, 0x94, 0x26, 0xe8, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// SSHSignerServiceClient is the client API for SSHSignerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type SSHSignerServiceClient interface {
+	// List all configured SSH signers
+	List(ctx context.Context, in *SSHSignerQuery, opts ...grpc.CallOption) (*v1alpha1.SSHSignerList, error)
+	// Get information about specified SSH signer from the server
+	Get(ctx context.Context, in *SSHSignerQuery, opts ...grpc.CallOption) (*v1alpha1.SSHSigner, error)
+	// Create an SSH signer in the server's configuration
+	Create(ctx context.Context, in *SSHSignerCreateRequest, opts ...grpc.CallOption) (*v1alpha1.SSHSigner, error)
+	// Delete specified SSH signer from the server's configuration
+	Delete(ctx context.Context, in *SSHSignerQuery, opts ...grpc.CallOption) (*SSHSignerResponse, error)
+}
+
+type sSHSignerServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewSSHSignerServiceClient(cc *grpc.ClientConn) SSHSignerServiceClient {
+	return &sSHSignerServiceClient{cc}
+}
+
+func (c *sSHSignerServiceClient) List(ctx context.Context, in *SSHSignerQuery, opts ...grpc.CallOption) (*v1alpha1.SSHSignerList, error) {
+	out := new(v1alpha1.SSHSignerList)
+	err := c.cc.Invoke(ctx, "/sshsigner.SSHSignerService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSHSignerServiceClient) Get(ctx context.Context, in *SSHSignerQuery, opts ...grpc.CallOption) (*v1alpha1.SSHSigner, error) {
+	out := new(v1alpha1.SSHSigner)
+	err := c.cc.Invoke(ctx, "/sshsigner.SSHSignerService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSHSignerServiceClient) Create(ctx context.Context, in *SSHSignerCreateRequest, opts ...grpc.CallOption) (*v1alpha1.SSHSigner, error) {
+	out := new(v1alpha1.SSHSigner)
+	err := c.cc.Invoke(ctx, "/sshsigner.SSHSignerService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sSHSignerServiceClient) Delete(ctx context.Context, in *SSHSignerQuery, opts ...grpc.CallOption) (*SSHSignerResponse, error) {
+	out := new(SSHSignerResponse)
+	err := c.cc.Invoke(ctx, "/sshsigner.SSHSignerService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// SSHSignerServiceServer is the server API for SSHSignerService service.
+type SSHSignerServiceServer interface {
+	// List all configured SSH signers
+	List(context.Context, *SSHSignerQuery) (*v1alpha1.SSHSignerList, error)
+	// Get information about specified SSH signer from the server
+	Get(context.Context, *SSHSignerQuery) (*v1alpha1.SSHSigner, error)
+	// Create an SSH signer in the server's configuration
+	Create(context.Context, *SSHSignerCreateRequest) (*v1alpha1.SSHSigner, error)
+	// Delete specified SSH signer from the server's configuration
+	Delete(context.Context, *SSHSignerQuery) (*SSHSignerResponse, error)
+}
+
+// UnimplementedSSHSignerServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedSSHSignerServiceServer struct {
+}
+
+func (*UnimplementedSSHSignerServiceServer) List(ctx context.Context, req *SSHSignerQuery) (*v1alpha1.SSHSignerList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedSSHSignerServiceServer) Get(ctx context.Context, req *SSHSignerQuery) (*v1alpha1.SSHSigner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedSSHSignerServiceServer) Create(ctx context.Context, req *SSHSignerCreateRequest) (*v1alpha1.SSHSigner, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedSSHSignerServiceServer) Delete(ctx context.Context, req *SSHSignerQuery) (*SSHSignerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+
+func RegisterSSHSignerServiceServer(s *grpc.Server, srv SSHSignerServiceServer) {
+	s.RegisterService(&_SSHSignerService_serviceDesc, srv)
+}
+
+func _SSHSignerService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHSignerQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSHSignerServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sshsigner.SSHSignerService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSHSignerServiceServer).List(ctx, req.(*SSHSignerQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSHSignerService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHSignerQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSHSignerServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sshsigner.SSHSignerService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSHSignerServiceServer).Get(ctx, req.(*SSHSignerQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSHSignerService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHSignerCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSHSignerServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sshsigner.SSHSignerService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSHSignerServiceServer).Create(ctx, req.(*SSHSignerCreateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SSHSignerService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SSHSignerQuery)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SSHSignerServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/sshsigner.SSHSignerService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SSHSignerServiceServer).Delete(ctx, req.(*SSHSignerQuery))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _SSHSignerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "sshsigner.SSHSignerService",
+	HandlerType: (*SSHSignerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "List",
+			Handler:    _SSHSignerService_List_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _SSHSignerService_Get_Handler,
+		},
+		{
+			MethodName: "Create",
+			Handler:    _SSHSignerService_Create_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _SSHSignerService_Delete_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "server/sshsigner/sshsigner.proto",
+}
+
+func (m *SSHSignerQuery) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSHSignerQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSHSignerQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSshsigner(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SSHSignerCreateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSHSignerCreateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSHSignerCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Upsert {
+		i--
+		if m.Upsert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Signer != nil {
+		{
+			size, err := m.Signer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSshsigner(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SSHSignerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSHSignerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSHSignerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSshsigner(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSshsigner(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SSHSignerQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSshsigner(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SSHSignerCreateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Signer != nil {
+		l = m.Signer.Size()
+		n += 1 + l + sovSshsigner(uint64(l))
+	}
+	if m.Upsert {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SSHSignerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovSshsigner(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozSshsigner(x uint64) (n int) {
+	return sovSshsigner(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SSHSignerQuery) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSshsigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SSHSignerQuery: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SSHSignerQuery: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSshsigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSshsigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSshsigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSshsigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSshsigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SSHSignerCreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSshsigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SSHSignerCreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SSHSignerCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSshsigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSshsigner
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSshsigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Signer == nil {
+				m.Signer = &v1alpha1.SSHSigner{}
+			}
+			if err := m.Signer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upsert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSshsigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Upsert = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSshsigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSshsigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SSHSignerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSshsigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SSHSignerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SSHSignerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSshsigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSshsigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipSshsigner(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowSshsigner
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSshsigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowSshsigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthSshsigner
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupSshsigner
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthSshsigner
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthSshsigner        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowSshsigner          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupSshsigner = fmt.Errorf("proto: unexpected end of group")
+)
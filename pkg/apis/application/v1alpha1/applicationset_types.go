@@ -96,11 +96,40 @@ type ApplicationSetStrategy struct {
 }
 type ApplicationSetRolloutStrategy struct {
 	Steps []ApplicationSetRolloutStep `json:"steps,omitempty" protobuf:"bytes,1,opt,name=steps"`
+	// OnFailure is the policy applied when a step fails. By default the rollout stops progressing. When set to "Rollback",
+	// an Application which becomes Degraded or fails to sync fails its step, and the already updated Applications of the
+	// failed step are rolled back to their previous deployment.
+	OnFailure string `json:"onFailure,omitempty" protobuf:"bytes,2,opt,name=onFailure"`
+	// RollbackPreviousSteps also rolls back the Applications of the steps preceding the failed step when OnFailure is "Rollback"
+	RollbackPreviousSteps bool `json:"rollbackPreviousSteps,omitempty" protobuf:"varint,3,opt,name=rollbackPreviousSteps"`
 }
 
 type ApplicationSetRolloutStep struct {
 	MatchExpressions []ApplicationMatchExpression `json:"matchExpressions,omitempty" protobuf:"bytes,1,opt,name=matchExpressions"`
 	MaxUpdate        *intstr.IntOrString          `json:"maxUpdate,omitempty" protobuf:"bytes,2,opt,name=maxUpdate"`
+	// Pause is how long the rollout waits, once every Application of this step is Healthy, before moving on to the next step
+	Pause *metav1.Duration `json:"pause,omitempty" protobuf:"bytes,3,opt,name=pause"`
+	// Analysis is evaluated after the pause has elapsed. The rollout only moves on to the next step if the analysis succeeds.
+	Analysis *ApplicationSetRolloutStepAnalysis `json:"analysis,omitempty" protobuf:"bytes,4,opt,name=analysis"`
+	// RequireApproval holds the rollout at this step, after the pause and analysis, until the step is approved
+	RequireApproval bool `json:"requireApproval,omitempty" protobuf:"varint,5,opt,name=requireApproval"`
+}
+
+// ApplicationSetRolloutStepAnalysis configures the analysis gate of a RollingSync step
+type ApplicationSetRolloutStepAnalysis struct {
+	// Prometheus evaluates a PromQL query against the Prometheus server configured on the ApplicationSet controller
+	Prometheus *ApplicationSetRolloutPrometheusAnalysis `json:"prometheus,omitempty" protobuf:"bytes,1,opt,name=prometheus"`
+	// Duration is how long the analysis keeps being evaluated. Every evaluation within the duration must succeed. Defaults to a single evaluation.
+	Duration *metav1.Duration `json:"duration,omitempty" protobuf:"bytes,2,opt,name=duration"`
+	// Interval is the time between two evaluations of the analysis. Defaults to 30s.
+	Interval *metav1.Duration `json:"interval,omitempty" protobuf:"bytes,3,opt,name=interval"`
+}
+
+// ApplicationSetRolloutPrometheusAnalysis is a PromQL based analysis. An evaluation succeeds when the query returns at
+// least one sample and every returned sample is non-zero, and fails when any returned sample is zero.
+type ApplicationSetRolloutPrometheusAnalysis struct {
+	// Query is the PromQL query to evaluate
+	Query string `json:"query" protobuf:"bytes,1,opt,name=query"`
 }
 
 type ApplicationMatchExpression struct {
@@ -822,6 +851,44 @@ type ApplicationSetStatus struct {
 	ResourcesCount int64 `json:"resourcesCount,omitempty" protobuf:"varint,4,opt,name=resourcesCount"`
 	// Health contains information about the applicationset's current health status based on the applicationset conditions
 	Health HealthStatus `json:"health,omitempty" protobuf:"bytes,5,opt,name=health"`
+	// RolloutSteps contains the state of the pause, analysis and approval gates of the RollingSync steps
+	RolloutSteps []ApplicationSetRolloutStepStatus `json:"rolloutSteps,omitempty" protobuf:"bytes,6,rep,name=rolloutSteps"`
+}
+
+// ApplicationSetRolloutStepPhase is the phase of the gates of a RollingSync step
+type ApplicationSetRolloutStepPhase string
+
+const (
+	// ApplicationSetRolloutStepPaused indicates that the step waits for its pause to elapse
+	ApplicationSetRolloutStepPaused ApplicationSetRolloutStepPhase = "Paused"
+	// ApplicationSetRolloutStepAnalyzing indicates that the analysis of the step is being evaluated
+	ApplicationSetRolloutStepAnalyzing ApplicationSetRolloutStepPhase = "Analyzing"
+	// ApplicationSetRolloutStepAwaitingApproval indicates that the step waits to be approved
+	ApplicationSetRolloutStepAwaitingApproval ApplicationSetRolloutStepPhase = "AwaitingApproval"
+	// ApplicationSetRolloutStepSucceeded indicates that all the gates of the step passed and the rollout can move on
+	ApplicationSetRolloutStepSucceeded ApplicationSetRolloutStepPhase = "Succeeded"
+	// ApplicationSetRolloutStepFailed indicates that a gate of the step failed and the rollout is halted
+	ApplicationSetRolloutStepFailed ApplicationSetRolloutStepPhase = "Failed"
+)
+
+// ApplicationSetRolloutStepStatus contains the state of the gates of a RollingSync step
+type ApplicationSetRolloutStepStatus struct {
+	// Step is the 1-based index of the step
+	Step string `json:"step" protobuf:"bytes,1,opt,name=step"`
+	// Phase is the current phase of the step gates
+	Phase ApplicationSetRolloutStepPhase `json:"phase" protobuf:"bytes,2,opt,name=phase,casttype=ApplicationSetRolloutStepPhase"`
+	// Message contains human-readable message indicating details about the phase
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// StartedAt is the time at which every Application of the step became Healthy
+	StartedAt *metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,4,opt,name=startedAt"`
+	// AnalysisStartedAt is the time at which the analysis of the step started
+	AnalysisStartedAt *metav1.Time `json:"analysisStartedAt,omitempty" protobuf:"bytes,5,opt,name=analysisStartedAt"`
+	// LastAnalysisTime is the time at which the analysis of the step was last evaluated
+	LastAnalysisTime *metav1.Time `json:"lastAnalysisTime,omitempty" protobuf:"bytes,6,opt,name=lastAnalysisTime"`
+	// Approved is set once the step has been approved
+	Approved bool `json:"approved,omitempty" protobuf:"varint,7,opt,name=approved"`
+	// LastTransitionTime is the time the phase last changed
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty" protobuf:"bytes,8,opt,name=lastTransitionTime"`
 }
 
 // ApplicationSetCondition contains details about an applicationset condition, which is usually an error or warning
@@ -898,6 +965,12 @@ const (
 	ProgressiveSyncProgressing ProgressiveSyncStatusCode = "Progressing"
 	// Indicates that the application has reached an Healthy state in regards to the requested sync
 	ProgressiveSyncHealthy ProgressiveSyncStatusCode = "Healthy"
+	// Indicates that the application or a gate of its rollout step failed, halting the rollout
+	ProgressiveSyncFailed ProgressiveSyncStatusCode = "Failed"
+	// Indicates that the application's rollout step failed and that a rollback of the application has been requested
+	ProgressiveSyncRollingBack ProgressiveSyncStatusCode = "RollingBack"
+	// Indicates that the rollback of the application has been performed
+	ProgressiveSyncRolledBack ProgressiveSyncStatusCode = "RolledBack"
 )
 
 // ApplicationSetApplicationStatus contains details about each Application managed by the ApplicationSet
@@ -65,6 +65,8 @@ type RepoCreds struct {
 	AzureServicePrincipalTenantId string `json:"azureServicePrincipalTenantId,omitempty" protobuf:"bytes,31,opt,name=azureServicePrincipalTenantId"`
 	// AzureActiveDirectoryEndpoint specifies the Azure Active Directory endpoint used for Service Principal authentication. If empty will default to https://login.microsoftonline.com
 	AzureActiveDirectoryEndpoint string `json:"azureActiveDirectoryEndpoint,omitempty" protobuf:"bytes,32,opt,name=azureActiveDirectoryEndpoint"`
+	// CommitSigningKey contains the ASCII-armored GnuPG or OpenSSH private key used by the commit server to sign hydrated commits
+	CommitSigningKey string `json:"commitSigningKey,omitempty" protobuf:"bytes,33,opt,name=commitSigningKey"`
 }
 
 // Repository is a repository holding application configurations
@@ -136,6 +138,8 @@ type Repository struct {
 	AzureServicePrincipalTenantId string `json:"azureServicePrincipalTenantId,omitempty" protobuf:"bytes,31,opt,name=azureServicePrincipalTenantId"`
 	// AzureActiveDirectoryEndpoint specifies the Azure Active Directory endpoint used for Service Principal authentication. If empty will default to https://login.microsoftonline.com
 	AzureActiveDirectoryEndpoint string `json:"azureActiveDirectoryEndpoint,omitempty" protobuf:"bytes,32,opt,name=azureActiveDirectoryEndpoint"`
+	// CommitSigningKey contains the ASCII-armored GnuPG or OpenSSH private key used by the commit server to sign hydrated commits
+	CommitSigningKey string `json:"commitSigningKey,omitempty" protobuf:"bytes,33,opt,name=commitSigningKey"`
 }
 
 // IsInsecure returns true if the repository has been configured to skip server verification or set to HTTP only
@@ -201,6 +205,9 @@ func (repo *Repository) CopyCredentialsFromRepo(source *Repository) {
 		if repo.AzureActiveDirectoryEndpoint == "" {
 			repo.AzureActiveDirectoryEndpoint = source.AzureActiveDirectoryEndpoint
 		}
+		if repo.CommitSigningKey == "" {
+			repo.CommitSigningKey = source.CommitSigningKey
+		}
 		repo.InsecureOCIForceHttp = source.InsecureOCIForceHttp
 		repo.ForceHttpBasicAuth = source.ForceHttpBasicAuth
 		repo.UseAzureWorkloadIdentity = source.UseAzureWorkloadIdentity
@@ -255,6 +262,9 @@ func (repo *Repository) CopyCredentialsFrom(source *RepoCreds) {
 		if repo.AzureActiveDirectoryEndpoint == "" {
 			repo.AzureActiveDirectoryEndpoint = source.AzureActiveDirectoryEndpoint
 		}
+		if repo.CommitSigningKey == "" {
+			repo.CommitSigningKey = source.CommitSigningKey
+		}
 		if repo.Proxy == "" {
 			repo.Proxy = source.Proxy
 		}
@@ -498,3 +508,35 @@ type GnuPGPublicKeyList struct {
 	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
 	Items           []GnuPGPublicKey `json:"items" protobuf:"bytes,2,rep,name=items"`
 }
+
+// SSHSigner is a representation of a signer allowed to sign git commits and tags with SSH keys
+type SSHSigner struct {
+	// Name identifies the signer in source integrity policies
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Fingerprints holds the SHA256 fingerprints of the signer's public keys
+	Fingerprints []string `json:"fingerprints,omitempty" protobuf:"bytes,2,rep,name=fingerprints"`
+	// KeyData holds the signer's public keys in the authorized_keys format, one per line
+	KeyData string `json:"keyData,omitempty" protobuf:"bytes,3,opt,name=keyData"`
+}
+
+// SSHSignerList is a collection of SSHSigner objects
+type SSHSignerList struct {
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []SSHSigner `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
+
+// CosignPublicKey is a representation of a public key trusted to sign OCI artifacts with cosign
+type CosignPublicKey struct {
+	// Name identifies the key in source integrity policies
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// KeyType holds the algorithm of the key (e.g. ECDSA-P-256)
+	KeyType string `json:"keyType,omitempty" protobuf:"bytes,2,opt,name=keyType"`
+	// KeyData holds the PEM encoded public key
+	KeyData string `json:"keyData,omitempty" protobuf:"bytes,3,opt,name=keyData"`
+}
+
+// CosignPublicKeyList is a collection of CosignPublicKey objects
+type CosignPublicKeyList struct {
+	metav1.ListMeta `json:"metadata,omitempty" protobuf:"bytes,1,opt,name=metadata"`
+	Items           []CosignPublicKey `json:"items" protobuf:"bytes,2,rep,name=items"`
+}
@@ -7,7 +7,11 @@ import (
 
 type SourceIntegrity struct {
 	// Git - policies for git source verification
-	Git *SourceIntegrityGit `json:"git" protobuf:"bytes,1,name=git"` // A mandatory field until there are alternatives
+	Git *SourceIntegrityGit `json:"git,omitempty" protobuf:"bytes,1,name=git"`
+	// OCI - policies for OCI source verification
+	OCI *SourceIntegrityOCI `json:"oci,omitempty" protobuf:"bytes,2,name=oci"`
+	// Helm - policies for Helm repository source verification
+	Helm *SourceIntegrityHelm `json:"helm,omitempty" protobuf:"bytes,3,name=helm"`
 }
 
 type SourceIntegrityGit struct {
@@ -18,7 +22,9 @@ type SourceIntegrityGitPolicy struct {
 	// List of repository criteria restricting repositories the policy will apply to
 	Repos []SourceIntegrityGitPolicyRepo `json:"repos" protobuf:"bytes,1,name=repos"`
 	// Verify GPG commit/tag signatures
-	GPG *SourceIntegrityGitPolicyGPG `json:"gpg" protobuf:"bytes,2,name=gpg"` // A mandatory field until there are alternatives
+	GPG *SourceIntegrityGitPolicyGPG `json:"gpg,omitempty" protobuf:"bytes,2,name=gpg"`
+	// Verify SSH commit/tag signatures
+	SSH *SourceIntegrityGitPolicySSH `json:"ssh,omitempty" protobuf:"bytes,3,name=ssh"`
 }
 
 type SourceIntegrityGitPolicyRepo struct {
@@ -53,6 +59,80 @@ type SourceIntegrityGitPolicyGPG struct {
 	Keys []string `json:"keys" protobuf:"bytes,3,name=keys"`
 }
 
+type SourceIntegrityGitPolicySSHMode string
+
+var (
+	// SourceIntegrityGitPolicySSHModeNone performs no verification at all. This is useful for troubleshooting.
+	SourceIntegrityGitPolicySSHModeNone SourceIntegrityGitPolicySSHMode = "none"
+	// SourceIntegrityGitPolicySSHModeHead verifies the current target revision, an annotated tag, or a commit.
+	SourceIntegrityGitPolicySSHModeHead SourceIntegrityGitPolicySSHMode = "head"
+	// SourceIntegrityGitPolicySSHModeStrict verifies all ancestry of target revision all the way to git init or seal commits.
+	// If pointing to an annotated tag, it verifies both the tag signature and the commit history.
+	SourceIntegrityGitPolicySSHModeStrict SourceIntegrityGitPolicySSHMode = "strict"
+)
+
+// SourceIntegrityGitPolicySSH verifies that the commit(s) are correctly signed with an SSH key (git's gpg.format=ssh)
+// of one of the signers listed in Signers.
+//
+// When declared together with GPG in the same policy, a commit or a tag is accepted when it satisfies either of them.
+// This permits verifying repositories where some contributors sign with GnuPG keys and others with SSH keys.
+type SourceIntegrityGitPolicySSH struct {
+	Mode SourceIntegrityGitPolicySSHMode `json:"mode" protobuf:"bytes,1,name=mode"`
+	// List of names of the signers to trust. The signers need to be configured in the argocd-ssh-signers-cm ConfigMap.
+	Signers []string `json:"signers" protobuf:"bytes,2,name=signers"`
+}
+
+type SourceIntegrityOCI struct {
+	Policies []*SourceIntegrityOCIPolicy `json:"policies" protobuf:"bytes,1,name=policies"`
+}
+
+type SourceIntegrityOCIPolicy struct {
+	// List of repository criteria restricting repositories the policy will apply to
+	Repos []SourceIntegrityOCIPolicyRepo `json:"repos" protobuf:"bytes,1,name=repos"`
+	// Verify cosign signatures of the resolved artifact digest
+	Cosign *SourceIntegrityOCIPolicyCosign `json:"cosign" protobuf:"bytes,2,name=cosign"` // A mandatory field until there are alternatives
+}
+
+type SourceIntegrityOCIPolicyRepo struct {
+	// URL specifier, glob. Matched against the source repoURL, including the oci:// scheme.
+	URL string `json:"url" protobuf:"bytes,1,name=url"`
+}
+
+// SourceIntegrityOCIPolicyCosign verifies that the resolved OCI artifact digest has a cosign signature attached,
+// made by one of the public keys listed in Keys.
+//
+// Only key-based signatures stored using the cosign tag convention (sha256-<digest>.sig) are recognized.
+// The transparency log is not consulted.
+type SourceIntegrityOCIPolicyCosign struct {
+	// List of names of cosign public keys to trust. The keys need to be configured in the argocd-cosign-keys-cm ConfigMap.
+	Keys []string `json:"keys" protobuf:"bytes,1,name=keys"`
+}
+
+type SourceIntegrityHelm struct {
+	Policies []*SourceIntegrityHelmPolicy `json:"policies" protobuf:"bytes,1,name=policies"`
+}
+
+type SourceIntegrityHelmPolicy struct {
+	// List of repository criteria restricting repositories the policy will apply to
+	Repos []SourceIntegrityHelmPolicyRepo `json:"repos" protobuf:"bytes,1,name=repos"`
+	// Verify the provenance file of the chart
+	Provenance *SourceIntegrityHelmPolicyProvenance `json:"provenance" protobuf:"bytes,2,name=provenance"` // A mandatory field until there are alternatives
+}
+
+type SourceIntegrityHelmPolicyRepo struct {
+	// URL specifier, glob. Matched against the source repoURL.
+	URL string `json:"url" protobuf:"bytes,1,name=url"`
+}
+
+// SourceIntegrityHelmPolicyProvenance verifies that the chart archive has a provenance file (<chart>-<version>.tgz.prov)
+// signed by one of the keys listed in Keys, and that the archive digest matches the one recorded in the provenance file.
+//
+// This policy can be deactivated through the ARGOCD_GPG_ENABLED environment variable.
+type SourceIntegrityHelmPolicyProvenance struct {
+	// List of key IDs to trust. The keys need to be in the repository server keyring.
+	Keys []string `json:"keys" protobuf:"bytes,1,name=keys"`
+}
+
 // CacheKey computes a unique string key to be used for caching.
 // The key's value will change if any of the fields in SourceIntegrity change.
 func (s *SourceIntegrity) CacheKey() string {
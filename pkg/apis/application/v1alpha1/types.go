@@ -430,8 +430,8 @@ type SourceHydrator struct {
 	DrySource DrySource `json:"drySource" protobuf:"bytes,1,name=drySource"`
 	// SyncSource specifies where to sync hydrated manifests from.
 	SyncSource SyncSource `json:"syncSource" protobuf:"bytes,2,name=syncSource"`
-	// HydrateTo specifies an optional "staging" location to push hydrated manifests to. An external system would then
-	// have to move manifests to the SyncSource, e.g. by pull request.
+	// HydrateTo specifies an optional "staging" location to push hydrated manifests to. Unless HydrateTo configures a
+	// promotion, an external system would then have to move manifests to the SyncSource, e.g. by pull request.
 	HydrateTo *HydrateTo `json:"hydrateTo,omitempty" protobuf:"bytes,3,opt,name=hydrateTo"`
 }
 
@@ -515,13 +515,98 @@ type SyncSource struct {
 	// RepoURL is the URL to the git repository that contains the hydrated manifests. If not set, defaults to
 	// the DrySource.RepoURL.
 	RepoURL string `json:"repoURL,omitempty" protobuf:"bytes,3,opt,name=repoURL"`
+	// Layout is the way the hydrated manifests are laid out in files within the Path. Defaults to SingleFile.
+	Layout HydratedManifestLayout `json:"layout,omitempty" protobuf:"bytes,4,opt,name=layout"`
 }
 
+// HydratedManifestLayout is the way hydrated manifests are laid out in files within the hydrated path
+// +kubebuilder:validation:Enum=SingleFile;PerResource;PerNamespace;Kustomization
+type HydratedManifestLayout string
+
+const (
+	// HydratedManifestLayoutSingleFile writes all the manifests to a single manifest.yaml file
+	HydratedManifestLayoutSingleFile HydratedManifestLayout = "SingleFile"
+	// HydratedManifestLayoutPerResource writes each manifest to its own <kind>-<name>.yaml file
+	HydratedManifestLayoutPerResource HydratedManifestLayout = "PerResource"
+	// HydratedManifestLayoutPerNamespace writes the manifests of each namespace to a <namespace>.yaml file, and the
+	// manifests without a namespace, like cluster-scoped ones, to a _cluster.yaml file
+	HydratedManifestLayoutPerNamespace HydratedManifestLayout = "PerNamespace"
+	// HydratedManifestLayoutKustomization writes all the manifests to a single manifest.yaml file, along with a
+	// kustomization.yaml file listing it as a resource
+	HydratedManifestLayoutKustomization HydratedManifestLayout = "Kustomization"
+)
+
 // HydrateTo specifies a branch to which hydrated manifests should be pushed as a "staging area" before being moved to
 // the SyncSource. The repository and path are inherited from SyncSource.
 type HydrateTo struct {
 	// TargetBranch is the branch to which hydrated manifests should be committed
 	TargetBranch string `json:"targetBranch" protobuf:"bytes,1,name=targetBranch"`
+	// Promotion configures Argo CD to move the hydrated manifests from the TargetBranch to the SyncSource branch. If not
+	// set, the manifests are left for an external system to promote.
+	Promotion *HydratePromotion `json:"promotion,omitempty" protobuf:"bytes,2,opt,name=promotion"`
+}
+
+// HydratePromotionStrategy is the way hydrated manifests are promoted to the SyncSource branch
+// +kubebuilder:validation:Enum=FastForward;PullRequest
+type HydratePromotionStrategy string
+
+const (
+	// HydratePromotionStrategyFastForward pushes the hydrated commit to the SyncSource branch, provided it
+	// fast-forwards the branch
+	HydratePromotionStrategyFastForward HydratePromotionStrategy = "FastForward"
+	// HydratePromotionStrategyPullRequest opens a pull request from the HydrateTo branch to the SyncSource branch
+	HydratePromotionStrategyPullRequest HydratePromotionStrategy = "PullRequest"
+)
+
+// HydratePromotion specifies how, and under which conditions, hydrated manifests are promoted from the HydrateTo
+// branch to the SyncSource branch.
+type HydratePromotion struct {
+	// Strategy is the way the hydrated manifests are promoted, either FastForward or PullRequest
+	Strategy HydratePromotionStrategy `json:"strategy" protobuf:"bytes,1,name=strategy"`
+	// PullRequest specifies the SCM provider to open the pull requests with. Required by the PullRequest strategy.
+	PullRequest *HydratePromotionPullRequest `json:"pullRequest,omitempty" protobuf:"bytes,2,opt,name=pullRequest"`
+	// Gates are the checks that all need to pass before the hydrated manifests are promoted
+	Gates []HydratePromotionGate `json:"gates,omitempty" protobuf:"bytes,3,rep,name=gates"`
+}
+
+// HydratePromotionPullRequestProvider is the SCM provider pull requests are opened with
+// +kubebuilder:validation:Enum=GitHub;GitLab
+type HydratePromotionPullRequestProvider string
+
+const (
+	HydratePromotionPullRequestProviderGitHub HydratePromotionPullRequestProvider = "GitHub"
+	HydratePromotionPullRequestProviderGitLab HydratePromotionPullRequestProvider = "GitLab"
+)
+
+// HydratePromotionPullRequest specifies the SCM provider hosting the hydrated repository. The provider is accessed with
+// the write credentials of the repository.
+type HydratePromotionPullRequest struct {
+	// Provider is the SCM provider hosting the repository, either GitHub or GitLab
+	Provider HydratePromotionPullRequestProvider `json:"provider" protobuf:"bytes,1,name=provider"`
+	// API is the URL of the provider API, e.g. of a GitHub Enterprise or self-hosted GitLab instance. Defaults to the
+	// public API of the provider.
+	API string `json:"api,omitempty" protobuf:"bytes,2,opt,name=api"`
+}
+
+// HydratePromotionGate is a check that needs to pass before hydrated manifests are promoted
+type HydratePromotionGate struct {
+	// Application requires another Application, typically the one of the previous environment, to be in a given state
+	Application *HydratePromotionApplicationGate `json:"application,omitempty" protobuf:"bytes,1,opt,name=application"`
+}
+
+// HydratePromotionApplicationGate requires an Application to report the given health, and optionally to be synced to
+// manifests hydrated from the same dry revision.
+type HydratePromotionApplicationGate struct {
+	// Name is the name of the Application
+	Name string `json:"name" protobuf:"bytes,1,name=name"`
+	// Namespace is the namespace of the Application. Defaults to the namespace of the promoted Application.
+	Namespace string `json:"namespace,omitempty" protobuf:"bytes,2,opt,name=namespace"`
+	// Health is the health status the Application needs to report. Defaults to Healthy.
+	Health health.HealthStatusCode `json:"health,omitempty" protobuf:"bytes,3,opt,name=health"`
+	// Synced requires the Application to be synced
+	Synced bool `json:"synced,omitempty" protobuf:"varint,4,opt,name=synced"`
+	// SameDryRevision requires the Application to be hydrated from the same dry revision as the promoted manifests
+	SameDryRevision bool `json:"sameDryRevision,omitempty" protobuf:"varint,5,opt,name=sameDryRevision"`
 }
 
 // DeepEquals returns true if the HydrateTo is deeply equal to the given HydrateTo.
@@ -533,8 +618,7 @@ func (in *HydrateTo) DeepEquals(to *HydrateTo) bool {
 		// We already know in is not nil.
 		return false
 	}
-	// Compare de-referenced structs.
-	return *in == *to
+	return reflect.DeepEqual(in, to)
 }
 
 // RefreshType specifies how to refresh the sources of a given application
@@ -1241,6 +1325,8 @@ type ApplicationStatus struct {
 	ControllerNamespace string `json:"controllerNamespace,omitempty" protobuf:"bytes,13,opt,name=controllerNamespace"`
 	// SourceHydrator stores information about the current state of source hydration
 	SourceHydrator SourceHydratorStatus `json:"sourceHydrator,omitempty" protobuf:"bytes,14,opt,name=sourceHydrator"`
+	// NotificationDeliveries contains the history of the most recent notifications sent about the application
+	NotificationDeliveries NotificationDeliveries `json:"notificationDeliveries,omitempty" protobuf:"bytes,15,opt,name=notificationDeliveries"`
 }
 
 // SourceHydratorStatus contains information about the current state of source hydration
@@ -1252,6 +1338,104 @@ type SourceHydratorStatus struct {
 	// LastComparedDryRevision holds the resolved revision from the most recent dry source comparison.
 	// This is updated on every evaluation, even when hydration is skipped due to no changes.
 	LastComparedDryRevision string `json:"lastComparedDryRevision,omitempty" protobuf:"bytes,3,opt,name=lastComparedDryRevision"`
+	// CurrentPromotion holds the status of the promotion of the most recently hydrated manifests
+	CurrentPromotion *HydratePromotionStatus `json:"currentPromotion,omitempty" protobuf:"bytes,4,opt,name=currentPromotion"`
+}
+
+// NotificationDeliveryPhase is the phase of the delivery of a notification
+type NotificationDeliveryPhase string
+
+const (
+	// NotificationDeliveryPhasePending indicates that the notification is waiting to be (re)delivered
+	NotificationDeliveryPhasePending NotificationDeliveryPhase = "Pending"
+	// NotificationDeliveryPhaseSucceeded indicates that the notification was delivered
+	NotificationDeliveryPhaseSucceeded NotificationDeliveryPhase = "Succeeded"
+	// NotificationDeliveryPhaseFailed indicates that the notification could not be delivered and is no longer retried
+	NotificationDeliveryPhaseFailed NotificationDeliveryPhase = "Failed"
+)
+
+// NotificationDelivery contains information about the delivery of a notification about the application
+type NotificationDelivery struct {
+	// ID is an auto incrementing identifier of the NotificationDelivery
+	ID int64 `json:"id" protobuf:"bytes,1,opt,name=id"`
+	// Trigger is the name of the trigger which sent the notification
+	Trigger string `json:"trigger,omitempty" protobuf:"bytes,2,opt,name=trigger"`
+	// Templates holds the names of the templates used to render the notification
+	Templates []string `json:"templates,omitempty" protobuf:"bytes,3,opt,name=templates"`
+	// Service is the name of the notification service, such as slack or webhook
+	Service string `json:"service" protobuf:"bytes,4,opt,name=service"`
+	// Recipient is the recipient of the notification, such as a Slack channel
+	Recipient string `json:"recipient,omitempty" protobuf:"bytes,5,opt,name=recipient"`
+	// Phase is the current phase of the delivery
+	Phase NotificationDeliveryPhase `json:"phase" protobuf:"bytes,6,opt,name=phase,casttype=NotificationDeliveryPhase"`
+	// Message contains the error of the last failed attempt
+	Message string `json:"message,omitempty" protobuf:"bytes,7,opt,name=message"`
+	// Attempts is the number of attempts made to deliver the notification
+	Attempts int64 `json:"attempts,omitempty" protobuf:"bytes,8,opt,name=attempts"`
+	// CreatedAt holds the time the notification was first sent
+	CreatedAt metav1.Time `json:"createdAt" protobuf:"bytes,9,opt,name=createdAt"`
+	// LastAttemptAt holds the time of the last attempt
+	LastAttemptAt *metav1.Time `json:"lastAttemptAt,omitempty" protobuf:"bytes,10,opt,name=lastAttemptAt"`
+	// NextAttemptAt holds the time at which a pending notification is delivered again
+	NextAttemptAt *metav1.Time `json:"nextAttemptAt,omitempty" protobuf:"bytes,11,opt,name=nextAttemptAt"`
+	// RedeliveryOf is the ID of the delivery redelivered by this delivery, if any
+	RedeliveryOf int64 `json:"redeliveryOf,omitempty" protobuf:"bytes,12,opt,name=redeliveryOf"`
+}
+
+// NotificationDeliveries is a list of notification deliveries, ordered from the oldest to the most recent
+type NotificationDeliveries []NotificationDelivery
+
+// Find returns the delivery with the given ID, or nil if there is none
+func (in NotificationDeliveries) Find(id int64) *NotificationDelivery {
+	for i := range in {
+		if in[i].ID == id {
+			return &in[i]
+		}
+	}
+	return nil
+}
+
+// NextID returns the ID of the next delivery
+func (in NotificationDeliveries) NextID() int64 {
+	if len(in) == 0 {
+		return 0
+	}
+	return in[len(in)-1].ID + 1
+}
+
+// Trunc truncates the list of deliveries to size n. The oldest completed deliveries are removed first, so that pending
+// deliveries are kept as long as possible.
+func (in NotificationDeliveries) Trunc(n int) NotificationDeliveries {
+	if n < 0 {
+		n = 0
+	}
+	excess := len(in) - n
+	if excess <= 0 {
+		return in
+	}
+	completed := 0
+	for _, delivery := range in {
+		if delivery.Phase != NotificationDeliveryPhasePending {
+			completed++
+		}
+	}
+	// pending deliveries are only removed if there are not enough completed ones
+	pending := max(excess-completed, 0)
+	res := make(NotificationDeliveries, 0, n)
+	for _, delivery := range in {
+		switch {
+		case excess == 0:
+			res = append(res, delivery)
+		case delivery.Phase != NotificationDeliveryPhasePending:
+			excess--
+		case pending > 0:
+			pending--
+			excess--
+		default:
+			res = append(res, delivery)
+		}
+	}
+	return res
 }
 
 func (status *ApplicationStatus) FindResource(key kube.ResourceKey) (*ResourceStatus, bool) {
@@ -1302,6 +1486,33 @@ const (
 	HydrateOperationPhaseHydrated  HydrateOperationPhase = "Hydrated"
 )
 
+// HydratePromotionStatus contains information about the promotion of hydrated manifests to the SyncSource branch
+type HydratePromotionStatus struct {
+	// HydratedSHA is the hydrated commit being promoted
+	HydratedSHA string `json:"hydratedSHA" protobuf:"bytes,1,opt,name=hydratedSHA"`
+	// Phase indicates the status of the promotion
+	Phase HydratePromotionPhase `json:"phase" protobuf:"bytes,2,opt,name=phase"`
+	// Message contains a message describing the current status of the promotion
+	Message string `json:"message,omitempty" protobuf:"bytes,3,opt,name=message"`
+	// PullRequestURL is the URL of the pull request promoting the manifests, set by the PullRequest strategy
+	PullRequestURL string `json:"pullRequestURL,omitempty" protobuf:"bytes,4,opt,name=pullRequestURL"`
+	// FinishedAt indicates when the promotion was completed, or when it failed
+	FinishedAt *metav1.Time `json:"finishedAt,omitempty" protobuf:"bytes,5,opt,name=finishedAt"`
+}
+
+// HydratePromotionPhase indicates the status of a promotion
+// +kubebuilder:validation:Enum=Pending;Promoted;Failed
+type HydratePromotionPhase string
+
+const (
+	// HydratePromotionPhasePending means the promotion waits for its gates to pass
+	HydratePromotionPhasePending HydratePromotionPhase = "Pending"
+	// HydratePromotionPhasePromoted means the branch was fast-forwarded, or the pull request was opened
+	HydratePromotionPhasePromoted HydratePromotionPhase = "Promoted"
+	// HydratePromotionPhaseFailed means the promotion failed, it is retried later
+	HydratePromotionPhaseFailed HydratePromotionPhase = "Failed"
+)
+
 // GetRevisions will return the current revision associated with the Application.
 // If app has multisources, it will return all corresponding revisions preserving
 // order from the app.spec.sources. If app has only one source, it will return a
@@ -1794,6 +2005,8 @@ type ResourceResult struct {
 	SyncPhase synccommon.SyncPhase `json:"syncPhase,omitempty" protobuf:"bytes,10,opt,name=syncPhase"`
 	// Images contains the images related to the ResourceResult
 	Images []string `json:"images,omitempty" protobuf:"bytes,11,opt,name=images"`
+	// StartedAt is the time at which the resource or hook started running, used to enforce the timeout of its sync wave
+	StartedAt *metav1.Time `json:"startedAt,omitempty" protobuf:"bytes,12,opt,name=startedAt"`
 }
 
 // GroupVersionKind returns the GVK schema information for a given resource within a sync result
@@ -1914,6 +2127,10 @@ const (
 	ApplicationConditionExcludedResourceWarning = "ExcludedResourceWarning"
 	// ApplicationConditionOrphanedResourceWarning indicates that application has orphaned resources
 	ApplicationConditionOrphanedResourceWarning = "OrphanedResourceWarning"
+	// ApplicationConditionPolicyViolationWarning indicates that application has resources which violate a manifest policy
+	ApplicationConditionPolicyViolationWarning = "PolicyViolationWarning"
+	// ApplicationConditionPolicyViolationError indicates that application has resources which violate a manifest policy denying the sync
+	ApplicationConditionPolicyViolationError = "PolicyViolationError"
 )
 
 // ApplicationCondition contains details about an application condition, which is usually an error or warning
@@ -2288,6 +2505,22 @@ type ResourceDiff struct {
 	ResourceVersion string `json:"resourceVersion,omitempty" protobuf:"bytes,11,opt,name=resourceVersion"`
 	// Modified indicates whether the live resource has changes compared to the target resource.
 	Modified bool `json:"modified,omitempty" protobuf:"bytes,12,opt,name=modified"`
+	// Changes lists the changed fields between the normalized live state and the predicted live state. The items of
+	// the lists are identified by the merge keys of the schema of the resource, so reordered items are not changes.
+	Changes []ResourceDiffChange `json:"changes,omitempty" protobuf:"bytes,13,rep,name=changes"`
+}
+
+// ResourceDiffChange is a change of a field between the normalized live state and the predicted live state of a resource
+type ResourceDiffChange struct {
+	// Operation is the kind of change of the field: add, remove or replace
+	Operation string `json:"op" protobuf:"bytes,1,opt,name=op"`
+	// Path is the path of the field, e.g. .spec.template.spec.containers[name="nginx"].image. It is empty if the whole
+	// resource is added or removed.
+	Path string `json:"path" protobuf:"bytes,2,opt,name=path"`
+	// LiveValue contains the JSON-serialized value of the field in the normalized live state, unless the field is added
+	LiveValue string `json:"liveValue,omitempty" protobuf:"bytes,3,opt,name=liveValue"`
+	// TargetValue contains the JSON-serialized value of the field in the predicted live state, unless the field is removed
+	TargetValue string `json:"targetValue,omitempty" protobuf:"bytes,4,opt,name=targetValue"`
 }
 
 // FullName returns full name of a node that was used for diffing in the format "group/kind/namespace/name"
@@ -2587,6 +2820,8 @@ type rawResourceOverride struct {
 	IgnoreDifferences     string           `json:"ignoreDifferences,omitempty"`
 	IgnoreResourceUpdates string           `json:"ignoreResourceUpdates,omitempty"`
 	KnownTypeFields       []KnownTypeField `json:"knownTypeFields,omitempty"`
+	HealthPlugin          *HealthPlugin    `json:"health.plugin,omitempty"`
+	HealthCEL             string           `json:"health.cel,omitempty"`
 }
 
 // ResourceOverride holds configuration to customize resource diffing and health assessment
@@ -2603,6 +2838,24 @@ type ResourceOverride struct {
 	IgnoreResourceUpdates OverrideIgnoreDiff `protobuf:"bytes,6,opt,name=ignoreResourceUpdates"`
 	// KnownTypeFields lists fields for which unit conversions should be applied.
 	KnownTypeFields []KnownTypeField `protobuf:"bytes,4,opt,name=knownTypeFields"`
+	// HealthPlugin is the health plugin which assesses the health of the resource. The health is assessed by the
+	// Lua script or the built-in health check if the plugin fails.
+	HealthPlugin *HealthPlugin `protobuf:"bytes,7,opt,name=healthPlugin"`
+	// HealthCEL contains a CEL expression that defines custom health checks for the resource. It takes precedence over
+	// the Lua script.
+	HealthCEL string `protobuf:"bytes,8,opt,name=healthCEL"`
+}
+
+// HealthPlugin configures a health plugin, which assesses the health of resources through the health plugin gRPC API
+type HealthPlugin struct {
+	// Name is the name of the plugin. The plugin serves the health plugin API on the Unix socket <name>.sock of the
+	// health plugins directory of the application controller.
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Timeout is the maximum duration of a health assessment by the plugin. Defaults to 5s.
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,2,opt,name=timeout"`
+	// CacheTTL is the duration during which the health of a version of a resource assessed by the plugin is cached.
+	// The health is not cached by default.
+	CacheTTL *metav1.Duration `json:"cacheTTL,omitempty" protobuf:"bytes,3,opt,name=cacheTTL"`
 }
 
 // UnmarshalJSON unmarshals a JSON byte slice into a ResourceOverride object.
@@ -2616,6 +2869,8 @@ func (ro *ResourceOverride) UnmarshalJSON(data []byte) error {
 	ro.KnownTypeFields = raw.KnownTypeFields
 	ro.HealthLua = raw.HealthLua
 	ro.UseOpenLibs = raw.UseOpenLibs
+	ro.HealthPlugin = raw.HealthPlugin
+	ro.HealthCEL = raw.HealthCEL
 	ro.Actions = raw.Actions
 	err := yaml.Unmarshal([]byte(raw.IgnoreDifferences), &ro.IgnoreDifferences)
 	if err != nil {
@@ -2639,7 +2894,7 @@ func (ro ResourceOverride) MarshalJSON() ([]byte, error) {
 	if err != nil {
 		return nil, err
 	}
-	raw := &rawResourceOverride{ro.HealthLua, ro.UseOpenLibs, ro.Actions, string(ignoreDifferencesData), string(ignoreResourceUpdatesData), ro.KnownTypeFields}
+	raw := &rawResourceOverride{ro.HealthLua, ro.UseOpenLibs, ro.Actions, string(ignoreDifferencesData), string(ignoreResourceUpdatesData), ro.KnownTypeFields, ro.HealthPlugin, ro.HealthCEL}
 	return json.Marshal(raw)
 }
 
@@ -2664,6 +2919,8 @@ type ResourceActions struct {
 	Definitions []ResourceActionDefinition `json:"definitions,omitempty" protobuf:"bytes,2,rep,name=definitions"`
 	// MergeBuiltinActions indicates whether built-in actions should be merged with custom actions.
 	MergeBuiltinActions bool `json:"mergeBuiltinActions,omitempty" yaml:"mergeBuiltinActions,omitempty" protobuf:"bytes,3,opt,name=mergeBuiltinActions"`
+	// ActionDiscoveryCEL contains a CEL expression for discovering actions. It takes precedence over the Lua script.
+	ActionDiscoveryCEL string `json:"discovery.cel,omitempty" yaml:"discovery.cel,omitempty" protobuf:"bytes,4,opt,name=actionDiscoveryCEL"`
 }
 
 // ResourceActionDefinition defines an individual action that can be executed on a resource.
@@ -2673,6 +2930,9 @@ type ResourceActionDefinition struct {
 	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
 	// ActionLua contains the Lua script that defines the behavior of the action.
 	ActionLua string `json:"action.lua" yaml:"action.lua" protobuf:"bytes,2,opt,name=actionLua"`
+	// ActionCEL contains the CEL expression that returns the patch applied to the resource by the action. It takes
+	// precedence over the Lua script.
+	ActionCEL string `json:"action.cel,omitempty" yaml:"action.cel,omitempty" protobuf:"bytes,3,opt,name=actionCEL"`
 }
 
 // ResourceAction represents an individual action that can be performed on a resource.
@@ -3682,6 +3682,30 @@ func TestRevisionHistories_Trunc(t *testing.T) {
 	assert.Empty(t, RevisionHistories{}.Trunc(-1))
 }
 
+func TestNotificationDeliveries_Trunc(t *testing.T) {
+	pending := NotificationDeliveryPhasePending
+	succeeded := NotificationDeliveryPhaseSucceeded
+	assert.Empty(t, NotificationDeliveries{}.Trunc(1))
+	assert.Empty(t, NotificationDeliveries{{ID: 0}}.Trunc(-1))
+	assert.Equal(t, NotificationDeliveries{{ID: 1}}, NotificationDeliveries{{ID: 0}, {ID: 1}}.Trunc(1))
+	// completed deliveries are removed before pending ones
+	assert.Equal(t,
+		NotificationDeliveries{{ID: 0, Phase: pending}, {ID: 2, Phase: succeeded}},
+		NotificationDeliveries{{ID: 0, Phase: pending}, {ID: 1, Phase: succeeded}, {ID: 2, Phase: succeeded}}.Trunc(2))
+	// the oldest pending deliveries are removed when there are not enough completed ones
+	assert.Equal(t,
+		NotificationDeliveries{{ID: 2, Phase: pending}},
+		NotificationDeliveries{{ID: 0, Phase: succeeded}, {ID: 1, Phase: pending}, {ID: 2, Phase: pending}}.Trunc(1))
+}
+
+func TestNotificationDeliveries_Find(t *testing.T) {
+	deliveries := NotificationDeliveries{{ID: 1, Service: "slack"}, {ID: 2, Service: "webhook"}}
+	assert.Equal(t, "webhook", deliveries.Find(2).Service)
+	assert.Nil(t, deliveries.Find(3))
+	assert.Equal(t, int64(3), deliveries.NextID())
+	assert.Equal(t, int64(0), NotificationDeliveries{}.NextID())
+}
+
 func TestApplicationSpec_GetRevisionHistoryLimit(t *testing.T) {
 	// default
 	assert.Equal(t, 10, ApplicationSpec{}.GetRevisionHistoryLimit())
@@ -5161,6 +5185,18 @@ func TestSourceHydrator_Equals(t *testing.T) {
 	}{
 		{"different SourceHydrators", SourceHydrator{}, SourceHydrator{DrySource: DrySource{Helm: &ApplicationSourceHelm{Namespace: "test"}}}, false},
 		{"equal SourceHydrators", SourceHydrator{DrySource: DrySource{Helm: &ApplicationSourceHelm{Namespace: "test"}}}, SourceHydrator{DrySource: DrySource{Helm: &ApplicationSourceHelm{Namespace: "test"}}}, true},
+		{
+			"equal promotions",
+			SourceHydrator{HydrateTo: &HydrateTo{TargetBranch: "next", Promotion: &HydratePromotion{Strategy: HydratePromotionStrategyFastForward, Gates: []HydratePromotionGate{{Application: &HydratePromotionApplicationGate{Name: "staging"}}}}}},
+			SourceHydrator{HydrateTo: &HydrateTo{TargetBranch: "next", Promotion: &HydratePromotion{Strategy: HydratePromotionStrategyFastForward, Gates: []HydratePromotionGate{{Application: &HydratePromotionApplicationGate{Name: "staging"}}}}}},
+			true,
+		},
+		{
+			"different promotions",
+			SourceHydrator{HydrateTo: &HydrateTo{TargetBranch: "next", Promotion: &HydratePromotion{Strategy: HydratePromotionStrategyFastForward}}},
+			SourceHydrator{HydrateTo: &HydrateTo{TargetBranch: "next", Promotion: &HydratePromotion{Strategy: HydratePromotionStrategyPullRequest}}},
+			false,
+		},
 	}
 
 	for _, testCase := range tests {
@@ -708,6 +708,22 @@ func (in *ApplicationSetResourceIgnoreDifferences) DeepCopy() *ApplicationSetRes
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRolloutPrometheusAnalysis) DeepCopyInto(out *ApplicationSetRolloutPrometheusAnalysis) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRolloutPrometheusAnalysis.
+func (in *ApplicationSetRolloutPrometheusAnalysis) DeepCopy() *ApplicationSetRolloutPrometheusAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRolloutPrometheusAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetRolloutStep) DeepCopyInto(out *ApplicationSetRolloutStep) {
 	*out = *in
@@ -723,6 +739,16 @@ func (in *ApplicationSetRolloutStep) DeepCopyInto(out *ApplicationSetRolloutStep
 		*out = new(intstr.IntOrString)
 		**out = **in
 	}
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(ApplicationSetRolloutStepAnalysis)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -736,6 +762,69 @@ func (in *ApplicationSetRolloutStep) DeepCopy() *ApplicationSetRolloutStep {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRolloutStepAnalysis) DeepCopyInto(out *ApplicationSetRolloutStepAnalysis) {
+	*out = *in
+	if in.Prometheus != nil {
+		in, out := &in.Prometheus, &out.Prometheus
+		*out = new(ApplicationSetRolloutPrometheusAnalysis)
+		**out = **in
+	}
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRolloutStepAnalysis.
+func (in *ApplicationSetRolloutStepAnalysis) DeepCopy() *ApplicationSetRolloutStepAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRolloutStepAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSetRolloutStepStatus) DeepCopyInto(out *ApplicationSetRolloutStepStatus) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.AnalysisStartedAt != nil {
+		in, out := &in.AnalysisStartedAt, &out.AnalysisStartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.LastAnalysisTime != nil {
+		in, out := &in.LastAnalysisTime, &out.LastAnalysisTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastTransitionTime != nil {
+		in, out := &in.LastTransitionTime, &out.LastTransitionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSetRolloutStepStatus.
+func (in *ApplicationSetRolloutStepStatus) DeepCopy() *ApplicationSetRolloutStepStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSetRolloutStepStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApplicationSetRolloutStrategy) DeepCopyInto(out *ApplicationSetRolloutStrategy) {
 	*out = *in
@@ -840,6 +929,13 @@ func (in *ApplicationSetStatus) DeepCopyInto(out *ApplicationSetStatus) {
 		}
 	}
 	in.Health.DeepCopyInto(&out.Health)
+	if in.RolloutSteps != nil {
+		in, out := &in.RolloutSteps, &out.RolloutSteps
+		*out = make([]ApplicationSetRolloutStepStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1459,6 +1555,13 @@ func (in *ApplicationStatus) DeepCopyInto(out *ApplicationStatus) {
 		copy(*out, *in)
 	}
 	in.SourceHydrator.DeepCopyInto(&out.SourceHydrator)
+	if in.NotificationDeliveries != nil {
+		in, out := &in.NotificationDeliveries, &out.NotificationDeliveries
+		*out = make(NotificationDeliveries, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1988,6 +2091,44 @@ func (in *ConnectionState) DeepCopy() *ConnectionState {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CosignPublicKey) DeepCopyInto(out *CosignPublicKey) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CosignPublicKey.
+func (in *CosignPublicKey) DeepCopy() *CosignPublicKey {
+	if in == nil {
+		return nil
+	}
+	out := new(CosignPublicKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CosignPublicKeyList) DeepCopyInto(out *CosignPublicKeyList) {
+	*out = *in
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CosignPublicKey, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CosignPublicKeyList.
+func (in *CosignPublicKeyList) DeepCopy() *CosignPublicKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(CosignPublicKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DrySource) DeepCopyInto(out *DrySource) {
 	*out = *in
@@ -2238,6 +2379,32 @@ func (in *GnuPGPublicKeyList) DeepCopy() *GnuPGPublicKeyList {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthPlugin) DeepCopyInto(out *HealthPlugin) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.CacheTTL != nil {
+		in, out := &in.CacheTTL, &out.CacheTTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthPlugin.
+func (in *HealthPlugin) DeepCopy() *HealthPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HealthStatus) DeepCopyInto(out *HealthStatus) {
 	*out = *in
@@ -2378,9 +2545,115 @@ func (in *HydrateOperation) DeepCopy() *HydrateOperation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HydratePromotion) DeepCopyInto(out *HydratePromotion) {
+	*out = *in
+	if in.PullRequest != nil {
+		in, out := &in.PullRequest, &out.PullRequest
+		*out = new(HydratePromotionPullRequest)
+		**out = **in
+	}
+	if in.Gates != nil {
+		in, out := &in.Gates, &out.Gates
+		*out = make([]HydratePromotionGate, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydratePromotion.
+func (in *HydratePromotion) DeepCopy() *HydratePromotion {
+	if in == nil {
+		return nil
+	}
+	out := new(HydratePromotion)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HydratePromotionApplicationGate) DeepCopyInto(out *HydratePromotionApplicationGate) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydratePromotionApplicationGate.
+func (in *HydratePromotionApplicationGate) DeepCopy() *HydratePromotionApplicationGate {
+	if in == nil {
+		return nil
+	}
+	out := new(HydratePromotionApplicationGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HydratePromotionGate) DeepCopyInto(out *HydratePromotionGate) {
+	*out = *in
+	if in.Application != nil {
+		in, out := &in.Application, &out.Application
+		*out = new(HydratePromotionApplicationGate)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydratePromotionGate.
+func (in *HydratePromotionGate) DeepCopy() *HydratePromotionGate {
+	if in == nil {
+		return nil
+	}
+	out := new(HydratePromotionGate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HydratePromotionPullRequest) DeepCopyInto(out *HydratePromotionPullRequest) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydratePromotionPullRequest.
+func (in *HydratePromotionPullRequest) DeepCopy() *HydratePromotionPullRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(HydratePromotionPullRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HydratePromotionStatus) DeepCopyInto(out *HydratePromotionStatus) {
+	*out = *in
+	if in.FinishedAt != nil {
+		in, out := &in.FinishedAt, &out.FinishedAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HydratePromotionStatus.
+func (in *HydratePromotionStatus) DeepCopy() *HydratePromotionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HydratePromotionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HydrateTo) DeepCopyInto(out *HydrateTo) {
 	*out = *in
+	if in.Promotion != nil {
+		in, out := &in.Promotion, &out.Promotion
+		*out = new(HydratePromotion)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2869,6 +3142,58 @@ func (in *NestedMergeGenerator) DeepCopy() *NestedMergeGenerator {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in NotificationDeliveries) DeepCopyInto(out *NotificationDeliveries) {
+	{
+		in := &in
+		*out = make(NotificationDeliveries, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+		return
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDeliveries.
+func (in NotificationDeliveries) DeepCopy() NotificationDeliveries {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDeliveries)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NotificationDelivery) DeepCopyInto(out *NotificationDelivery) {
+	*out = *in
+	if in.Templates != nil {
+		in, out := &in.Templates, &out.Templates
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.CreatedAt.DeepCopyInto(&out.CreatedAt)
+	if in.LastAttemptAt != nil {
+		in, out := &in.LastAttemptAt, &out.LastAttemptAt
+		*out = (*in).DeepCopy()
+	}
+	if in.NextAttemptAt != nil {
+		in, out := &in.NextAttemptAt, &out.NextAttemptAt
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NotificationDelivery.
+func (in *NotificationDelivery) DeepCopy() *NotificationDelivery {
+	if in == nil {
+		return nil
+	}
+	out := new(NotificationDelivery)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OCIMetadata) DeepCopyInto(out *OCIMetadata) {
 	*out = *in
@@ -3743,6 +4068,11 @@ func (in *ResourceActions) DeepCopy() *ResourceActions {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceDiff) DeepCopyInto(out *ResourceDiff) {
 	*out = *in
+	if in.Changes != nil {
+		in, out := &in.Changes, &out.Changes
+		*out = make([]ResourceDiffChange, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -3756,6 +4086,22 @@ func (in *ResourceDiff) DeepCopy() *ResourceDiff {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceDiffChange) DeepCopyInto(out *ResourceDiffChange) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceDiffChange.
+func (in *ResourceDiffChange) DeepCopy() *ResourceDiffChange {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceDiffChange)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceIgnoreDifferences) DeepCopyInto(out *ResourceIgnoreDifferences) {
 	*out = *in
@@ -3890,6 +4236,11 @@ func (in *ResourceOverride) DeepCopyInto(out *ResourceOverride) {
 		*out = make([]KnownTypeField, len(*in))
 		copy(*out, *in)
 	}
+	if in.HealthPlugin != nil {
+		in, out := &in.HealthPlugin, &out.HealthPlugin
+		*out = new(HealthPlugin)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3927,6 +4278,10 @@ func (in *ResourceResult) DeepCopyInto(out *ResourceResult) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
 	return
 }
 
@@ -4408,6 +4763,51 @@ func (in *SCMProviderGeneratorGitlab) DeepCopy() *SCMProviderGeneratorGitlab {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHSigner) DeepCopyInto(out *SSHSigner) {
+	*out = *in
+	if in.Fingerprints != nil {
+		in, out := &in.Fingerprints, &out.Fingerprints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHSigner.
+func (in *SSHSigner) DeepCopy() *SSHSigner {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHSigner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SSHSignerList) DeepCopyInto(out *SSHSignerList) {
+	*out = *in
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SSHSigner, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SSHSignerList.
+func (in *SSHSignerList) DeepCopy() *SSHSignerList {
+	if in == nil {
+		return nil
+	}
+	out := new(SSHSignerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecretRef) DeepCopyInto(out *SecretRef) {
 	*out = *in
@@ -4448,7 +4848,7 @@ func (in *SourceHydrator) DeepCopyInto(out *SourceHydrator) {
 	if in.HydrateTo != nil {
 		in, out := &in.HydrateTo, &out.HydrateTo
 		*out = new(HydrateTo)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	return
 }
@@ -4476,6 +4876,11 @@ func (in *SourceHydratorStatus) DeepCopyInto(out *SourceHydratorStatus) {
 		*out = new(HydrateOperation)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CurrentPromotion != nil {
+		in, out := &in.CurrentPromotion, &out.CurrentPromotion
+		*out = new(HydratePromotionStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4497,6 +4902,16 @@ func (in *SourceIntegrity) DeepCopyInto(out *SourceIntegrity) {
 		*out = new(SourceIntegrityGit)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(SourceIntegrityOCI)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Helm != nil {
+		in, out := &in.Helm, &out.Helm
+		*out = new(SourceIntegrityHelm)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4594,6 +5009,11 @@ func (in *SourceIntegrityGitPolicy) DeepCopyInto(out *SourceIntegrityGitPolicy)
 		*out = new(SourceIntegrityGitPolicyGPG)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.SSH != nil {
+		in, out := &in.SSH, &out.SSH
+		*out = new(SourceIntegrityGitPolicySSH)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -4644,6 +5064,207 @@ func (in *SourceIntegrityGitPolicyRepo) DeepCopy() *SourceIntegrityGitPolicyRepo
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceIntegrityGitPolicySSH) DeepCopyInto(out *SourceIntegrityGitPolicySSH) {
+	*out = *in
+	if in.Signers != nil {
+		in, out := &in.Signers, &out.Signers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceIntegrityGitPolicySSH.
+func (in *SourceIntegrityGitPolicySSH) DeepCopy() *SourceIntegrityGitPolicySSH {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceIntegrityGitPolicySSH)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceIntegrityHelm) DeepCopyInto(out *SourceIntegrityHelm) {
+	*out = *in
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]*SourceIntegrityHelmPolicy, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SourceIntegrityHelmPolicy)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceIntegrityHelm.
+func (in *SourceIntegrityHelm) DeepCopy() *SourceIntegrityHelm {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceIntegrityHelm)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceIntegrityHelmPolicy) DeepCopyInto(out *SourceIntegrityHelmPolicy) {
+	*out = *in
+	if in.Repos != nil {
+		in, out := &in.Repos, &out.Repos
+		*out = make([]SourceIntegrityHelmPolicyRepo, len(*in))
+		copy(*out, *in)
+	}
+	if in.Provenance != nil {
+		in, out := &in.Provenance, &out.Provenance
+		*out = new(SourceIntegrityHelmPolicyProvenance)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceIntegrityHelmPolicy.
+func (in *SourceIntegrityHelmPolicy) DeepCopy() *SourceIntegrityHelmPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceIntegrityHelmPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceIntegrityHelmPolicyProvenance) DeepCopyInto(out *SourceIntegrityHelmPolicyProvenance) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceIntegrityHelmPolicyProvenance.
+func (in *SourceIntegrityHelmPolicyProvenance) DeepCopy() *SourceIntegrityHelmPolicyProvenance {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceIntegrityHelmPolicyProvenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceIntegrityHelmPolicyRepo) DeepCopyInto(out *SourceIntegrityHelmPolicyRepo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceIntegrityHelmPolicyRepo.
+func (in *SourceIntegrityHelmPolicyRepo) DeepCopy() *SourceIntegrityHelmPolicyRepo {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceIntegrityHelmPolicyRepo)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceIntegrityOCI) DeepCopyInto(out *SourceIntegrityOCI) {
+	*out = *in
+	if in.Policies != nil {
+		in, out := &in.Policies, &out.Policies
+		*out = make([]*SourceIntegrityOCIPolicy, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(SourceIntegrityOCIPolicy)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceIntegrityOCI.
+func (in *SourceIntegrityOCI) DeepCopy() *SourceIntegrityOCI {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceIntegrityOCI)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceIntegrityOCIPolicy) DeepCopyInto(out *SourceIntegrityOCIPolicy) {
+	*out = *in
+	if in.Repos != nil {
+		in, out := &in.Repos, &out.Repos
+		*out = make([]SourceIntegrityOCIPolicyRepo, len(*in))
+		copy(*out, *in)
+	}
+	if in.Cosign != nil {
+		in, out := &in.Cosign, &out.Cosign
+		*out = new(SourceIntegrityOCIPolicyCosign)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceIntegrityOCIPolicy.
+func (in *SourceIntegrityOCIPolicy) DeepCopy() *SourceIntegrityOCIPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceIntegrityOCIPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceIntegrityOCIPolicyCosign) DeepCopyInto(out *SourceIntegrityOCIPolicyCosign) {
+	*out = *in
+	if in.Keys != nil {
+		in, out := &in.Keys, &out.Keys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceIntegrityOCIPolicyCosign.
+func (in *SourceIntegrityOCIPolicyCosign) DeepCopy() *SourceIntegrityOCIPolicyCosign {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceIntegrityOCIPolicyCosign)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SourceIntegrityOCIPolicyRepo) DeepCopyInto(out *SourceIntegrityOCIPolicyRepo) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SourceIntegrityOCIPolicyRepo.
+func (in *SourceIntegrityOCIPolicyRepo) DeepCopy() *SourceIntegrityOCIPolicyRepo {
+	if in == nil {
+		return nil
+	}
+	out := new(SourceIntegrityOCIPolicyRepo)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SuccessfulHydrateOperation) DeepCopyInto(out *SuccessfulHydrateOperation) {
 	*out = *in
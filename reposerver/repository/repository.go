@@ -442,7 +442,11 @@ func (s *Service) runRepoOperation(
 		}
 
 		return operation(ociPath, revision, revision, func() (*operationContext, error) {
-			return &operationContext{appPath, "", nil}, nil
+			sourceIntegrityResult, err := sourceintegrity.VerifyOCI(ctx, sourceIntegrity, ociClient, repo.Repo, revision)
+			if err != nil {
+				return nil, err
+			}
+			return &operationContext{appPath, "", sourceIntegrityResult}, nil
 		})
 	} else if source.IsHelm() {
 		if settings.noCache {
@@ -477,7 +481,11 @@ func (s *Service) runRepoOperation(
 			}
 		}
 		return operation(chartPath, revision, revision, func() (*operationContext, error) {
-			return &operationContext{chartPath, "", nil}, nil
+			sourceIntegrityResult, err := sourceintegrity.VerifyHelm(ctx, sourceIntegrity, helmClient, repo.Repo, source.Chart, revision, helmPassCredentials)
+			if err != nil {
+				return nil, err
+			}
+			return &operationContext{chartPath, "", sourceIntegrityResult}, nil
 		})
 	}
 	closer, err := s.repoLock.Lock(gitClient.Root(), revision, settings.allowConcurrent, func(clean bool) (goio.Closer, error) {
@@ -180,6 +180,7 @@ func newServiceWithMocks(t *testing.T, root string) (*Service, *gitmocks.Client,
 		helmClient.EXPECT().ExtractChart(mock.Anything, oobChart, version, false, int64(0), false).Return("./testdata2/out-of-bounds-chart", utilio.NopCloser, nil)
 		helmClient.EXPECT().CleanChartCache(chart, version).Return(nil)
 		helmClient.EXPECT().CleanChartCache(oobChart, version).Return(nil)
+		helmClient.EXPECT().GetProvenance(mock.Anything, chart, version, false).Return(&helm.Provenance{ChartDigest: "sha256:0000", Data: []byte("unsigned")}, nil)
 
 		ociClient.EXPECT().GetTags(mock.Anything, mock.Anything).Return(nil, nil)
 		ociClient.EXPECT().ResolveRevision(mock.Anything, mock.Anything, mock.Anything).Return("", nil)
@@ -590,6 +591,23 @@ func TestHelmManifestFromChartRepo(t *testing.T) {
 	gitMocks.AssertNotCalled(t, "LsRemote", mock.Anything)
 }
 
+func TestHelmManifestFromChartRepoSourceIntegrity(t *testing.T) {
+	t.Setenv(common.EnvGnuPGHome, t.TempDir())
+	service := newService(t, ".")
+	source := &v1alpha1.ApplicationSource{RepoURL: "https://helm.example.com", Chart: "my-chart", TargetRevision: ">= 1.0.0"}
+	request := &apiclient.ManifestRequest{
+		Repo: &v1alpha1.Repository{Repo: "https://helm.example.com"}, ApplicationSource: source, NoCache: true, ProjectName: "something",
+		ProjectSourceRepos: []string{"*"},
+		SourceIntegrity: &v1alpha1.SourceIntegrity{Helm: &v1alpha1.SourceIntegrityHelm{Policies: []*v1alpha1.SourceIntegrityHelmPolicy{{
+			Repos:      []v1alpha1.SourceIntegrityHelmPolicyRepo{{URL: "https://helm.example.com"}},
+			Provenance: &v1alpha1.SourceIntegrityHelmPolicyProvenance{Keys: []string{"4AEE18F83AFDEB23"}},
+		}}}},
+	}
+	response, err := service.GenerateManifest(t.Context(), request)
+	require.NoError(t, err)
+	require.EqualError(t, response.SourceIntegrityResult.AsError(), "HELM/PROVENANCE: Failed verifying provenance of chart my-chart 1.1.0: unsigned (key_id=)")
+}
+
 func TestHelmChartReferencingExternalValues(t *testing.T) {
 	service := newService(t, ".")
 	spec := v1alpha1.ApplicationSpec{
@@ -41,6 +41,7 @@ import (
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
 	argocommon "github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	eventspb "github.com/argoproj/argo-cd/v3/pkg/apiclient/events"
@@ -106,6 +107,8 @@ type Server struct {
 	projInformer           cache.SharedIndexInformer
 	enabledNamespaces      []string
 	syncWithReplaceAllowed bool
+	hydratorEnabled        bool
+	commitClientset        commitclient.Clientset
 }
 
 // NewServer returns a new instance of the Application service
@@ -127,6 +130,8 @@ func NewServer(
 	enabledNamespaces []string,
 	enableK8sEvent []string,
 	syncWithReplaceAllowed bool,
+	hydratorEnabled bool,
+	commitClientset commitclient.Clientset,
 ) (application.ApplicationServiceServer, AppResourceTreeFn) {
 	if appBroadcaster == nil {
 		appBroadcaster = broadcast.NewHandler[v1alpha1.Application, v1alpha1.ApplicationWatchEvent](
@@ -160,6 +165,8 @@ func NewServer(
 		projInformer:           projInformer,
 		enabledNamespaces:      enabledNamespaces,
 		syncWithReplaceAllowed: syncWithReplaceAllowed,
+		hydratorEnabled:        hydratorEnabled,
+		commitClientset:        commitClientset,
 	}
 	return s, s.getAppResources
 }
@@ -2295,29 +2302,11 @@ func (s *Server) Rollback(ctx context.Context, rollbackReq *application.Applicat
 		return nil, status.Errorf(codes.FailedPrecondition, "cannot rollback to revision deployed with Argo CD v0.11 or lower. sync to revision instead.")
 	}
 
-	var syncOptions v1alpha1.SyncOptions
-	if a.Spec.SyncPolicy != nil {
-		syncOptions = a.Spec.SyncPolicy.SyncOptions
-	}
-
-	// Rollback is just a convenience around Sync
-	op := v1alpha1.Operation{
-		Sync: &v1alpha1.SyncOperation{
-			Revision:     deploymentInfo.Revision,
-			Revisions:    deploymentInfo.Revisions,
-			DryRun:       rollbackReq.GetDryRun(),
-			Prune:        rollbackReq.GetPrune(),
-			SyncOptions:  syncOptions,
-			SyncStrategy: &v1alpha1.SyncStrategy{Apply: &v1alpha1.SyncStrategyApply{}},
-			Source:       &deploymentInfo.Source,
-			Sources:      deploymentInfo.Sources,
-		},
-		InitiatedBy: v1alpha1.OperationInitiator{Username: session.Username(ctx)},
-	}
+	op := argo.NewRollbackOperation(a, deploymentInfo, rollbackReq.GetPrune(), rollbackReq.GetDryRun(), v1alpha1.OperationInitiator{Username: session.Username(ctx)})
 	appName := rollbackReq.GetName()
 	appNs := s.appNamespaceOrDefault(rollbackReq.GetAppNamespace())
 	appIf := s.appclientset.ArgoprojV1alpha1().Applications(appNs)
-	a, err = argo.SetAppOperation(appIf, appName, &op)
+	a, err = argo.SetAppOperation(appIf, appName, op)
 	if err != nil {
 		return nil, fmt.Errorf("error setting app operation: %w", err)
 	}
@@ -2620,18 +2609,7 @@ func (s *Server) getAvailableActions(resourceOverrides map[string]v1alpha1.Resou
 		ResourceOverrides: resourceOverrides,
 	}
 
-	discoveryScripts, err := luaVM.GetResourceActionDiscovery(obj)
-	if err != nil {
-		return nil, fmt.Errorf("error getting Lua discovery script: %w", err)
-	}
-	if len(discoveryScripts) == 0 {
-		return []v1alpha1.ResourceAction{}, nil
-	}
-	availableActions, err := luaVM.ExecuteResourceActionDiscovery(obj, discoveryScripts)
-	if err != nil {
-		return nil, fmt.Errorf("error executing Lua discovery script: %w", err)
-	}
-	return availableActions, nil
+	return luaVM.DiscoverResourceActions(obj)
 }
 
 // RunResourceAction runs a resource action on a live resource
@@ -2692,12 +2670,12 @@ func (s *Server) RunResourceActionV2(ctx context.Context, q *application.Resourc
 	}
 	action, err := luaVM.GetResourceAction(liveObj, q.GetAction())
 	if err != nil {
-		return nil, fmt.Errorf("error getting Lua resource action: %w", err)
+		return nil, fmt.Errorf("error getting resource action: %w", err)
 	}
 
-	newObjects, err := luaVM.ExecuteResourceAction(liveObj, action.ActionLua, q.GetResourceActionParameters())
+	newObjects, err := luaVM.ExecuteResourceActionDefinition(liveObj, action, q.GetResourceActionParameters())
 	if err != nil {
-		return nil, fmt.Errorf("error executing Lua resource action: %w", err)
+		return nil, fmt.Errorf("error executing resource action: %w", err)
 	}
 
 	var app *v1alpha1.Application
@@ -3190,6 +3168,17 @@ func (s *Server) ServerSideDiff(ctx context.Context, q *application.ApplicationS
 			}
 		}
 
+		// The changes are computed from the masked states so that they never reveal secret data. They are informational
+		// only, so the diff is still returned without them if they cannot be computed.
+		changes, err := argodiff.SemanticChanges(diff.DiffResult{
+			Modified:       diffRes.Modified,
+			NormalizedLive: []byte(liveState),
+			PredictedLive:  []byte(targetState),
+		}, gvkParser)
+		if err != nil {
+			log.Warnf("Failed to compute the changes of %s/%s: %v", kind, name, err)
+		}
+
 		responseDiffs = append(responseDiffs, &v1alpha1.ResourceDiff{
 			Group:           group,
 			Kind:            kind,
@@ -3201,6 +3190,7 @@ func (s *Server) ServerSideDiff(ctx context.Context, q *application.ApplicationS
 			Hook:            hook,
 			Modified:        diffRes.Modified,
 			ResourceVersion: resourceVersion,
+			Changes:         changes,
 		})
 	}
 
@@ -44,6 +44,8 @@ import (
 	k8scache "k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/yaml"
 
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	commitmocks "github.com/argoproj/argo-cd/v3/commitserver/apiclient/mocks"
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
@@ -311,6 +313,8 @@ func newTestAppServerWithEnforcerConfigure(t *testing.T, f func(*rbac.Enforcer),
 		[]string{},
 		testEnableEventList,
 		true,
+		true,
+		nil,
 	)
 	return server.(*Server)
 }
@@ -495,6 +499,8 @@ func newTestAppServerWithEnforcerConfigureWithBenchmark(b *testing.B, f func(*rb
 		[]string{},
 		testEnableEventList,
 		true,
+		true,
+		nil,
 	)
 	return server.(*Server)
 }
@@ -2813,6 +2819,93 @@ func TestGetManifests_SourceHydrator(t *testing.T) {
 	mockRepoServiceClient.AssertExpectations(t)
 }
 
+func TestHydrateDryRun(t *testing.T) {
+	t.Parallel()
+
+	newHydratorApp := func() *v1alpha1.Application {
+		testApp := newTestApp()
+		testApp.Spec.SourceHydrator = &v1alpha1.SourceHydrator{
+			DrySource: v1alpha1.DrySource{
+				RepoURL:        "https://github.com/org/dry-repo",
+				Path:           "manifests/dry",
+				TargetRevision: "main",
+			},
+			SyncSource: v1alpha1.SyncSource{
+				TargetBranch: "env/dev",
+				Path:         "manifests/sync",
+			},
+		}
+		return testApp
+	}
+
+	t.Run("source hydrator not used", func(t *testing.T) {
+		t.Parallel()
+		testApp := newTestApp()
+		appServer := newTestAppServer(t, testApp)
+
+		_, err := appServer.HydrateDryRun(t.Context(), &application.ApplicationHydrateDryRunQuery{Name: &testApp.Name})
+		require.Error(t, err)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "does not use the source hydrator")
+	})
+
+	t.Run("source hydrator disabled", func(t *testing.T) {
+		t.Parallel()
+		testApp := newHydratorApp()
+		appServer := newTestAppServer(t, testApp)
+		appServer.hydratorEnabled = false
+
+		_, err := appServer.HydrateDryRun(t.Context(), &application.ApplicationHydrateDryRunQuery{Name: &testApp.Name})
+		require.Error(t, err)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("returns the diff from the commit server", func(t *testing.T) {
+		t.Parallel()
+		testApp := newHydratorApp()
+		appServer := newTestAppServer(t, testApp)
+
+		repoServiceClient := &mocks.RepoServerServiceClient{}
+		repoServiceClient.EXPECT().ResolveRevision(mock.Anything, mock.MatchedBy(func(r *apiclient.ResolveRevisionRequest) bool {
+			return r.AmbiguousRevision == "feature"
+		})).Return(&apiclient.ResolveRevisionResponse{Revision: "abc123"}, nil)
+		repoServiceClient.EXPECT().GenerateManifest(mock.Anything, mock.MatchedBy(func(mr *apiclient.ManifestRequest) bool {
+			return mr.Repo.Repo == "https://github.com/org/dry-repo" &&
+				mr.ApplicationSource.Path == "manifests/dry" &&
+				mr.Revision == "abc123"
+		})).Return(&apiclient.ManifestResponse{
+			Manifests: []string{
+				`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cm"}}`,
+				`{"apiVersion":"v1","kind":"Secret","metadata":{"name":"secret"},"data":{"password":"aHVudGVyMg=="}}`,
+			},
+			Revision: "abc123",
+		}, nil)
+		repoServiceClient.EXPECT().GetRevisionMetadata(mock.Anything, mock.Anything).Return(&v1alpha1.RevisionMetadata{Author: "author"}, nil)
+		appServer.repoClientset = &mocks.Clientset{RepoServerServiceClient: repoServiceClient}
+
+		commitServiceClient := commitmocks.NewCommitServiceClient(t)
+		commitServiceClient.EXPECT().DiffHydratedManifests(mock.Anything, mock.MatchedBy(func(r *commitclient.CommitHydratedManifestsRequest) bool {
+			return r.DrySha == "abc123" &&
+				r.SyncBranch == "env/dev" &&
+				r.TargetBranch == "env/dev" &&
+				len(r.Paths) == 1 && r.Paths[0].Path == "manifests/sync" && len(r.Paths[0].Manifests) == 2 &&
+				// Secrets are diffed with their actual data, the commit server redacts it from the diff
+				strings.Contains(r.Paths[0].Manifests[1].ManifestJSON, "aHVudGVyMg==")
+		})).Return(&commitclient.DiffHydratedManifestsResponse{HydratedSha: "def456", Diff: "diff --git a/manifests/sync/manifest.yaml"}, nil)
+		appServer.commitClientset = &commitmocks.Clientset{CommitServiceClient: commitServiceClient}
+
+		resp, err := appServer.HydrateDryRun(t.Context(), &application.ApplicationHydrateDryRunQuery{
+			Name:     &testApp.Name,
+			Revision: new("feature"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "abc123", resp.GetDrySha())
+		assert.Equal(t, "env/dev", resp.GetTargetBranch())
+		assert.Equal(t, "def456", resp.GetHydratedSha())
+		assert.Equal(t, "diff --git a/manifests/sync/manifest.yaml", resp.GetDiff())
+	})
+}
+
 func TestRollbackApp(t *testing.T) {
 	testApp := newTestApp()
 	testApp.Status.History = []v1alpha1.RevisionHistory{{
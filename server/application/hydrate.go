@@ -0,0 +1,125 @@
+package application
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	commitclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
+	"github.com/argoproj/argo-cd/v3/controller"
+	"github.com/argoproj/argo-cd/v3/controller/hydrator"
+	"github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/reposerver/apiclient"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	utilio "github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/security"
+)
+
+// HydrateDryRun returns the changes the source hydrator would commit for the application, without committing them. It
+// renders the dry source like the hydrator does, and lets the commit server write the manifests to a temporary clone of
+// the hydrated repository, to diff them against the tip of the target branch. The values of Secrets are redacted from
+// the returned diff.
+func (s *Server) HydrateDryRun(ctx context.Context, q *application.ApplicationHydrateDryRunQuery) (*application.ApplicationHydrateDryRunResponse, error) {
+	if q.Name == nil || *q.Name == "" {
+		return nil, errors.New("invalid request: application name is missing")
+	}
+	a, proj, err := s.getApplicationEnforceRBACInformer(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.isNamespaceEnabled(a.Namespace) {
+		return nil, security.NamespaceNotPermittedError(a.Namespace)
+	}
+	if !s.hydratorEnabled {
+		return nil, status.Error(codes.FailedPrecondition, "the source hydrator is not enabled")
+	}
+	if a.Spec.SourceHydrator == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "application %s does not use the source hydrator", a.QualifiedName())
+	}
+	hydrateToSource := a.Spec.GetHydrateToSource()
+	if !proj.IsSourcePermitted(hydrateToSource) {
+		return nil, status.Errorf(codes.InvalidArgument, "destination repo %s is not permitted in project '%s'", git.SanitizeRepoURL(hydrateToSource.RepoURL), proj.Name)
+	}
+
+	// The manifests are generated the same way the hydrator generates them, so that the preview matches the commit.
+	repoObjsGetter := controller.NewHydratorRepoObjsGetter(s.db, s.appclientset, s.repoClientset, s.ns, s.settingsMgr)
+	drySha, pathDetails, err := hydrator.GetManifests(ctx, repoObjsGetter, a, q.GetRevision(), proj)
+	if err != nil {
+		return nil, fmt.Errorf("error getting manifests: %w", err)
+	}
+
+	revisionMetadata, err := s.getDryRevisionMetadata(ctx, a.Spec.SourceHydrator.DrySource.RepoURL, proj.Name, drySha)
+	if err != nil {
+		return nil, err
+	}
+
+	repo, err := s.db.GetWriteRepository(ctx, hydrateToSource.RepoURL, proj.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error getting hydrator credentials: %w", err)
+	}
+	if repo == nil {
+		// Try without credentials.
+		repo = &v1alpha1.Repository{
+			Repo: hydrateToSource.RepoURL,
+		}
+	}
+	readmeTemplate, err := s.settingsMgr.GetHydratorReadmeTemplate()
+	if err != nil {
+		return nil, fmt.Errorf("error getting hydrated readme message template: %w", err)
+	}
+
+	closer, commitService, err := s.commitClientset.NewCommitServerClient()
+	if err != nil {
+		return nil, fmt.Errorf("error creating commit service client: %w", err)
+	}
+	defer utilio.Close(closer)
+	resp, err := commitService.DiffHydratedManifests(ctx, &commitclient.CommitHydratedManifestsRequest{
+		Repo:                 repo,
+		SyncBranch:           a.Spec.SourceHydrator.SyncSource.TargetBranch,
+		TargetBranch:         hydrateToSource.TargetRevision,
+		DrySha:               drySha,
+		Paths:                []*commitclient.PathDetails{pathDetails},
+		DryCommitMetadata:    revisionMetadata,
+		ReadmeMessage:        readmeTemplate,
+		SensitiveAnnotations: slices.Collect(maps.Keys(s.settingsMgr.GetSensitiveAnnotations())),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error diffing hydrated manifests: %w", err)
+	}
+
+	return &application.ApplicationHydrateDryRunResponse{
+		DrySha:       &drySha,
+		TargetBranch: &hydrateToSource.TargetRevision,
+		HydratedSha:  &resp.HydratedSha,
+		Diff:         &resp.Diff,
+	}, nil
+}
+
+// getDryRevisionMetadata gets the metadata of the dry source revision, to be written to the hydrated manifests.
+func (s *Server) getDryRevisionMetadata(ctx context.Context, repoURL, project, revision string) (*v1alpha1.RevisionMetadata, error) {
+	repo, err := s.db.GetRepository(ctx, repoURL, project)
+	if err != nil {
+		return nil, fmt.Errorf("error getting repository: %w", err)
+	}
+	closer, repoClient, err := s.repoClientset.NewRepoServerClient()
+	if err != nil {
+		return nil, fmt.Errorf("error creating repo server client: %w", err)
+	}
+	defer utilio.Close(closer)
+	revisionMetadata, err := repoClient.GetRevisionMetadata(ctx, &apiclient.RepoServerRevisionMetadataRequest{
+		Repo:     repo,
+		Revision: revision,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error getting revision metadata: %w", err)
+	}
+	return revisionMetadata, nil
+}
@@ -3,6 +3,7 @@ package applicationset
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"reflect"
@@ -20,6 +21,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
@@ -28,6 +30,7 @@ import (
 
 	appsettemplate "github.com/argoproj/argo-cd/v3/applicationset/controllers/template"
 	"github.com/argoproj/argo-cd/v3/applicationset/generators"
+	"github.com/argoproj/argo-cd/v3/applicationset/progressivesync"
 	"github.com/argoproj/argo-cd/v3/applicationset/services"
 	appsetstatus "github.com/argoproj/argo-cd/v3/applicationset/status"
 	appsetutils "github.com/argoproj/argo-cd/v3/applicationset/utils"
@@ -444,6 +447,78 @@ func (s *Server) Delete(ctx context.Context, q *applicationset.ApplicationSetDel
 	return &applicationset.ApplicationSetResponse{}, nil
 }
 
+// ApproveStep approves a RollingSync step of an ApplicationSet which requires approval
+func (s *Server) ApproveStep(ctx context.Context, q *applicationset.ApplicationSetRolloutStepRequest) (*v1alpha1.ApplicationSet, error) {
+	return s.decideRolloutStep(ctx, q, true)
+}
+
+// RejectStep rejects a gated RollingSync step of an ApplicationSet, which fails the step
+func (s *Server) RejectStep(ctx context.Context, q *applicationset.ApplicationSetRolloutStepRequest) (*v1alpha1.ApplicationSet, error) {
+	return s.decideRolloutStep(ctx, q, false)
+}
+
+// decideRolloutStep annotates the ApplicationSet with the approval or the rejection of a RollingSync step. The decision
+// is applied to the step, and persisted in the ApplicationSet status, by the ApplicationSet controller.
+func (s *Server) decideRolloutStep(ctx context.Context, q *applicationset.ApplicationSetRolloutStepRequest, approve bool) (*v1alpha1.ApplicationSet, error) {
+	namespace := s.appsetNamespaceOrDefault(q.AppsetNamespace)
+
+	appset, err := s.getAppSetEnforceRBAC(ctx, rbac.ActionApprove, namespace, q.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !progressivesync.RollingSyncStrategyEnabled(appset) {
+		return nil, status.Errorf(codes.FailedPrecondition, "ApplicationSet %s does not use the RollingSync strategy", appset.Name)
+	}
+	strategy := appset.Spec.Strategy
+	if q.Step < 1 || int(q.Step) > len(strategy.RollingSync.Steps) {
+		return nil, status.Errorf(codes.InvalidArgument, "step %d does not exist, the RollingSync strategy has %d steps", q.Step, len(strategy.RollingSync.Steps))
+	}
+	step := strategy.RollingSync.Steps[q.Step-1]
+	if approve && !step.RequireApproval {
+		return nil, status.Errorf(codes.FailedPrecondition, "step %d does not require approval", q.Step)
+	}
+	if !approve && step.Pause == nil && step.Analysis == nil && !step.RequireApproval {
+		return nil, status.Errorf(codes.FailedPrecondition, "step %d has no gate to reject", q.Step)
+	}
+
+	stepName := strconv.Itoa(int(q.Step))
+	var stepStatus *v1alpha1.ApplicationSetRolloutStepStatus
+	for i := range appset.Status.RolloutSteps {
+		if appset.Status.RolloutSteps[i].Step == stepName {
+			stepStatus = &appset.Status.RolloutSteps[i]
+		}
+	}
+	if stepStatus == nil || stepStatus.Phase == v1alpha1.ApplicationSetRolloutStepSucceeded || stepStatus.Phase == v1alpha1.ApplicationSetRolloutStepFailed {
+		return nil, status.Errorf(codes.FailedPrecondition, "step %d is not waiting at its gates", q.Step)
+	}
+
+	annotation := argocommon.AnnotationApplicationSetRolloutApproveStep
+	action := "approved"
+	if !approve {
+		annotation = argocommon.AnnotationApplicationSetRolloutRejectStep
+		action = "rejected"
+	}
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{annotation: stepName},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling ApplicationSet patch: %w", err)
+	}
+
+	s.projectLock.RLock(appset.Spec.Template.Spec.Project)
+	defer s.projectLock.RUnlock(appset.Spec.Template.Spec.Project)
+
+	res, err := s.appclientset.ArgoprojV1alpha1().ApplicationSets(namespace).Patch(ctx, appset.Name, types.MergePatchType, patch, metav1.PatchOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error patching ApplicationSet: %w", err)
+	}
+	s.logAppSetEvent(ctx, res, argo.EventReasonResourceUpdated, fmt.Sprintf("%s rollout step %s", action, stepName))
+	return res, nil
+}
+
 func (s *Server) ResourceTree(ctx context.Context, q *applicationset.ApplicationSetTreeQuery) (*v1alpha1.ApplicationSetTree, error) {
 	namespace := s.appsetNamespaceOrDefault(q.AppsetNamespace)
 
@@ -742,6 +742,81 @@ func TestResourceTree(t *testing.T) {
 	})
 }
 
+func TestDecideRolloutStep(t *testing.T) {
+	newRolloutAppSet := func(phase appsv1.ApplicationSetRolloutStepPhase) *appsv1.ApplicationSet {
+		return newTestAppSet(func(appset *appsv1.ApplicationSet) {
+			appset.Name = "AppSet1"
+			appset.Spec.Strategy = &appsv1.ApplicationSetStrategy{
+				Type: "RollingSync",
+				RollingSync: &appsv1.ApplicationSetRolloutStrategy{
+					Steps: []appsv1.ApplicationSetRolloutStep{
+						{RequireApproval: true},
+						{},
+					},
+				},
+			}
+			appset.Status.RolloutSteps = []appsv1.ApplicationSetRolloutStepStatus{{Step: "1", Phase: phase}}
+		})
+	}
+
+	t.Run("ApproveStep annotates the ApplicationSet", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, newRolloutAppSet(appsv1.ApplicationSetRolloutStepAwaitingApproval))
+
+		res, err := appSetServer.ApproveStep(t.Context(), &applicationset.ApplicationSetRolloutStepRequest{Name: "AppSet1", Step: 1})
+		require.NoError(t, err)
+		assert.Equal(t, "1", res.Annotations[common.AnnotationApplicationSetRolloutApproveStep])
+	})
+
+	t.Run("RejectStep annotates the ApplicationSet", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, newRolloutAppSet(appsv1.ApplicationSetRolloutStepAwaitingApproval))
+
+		res, err := appSetServer.RejectStep(t.Context(), &applicationset.ApplicationSetRolloutStepRequest{Name: "AppSet1", AppsetNamespace: testNamespace, Step: 1})
+		require.NoError(t, err)
+		assert.Equal(t, "1", res.Annotations[common.AnnotationApplicationSetRolloutRejectStep])
+	})
+
+	t.Run("ApproveStep of a step without approval gate", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, newRolloutAppSet(appsv1.ApplicationSetRolloutStepAwaitingApproval))
+
+		_, err := appSetServer.ApproveStep(t.Context(), &applicationset.ApplicationSetRolloutStepRequest{Name: "AppSet1", Step: 2})
+		assert.EqualError(t, err, "rpc error: code = FailedPrecondition desc = step 2 does not require approval")
+	})
+
+	t.Run("ApproveStep of a step which does not exist", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, newRolloutAppSet(appsv1.ApplicationSetRolloutStepAwaitingApproval))
+
+		_, err := appSetServer.ApproveStep(t.Context(), &applicationset.ApplicationSetRolloutStepRequest{Name: "AppSet1", Step: 3})
+		assert.EqualError(t, err, "rpc error: code = InvalidArgument desc = step 3 does not exist, the RollingSync strategy has 2 steps")
+	})
+
+	t.Run("ApproveStep of a step which already succeeded", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, newRolloutAppSet(appsv1.ApplicationSetRolloutStepSucceeded))
+
+		_, err := appSetServer.ApproveStep(t.Context(), &applicationset.ApplicationSetRolloutStepRequest{Name: "AppSet1", Step: 1})
+		assert.EqualError(t, err, "rpc error: code = FailedPrecondition desc = step 1 is not waiting at its gates")
+	})
+
+	t.Run("ApproveStep of an ApplicationSet without RollingSync", func(t *testing.T) {
+		appSetServer := newTestAppSetServer(t, newTestAppSet(func(appset *appsv1.ApplicationSet) {
+			appset.Name = "AppSet1"
+		}))
+
+		_, err := appSetServer.ApproveStep(t.Context(), &applicationset.ApplicationSetRolloutStepRequest{Name: "AppSet1", Step: 1})
+		assert.EqualError(t, err, "rpc error: code = FailedPrecondition desc = ApplicationSet AppSet1 does not use the RollingSync strategy")
+	})
+
+	t.Run("ApproveStep without approve permission", func(t *testing.T) {
+		f := func(enf *rbac.Enforcer) {
+			_ = enf.SetBuiltinPolicy(`p, role:readonly, applicationsets, get, */*, allow`)
+			enf.SetDefaultRole("role:readonly")
+		}
+		appSetServer, _ := newTestAppSetServerWithEnforcerConfigure(t, f, testNamespace, newRolloutAppSet(appsv1.ApplicationSetRolloutStepAwaitingApproval))
+
+		_, err := appSetServer.ApproveStep(t.Context(), &applicationset.ApplicationSetRolloutStepRequest{Name: "AppSet1", Step: 1})
+		assert.EqualError(t, err, "rpc error: code = PermissionDenied desc = permission denied: applicationsets, approve, default/AppSet1")
+	})
+}
+
 func TestListResourceEvents(t *testing.T) {
 	appSet1 := newTestAppSet(func(appset *appsv1.ApplicationSet) {
 		appset.Name = "AppSet1"
@@ -0,0 +1,97 @@
+package cosignkey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	cosignkeypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/cosignkey"
+	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+)
+
+// Server provides a service of type CosignKeyService
+type Server struct {
+	db  db.ArgoDB
+	enf *rbac.Enforcer
+}
+
+// NewServer returns a new instance of the service with type CosignKeyService
+func NewServer(db db.ArgoDB, enf *rbac.Enforcer) *Server {
+	return &Server{
+		db:  db,
+		enf: enf,
+	}
+}
+
+// List returns a list of cosign public keys in the configuration. Cosign keys share the RBAC resource with GnuPG keys.
+func (s *Server) List(ctx context.Context, _ *cosignkeypkg.CosignKeyQuery) (*appsv1.CosignPublicKeyList, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceGPGKeys, rbac.ActionGet, ""); err != nil {
+		return nil, err
+	}
+	keys, err := s.db.ListConfiguredCosignPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keyList := &appsv1.CosignPublicKeyList{}
+	for _, v := range keys {
+		// Remove key's data from list result to save some bytes
+		v.KeyData = ""
+		keyList.Items = append(keyList.Items, *v)
+	}
+	slices.SortFunc(keyList.Items, func(a, b appsv1.CosignPublicKey) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return keyList, nil
+}
+
+// Get retrieves a single cosign public key from the configuration
+func (s *Server) Get(ctx context.Context, q *cosignkeypkg.CosignKeyQuery) (*appsv1.CosignPublicKey, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceGPGKeys, rbac.ActionGet, ""); err != nil {
+		return nil, err
+	}
+
+	keys, err := s.db.ListConfiguredCosignPublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if key, ok := keys[q.Name]; ok {
+		return key, nil
+	}
+
+	return nil, fmt.Errorf("no such cosign public key: %s", q.Name)
+}
+
+// Create adds a cosign public key to the server's configuration
+func (s *Server) Create(ctx context.Context, q *cosignkeypkg.CosignKeyCreateRequest) (*appsv1.CosignPublicKey, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceGPGKeys, rbac.ActionCreate, ""); err != nil {
+		return nil, err
+	}
+
+	if q.Publickey == nil {
+		return nil, errors.New("no cosign public key submitted")
+	}
+	if strings.TrimSpace(q.Publickey.KeyData) == "" {
+		return nil, errors.New("submitted key data is empty")
+	}
+
+	return s.db.AddCosignPublicKey(ctx, q.Publickey, q.Upsert)
+}
+
+// Delete removes a single cosign public key from the server's configuration
+func (s *Server) Delete(ctx context.Context, q *cosignkeypkg.CosignKeyQuery) (*cosignkeypkg.CosignKeyResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceGPGKeys, rbac.ActionDelete, ""); err != nil {
+		return nil, err
+	}
+
+	err := s.db.DeleteCosignPublicKey(ctx, q.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cosignkeypkg.CosignKeyResponse{}, nil
+}
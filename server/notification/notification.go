@@ -2,21 +2,43 @@ package notification
 
 import (
 	"context"
+	"errors"
 
 	"github.com/argoproj/notifications-engine/pkg/api"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	argocommon "github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/notification"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
+	"github.com/argoproj/argo-cd/v3/util/notification/delivery"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+	"github.com/argoproj/argo-cd/v3/util/security"
+	"github.com/argoproj/argo-cd/v3/util/session"
 )
 
 // Server provides an Application service
 type Server struct {
-	apiFactory api.Factory
+	apiFactory        api.Factory
+	ns                string
+	appclientset      appclientset.Interface
+	enf               *rbac.Enforcer
+	enabledNamespaces []string
 }
 
 // NewServer returns a new instance of the Application service
-func NewServer(apiFactory api.Factory) notification.NotificationServiceServer {
-	s := &Server{apiFactory: apiFactory}
+func NewServer(apiFactory api.Factory, namespace string, appclientset appclientset.Interface, enf *rbac.Enforcer, enabledNamespaces []string) notification.NotificationServiceServer {
+	s := &Server{
+		apiFactory:        apiFactory,
+		ns:                namespace,
+		appclientset:      appclientset,
+		enf:               enf,
+		enabledNamespaces: enabledNamespaces,
+	}
 	return s
 }
 
@@ -66,3 +88,66 @@ func (s *Server) ListTemplates(_ context.Context, _ *notification.TemplatesListR
 	}
 	return &notification.TemplateList{Items: templates}, nil
 }
+
+// ListDeliveries returns the history of the notifications delivered about an application
+func (s *Server) ListDeliveries(ctx context.Context, q *notification.DeliveriesListRequest) (*notification.DeliveryList, error) {
+	a, err := s.getAppEnforceRBAC(ctx, rbac.ActionGet, q.GetProject(), q.GetAppNamespace(), q.GetAppName())
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*v1alpha1.NotificationDelivery, 0, len(a.Status.NotificationDeliveries))
+	for i := range a.Status.NotificationDeliveries {
+		items = append(items, &a.Status.NotificationDeliveries[i])
+	}
+	return &notification.DeliveryList{Items: items}, nil
+}
+
+// Redeliver sends a notification about an application again
+func (s *Server) Redeliver(ctx context.Context, q *notification.RedeliverRequest) (*v1alpha1.NotificationDelivery, error) {
+	a, err := s.getAppEnforceRBAC(ctx, rbac.ActionUpdate, q.GetProject(), q.GetAppNamespace(), q.GetAppName())
+	if err != nil {
+		return nil, err
+	}
+	redelivery, err := delivery.RedeliverApplication(ctx, s.appclientset.ArgoprojV1alpha1().Applications(a.Namespace), a.Name, q.GetId())
+	switch {
+	case errors.Is(err, delivery.ErrNotFound):
+		return nil, status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, delivery.ErrPending):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		return nil, status.Errorf(codes.Internal, "error redelivering notification: %v", err)
+	}
+	log.WithFields(log.Fields{
+		"application": a.Name,
+		"namespace":   a.Namespace,
+		"user":        session.Username(ctx),
+	}).Infof("requested redelivery of notification delivery %d", q.GetId())
+	return redelivery, nil
+}
+
+// getAppEnforceRBAC returns the application after checking that the user is allowed to perform the action on it. Like
+// the Application service, it does not reveal whether an application exists to users who cannot access it.
+func (s *Server) getAppEnforceRBAC(ctx context.Context, action, project, namespace, name string) (*v1alpha1.Application, error) {
+	if namespace == "" {
+		namespace = s.ns
+	}
+	if project != "" {
+		if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, action, security.RBACName(s.ns, project, namespace, name)); err != nil {
+			return nil, argocommon.PermissionDeniedAPIError
+		}
+	}
+	if !security.IsNamespaceEnabled(namespace, s.ns, s.enabledNamespaces) {
+		return nil, security.NamespaceNotPermittedError(namespace)
+	}
+	a, err := s.appclientset.ArgoprojV1alpha1().Applications(namespace).Get(ctx, name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) && project != "" {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, argocommon.PermissionDeniedAPIError
+	}
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceApplications, action, a.RBACName(s.ns)); err != nil {
+		return nil, argocommon.PermissionDeniedAPIError
+	}
+	return a, nil
+}
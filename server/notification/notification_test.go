@@ -1,19 +1,27 @@
 package notification
 
 import (
+	"context"
 	"os"
 	"testing"
 
 	"github.com/argoproj/notifications-engine/pkg/api"
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	corev1 "k8s.io/api/core/v1"
 
+	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient/notification"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appsfake "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
 	"github.com/argoproj/argo-cd/v3/reposerver/apiclient/mocks"
 	service "github.com/argoproj/argo-cd/v3/util/notification/argocd"
 	"github.com/argoproj/argo-cd/v3/util/notification/k8s"
 	"github.com/argoproj/argo-cd/v3/util/notification/settings"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -76,7 +84,7 @@ func TestNotificationServer(t *testing.T) {
 
 	t.Run("TestListServices", func(t *testing.T) {
 		t.Parallel()
-		server := NewServer(apiFactory)
+		server := NewServer(apiFactory, testNamespace, nil, nil, nil)
 		services, err := server.ListServices(ctx, &notification.ServicesListRequest{})
 		require.NoError(t, err)
 		assert.Len(t, services.Items, 1)
@@ -85,7 +93,7 @@ func TestNotificationServer(t *testing.T) {
 	})
 	t.Run("TestListTriggers", func(t *testing.T) {
 		t.Parallel()
-		server := NewServer(apiFactory)
+		server := NewServer(apiFactory, testNamespace, nil, nil, nil)
 		triggers, err := server.ListTriggers(ctx, &notification.TriggersListRequest{})
 		require.NoError(t, err)
 		assert.Len(t, triggers.Items, 1)
@@ -94,7 +102,7 @@ func TestNotificationServer(t *testing.T) {
 	})
 	t.Run("TestListTemplates", func(t *testing.T) {
 		t.Parallel()
-		server := NewServer(apiFactory)
+		server := NewServer(apiFactory, testNamespace, nil, nil, nil)
 		templates, err := server.ListTemplates(ctx, &notification.TemplatesListRequest{})
 		require.NoError(t, err)
 		assert.Len(t, templates.Items, 1)
@@ -102,3 +110,83 @@ func TestNotificationServer(t *testing.T) {
 		assert.NotEmpty(t, templates.Items[0])
 	})
 }
+
+func userContext(ctx context.Context, user string) context.Context {
+	//nolint:staticcheck
+	return context.WithValue(ctx, "claims", &jwt.RegisteredClaims{Subject: user})
+}
+
+func TestNotificationServer_Deliveries(t *testing.T) {
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: testNamespace},
+		Spec:       v1alpha1.ApplicationSpec{Project: "default"},
+		Status: v1alpha1.ApplicationStatus{
+			NotificationDeliveries: v1alpha1.NotificationDeliveries{{
+				ID:        0,
+				Trigger:   "on-created",
+				Templates: []string{"app-created"},
+				Service:   "webhook",
+				Recipient: "test",
+				Phase:     v1alpha1.NotificationDeliveryPhaseFailed,
+				Message:   "connection refused",
+				Attempts:  5,
+			}, {
+				ID:      1,
+				Service: "webhook",
+				Phase:   v1alpha1.NotificationDeliveryPhasePending,
+			}},
+		},
+	}
+	appClientset := appsfake.NewSimpleClientset(app)
+	enforcer := rbac.NewEnforcer(fake.NewClientset(), testNamespace, common.ArgoCDRBACConfigMapName, nil)
+	enforcer.SetClaimsEnforcerFunc(func(claims jwt.Claims, rvals ...any) bool {
+		subject, _ := claims.GetSubject()
+		return subject == "admin" || (subject == "viewer" && rvals[2] == rbac.ActionGet)
+	})
+	server := NewServer(nil, testNamespace, appClientset, enforcer, nil)
+
+	t.Run("ListDeliveries", func(t *testing.T) {
+		deliveries, err := server.ListDeliveries(userContext(t.Context(), "viewer"), &notification.DeliveriesListRequest{AppName: new("guestbook")})
+		require.NoError(t, err)
+		require.Len(t, deliveries.Items, 2)
+		assert.Equal(t, "connection refused", deliveries.Items[0].Message)
+	})
+
+	t.Run("ListDeliveriesPermissionDenied", func(t *testing.T) {
+		_, err := server.ListDeliveries(userContext(t.Context(), "nobody"), &notification.DeliveriesListRequest{AppName: new("guestbook")})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		// the existence of applications is not revealed
+		_, err = server.ListDeliveries(userContext(t.Context(), "nobody"), &notification.DeliveriesListRequest{AppName: new("missing")})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("RedeliverPermissionDenied", func(t *testing.T) {
+		_, err := server.Redeliver(userContext(t.Context(), "viewer"), &notification.RedeliverRequest{AppName: new("guestbook"), Id: new(int64(0))})
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("RedeliverPending", func(t *testing.T) {
+		_, err := server.Redeliver(userContext(t.Context(), "admin"), &notification.RedeliverRequest{AppName: new("guestbook"), Id: new(int64(1))})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("RedeliverNotFound", func(t *testing.T) {
+		_, err := server.Redeliver(userContext(t.Context(), "admin"), &notification.RedeliverRequest{AppName: new("guestbook"), Id: new(int64(5))})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("Redeliver", func(t *testing.T) {
+		redelivery, err := server.Redeliver(userContext(t.Context(), "admin"), &notification.RedeliverRequest{AppName: new("guestbook"), Id: new(int64(0))})
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), redelivery.ID)
+		assert.Equal(t, int64(0), redelivery.RedeliveryOf)
+		assert.Equal(t, v1alpha1.NotificationDeliveryPhasePending, redelivery.Phase)
+		assert.Equal(t, []string{"app-created"}, redelivery.Templates)
+		assert.Zero(t, redelivery.Attempts)
+
+		updated, err := appClientset.ArgoprojV1alpha1().Applications(testNamespace).Get(t.Context(), "guestbook", metav1.GetOptions{})
+		require.NoError(t, err)
+		require.Len(t, updated.Status.NotificationDeliveries, 3)
+		assert.Equal(t, *redelivery, updated.Status.NotificationDeliveries[2])
+	})
+}
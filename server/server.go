@@ -70,6 +70,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	commitapiclient "github.com/argoproj/argo-cd/v3/commitserver/apiclient"
 	"github.com/argoproj/argo-cd/v3/common"
 	"github.com/argoproj/argo-cd/v3/pkg/apiclient"
 	accountpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/account"
@@ -77,6 +78,7 @@ import (
 	applicationsetpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/applicationset"
 	certificatepkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/certificate"
 	clusterpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/cluster"
+	cosignkeypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/cosignkey"
 	gpgkeypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/gpgkey"
 	notificationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/notification"
 	projectpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/project"
@@ -84,6 +86,7 @@ import (
 	repositorypkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/repository"
 	sessionpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/session"
 	settingspkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/settings"
+	sshsignerpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/sshsigner"
 	versionpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/version"
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	appclientset "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned"
@@ -98,6 +101,7 @@ import (
 	servercache "github.com/argoproj/argo-cd/v3/server/cache"
 	"github.com/argoproj/argo-cd/v3/server/certificate"
 	"github.com/argoproj/argo-cd/v3/server/cluster"
+	"github.com/argoproj/argo-cd/v3/server/cosignkey"
 	"github.com/argoproj/argo-cd/v3/server/extension"
 	"github.com/argoproj/argo-cd/v3/server/gpgkey"
 	"github.com/argoproj/argo-cd/v3/server/logout"
@@ -109,6 +113,7 @@ import (
 	"github.com/argoproj/argo-cd/v3/server/repository"
 	"github.com/argoproj/argo-cd/v3/server/session"
 	"github.com/argoproj/argo-cd/v3/server/settings"
+	"github.com/argoproj/argo-cd/v3/server/sshsigner"
 	"github.com/argoproj/argo-cd/v3/server/version"
 	"github.com/argoproj/argo-cd/v3/ui"
 	"github.com/argoproj/argo-cd/v3/util/assets"
@@ -240,6 +245,7 @@ type ArgoCDServerOpts struct {
 	KubeClientset           kubernetes.Interface
 	AppClientset            appclientset.Interface
 	RepoClientset           repoapiclient.Clientset
+	CommitClientset         commitapiclient.Clientset
 	Cache                   *servercache.Cache
 	RepoServerCache         *repocache.Cache
 	RedisClient             *redis.Client
@@ -1001,6 +1007,8 @@ func (server *ArgoCDServer) newGRPCServer(prometheusRegistry *prometheus.Registr
 	accountpkg.RegisterAccountServiceServer(grpcS, server.serviceSet.AccountService)
 	certificatepkg.RegisterCertificateServiceServer(grpcS, server.serviceSet.CertificateService)
 	gpgkeypkg.RegisterGPGKeyServiceServer(grpcS, server.serviceSet.GpgkeyService)
+	sshsignerpkg.RegisterSSHSignerServiceServer(grpcS, server.serviceSet.SSHSignerService)
+	cosignkeypkg.RegisterCosignKeyServiceServer(grpcS, server.serviceSet.CosignKeyService)
 	// Register reflection service on gRPC server.
 	reflection.Register(grpcS)
 	serverMetrics.InitializeMetrics(grpcS)
@@ -1022,6 +1030,8 @@ type ArgoCDServiceSet struct {
 	NotificationService   notificationpkg.NotificationServiceServer
 	CertificateService    *certificate.Server
 	GpgkeyService         *gpgkey.Server
+	SSHSignerService      *sshsigner.Server
+	CosignKeyService      *cosignkey.Server
 	VersionService        *version.Server
 }
 
@@ -1054,6 +1064,8 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 		a.ApplicationNamespaces,
 		a.EnableK8sEvent,
 		a.SyncWithReplaceAllowed,
+		a.HydratorEnabled,
+		a.CommitClientset,
 	)
 
 	applicationSetService := applicationset.NewServer(
@@ -1085,9 +1097,11 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 	settingsService := settings.NewServer(a.settingsMgr, a.RepoClientset, a, a.DisableAuth, appsInAnyNamespaceEnabled, a.HydratorEnabled, a.SyncWithReplaceAllowed)
 	accountService := account.NewServer(a.sessionMgr, a.settingsMgr, a.enf, a.Namespace)
 
-	notificationService := notification.NewServer(a.apiFactory)
+	notificationService := notification.NewServer(a.apiFactory, a.Namespace, a.AppClientset, a.enf, a.ApplicationNamespaces)
 	certificateService := certificate.NewServer(a.db, a.enf)
 	gpgkeyService := gpgkey.NewServer(a.db, a.enf)
+	sshSignerService := sshsigner.NewServer(a.db, a.enf)
+	cosignKeyService := cosignkey.NewServer(a.db, a.enf)
 	versionService := version.NewServer(a, func() (bool, error) {
 		if a.DisableAuth {
 			return true, nil
@@ -1113,6 +1127,8 @@ func newArgoCDServiceSet(a *ArgoCDServer) *ArgoCDServiceSet {
 		NotificationService:   notificationService,
 		CertificateService:    certificateService,
 		GpgkeyService:         gpgkeyService,
+		SSHSignerService:      sshSignerService,
+		CosignKeyService:      cosignKeyService,
 		VersionService:        versionService,
 	}
 }
@@ -1258,6 +1274,8 @@ func (server *ArgoCDServer) newHTTPServer(ctx context.Context, port int, grpcWeb
 	mustRegisterGWHandler(ctx, accountpkg.RegisterAccountServiceHandler, gwmux, conn)
 	mustRegisterGWHandler(ctx, certificatepkg.RegisterCertificateServiceHandler, gwmux, conn)
 	mustRegisterGWHandler(ctx, gpgkeypkg.RegisterGPGKeyServiceHandler, gwmux, conn)
+	mustRegisterGWHandler(ctx, sshsignerpkg.RegisterSSHSignerServiceHandler, gwmux, conn)
+	mustRegisterGWHandler(ctx, cosignkeypkg.RegisterCosignKeyServiceHandler, gwmux, conn)
 
 	registerSwaggerUI(mux, server.RootPath, server.DisableSwaggerUI)
 	healthz.ServeHealthCheck(mux, server.healthCheck)
@@ -0,0 +1,97 @@
+package sshsigner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	sshsignerpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/sshsigner"
+	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/db"
+	"github.com/argoproj/argo-cd/v3/util/rbac"
+)
+
+// Server provides a service of type SSHSignerService
+type Server struct {
+	db  db.ArgoDB
+	enf *rbac.Enforcer
+}
+
+// NewServer returns a new instance of the service with type SSHSignerService
+func NewServer(db db.ArgoDB, enf *rbac.Enforcer) *Server {
+	return &Server{
+		db:  db,
+		enf: enf,
+	}
+}
+
+// List returns a list of SSH signers in the configuration. SSH signers share the RBAC resource with GnuPG keys.
+func (s *Server) List(ctx context.Context, _ *sshsignerpkg.SSHSignerQuery) (*appsv1.SSHSignerList, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceGPGKeys, rbac.ActionGet, ""); err != nil {
+		return nil, err
+	}
+	signers, err := s.db.ListConfiguredSSHSigners(ctx)
+	if err != nil {
+		return nil, err
+	}
+	signerList := &appsv1.SSHSignerList{}
+	for _, v := range signers {
+		// Remove signer's key data from list result to save some bytes
+		v.KeyData = ""
+		signerList.Items = append(signerList.Items, *v)
+	}
+	slices.SortFunc(signerList.Items, func(a, b appsv1.SSHSigner) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return signerList, nil
+}
+
+// Get retrieves a single SSH signer from the configuration
+func (s *Server) Get(ctx context.Context, q *sshsignerpkg.SSHSignerQuery) (*appsv1.SSHSigner, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceGPGKeys, rbac.ActionGet, ""); err != nil {
+		return nil, err
+	}
+
+	signers, err := s.db.ListConfiguredSSHSigners(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if signer, ok := signers[q.Name]; ok {
+		return signer, nil
+	}
+
+	return nil, fmt.Errorf("no such SSH signer: %s", q.Name)
+}
+
+// Create adds an SSH signer to the server's configuration
+func (s *Server) Create(ctx context.Context, q *sshsignerpkg.SSHSignerCreateRequest) (*appsv1.SSHSigner, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceGPGKeys, rbac.ActionCreate, ""); err != nil {
+		return nil, err
+	}
+
+	if q.Signer == nil {
+		return nil, errors.New("no SSH signer submitted")
+	}
+	if strings.TrimSpace(q.Signer.KeyData) == "" {
+		return nil, errors.New("submitted key data is empty")
+	}
+
+	return s.db.AddSSHSigner(ctx, q.Signer, q.Upsert)
+}
+
+// Delete removes a single SSH signer from the server's configuration
+func (s *Server) Delete(ctx context.Context, q *sshsignerpkg.SSHSignerQuery) (*sshsignerpkg.SSHSignerResponse, error) {
+	if err := s.enf.EnforceErr(ctx.Value("claims"), rbac.ResourceGPGKeys, rbac.ActionDelete, ""); err != nil {
+		return nil, err
+	}
+
+	err := s.db.DeleteSSHSigner(ctx, q.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sshsignerpkg.SSHSignerResponse{}, nil
+}
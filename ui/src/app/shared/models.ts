@@ -275,10 +275,31 @@ export interface SyncSource {
     targetBranch: string;
     path: string;
     repoURL?: string;
+    layout?: 'SingleFile' | 'PerResource' | 'PerNamespace' | 'Kustomization';
 }
 
 export interface HydrateTo {
     targetBranch: string;
+    promotion?: HydratePromotion;
+}
+
+export interface HydratePromotion {
+    strategy: 'FastForward' | 'PullRequest';
+    pullRequest?: {
+        provider: 'GitHub' | 'GitLab';
+        api?: string;
+    };
+    gates?: HydratePromotionGate[];
+}
+
+export interface HydratePromotionGate {
+    application?: {
+        name: string;
+        namespace?: string;
+        health?: HealthStatusCode;
+        synced?: boolean;
+        sameDryRevision?: boolean;
+    };
 }
 
 export interface ApplicationSourceHelm {
@@ -544,6 +565,15 @@ export interface ApplicationStatus {
 export interface SourceHydratorStatus {
     lastSuccessfulOperation?: SuccessfulHydrateOperation;
     currentOperation?: HydrateOperation;
+    currentPromotion?: HydratePromotionStatus;
+}
+
+export interface HydratePromotionStatus {
+    hydratedSHA: string;
+    phase: 'Pending' | 'Promoted' | 'Failed';
+    message?: string;
+    pullRequestURL?: string;
+    finishedAt?: models.Time;
 }
 
 export interface HydrateOperation {
@@ -963,6 +963,29 @@ func SetAppOperation(appIf v1alpha1.ApplicationInterface, appName string, op *ar
 	}
 }
 
+// NewRollbackOperation returns the sync operation which rolls the application back to the given deployment of its history
+func NewRollbackOperation(app *argoappv1.Application, deployment *argoappv1.RevisionHistory, prune bool, dryRun bool, initiatedBy argoappv1.OperationInitiator) *argoappv1.Operation {
+	var syncOptions argoappv1.SyncOptions
+	if app.Spec.SyncPolicy != nil {
+		syncOptions = app.Spec.SyncPolicy.SyncOptions
+	}
+
+	// Rollback is just a convenience around Sync
+	return &argoappv1.Operation{
+		Sync: &argoappv1.SyncOperation{
+			Revision:     deployment.Revision,
+			Revisions:    deployment.Revisions,
+			DryRun:       dryRun,
+			Prune:        prune,
+			SyncOptions:  syncOptions,
+			SyncStrategy: &argoappv1.SyncStrategy{Apply: &argoappv1.SyncStrategyApply{}},
+			Source:       &deployment.Source,
+			Sources:      deployment.Sources,
+		},
+		InitiatedBy: initiatedBy,
+	}
+}
+
 // ContainsSyncResource determines if the given resource exists in the provided slice of sync operation resources.
 func ContainsSyncResource(name string, namespace string, gvk schema.GroupVersionKind, rr []argoappv1.SyncOperationResource) bool {
 	for _, r := range rr {
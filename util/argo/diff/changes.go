@@ -0,0 +1,81 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/diff"
+	k8smanagedfields "k8s.io/apimachinery/pkg/util/managedfields"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// SemanticChanges returns the changed fields between the normalized live state and the predicted live state of the
+// given diff result, or nil if the resource is not modified. The items of the lists are matched by the merge keys of
+// the schema resolved with the given GVK parser, or of the built-in types if the parser is nil.
+func SemanticChanges(diffRes diff.DiffResult, gvkParser *k8smanagedfields.GvkParser) ([]v1alpha1.ResourceDiffChange, error) {
+	if !diffRes.Modified {
+		return nil, nil
+	}
+	live, err := v1alpha1.UnmarshalToUnstructured(string(diffRes.NormalizedLive))
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling normalized live state: %w", err)
+	}
+	target, err := v1alpha1.UnmarshalToUnstructured(string(diffRes.PredictedLive))
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling predicted live state: %w", err)
+	}
+	changes, err := diff.SemanticDiff(live, target, gvkParser)
+	if err != nil {
+		return nil, fmt.Errorf("error computing semantic diff: %w", err)
+	}
+	return ToResourceDiffChanges(changes)
+}
+
+// ToResourceDiffChanges converts the changes of a semantic diff to their API representation
+func ToResourceDiffChanges(changes []diff.Change) ([]v1alpha1.ResourceDiffChange, error) {
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	res := make([]v1alpha1.ResourceDiffChange, len(changes))
+	for i, change := range changes {
+		res[i] = v1alpha1.ResourceDiffChange{Operation: string(change.Operation), Path: change.Path}
+		if change.Live != nil {
+			data, err := json.Marshal(change.Live)
+			if err != nil {
+				return nil, fmt.Errorf("error marshaling live value of %s: %w", change.Path, err)
+			}
+			res[i].LiveValue = string(data)
+		}
+		if change.Target != nil {
+			data, err := json.Marshal(change.Target)
+			if err != nil {
+				return nil, fmt.Errorf("error marshaling target value of %s: %w", change.Path, err)
+			}
+			res[i].TargetValue = string(data)
+		}
+	}
+	return res, nil
+}
+
+// FromResourceDiffChanges converts the API representation of the changes of a semantic diff back to the changes
+func FromResourceDiffChanges(changes []v1alpha1.ResourceDiffChange) ([]diff.Change, error) {
+	if len(changes) == 0 {
+		return nil, nil
+	}
+	res := make([]diff.Change, len(changes))
+	for i, change := range changes {
+		res[i] = diff.Change{Operation: diff.ChangeOperation(change.Operation), Path: change.Path}
+		if change.LiveValue != "" {
+			if err := json.Unmarshal([]byte(change.LiveValue), &res[i].Live); err != nil {
+				return nil, fmt.Errorf("error unmarshaling live value of %s: %w", change.Path, err)
+			}
+		}
+		if change.TargetValue != "" {
+			if err := json.Unmarshal([]byte(change.TargetValue), &res[i].Target); err != nil {
+				return nil, fmt.Errorf("error unmarshaling target value of %s: %w", change.Path, err)
+			}
+		}
+	}
+	return res, nil
+}
@@ -0,0 +1,70 @@
+package diff_test
+
+import (
+	"testing"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/diff"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	argodiff "github.com/argoproj/argo-cd/v3/util/argo/diff"
+)
+
+const changesLiveConfigMap = `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"my-map"},"data":{"foo":"bar","removed":"value"}}`
+
+const changesTargetConfigMap = `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"my-map"},"data":{"foo":"baz","added":"value"}}`
+
+func TestSemanticChanges(t *testing.T) {
+	t.Parallel()
+	t.Run("will return the changed fields of a modified resource", func(t *testing.T) {
+		changes, err := argodiff.SemanticChanges(diff.DiffResult{
+			Modified:       true,
+			NormalizedLive: []byte(changesLiveConfigMap),
+			PredictedLive:  []byte(changesTargetConfigMap),
+		}, nil)
+		require.NoError(t, err)
+		assert.Equal(t, []v1alpha1.ResourceDiffChange{
+			{Operation: "add", Path: ".data.added", TargetValue: `"value"`},
+			{Operation: "replace", Path: ".data.foo", LiveValue: `"bar"`, TargetValue: `"baz"`},
+			{Operation: "remove", Path: ".data.removed", LiveValue: `"value"`},
+		}, changes)
+	})
+	t.Run("will return the whole resource if it is missing from the cluster", func(t *testing.T) {
+		changes, err := argodiff.SemanticChanges(diff.DiffResult{
+			Modified:       true,
+			NormalizedLive: []byte("null"),
+			PredictedLive:  []byte(changesTargetConfigMap),
+		}, nil)
+		require.NoError(t, err)
+		require.Len(t, changes, 1)
+		assert.Equal(t, "add", changes[0].Operation)
+		assert.Empty(t, changes[0].Path)
+		assert.JSONEq(t, changesTargetConfigMap, changes[0].TargetValue)
+	})
+	t.Run("will return no changes if the resource is not modified", func(t *testing.T) {
+		changes, err := argodiff.SemanticChanges(diff.DiffResult{
+			NormalizedLive: []byte(changesLiveConfigMap),
+			PredictedLive:  []byte(changesLiveConfigMap),
+		}, nil)
+		require.NoError(t, err)
+		assert.Nil(t, changes)
+	})
+}
+
+func TestFromResourceDiffChanges(t *testing.T) {
+	t.Parallel()
+	changes := []diff.Change{
+		{Operation: diff.ChangeOperationAdd, Path: ".data.added", Target: "value"},
+		{Operation: diff.ChangeOperationReplace, Path: ".spec.replicas", Live: float64(1), Target: float64(2)},
+		{Operation: diff.ChangeOperationRemove, Path: ".data.removed", Live: map[string]any{"key": "value"}},
+	}
+	resourceDiffChanges, err := argodiff.ToResourceDiffChanges(changes)
+	require.NoError(t, err)
+	converted, err := argodiff.FromResourceDiffChanges(resourceDiffChanges)
+	require.NoError(t, err)
+	assert.Equal(t, changes, converted)
+
+	_, err = argodiff.FromResourceDiffChanges([]v1alpha1.ResourceDiffChange{{Operation: "add", Path: ".data.added", TargetValue: "{"}})
+	require.Error(t, err)
+}
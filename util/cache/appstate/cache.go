@@ -20,6 +20,9 @@ var (
 
 const (
 	clusterInfoCacheExpiration = 10 * time.Minute
+	// clusterCacheSnapshotExpiration is the expiration of the cluster cache snapshots, after which the resource versions
+	// they hold have most likely been compacted anyway
+	clusterCacheSnapshotExpiration = 24 * time.Hour
 )
 
 type Cache struct {
@@ -129,3 +132,18 @@ func (c *Cache) GetClusterInfo(server string, res *appv1.ClusterInfo) error {
 	err := c.GetItem(clusterInfoKey(server), &res)
 	return err
 }
+
+func clusterCacheSnapshotKey(server string) string {
+	return "cluster|cache-snapshot|" + server
+}
+
+// SetClusterCacheSnapshot stores the snapshot of the cache of the given cluster, or deletes it if the snapshot is nil
+func (c *Cache) SetClusterCacheSnapshot(server string, snapshot []byte) error {
+	return c.SetItem(clusterCacheSnapshotKey(server), snapshot, clusterCacheSnapshotExpiration, snapshot == nil)
+}
+
+func (c *Cache) GetClusterCacheSnapshot(server string) ([]byte, error) {
+	var snapshot []byte
+	err := c.GetItem(clusterCacheSnapshotKey(server), &snapshot)
+	return snapshot, err
+}
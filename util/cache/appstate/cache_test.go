@@ -80,6 +80,26 @@ func TestCache_GetClusterInfo(t *testing.T) {
 	assert.Equal(t, &ClusterInfo{ServerVersion: "0.24.0"}, res)
 }
 
+func TestCache_GetClusterCacheSnapshot(t *testing.T) {
+	t.Parallel()
+	cache := newFixtures().Cache
+	// cache miss
+	_, err := cache.GetClusterCacheSnapshot("http://kind-cluster")
+	assert.Equal(t, ErrCacheMiss, err)
+	// populate cache
+	err = cache.SetClusterCacheSnapshot("http://kind-cluster", []byte("snapshot"))
+	require.NoError(t, err)
+	// cache hit
+	snapshot, err := cache.GetClusterCacheSnapshot("http://kind-cluster")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("snapshot"), snapshot)
+	// delete
+	err = cache.SetClusterCacheSnapshot("http://kind-cluster", nil)
+	require.NoError(t, err)
+	_, err = cache.GetClusterCacheSnapshot("http://kind-cluster")
+	assert.Equal(t, ErrCacheMiss, err)
+}
+
 func TestAddCacheFlagsToCmd(t *testing.T) {
 	t.Parallel()
 	cache, err := AddCacheFlagsToCmd(&cobra.Command{})()
@@ -0,0 +1,249 @@
+package cel
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/golang/groupcache/lru"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/ext"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const (
+	incorrectReturnType = "expect %s output from CEL expression, not %s"
+	invalidHealthStatus = "CEL expression returned an invalid health status"
+
+	// objVariable is the name of the variable holding the resource, named like its Lua counterpart
+	objVariable = "obj"
+	// actionParamsVariable is the name of the variable holding the parameters of the action, named like its Lua counterpart
+	actionParamsVariable = "actionParams"
+	// nowVariable is the name of the variable holding the time of the evaluation
+	nowVariable = "now"
+
+	// costLimit limits the cost of the evaluation of a single expression
+	costLimit = 1000000
+	// evaluationTimeout limits the duration of the evaluation of a single expression, like the timeout of the Lua scripts
+	evaluationTimeout = 1 * time.Second
+)
+
+// compiledProgramCacheSize is the maximum number of compiled expressions kept in memory
+const compiledProgramCacheSize = 1024
+
+var (
+	// compiledPrograms holds the compiled programs keyed by their expression
+	compiledPrograms = newCompiledProgramCache()
+
+	envOnce sync.Once
+	env     *cel.Env
+	envErr  error
+)
+
+// compiledProgramCache is an LRU cache of compiled programs, which are safe for concurrent use
+type compiledProgramCache struct {
+	mu    sync.Mutex
+	cache *lru.Cache
+}
+
+func newCompiledProgramCache() *compiledProgramCache {
+	return &compiledProgramCache{
+		cache: lru.New(compiledProgramCacheSize),
+	}
+}
+
+func (c *compiledProgramCache) get(key string) (cel.Program, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if v, ok := c.cache.Get(key); ok {
+		return v.(cel.Program), true
+	}
+	return nil, false
+}
+
+func (c *compiledProgramCache) add(key string, program cel.Program) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if _, ok := c.cache.Get(key); ok {
+		return
+	}
+	c.cache.Add(key, program)
+}
+
+// getEnv returns the CEL environment shared by the health checks and the resource actions
+func getEnv() (*cel.Env, error) {
+	envOnce.Do(func() {
+		env, envErr = cel.NewEnv(
+			cel.Variable(objVariable, cel.DynType),
+			cel.Variable(actionParamsVariable, cel.MapType(cel.StringType, cel.StringType)),
+			cel.Variable(nowVariable, cel.TimestampType),
+			ext.Strings(),
+		)
+	})
+	return env, envErr
+}
+
+// getProgram returns the compiled program of the given expression, compiling it on the first use
+func getProgram(expression string) (cel.Program, error) {
+	if program, ok := compiledPrograms.get(expression); ok {
+		return program, nil
+	}
+	env, err := getEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+	ast, issues := env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	program, err := env.Program(ast, cel.CostLimit(costLimit), cel.InterruptCheckFrequency(100))
+	if err != nil {
+		return nil, err
+	}
+	compiledPrograms.add(expression, program)
+	return program, nil
+}
+
+// evaluate evaluates the expression against the given resource and action parameters
+func evaluate(obj *unstructured.Unstructured, expression string, resourceActionParameters []*applicationpkg.ResourceActionParameters) (ref.Val, error) {
+	program, err := getProgram(expression)
+	if err != nil {
+		return nil, err
+	}
+	actionParams := make(map[string]string, len(resourceActionParameters))
+	for _, resourceActionParameter := range resourceActionParameters {
+		actionParams[resourceActionParameter.GetName()] = resourceActionParameter.GetValue()
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), evaluationTimeout)
+	defer cancel()
+	out, _, err := program.ContextEval(ctx, map[string]any{
+		objVariable:          obj.Object,
+		actionParamsVariable: actionParams,
+		nowVariable:          time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// toJSON converts a map returned by an expression to JSON
+func toJSON(out ref.Val) ([]byte, error) {
+	if out.Type() != types.MapType {
+		return nil, fmt.Errorf(incorrectReturnType, "map", out.Type().TypeName())
+	}
+	value, err := out.ConvertToNative(reflect.TypeFor[*structpb.Value]())
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert CEL output: %w", err)
+	}
+	return protojson.Marshal(value.(*structpb.Value))
+}
+
+// ExecuteHealth evaluates the CEL expression to generate the health status of a resource. The expression must return
+// a map with the status and message of the resource, or null or an empty map if it does not assess its health.
+func ExecuteHealth(obj *unstructured.Unstructured, expression string) (*health.HealthStatus, error) {
+	out, err := evaluate(obj, expression, nil)
+	if err != nil {
+		return nil, err
+	}
+	if out.Type() == types.NullType {
+		return &health.HealthStatus{}, nil
+	}
+	jsonBytes, err := toJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	healthStatus := &health.HealthStatus{}
+	if err := json.Unmarshal(jsonBytes, healthStatus); err != nil {
+		return nil, err
+	}
+	if !isValidHealthStatusCode(healthStatus.Status) {
+		return &health.HealthStatus{
+			Status:  health.HealthStatusUnknown,
+			Message: invalidHealthStatus,
+		}, nil
+	}
+	return healthStatus, nil
+}
+
+func isValidHealthStatusCode(statusCode health.HealthStatusCode) bool {
+	switch statusCode {
+	case "", health.HealthStatusUnknown, health.HealthStatusProgressing, health.HealthStatusSuspended, health.HealthStatusHealthy, health.HealthStatusDegraded, health.HealthStatusMissing:
+		return true
+	}
+	return false
+}
+
+// ExecuteResourceActionDiscovery evaluates the CEL expression to discover the actions available for a resource. The
+// expression must return a map of the action names to their properties (disabled, iconClass, displayName and params).
+func ExecuteResourceActionDiscovery(obj *unstructured.Unstructured, expression string) ([]appv1.ResourceAction, error) {
+	out, err := evaluate(obj, expression, nil)
+	if err != nil {
+		return nil, err
+	}
+	jsonBytes, err := toJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	actionsMap := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(jsonBytes, &actionsMap); err != nil {
+		return nil, fmt.Errorf("error unmarshaling action map: %w", err)
+	}
+	availableActions := make([]appv1.ResourceAction, 0, len(actionsMap))
+	for name, value := range actionsMap {
+		var resourceAction appv1.ResourceAction
+		if err := json.Unmarshal(value, &resourceAction); err != nil {
+			return nil, fmt.Errorf("error unmarshaling resource action %s: %w", name, err)
+		}
+		resourceAction.Name = name
+		availableActions = append(availableActions, resourceAction)
+	}
+	sort.Slice(availableActions, func(i, j int) bool {
+		return availableActions[i].Name < availableActions[j].Name
+	})
+	return availableActions, nil
+}
+
+// ExecuteResourceAction evaluates the CEL expression of an action and returns the patched resource. The expression
+// must return a JSON merge patch, which is applied to the resource.
+func ExecuteResourceAction(obj *unstructured.Unstructured, expression string, resourceActionParameters []*applicationpkg.ResourceActionParameters) (*unstructured.Unstructured, error) {
+	out, err := evaluate(obj, expression, resourceActionParameters)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := toJSON(out)
+	if err != nil {
+		return nil, err
+	}
+	objBytes, err := obj.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling resource: %w", err)
+	}
+	patchedBytes, err := jsonpatch.MergePatch(objBytes, patch)
+	if err != nil {
+		return nil, fmt.Errorf("error applying patch: %w", err)
+	}
+	newObj := &unstructured.Unstructured{}
+	if err := newObj.UnmarshalJSON(patchedBytes); err != nil {
+		return nil, fmt.Errorf("error unmarshaling patched resource: %w", err)
+	}
+	if newObj.GroupVersionKind() != obj.GroupVersionKind() || newObj.GetName() != obj.GetName() || newObj.GetNamespace() != obj.GetNamespace() {
+		return nil, errors.New("CEL action patch must not change the kind, name or namespace of the resource")
+	}
+	return newObj, nil
+}
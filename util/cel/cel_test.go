@@ -0,0 +1,135 @@
+package cel
+
+import (
+	"testing"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
+	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+const objYAML = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+spec:
+  replicas: 2
+status:
+  readyReplicas: 1
+`
+
+func getObj(t *testing.T) *unstructured.Unstructured {
+	t.Helper()
+	jsonBytes, err := yaml.YAMLToJSON([]byte(objYAML))
+	require.NoError(t, err)
+	obj := &unstructured.Unstructured{}
+	require.NoError(t, obj.UnmarshalJSON(jsonBytes))
+	return obj
+}
+
+func TestExecuteHealth(t *testing.T) {
+	obj := getObj(t)
+
+	t.Run("Degraded", func(t *testing.T) {
+		status, err := ExecuteHealth(obj, `obj.status.readyReplicas == obj.spec.replicas ?
+			{"status": "Healthy"} :
+			{"status": "Degraded", "message": "ready replicas: " + string(obj.status.readyReplicas)}`)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusDegraded, Message: "ready replicas: 1"}, status)
+	})
+
+	t.Run("Null", func(t *testing.T) {
+		status, err := ExecuteHealth(obj, `null`)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{}, status)
+	})
+
+	t.Run("InvalidStatus", func(t *testing.T) {
+		status, err := ExecuteHealth(obj, `{"status": "Unhealthy"}`)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusUnknown, Message: invalidHealthStatus}, status)
+	})
+
+	t.Run("NonMapReturn", func(t *testing.T) {
+		_, err := ExecuteHealth(obj, `"Healthy"`)
+		require.EqualError(t, err, "expect map output from CEL expression, not string")
+	})
+
+	t.Run("InvalidExpression", func(t *testing.T) {
+		_, err := ExecuteHealth(obj, `obj.status.`)
+		require.ErrorContains(t, err, "Syntax error")
+	})
+
+	t.Run("MissingField", func(t *testing.T) {
+		_, err := ExecuteHealth(obj, `{"status": obj.status.phase}`)
+		require.ErrorContains(t, err, "no such key: phase")
+	})
+}
+
+func TestExecuteResourceActionDiscovery(t *testing.T) {
+	actions, err := ExecuteResourceActionDiscovery(getObj(t), `{
+		"scale": {"params": [{"name": "replicas"}]},
+		"resume": {"disabled": !has(obj.spec.paused) || !obj.spec.paused, "iconClass": "fa fa-play"},
+	}`)
+	require.NoError(t, err)
+	assert.Equal(t, []appv1.ResourceAction{
+		{Name: "resume", Disabled: true, IconClass: "fa fa-play"},
+		{Name: "scale", Params: []appv1.ResourceActionParam{{Name: "replicas"}}},
+	}, actions)
+}
+
+func TestExecuteResourceAction(t *testing.T) {
+	obj := getObj(t)
+	name := "replicas"
+	value := "5"
+	params := []*applicationpkg.ResourceActionParameters{{Name: &name, Value: &value}}
+
+	t.Run("Patch", func(t *testing.T) {
+		newObj, err := ExecuteResourceAction(obj, `{"spec": {"replicas": int(actionParams.replicas), "paused": null}, "metadata": {"annotations": {"restartedAt": string(now)}}}`, params)
+		require.NoError(t, err)
+		replicas, _, err := unstructured.NestedInt64(newObj.Object, "spec", "replicas")
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), replicas)
+		assert.NotEmpty(t, newObj.GetAnnotations()["restartedAt"])
+		assert.Equal(t, "guestbook", newObj.GetName())
+		// the original resource is not modified
+		replicas, _, err = unstructured.NestedInt64(obj.Object, "spec", "replicas")
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), replicas)
+	})
+
+	t.Run("RenamedResource", func(t *testing.T) {
+		_, err := ExecuteResourceAction(obj, `{"metadata": {"name": "other"}}`, nil)
+		require.EqualError(t, err, "CEL action patch must not change the kind, name or namespace of the resource")
+	})
+
+	t.Run("NonMapReturn", func(t *testing.T) {
+		_, err := ExecuteResourceAction(obj, `[1, 2]`, nil)
+		require.EqualError(t, err, "expect map output from CEL expression, not list")
+	})
+}
+
+func TestCompiledProgramCache(t *testing.T) {
+	expression := `{"status": "Healthy", "message": "cached"}`
+	_, err := ExecuteHealth(getObj(t), expression)
+	require.NoError(t, err)
+	cached, ok := compiledPrograms.get(expression)
+	require.True(t, ok)
+
+	program, err := getProgram(expression)
+	require.NoError(t, err)
+	assert.Equal(t, cached, program)
+
+	// compilation failures are not cached
+	_, err = getProgram(`{`)
+	require.Error(t, err)
+	_, ok = compiledPrograms.get(`{`)
+	assert.False(t, ok)
+}
@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/sourceintegrity"
+)
+
+// Validates a single cosign public key and returns the key with its type
+func validateCosignPublicKey(name string, keyData string) (*appsv1.CosignPublicKey, error) {
+	if err := sourceintegrity.ValidateCosignKeyName(name); err != nil {
+		return nil, err
+	}
+	key, err := sourceintegrity.ParseCosignPublicKey([]byte(keyData))
+	if err != nil {
+		return nil, fmt.Errorf("error validating cosign public key: %w", err)
+	}
+	return &appsv1.CosignPublicKey{
+		Name:    name,
+		KeyType: sourceintegrity.CosignKeyType(key),
+		KeyData: keyData,
+	}, nil
+}
+
+// ListConfiguredCosignPublicKeys returns a list of all configured cosign public keys from the ConfigMap
+func (db *db) ListConfiguredCosignPublicKeys(_ context.Context) (map[string]*appsv1.CosignPublicKey, error) {
+	log.Debugf("Loading cosign public keys from config map")
+	result := make(map[string]*appsv1.CosignPublicKey)
+	keysCM, err := db.settingsMgr.GetConfigMapByName(common.ArgoCDCosignKeysConfigMapName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting cosign keys configmap: %w", err)
+	}
+
+	for name, keyData := range keysCM.Data {
+		key, err := validateCosignPublicKey(name, keyData)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing cosign public key %q: %w", name, err)
+		}
+		result[name] = key
+	}
+
+	return result, nil
+}
+
+// AddCosignPublicKey adds a cosign public key to the configuration
+func (db *db) AddCosignPublicKey(ctx context.Context, key *appsv1.CosignPublicKey, upsert bool) (*appsv1.CosignPublicKey, error) {
+	validated, err := validateCosignPublicKey(key.Name, key.KeyData)
+	if err != nil {
+		return nil, err
+	}
+
+	keysCM, err := db.settingsMgr.GetConfigMapByName(common.ArgoCDCosignKeysConfigMapName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting cosign keys configmap: %w", err)
+	}
+
+	if existing, ok := keysCM.Data[key.Name]; ok && !upsert {
+		if existing == key.KeyData {
+			return validated, nil
+		}
+		return nil, fmt.Errorf("cosign public key %q already exists with different data", key.Name)
+	}
+
+	keysCM.Data[key.Name] = key.KeyData
+	err = db.settingsMgr.SaveCosignPublicKeyData(ctx, keysCM.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error saving cosign public key data: %w", err)
+	}
+
+	return validated, nil
+}
+
+// DeleteCosignPublicKey deletes a cosign public key from the configuration
+func (db *db) DeleteCosignPublicKey(ctx context.Context, name string) error {
+	keysCM, err := db.settingsMgr.GetConfigMapByName(common.ArgoCDCosignKeysConfigMapName)
+	if err != nil {
+		return fmt.Errorf("error getting cosign keys configmap: %w", err)
+	}
+
+	if _, ok := keysCM.Data[name]; !ok {
+		return fmt.Errorf("no such cosign public key configured: %s", name)
+	}
+
+	delete(keysCM.Data, name)
+
+	err = db.settingsMgr.SaveCosignPublicKeyData(ctx, keysCM.Data)
+	if err != nil {
+		return fmt.Errorf("error saving cosign public key data: %w", err)
+	}
+	return nil
+}
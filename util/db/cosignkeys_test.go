@@ -0,0 +1,126 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	cosignReleaseKey = `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE61k+PIVDTkPZ47qkAglWyGuiqw26
+LEkKoKrdurzJAGxZ+f2dAoqhO7rLkdbRpLegaQw3auw06CEaap0MkzcCyw==
+-----END PUBLIC KEY-----
+`
+	cosignNightlyKey = `-----BEGIN PUBLIC KEY-----
+MCowBQYDK2VwAyEAPltK5P4SD5GWKdSE9GwoDcKo6bWSur526CGtBQ7Q72U=
+-----END PUBLIC KEY-----
+`
+)
+
+// Returns a fake client set with cosign keys config map for use in tests
+func getCosignKeysClientset(data map[string]string) *fake.Clientset {
+	cm := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-cm",
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: nil,
+	}
+	keysCM := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDCosignKeysConfigMapName,
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: data,
+	}
+
+	return fake.NewClientset([]runtime.Object{&cm, &keysCM}...)
+}
+
+func Test_ListConfiguredCosignPublicKeys(t *testing.T) {
+	t.Run("good case", func(t *testing.T) {
+		clientset := getCosignKeysClientset(map[string]string{"release": cosignReleaseKey, "nightly": cosignNightlyKey})
+		db := NewDB(testNamespace, settings.NewSettingsManager(t.Context(), clientset, testNamespace), clientset)
+
+		keys, err := db.ListConfiguredCosignPublicKeys(t.Context())
+		require.NoError(t, err)
+		assert.Len(t, keys, 2)
+		assert.Equal(t, "ECDSA-P-256", keys["release"].KeyType)
+		assert.Equal(t, "ED25519", keys["nightly"].KeyType)
+	})
+
+	t.Run("bad case - garbage key", func(t *testing.T) {
+		clientset := getCosignKeysClientset(map[string]string{"release": "garbage"})
+		db := NewDB(testNamespace, settings.NewSettingsManager(t.Context(), clientset, testNamespace), clientset)
+
+		_, err := db.ListConfiguredCosignPublicKeys(t.Context())
+		require.ErrorContains(t, err, `error parsing cosign public key "release"`)
+	})
+}
+
+func Test_AddCosignPublicKey(t *testing.T) {
+	clientset := getCosignKeysClientset(nil)
+	settingsMgr := settings.NewSettingsManager(t.Context(), clientset, testNamespace)
+	db := NewDB(testNamespace, settingsMgr, clientset)
+
+	// Key should be added
+	key, err := db.AddCosignPublicKey(t.Context(), &appsv1.CosignPublicKey{Name: "release", KeyData: cosignReleaseKey}, false)
+	require.NoError(t, err)
+	assert.Equal(t, "ECDSA-P-256", key.KeyType)
+	cm, err := settingsMgr.GetConfigMapByName(common.ArgoCDCosignKeysConfigMapName)
+	require.NoError(t, err)
+	assert.Len(t, cm.Data, 1)
+
+	// Same key with the same data is accepted
+	_, err = db.AddCosignPublicKey(t.Context(), &appsv1.CosignPublicKey{Name: "release", KeyData: cosignReleaseKey}, false)
+	require.NoError(t, err)
+
+	// Same key with different data needs upsert
+	_, err = db.AddCosignPublicKey(t.Context(), &appsv1.CosignPublicKey{Name: "release", KeyData: cosignNightlyKey}, false)
+	require.EqualError(t, err, `cosign public key "release" already exists with different data`)
+	_, err = db.AddCosignPublicKey(t.Context(), &appsv1.CosignPublicKey{Name: "release", KeyData: cosignNightlyKey}, true)
+	require.NoError(t, err)
+	keys, err := db.ListConfiguredCosignPublicKeys(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "ED25519", keys["release"].KeyType)
+
+	// Invalid input should result in error
+	_, err = db.AddCosignPublicKey(t.Context(), &appsv1.CosignPublicKey{Name: "../nightly", KeyData: cosignNightlyKey}, false)
+	require.EqualError(t, err, `invalid cosign key name "../nightly"`)
+	_, err = db.AddCosignPublicKey(t.Context(), &appsv1.CosignPublicKey{Name: "nightly", KeyData: "garbage"}, false)
+	require.EqualError(t, err, "error validating cosign public key: no PEM data found")
+	cm, err = settingsMgr.GetConfigMapByName(common.ArgoCDCosignKeysConfigMapName)
+	require.NoError(t, err)
+	assert.Len(t, cm.Data, 1)
+}
+
+func Test_DeleteCosignPublicKey(t *testing.T) {
+	clientset := getCosignKeysClientset(map[string]string{"release": cosignReleaseKey, "nightly": cosignNightlyKey})
+	db := NewDB(testNamespace, settings.NewSettingsManager(t.Context(), clientset, testNamespace), clientset)
+
+	// Key should be removed
+	require.NoError(t, db.DeleteCosignPublicKey(t.Context(), "release"))
+
+	// Key should not exist anymore, therefore can't be deleted again
+	require.EqualError(t, db.DeleteCosignPublicKey(t.Context(), "release"), "no such cosign public key configured: release")
+
+	keys, err := db.ListConfiguredCosignPublicKeys(t.Context())
+	require.NoError(t, err)
+	assert.Len(t, keys, 1)
+}
@@ -120,6 +120,20 @@ type ArgoDB interface {
 	// DeleteGPGPublicKey removes a GPG public key from the configuration
 	DeleteGPGPublicKey(ctx context.Context, keyID string) error
 
+	// ListConfiguredSSHSigners returns all SSH signers that are configured
+	ListConfiguredSSHSigners(ctx context.Context) (map[string]*appv1.SSHSigner, error)
+	// AddSSHSigner adds an SSH signer to the configuration, or replaces its keys if upsert is set
+	AddSSHSigner(ctx context.Context, signer *appv1.SSHSigner, upsert bool) (*appv1.SSHSigner, error)
+	// DeleteSSHSigner removes an SSH signer from the configuration
+	DeleteSSHSigner(ctx context.Context, name string) error
+
+	// ListConfiguredCosignPublicKeys returns all cosign public keys that are configured
+	ListConfiguredCosignPublicKeys(ctx context.Context) (map[string]*appv1.CosignPublicKey, error)
+	// AddCosignPublicKey adds a cosign public key to the configuration, or replaces it if upsert is set
+	AddCosignPublicKey(ctx context.Context, key *appv1.CosignPublicKey, upsert bool) (*appv1.CosignPublicKey, error)
+	// DeleteCosignPublicKey removes a cosign public key from the configuration
+	DeleteCosignPublicKey(ctx context.Context, name string) error
+
 	// GetApplicationControllerReplicas gets the replicas of application controller
 	GetApplicationControllerReplicas() int
 }
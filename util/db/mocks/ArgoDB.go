@@ -39,6 +39,80 @@ func (_m *ArgoDB) EXPECT() *ArgoDB_Expecter {
 	return &ArgoDB_Expecter{mock: &_m.Mock}
 }
 
+// AddCosignPublicKey provides a mock function for the type ArgoDB
+func (_mock *ArgoDB) AddCosignPublicKey(ctx context.Context, key *v1alpha1.CosignPublicKey, upsert bool) (*v1alpha1.CosignPublicKey, error) {
+	ret := _mock.Called(ctx, key, upsert)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddCosignPublicKey")
+	}
+
+	var r0 *v1alpha1.CosignPublicKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1alpha1.CosignPublicKey, bool) (*v1alpha1.CosignPublicKey, error)); ok {
+		return returnFunc(ctx, key, upsert)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1alpha1.CosignPublicKey, bool) *v1alpha1.CosignPublicKey); ok {
+		r0 = returnFunc(ctx, key, upsert)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.CosignPublicKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1alpha1.CosignPublicKey, bool) error); ok {
+		r1 = returnFunc(ctx, key, upsert)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ArgoDB_AddCosignPublicKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddCosignPublicKey'
+type ArgoDB_AddCosignPublicKey_Call struct {
+	*mock.Call
+}
+
+// AddCosignPublicKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key *v1alpha1.CosignPublicKey
+//   - upsert bool
+func (_e *ArgoDB_Expecter) AddCosignPublicKey(ctx any, key any, upsert any) *ArgoDB_AddCosignPublicKey_Call {
+	return &ArgoDB_AddCosignPublicKey_Call{Call: _e.mock.On("AddCosignPublicKey", ctx, key, upsert)}
+}
+
+func (_c *ArgoDB_AddCosignPublicKey_Call) Run(run func(ctx context.Context, key *v1alpha1.CosignPublicKey, upsert bool)) *ArgoDB_AddCosignPublicKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v1alpha1.CosignPublicKey
+		if args[1] != nil {
+			arg1 = args[1].(*v1alpha1.CosignPublicKey)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ArgoDB_AddCosignPublicKey_Call) Return(cosignPublicKey *v1alpha1.CosignPublicKey, err error) *ArgoDB_AddCosignPublicKey_Call {
+	_c.Call.Return(cosignPublicKey, err)
+	return _c
+}
+
+func (_c *ArgoDB_AddCosignPublicKey_Call) RunAndReturn(run func(ctx context.Context, key *v1alpha1.CosignPublicKey, upsert bool) (*v1alpha1.CosignPublicKey, error)) *ArgoDB_AddCosignPublicKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddGPGPublicKey provides a mock function for the type ArgoDB
 func (_mock *ArgoDB) AddGPGPublicKey(ctx context.Context, keyData string) (map[string]*v1alpha1.GnuPGPublicKey, []string, error) {
 	ret := _mock.Called(ctx, keyData)
@@ -115,6 +189,80 @@ func (_c *ArgoDB_AddGPGPublicKey_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// AddSSHSigner provides a mock function for the type ArgoDB
+func (_mock *ArgoDB) AddSSHSigner(ctx context.Context, signer *v1alpha1.SSHSigner, upsert bool) (*v1alpha1.SSHSigner, error) {
+	ret := _mock.Called(ctx, signer, upsert)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddSSHSigner")
+	}
+
+	var r0 *v1alpha1.SSHSigner
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1alpha1.SSHSigner, bool) (*v1alpha1.SSHSigner, error)); ok {
+		return returnFunc(ctx, signer, upsert)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *v1alpha1.SSHSigner, bool) *v1alpha1.SSHSigner); ok {
+		r0 = returnFunc(ctx, signer, upsert)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*v1alpha1.SSHSigner)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *v1alpha1.SSHSigner, bool) error); ok {
+		r1 = returnFunc(ctx, signer, upsert)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ArgoDB_AddSSHSigner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddSSHSigner'
+type ArgoDB_AddSSHSigner_Call struct {
+	*mock.Call
+}
+
+// AddSSHSigner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - signer *v1alpha1.SSHSigner
+//   - upsert bool
+func (_e *ArgoDB_Expecter) AddSSHSigner(ctx any, signer any, upsert any) *ArgoDB_AddSSHSigner_Call {
+	return &ArgoDB_AddSSHSigner_Call{Call: _e.mock.On("AddSSHSigner", ctx, signer, upsert)}
+}
+
+func (_c *ArgoDB_AddSSHSigner_Call) Run(run func(ctx context.Context, signer *v1alpha1.SSHSigner, upsert bool)) *ArgoDB_AddSSHSigner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *v1alpha1.SSHSigner
+		if args[1] != nil {
+			arg1 = args[1].(*v1alpha1.SSHSigner)
+		}
+		var arg2 bool
+		if args[2] != nil {
+			arg2 = args[2].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *ArgoDB_AddSSHSigner_Call) Return(sSHSigner *v1alpha1.SSHSigner, err error) *ArgoDB_AddSSHSigner_Call {
+	_c.Call.Return(sSHSigner, err)
+	return _c
+}
+
+func (_c *ArgoDB_AddSSHSigner_Call) RunAndReturn(run func(ctx context.Context, signer *v1alpha1.SSHSigner, upsert bool) (*v1alpha1.SSHSigner, error)) *ArgoDB_AddSSHSigner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateCluster provides a mock function for the type ArgoDB
 func (_mock *ArgoDB) CreateCluster(ctx context.Context, c *v1alpha1.Cluster) (*v1alpha1.Cluster, error) {
 	ret := _mock.Called(ctx, c)
@@ -586,6 +734,63 @@ func (_c *ArgoDB_DeleteCluster_Call) RunAndReturn(run func(ctx context.Context,
 	return _c
 }
 
+// DeleteCosignPublicKey provides a mock function for the type ArgoDB
+func (_mock *ArgoDB) DeleteCosignPublicKey(ctx context.Context, name string) error {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteCosignPublicKey")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ArgoDB_DeleteCosignPublicKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteCosignPublicKey'
+type ArgoDB_DeleteCosignPublicKey_Call struct {
+	*mock.Call
+}
+
+// DeleteCosignPublicKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *ArgoDB_Expecter) DeleteCosignPublicKey(ctx any, name any) *ArgoDB_DeleteCosignPublicKey_Call {
+	return &ArgoDB_DeleteCosignPublicKey_Call{Call: _e.mock.On("DeleteCosignPublicKey", ctx, name)}
+}
+
+func (_c *ArgoDB_DeleteCosignPublicKey_Call) Run(run func(ctx context.Context, name string)) *ArgoDB_DeleteCosignPublicKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ArgoDB_DeleteCosignPublicKey_Call) Return(err error) *ArgoDB_DeleteCosignPublicKey_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ArgoDB_DeleteCosignPublicKey_Call) RunAndReturn(run func(ctx context.Context, name string) error) *ArgoDB_DeleteCosignPublicKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteGPGPublicKey provides a mock function for the type ArgoDB
 func (_mock *ArgoDB) DeleteGPGPublicKey(ctx context.Context, keyID string) error {
 	ret := _mock.Called(ctx, keyID)
@@ -763,6 +968,63 @@ func (_c *ArgoDB_DeleteRepositoryCredentials_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// DeleteSSHSigner provides a mock function for the type ArgoDB
+func (_mock *ArgoDB) DeleteSSHSigner(ctx context.Context, name string) error {
+	ret := _mock.Called(ctx, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteSSHSigner")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = returnFunc(ctx, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// ArgoDB_DeleteSSHSigner_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteSSHSigner'
+type ArgoDB_DeleteSSHSigner_Call struct {
+	*mock.Call
+}
+
+// DeleteSSHSigner is a helper method to define mock.On call
+//   - ctx context.Context
+//   - name string
+func (_e *ArgoDB_Expecter) DeleteSSHSigner(ctx any, name any) *ArgoDB_DeleteSSHSigner_Call {
+	return &ArgoDB_DeleteSSHSigner_Call{Call: _e.mock.On("DeleteSSHSigner", ctx, name)}
+}
+
+func (_c *ArgoDB_DeleteSSHSigner_Call) Run(run func(ctx context.Context, name string)) *ArgoDB_DeleteSSHSigner_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *ArgoDB_DeleteSSHSigner_Call) Return(err error) *ArgoDB_DeleteSSHSigner_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *ArgoDB_DeleteSSHSigner_Call) RunAndReturn(run func(ctx context.Context, name string) error) *ArgoDB_DeleteSSHSigner_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteWriteRepository provides a mock function for the type ArgoDB
 func (_mock *ArgoDB) DeleteWriteRepository(ctx context.Context, name string, project string) error {
 	ret := _mock.Called(ctx, name, project)
@@ -1725,6 +1987,68 @@ func (_c *ArgoDB_ListClusters_Call) RunAndReturn(run func(ctx context.Context) (
 	return _c
 }
 
+// ListConfiguredCosignPublicKeys provides a mock function for the type ArgoDB
+func (_mock *ArgoDB) ListConfiguredCosignPublicKeys(ctx context.Context) (map[string]*v1alpha1.CosignPublicKey, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListConfiguredCosignPublicKeys")
+	}
+
+	var r0 map[string]*v1alpha1.CosignPublicKey
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (map[string]*v1alpha1.CosignPublicKey, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) map[string]*v1alpha1.CosignPublicKey); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*v1alpha1.CosignPublicKey)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ArgoDB_ListConfiguredCosignPublicKeys_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConfiguredCosignPublicKeys'
+type ArgoDB_ListConfiguredCosignPublicKeys_Call struct {
+	*mock.Call
+}
+
+// ListConfiguredCosignPublicKeys is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ArgoDB_Expecter) ListConfiguredCosignPublicKeys(ctx any) *ArgoDB_ListConfiguredCosignPublicKeys_Call {
+	return &ArgoDB_ListConfiguredCosignPublicKeys_Call{Call: _e.mock.On("ListConfiguredCosignPublicKeys", ctx)}
+}
+
+func (_c *ArgoDB_ListConfiguredCosignPublicKeys_Call) Run(run func(ctx context.Context)) *ArgoDB_ListConfiguredCosignPublicKeys_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *ArgoDB_ListConfiguredCosignPublicKeys_Call) Return(stringToCosignPublicKey map[string]*v1alpha1.CosignPublicKey, err error) *ArgoDB_ListConfiguredCosignPublicKeys_Call {
+	_c.Call.Return(stringToCosignPublicKey, err)
+	return _c
+}
+
+func (_c *ArgoDB_ListConfiguredCosignPublicKeys_Call) RunAndReturn(run func(ctx context.Context) (map[string]*v1alpha1.CosignPublicKey, error)) *ArgoDB_ListConfiguredCosignPublicKeys_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListConfiguredGPGPublicKeys provides a mock function for the type ArgoDB
 func (_mock *ArgoDB) ListConfiguredGPGPublicKeys(ctx context.Context) (map[string]*v1alpha1.GnuPGPublicKey, error) {
 	ret := _mock.Called(ctx)
@@ -1787,6 +2111,68 @@ func (_c *ArgoDB_ListConfiguredGPGPublicKeys_Call) RunAndReturn(run func(ctx con
 	return _c
 }
 
+// ListConfiguredSSHSigners provides a mock function for the type ArgoDB
+func (_mock *ArgoDB) ListConfiguredSSHSigners(ctx context.Context) (map[string]*v1alpha1.SSHSigner, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListConfiguredSSHSigners")
+	}
+
+	var r0 map[string]*v1alpha1.SSHSigner
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (map[string]*v1alpha1.SSHSigner, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) map[string]*v1alpha1.SSHSigner); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*v1alpha1.SSHSigner)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// ArgoDB_ListConfiguredSSHSigners_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListConfiguredSSHSigners'
+type ArgoDB_ListConfiguredSSHSigners_Call struct {
+	*mock.Call
+}
+
+// ListConfiguredSSHSigners is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *ArgoDB_Expecter) ListConfiguredSSHSigners(ctx any) *ArgoDB_ListConfiguredSSHSigners_Call {
+	return &ArgoDB_ListConfiguredSSHSigners_Call{Call: _e.mock.On("ListConfiguredSSHSigners", ctx)}
+}
+
+func (_c *ArgoDB_ListConfiguredSSHSigners_Call) Run(run func(ctx context.Context)) *ArgoDB_ListConfiguredSSHSigners_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *ArgoDB_ListConfiguredSSHSigners_Call) Return(stringToSSHSigner map[string]*v1alpha1.SSHSigner, err error) *ArgoDB_ListConfiguredSSHSigners_Call {
+	_c.Call.Return(stringToSSHSigner, err)
+	return _c
+}
+
+func (_c *ArgoDB_ListConfiguredSSHSigners_Call) RunAndReturn(run func(ctx context.Context) (map[string]*v1alpha1.SSHSigner, error)) *ArgoDB_ListConfiguredSSHSigners_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ListHelmRepositories provides a mock function for the type ArgoDB
 func (_mock *ArgoDB) ListHelmRepositories(ctx context.Context) ([]*v1alpha1.Repository, error) {
 	ret := _mock.Called(ctx)
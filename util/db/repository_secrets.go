@@ -349,6 +349,7 @@ func secretToRepository(secret *corev1.Secret) (*appsv1.Repository, error) {
 		AzureServicePrincipalClientSecret: string(secretCopy.Data["azureServicePrincipalClientSecret"]),
 		AzureServicePrincipalTenantId:     string(secretCopy.Data["azureServicePrincipalTenantId"]),
 		AzureActiveDirectoryEndpoint:      string(secretCopy.Data["azureActiveDirectoryEndpoint"]),
+		CommitSigningKey:                  string(secretCopy.Data["commitSigningKey"]),
 	}
 
 	insecureIgnoreHostKey, err := boolOrFalse(secretCopy, "insecureIgnoreHostKey")
@@ -459,6 +460,7 @@ func (s *secretsRepositoryBackend) repositoryToSecret(repository *appsv1.Reposit
 	updateSecretString(secretCopy, "azureServicePrincipalClientSecret", repository.AzureServicePrincipalClientSecret)
 	updateSecretString(secretCopy, "azureServicePrincipalTenantId", repository.AzureServicePrincipalTenantId)
 	updateSecretString(secretCopy, "azureActiveDirectoryEndpoint", repository.AzureActiveDirectoryEndpoint)
+	updateSecretString(secretCopy, "commitSigningKey", repository.CommitSigningKey)
 	addSecretMetadata(secretCopy, s.getSecretType())
 
 	return secretCopy
@@ -485,6 +487,7 @@ func (s *secretsRepositoryBackend) secretToRepoCred(secret *corev1.Secret) (*app
 		AzureServicePrincipalClientSecret: string(secretCopy.Data["azureServicePrincipalClientSecret"]),
 		AzureServicePrincipalTenantId:     string(secretCopy.Data["azureServicePrincipalTenantID"]),
 		AzureActiveDirectoryEndpoint:      string(secretCopy.Data["azureActiveDirectoryEndpoint"]),
+		CommitSigningKey:                  string(secretCopy.Data["commitSigningKey"]),
 	}
 
 	enableOCI, err := boolOrFalse(secretCopy, "enableOCI")
@@ -556,6 +559,7 @@ func (s *secretsRepositoryBackend) repoCredsToSecret(repoCreds *appsv1.RepoCreds
 	updateSecretString(secretCopy, "azureServicePrincipalClientSecret", repoCreds.AzureServicePrincipalClientSecret)
 	updateSecretString(secretCopy, "azureServicePrincipalTenantID", repoCreds.AzureServicePrincipalTenantId)
 	updateSecretString(secretCopy, "azureActiveDirectoryEndpoint", repoCreds.AzureActiveDirectoryEndpoint)
+	updateSecretString(secretCopy, "commitSigningKey", repoCreds.CommitSigningKey)
 	addSecretMetadata(secretCopy, s.getRepoCredSecretType())
 
 	return secretCopy
@@ -0,0 +1,96 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/sourceintegrity"
+)
+
+// Validates the public keys of a single SSH signer and returns the signer with the key fingerprints
+func validateSSHSigner(name string, keyData string) (*appsv1.SSHSigner, error) {
+	if err := sourceintegrity.ValidateSSHSignerName(name); err != nil {
+		return nil, err
+	}
+	keys, err := sourceintegrity.ParseSSHPublicKeys(keyData)
+	if err != nil {
+		return nil, fmt.Errorf("error validating SSH public keys: %w", err)
+	}
+	return &appsv1.SSHSigner{
+		Name:         name,
+		Fingerprints: sourceintegrity.SSHFingerprints(keys),
+		KeyData:      keyData,
+	}, nil
+}
+
+// ListConfiguredSSHSigners returns a list of all configured SSH signers from the ConfigMap
+func (db *db) ListConfiguredSSHSigners(_ context.Context) (map[string]*appsv1.SSHSigner, error) {
+	log.Debugf("Loading SSH signers from config map")
+	result := make(map[string]*appsv1.SSHSigner)
+	signersCM, err := db.settingsMgr.GetConfigMapByName(common.ArgoCDSSHSignersConfigMapName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting SSH signers configmap: %w", err)
+	}
+
+	for name, keyData := range signersCM.Data {
+		signer, err := validateSSHSigner(name, keyData)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing SSH signer %q: %w", name, err)
+		}
+		result[name] = signer
+	}
+
+	return result, nil
+}
+
+// AddSSHSigner adds an SSH signer with its public keys to the configuration
+func (db *db) AddSSHSigner(ctx context.Context, signer *appsv1.SSHSigner, upsert bool) (*appsv1.SSHSigner, error) {
+	validated, err := validateSSHSigner(signer.Name, signer.KeyData)
+	if err != nil {
+		return nil, err
+	}
+
+	signersCM, err := db.settingsMgr.GetConfigMapByName(common.ArgoCDSSHSignersConfigMapName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting SSH signers configmap: %w", err)
+	}
+
+	if existing, ok := signersCM.Data[signer.Name]; ok && !upsert {
+		if existing == signer.KeyData {
+			return validated, nil
+		}
+		return nil, fmt.Errorf("SSH signer %q already exists with different keys", signer.Name)
+	}
+
+	signersCM.Data[signer.Name] = signer.KeyData
+	err = db.settingsMgr.SaveSSHSignerData(ctx, signersCM.Data)
+	if err != nil {
+		return nil, fmt.Errorf("error saving SSH signer data: %w", err)
+	}
+
+	return validated, nil
+}
+
+// DeleteSSHSigner deletes an SSH signer from the configuration
+func (db *db) DeleteSSHSigner(ctx context.Context, name string) error {
+	signersCM, err := db.settingsMgr.GetConfigMapByName(common.ArgoCDSSHSignersConfigMapName)
+	if err != nil {
+		return fmt.Errorf("error getting SSH signers configmap: %w", err)
+	}
+
+	if _, ok := signersCM.Data[name]; !ok {
+		return fmt.Errorf("no such SSH signer configured: %s", name)
+	}
+
+	delete(signersCM.Data, name)
+
+	err = db.settingsMgr.SaveSSHSignerData(ctx, signersCM.Data)
+	if err != nil {
+		return fmt.Errorf("error saving SSH signer data: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package db
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	appsv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	sshSignerAliceKey         = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEhdavHuxYWEStGk8K0Q+XKnFiN2ipjktAjmwFWiKhiX alice@example.com"
+	sshSignerAliceFingerprint = "SHA256:+TmsGGTUoPmVRonuGm7kHu2TMVj80QXKHnoSKnNSrtI"
+	sshSignerBobKey           = "ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIK6HmbMutHF8on/fqKSujRfT7kNnv0wSckYZkZA0yYAk bob@example.com"
+)
+
+// Returns a fake client set with SSH signers config map for use in tests
+func getSSHSignersClientset(data map[string]string) *fake.Clientset {
+	cm := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "argocd-cm",
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: nil,
+	}
+	signersCM := corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDSSHSignersConfigMapName,
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: data,
+	}
+
+	return fake.NewClientset([]runtime.Object{&cm, &signersCM}...)
+}
+
+func Test_ListConfiguredSSHSigners(t *testing.T) {
+	t.Run("good case", func(t *testing.T) {
+		clientset := getSSHSignersClientset(map[string]string{"alice": sshSignerAliceKey, "bob": sshSignerBobKey})
+		db := NewDB(testNamespace, settings.NewSettingsManager(t.Context(), clientset, testNamespace), clientset)
+
+		signers, err := db.ListConfiguredSSHSigners(t.Context())
+		require.NoError(t, err)
+		assert.Len(t, signers, 2)
+		assert.Equal(t, []string{sshSignerAliceFingerprint}, signers["alice"].Fingerprints)
+	})
+
+	t.Run("bad case - garbage key", func(t *testing.T) {
+		clientset := getSSHSignersClientset(map[string]string{"alice": "ssh-ed25519 garbage"})
+		db := NewDB(testNamespace, settings.NewSettingsManager(t.Context(), clientset, testNamespace), clientset)
+
+		_, err := db.ListConfiguredSSHSigners(t.Context())
+		require.ErrorContains(t, err, `error parsing SSH signer "alice"`)
+	})
+}
+
+func Test_AddSSHSigner(t *testing.T) {
+	clientset := getSSHSignersClientset(nil)
+	settingsMgr := settings.NewSettingsManager(t.Context(), clientset, testNamespace)
+	db := NewDB(testNamespace, settingsMgr, clientset)
+
+	// Signer should be added
+	signer, err := db.AddSSHSigner(t.Context(), &appsv1.SSHSigner{Name: "alice", KeyData: sshSignerAliceKey}, false)
+	require.NoError(t, err)
+	assert.Equal(t, []string{sshSignerAliceFingerprint}, signer.Fingerprints)
+	cm, err := settingsMgr.GetConfigMapByName(common.ArgoCDSSHSignersConfigMapName)
+	require.NoError(t, err)
+	assert.Len(t, cm.Data, 1)
+
+	// Same signer with the same keys is accepted
+	_, err = db.AddSSHSigner(t.Context(), &appsv1.SSHSigner{Name: "alice", KeyData: sshSignerAliceKey}, false)
+	require.NoError(t, err)
+
+	// Same signer with different keys needs upsert
+	_, err = db.AddSSHSigner(t.Context(), &appsv1.SSHSigner{Name: "alice", KeyData: sshSignerBobKey}, false)
+	require.EqualError(t, err, `SSH signer "alice" already exists with different keys`)
+	_, err = db.AddSSHSigner(t.Context(), &appsv1.SSHSigner{Name: "alice", KeyData: sshSignerAliceKey + "\n" + sshSignerBobKey}, true)
+	require.NoError(t, err)
+	signers, err := db.ListConfiguredSSHSigners(t.Context())
+	require.NoError(t, err)
+	assert.Len(t, signers["alice"].Fingerprints, 2)
+
+	// Invalid input should result in error
+	_, err = db.AddSSHSigner(t.Context(), &appsv1.SSHSigner{Name: "../bob", KeyData: sshSignerBobKey}, false)
+	require.EqualError(t, err, "'../bob' is not a valid SSH signer name")
+	_, err = db.AddSSHSigner(t.Context(), &appsv1.SSHSigner{Name: "bob", KeyData: "garbage"}, false)
+	require.Error(t, err)
+	cm, err = settingsMgr.GetConfigMapByName(common.ArgoCDSSHSignersConfigMapName)
+	require.NoError(t, err)
+	assert.Len(t, cm.Data, 1)
+}
+
+func Test_DeleteSSHSigner(t *testing.T) {
+	clientset := getSSHSignersClientset(map[string]string{"alice": sshSignerAliceKey, "bob": sshSignerBobKey})
+	db := NewDB(testNamespace, settings.NewSettingsManager(t.Context(), clientset, testNamespace), clientset)
+
+	// Signer should be removed
+	require.NoError(t, db.DeleteSSHSigner(t.Context(), "alice"))
+
+	// Signer should not exist anymore, therefore can't be deleted again
+	require.EqualError(t, db.DeleteSSHSigner(t.Context(), "alice"), "no such SSH signer configured: alice")
+
+	signers, err := db.ListConfiguredSSHSigners(t.Context())
+	require.NoError(t, err)
+	assert.Len(t, signers, 1)
+}
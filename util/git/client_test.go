@@ -1347,6 +1347,149 @@ func Test_nativeGitClient_CommitAndPush(t *testing.T) {
 	require.Equal(t, expectedCommitHash, actualCommitHash)
 }
 
+func Test_nativeGitClient_SetSigningKey(t *testing.T) {
+	_, sshKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	sshPemBlock, err := ssh.MarshalPrivateKey(sshKey, "")
+	require.NoError(t, err)
+	sshSigner, err := ssh.NewSignerFromKey(sshKey)
+	require.NoError(t, err)
+
+	gnupgHome := t.TempDir()
+	err = runCmd(t.Context(), gnupgHome, "gpg", "--batch", "--homedir", gnupgHome, "--passphrase", "", "--quick-gen-key", "Argo CD Signing <signing@example.com>", "ed25519", "sign", "never")
+	require.NoError(t, err)
+	gpgKey, err := outputCmd(t.Context(), gnupgHome, "gpg", "--batch", "--homedir", gnupgHome, "--armor", "--export-secret-keys")
+	require.NoError(t, err)
+	gpgKeys, err := outputCmd(t.Context(), gnupgHome, "gpg", "--batch", "--homedir", gnupgHome, "--with-colons", "--list-secret-keys")
+	require.NoError(t, err)
+	var gpgFingerprint string
+	for line := range strings.SplitSeq(string(gpgKeys), "\n") {
+		if fields := strings.Split(line, ":"); fields[0] == "fpr" {
+			gpgFingerprint = fields[9]
+			break
+		}
+	}
+	require.NotEmpty(t, gpgFingerprint)
+
+	tests := []struct {
+		name                string
+		key                 string
+		expectedFingerprint string
+		expectedSignature   string
+	}{
+		{
+			name:                "SSH key",
+			key:                 string(pem.EncodeToMemory(sshPemBlock)),
+			expectedFingerprint: ssh.FingerprintSHA256(sshSigner.PublicKey()),
+			expectedSignature:   "-----BEGIN SSH SIGNATURE-----",
+		},
+		{
+			name:                "GnuPG key",
+			key:                 string(gpgKey),
+			expectedFingerprint: gpgFingerprint,
+			expectedSignature:   "-----BEGIN PGP SIGNATURE-----",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := t.Context()
+			tempDir, err := _createEmptyGitRepo(ctx)
+			require.NoError(t, err)
+			err = runCmd(ctx, tempDir, "git", "config", "--local", "receive.denyCurrentBranch", "updateInstead")
+			require.NoError(t, err)
+			gitCurrentBranch, err := outputCmd(ctx, tempDir, "git", "rev-parse", "--abbrev-ref", "HEAD")
+			require.NoError(t, err)
+			branch := strings.TrimSpace(string(gitCurrentBranch))
+
+			client, err := NewClient("file://"+tempDir, NopCreds{}, true, false, "", "")
+			require.NoError(t, err)
+			require.NoError(t, client.Init())
+			out, err := client.SetAuthor(ctx, "test", "test@example.com")
+			require.NoError(t, err, "error output: ", out)
+			require.NoError(t, client.Fetch(ctx, branch, 0))
+			out, err = client.Checkout(ctx, branch, false, true)
+			require.NoError(t, err, "error output: ", out)
+
+			fingerprint, err := client.SetSigningKey(ctx, tt.key)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedFingerprint, fingerprint)
+
+			err = runCmd(ctx, client.Root(), "touch", "README.md")
+			require.NoError(t, err)
+			out, err = client.CommitAndPush(ctx, branch, "docs: README")
+			require.NoError(t, err, "error output: %s", out)
+
+			commit, err := outputCmd(ctx, tempDir, "git", "cat-file", "commit", "HEAD")
+			require.NoError(t, err)
+			assert.Contains(t, string(commit), "gpgsig "+tt.expectedSignature)
+		})
+	}
+
+	t.Run("invalid key", func(t *testing.T) {
+		ctx := t.Context()
+		tempDir, err := _createEmptyGitRepo(ctx)
+		require.NoError(t, err)
+		client, err := NewClient("file://"+tempDir, NopCreds{}, true, false, "", "")
+		require.NoError(t, err)
+		require.NoError(t, client.Init())
+
+		_, err = client.SetSigningKey(ctx, "not a key")
+		require.ErrorContains(t, err, "failed to parse signing key")
+	})
+}
+
+func Test_nativeGitClient_FastForward(t *testing.T) {
+	ctx := t.Context()
+	tempDir, err := _createEmptyGitRepo(ctx)
+	require.NoError(t, err)
+
+	gitCurrentBranch, err := outputCmd(ctx, tempDir, "git", "rev-parse", "--abbrev-ref", "HEAD")
+	require.NoError(t, err)
+	branch := strings.TrimSpace(string(gitCurrentBranch))
+
+	client, err := NewClient("file://"+tempDir, NopCreds{}, true, false, "", "")
+	require.NoError(t, err)
+
+	err = client.Init()
+	require.NoError(t, err)
+
+	out, err := client.SetAuthor(t.Context(), "test", "test@example.com")
+	require.NoError(t, err, "error output: ", out)
+
+	err = client.Fetch(t.Context(), branch, 0)
+	require.NoError(t, err)
+
+	out, err = client.Checkout(t.Context(), branch, false, true)
+	require.NoError(t, err, "error output: ", out)
+
+	err = runCmd(ctx, client.Root(), "git", "commit", "--allow-empty", "-m", "staged")
+	require.NoError(t, err)
+	staged, err := client.CommitSHA(t.Context())
+	require.NoError(t, err)
+
+	// the promoted branch does not exist yet
+	out, err = client.FastForward(t.Context(), staged, "promoted")
+	require.NoError(t, err, "error output: %s", out)
+	promoted, err := outputCmd(ctx, tempDir, "git", "rev-parse", "promoted")
+	require.NoError(t, err)
+	assert.Equal(t, staged, strings.TrimSpace(string(promoted)))
+
+	// the branch is fast-forwarded to a descendant commit
+	err = runCmd(ctx, client.Root(), "git", "commit", "--allow-empty", "-m", "staged again")
+	require.NoError(t, err)
+	descendant, err := client.CommitSHA(t.Context())
+	require.NoError(t, err)
+	_, err = client.FastForward(t.Context(), descendant, "promoted")
+	require.NoError(t, err)
+	promoted, err = outputCmd(ctx, tempDir, "git", "rev-parse", "promoted")
+	require.NoError(t, err)
+	assert.Equal(t, descendant, strings.TrimSpace(string(promoted)))
+
+	// the branch is not rewound to an ancestor
+	_, err = client.FastForward(t.Context(), staged, "promoted")
+	require.Error(t, err)
+}
+
 func Test_newAuth_AzureWorkloadIdentity(t *testing.T) {
 	tokenprovider := new(mocks.TokenProvider)
 	tokenprovider.EXPECT().GetToken(azureDevopsEntraResourceId).Return(&workloadidentity.Token{AccessToken: "accessToken"}, nil).Maybe()
@@ -2092,6 +2235,48 @@ func Test_nativeGitClient_HasFileChanged(t *testing.T) {
 	require.True(t, changed, "expected modified file to be reported as changed")
 }
 
+func Test_nativeGitClient_AddAndDiff(t *testing.T) {
+	ctx := t.Context()
+	tempDir, err := _createEmptyGitRepo(ctx)
+	require.NoError(t, err)
+
+	gitCurrentBranch, err := outputCmd(ctx, tempDir, "git", "rev-parse", "--abbrev-ref", "HEAD")
+	require.NoError(t, err)
+	branch := strings.TrimSpace(string(gitCurrentBranch))
+
+	client, err := NewClient("file://"+tempDir, NopCreds{}, true, false, "", "")
+	require.NoError(t, err)
+
+	err = client.Init()
+	require.NoError(t, err)
+
+	err = client.Fetch(t.Context(), branch, 0)
+	require.NoError(t, err)
+
+	out, err := client.Checkout(t.Context(), branch, false, true)
+	require.NoError(t, err, "error output: ", out)
+
+	// Nothing changed
+	diff, err := client.AddAndDiff(t.Context())
+	require.NoError(t, err)
+	assert.Empty(t, diff)
+
+	// Untracked files are part of the diff
+	err = os.WriteFile(filepath.Join(client.Root(), "sample.txt"), []byte("first version\n"), 0o644)
+	require.NoError(t, err)
+	diff, err = client.AddAndDiff(t.Context())
+	require.NoError(t, err)
+	assert.Contains(t, diff, "+++ b/sample.txt")
+	assert.Contains(t, diff, "+first version")
+
+	// Nothing is committed
+	sha, err := client.CommitSHA(t.Context())
+	require.NoError(t, err)
+	head, err := outputCmd(ctx, tempDir, "git", "rev-parse", "HEAD")
+	require.NoError(t, err)
+	assert.Equal(t, strings.TrimSpace(string(head)), sha)
+}
+
 func Test_LsSignatures_Error(t *testing.T) {
 	ctx := t.Context()
 	tempDir, err := _createEmptyGitRepo(ctx)
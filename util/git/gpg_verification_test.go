@@ -313,6 +313,23 @@ func Test_parseGpgSignStatus(t *testing.T) {
 [GNUPG:] TRUST_ULTIMATE 0 pgp user17@argo.io`,
 			"", GPGVerificationResultGood, "9515ACB41E14E7F9",
 		},
+		{
+			nil,
+			`Good "git" signature for alice with ED25519 key SHA256:ULibKJyJ5eufs5DCo+ie63cjf1A90JvrMIdPLdnYKRI`,
+			"", GPGVerificationResultGood, "SHA256:ULibKJyJ5eufs5DCo+ie63cjf1A90JvrMIdPLdnYKRI",
+		},
+		{
+			errors.New("fake"),
+			`Good "git" signature with ED25519 key SHA256:c/h3eTc3LSB4uJzYPmjOfuhumRx90FiSlPyXVBfapfo
+No principal matched.`,
+			"", GPGVerificationResultUntrusted, "SHA256:c/h3eTc3LSB4uJzYPmjOfuhumRx90FiSlPyXVBfapfo",
+		},
+		{
+			errors.New("fake"),
+			`Signature verification failed: incorrect signature
+Could not verify signature.`,
+			"", GPGVerificationResultBad, "",
+		},
 	}
 
 	for _, tt := range testCases {
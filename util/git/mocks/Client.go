@@ -38,6 +38,66 @@ func (_m *Client) EXPECT() *Client_Expecter {
 	return &Client_Expecter{mock: &_m.Mock}
 }
 
+// AddAndDiff provides a mock function for the type Client
+func (_mock *Client) AddAndDiff(ctx context.Context) (string, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddAndDiff")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (string, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) string); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Client_AddAndDiff_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddAndDiff'
+type Client_AddAndDiff_Call struct {
+	*mock.Call
+}
+
+// AddAndDiff is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *Client_Expecter) AddAndDiff(ctx any) *Client_AddAndDiff_Call {
+	return &Client_AddAndDiff_Call{Call: _e.mock.On("AddAndDiff", ctx)}
+}
+
+func (_c *Client_AddAndDiff_Call) Run(run func(ctx context.Context)) *Client_AddAndDiff_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *Client_AddAndDiff_Call) Return(s string, err error) *Client_AddAndDiff_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *Client_AddAndDiff_Call) RunAndReturn(run func(ctx context.Context) (string, error)) *Client_AddAndDiff_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // AddAndPushNote provides a mock function for the type Client
 func (_mock *Client) AddAndPushNote(ctx context.Context, sha string, namespace string, note string) error {
 	ret := _mock.Called(ctx, sha, namespace, note)
@@ -541,6 +601,78 @@ func (_c *Client_CommitSHA_Call) RunAndReturn(run func(ctx context.Context) (str
 	return _c
 }
 
+// FastForward provides a mock function for the type Client
+func (_mock *Client) FastForward(ctx context.Context, revision string, branch string) (string, error) {
+	ret := _mock.Called(ctx, revision, branch)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FastForward")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return returnFunc(ctx, revision, branch)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = returnFunc(ctx, revision, branch)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = returnFunc(ctx, revision, branch)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Client_FastForward_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FastForward'
+type Client_FastForward_Call struct {
+	*mock.Call
+}
+
+// FastForward is a helper method to define mock.On call
+//   - ctx context.Context
+//   - revision string
+//   - branch string
+func (_e *Client_Expecter) FastForward(ctx any, revision any, branch any) *Client_FastForward_Call {
+	return &Client_FastForward_Call{Call: _e.mock.On("FastForward", ctx, revision, branch)}
+}
+
+func (_c *Client_FastForward_Call) Run(run func(ctx context.Context, revision string, branch string)) *Client_FastForward_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *Client_FastForward_Call) Return(s string, err error) *Client_FastForward_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *Client_FastForward_Call) RunAndReturn(run func(ctx context.Context, revision string, branch string) (string, error)) *Client_FastForward_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Fetch provides a mock function for the type Client
 func (_mock *Client) Fetch(ctx context.Context, revision string, depth int64) error {
 	ret := _mock.Called(ctx, revision, depth)
@@ -1525,6 +1657,72 @@ func (_c *Client_SetAuthor_Call) RunAndReturn(run func(ctx context.Context, name
 	return _c
 }
 
+// SetSigningKey provides a mock function for the type Client
+func (_mock *Client) SetSigningKey(ctx context.Context, key string) (string, error) {
+	ret := _mock.Called(ctx, key)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetSigningKey")
+	}
+
+	var r0 string
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return returnFunc(ctx, key)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = returnFunc(ctx, key)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Client_SetSigningKey_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetSigningKey'
+type Client_SetSigningKey_Call struct {
+	*mock.Call
+}
+
+// SetSigningKey is a helper method to define mock.On call
+//   - ctx context.Context
+//   - key string
+func (_e *Client_Expecter) SetSigningKey(ctx any, key any) *Client_SetSigningKey_Call {
+	return &Client_SetSigningKey_Call{Call: _e.mock.On("SetSigningKey", ctx, key)}
+}
+
+func (_c *Client_SetSigningKey_Call) Run(run func(ctx context.Context, key string)) *Client_SetSigningKey_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Client_SetSigningKey_Call) Return(s string, err error) *Client_SetSigningKey_Call {
+	_c.Call.Return(s, err)
+	return _c
+}
+
+func (_c *Client_SetSigningKey_Call) RunAndReturn(run func(ctx context.Context, key string) (string, error)) *Client_SetSigningKey_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Submodule provides a mock function for the type Client
 func (_mock *Client) Submodule(ctx context.Context) error {
 	ret := _mock.Called(ctx)
@@ -3,8 +3,10 @@ package helm
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -41,6 +43,9 @@ var (
 	ErrOCINotEnabled = errors.New("could not perform the action when oci is not enabled")
 )
 
+// provenanceFileExtension is appended to the chart archive name by helm to name the provenance file
+const provenanceFileExtension = ".prov"
+
 // userAgentTransport wraps an http.RoundTripper to add User-Agent header to all requests
 type userAgentTransport struct {
 	Transport http.RoundTripper
@@ -63,9 +68,18 @@ type Client interface {
 	ExtractChart(ctx context.Context, chart string, version string, passCredentials bool, manifestMaxExtractedSize int64, disableManifestMaxExtractedSize bool) (string, utilio.Closer, error)
 	GetIndex(ctx context.Context, noCache bool, maxIndexSize int64) (*Index, error)
 	GetTags(ctx context.Context, chart string, noCache bool) ([]string, error)
+	GetProvenance(ctx context.Context, chart string, version string, passCredentials bool) (*Provenance, error)
 	TestHelmOCI(ctx context.Context) (bool, error)
 }
 
+// Provenance holds the provenance file of a chart, see https://helm.sh/docs/topics/provenance/
+type Provenance struct {
+	// ChartDigest is the digest of the cached chart archive, in the sha256:<hex> format used by the provenance files
+	ChartDigest string
+	// Data is the content of the provenance file, a PGP clear-signed document
+	Data []byte
+}
+
 type ClientOpts func(c *nativeHelmChart)
 
 func WithIndexCache(indexCache indexCache) ClientOpts {
@@ -168,9 +182,26 @@ func (c *nativeHelmChart) CleanChartCache(chart string, version string) error {
 	if err := os.RemoveAll(cachePath); err != nil {
 		return fmt.Errorf("error removing chart cache at %s: %w", cachePath, err)
 	}
+	if err := os.RemoveAll(getCachedProvenancePath(cachePath)); err != nil {
+		return fmt.Errorf("error removing chart provenance cache at %s: %w", cachePath, err)
+	}
 	return nil
 }
 
+// fileDigest computes the sha256 digest of the file in the format used by the helm provenance files
+func fileDigest(filePath string) (string, error) {
+	f, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer utilio.Close(f)
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return "sha256:" + hex.EncodeToString(h.Sum(nil)), nil
+}
+
 func untarChart(ctx context.Context, tempDir string, cachedChartPath string, manifestMaxExtractedSize int64, disableManifestMaxExtractedSize bool) error {
 	if disableManifestMaxExtractedSize {
 		cmd := exec.CommandContext(ctx, "tar", "-zxvf", cachedChartPath)
@@ -249,35 +280,10 @@ func (c *nativeHelmChart) ExtractChart(ctx context.Context, chart string, versio
 		}
 		defer func() { _ = os.RemoveAll(tempDest) }()
 
-		if c.enableOci {
-			helmPassword, err := c.creds.GetPassword()
-			if err != nil {
-				return "", nil, fmt.Errorf("failed to get password for helm registry: %w", err)
-			}
-			if helmPassword != "" && c.creds.GetUsername() != "" {
-				_, err = helmCmd.RegistryLogin(ctx, c.repoURL, c.creds, c.plainHTTP)
-				if err != nil {
-					_ = os.RemoveAll(tempDir)
-					return "", nil, fmt.Errorf("error logging into OCI registry: %w", err)
-				}
-
-				defer func() {
-					_, _ = helmCmd.RegistryLogout(c.repoURL, c.creds)
-				}()
-			}
-
-			// 'helm pull' ensures that chart is downloaded into temp directory
-			_, err = helmCmd.PullOCI(c.repoURL, chart, version, tempDest, c.creds, c.plainHTTP)
-			if err != nil {
-				_ = os.RemoveAll(tempDir)
-				return "", nil, fmt.Errorf("error pulling OCI chart: %w", err)
-			}
-		} else {
-			_, err = helmCmd.Fetch(c.repoURL, chart, version, tempDest, c.creds, passCredentials)
-			if err != nil {
-				_ = os.RemoveAll(tempDir)
-				return "", nil, fmt.Errorf("error fetching chart: %w", err)
-			}
+		err = c.pullChart(ctx, helmCmd, chart, version, passCredentials, tempDest, false)
+		if err != nil {
+			_ = os.RemoveAll(tempDir)
+			return "", nil, err
 		}
 
 		// 'helm pull/fetch' file downloads chart into the tgz file and we move that to where we want it
@@ -291,6 +297,11 @@ func (c *nativeHelmChart) ExtractChart(ctx context.Context, chart string, versio
 
 		chartFilePath := filepath.Join(tempDest, infos[0].Name())
 
+		// the provenance file cached for the previous chart archive, if any, does not belong to the new one
+		if err := os.RemoveAll(getCachedProvenancePath(cachedChartPath)); err != nil {
+			return "", nil, fmt.Errorf("error removing cached chart provenance: %w", err)
+		}
+
 		err = os.Rename(chartFilePath, cachedChartPath)
 		if err != nil {
 			return "", nil, fmt.Errorf("error renaming file from %s to %s: %w", chartFilePath, cachedChartPath, err)
@@ -307,6 +318,101 @@ func (c *nativeHelmChart) ExtractChart(ctx context.Context, chart string, versio
 	}), nil
 }
 
+// pullChart downloads the chart archive into the destination directory, together with its provenance file if prov is set.
+func (c *nativeHelmChart) pullChart(ctx context.Context, helmCmd *Cmd, chart string, version string, passCredentials bool, destination string, prov bool) error {
+	if c.enableOci {
+		helmPassword, err := c.creds.GetPassword()
+		if err != nil {
+			return fmt.Errorf("failed to get password for helm registry: %w", err)
+		}
+		if helmPassword != "" && c.creds.GetUsername() != "" {
+			_, err = helmCmd.RegistryLogin(ctx, c.repoURL, c.creds, c.plainHTTP)
+			if err != nil {
+				return fmt.Errorf("error logging into OCI registry: %w", err)
+			}
+
+			defer func() {
+				_, _ = helmCmd.RegistryLogout(c.repoURL, c.creds)
+			}()
+		}
+
+		// 'helm pull' ensures that chart is downloaded into temp directory
+		_, err = helmCmd.PullOCI(c.repoURL, chart, version, destination, c.creds, c.plainHTTP, prov)
+		if err != nil {
+			return fmt.Errorf("error pulling OCI chart: %w", err)
+		}
+		return nil
+	}
+
+	_, err := helmCmd.Fetch(c.repoURL, chart, version, destination, c.creds, passCredentials, prov)
+	if err != nil {
+		return fmt.Errorf("error fetching chart: %w", err)
+	}
+	return nil
+}
+
+// GetProvenance returns the provenance file of a chart previously downloaded by ExtractChart, together with the digest
+// of the cached chart archive. The provenance file is downloaded on the first call, and cached alongside the chart.
+func (c *nativeHelmChart) GetProvenance(ctx context.Context, chart string, version string, passCredentials bool) (*Provenance, error) {
+	cachedChartPath, err := c.getCachedChartPath(chart, version)
+	if err != nil {
+		return nil, fmt.Errorf("error getting cached chart path: %w", err)
+	}
+
+	c.repoLock.Lock(cachedChartPath)
+	defer c.repoLock.Unlock(cachedChartPath)
+
+	chartDigest, err := fileDigest(cachedChartPath)
+	if err != nil {
+		return nil, fmt.Errorf("error computing digest of cached chart: %w", err)
+	}
+
+	cachedProvenancePath := getCachedProvenancePath(cachedChartPath)
+	exists, err := fileExist(cachedProvenancePath)
+	if err != nil {
+		return nil, fmt.Errorf("error checking existence of cached chart provenance: %w", err)
+	}
+
+	if !exists {
+		helmCmd, err := NewCmdWithVersion("", c.enableOci, c.proxy, c.noProxy)
+		if err != nil {
+			return nil, fmt.Errorf("error creating Helm command: %w", err)
+		}
+		defer helmCmd.Close()
+
+		tempDest, err := files.CreateTempDir(os.TempDir())
+		if err != nil {
+			return nil, fmt.Errorf("error creating temporary destination directory: %w", err)
+		}
+		defer func() { _ = os.RemoveAll(tempDest) }()
+
+		// helm only downloads the provenance file together with the chart archive, the archive is thrown away
+		err = c.pullChart(ctx, helmCmd, chart, version, passCredentials, tempDest, true)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching chart provenance: %w", err)
+		}
+
+		provenanceFiles, err := filepath.Glob(filepath.Join(tempDest, "*"+provenanceFileExtension))
+		if err != nil {
+			return nil, fmt.Errorf("error looking up provenance file in %s: %w", tempDest, err)
+		}
+		if len(provenanceFiles) != 1 {
+			return nil, fmt.Errorf("expected 1 provenance file, found %v", len(provenanceFiles))
+		}
+
+		err = os.Rename(provenanceFiles[0], cachedProvenancePath)
+		if err != nil {
+			return nil, fmt.Errorf("error renaming file from %s to %s: %w", provenanceFiles[0], cachedProvenancePath, err)
+		}
+	}
+
+	data, err := os.ReadFile(cachedProvenancePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading cached chart provenance: %w", err)
+	}
+	return &Provenance{ChartDigest: chartDigest, Data: data}, nil
+}
+
 func (c *nativeHelmChart) GetIndex(ctx context.Context, noCache bool, maxIndexSize int64) (*Index, error) {
 	indexLock.Lock(c.repoURL)
 	defer indexLock.Unlock(c.repoURL)
@@ -472,6 +578,10 @@ func (c *nativeHelmChart) getCachedChartPath(chart string, version string) (stri
 	return c.chartCachePaths.GetPath(string(keyData))
 }
 
+func getCachedProvenancePath(cachedChartPath string) string {
+	return cachedChartPath + provenanceFileExtension
+}
+
 // Ensures that given OCI registries URL does not have protocol
 func IsHelmOciRepo(repoURL string) bool {
 	if repoURL == "" {
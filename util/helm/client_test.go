@@ -4,6 +4,7 @@ import (
 	"archive/tar"
 	"bytes"
 	"compress/gzip"
+	"crypto/sha256"
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
@@ -870,6 +871,84 @@ entries:
 	requireChartRequests(2)
 }
 
+func Test_nativeHelmChart_GetProvenance(t *testing.T) {
+	const (
+		chartName    = "test-chart"
+		chartVersion = "1.0.0"
+		provenance   = "-----BEGIN PGP SIGNED MESSAGE-----\n"
+	)
+
+	var (
+		mu                 sync.Mutex
+		provenanceRequests int
+		chartArchive       = testHelmChartArchive(t, chartName, chartVersion, "foo: bar\n")
+		server             *httptest.Server
+	)
+
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/index.yaml":
+			w.Header().Set("Content-Type", "application/x-yaml")
+			_, _ = fmt.Fprintf(w, `apiVersion: v1
+entries:
+  %[1]s:
+  - apiVersion: v2
+    name: %[1]s
+    version: %[2]s
+    urls:
+    - %[3]s/%[1]s-%[2]s.tgz
+`, chartName, chartVersion, server.URL)
+		case "/" + chartName + "-" + chartVersion + ".tgz":
+			w.Header().Set("Content-Type", "application/gzip")
+			_, _ = w.Write(chartArchive)
+		case "/" + chartName + "-" + chartVersion + ".tgz.prov":
+			mu.Lock()
+			provenanceRequests++
+			mu.Unlock()
+			_, _ = w.Write([]byte(provenance))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	client := NewClient(server.URL, HelmCreds{}, false, "", "", WithChartPaths(utilio.NewRandomizedTempPaths(t.TempDir())))
+	requireProvenanceRequests := func(expected int) {
+		t.Helper()
+		mu.Lock()
+		defer mu.Unlock()
+		require.Equal(t, expected, provenanceRequests)
+	}
+
+	// The chart needs to be extracted first
+	_, err := client.GetProvenance(t.Context(), chartName, chartVersion, false)
+	require.ErrorContains(t, err, "error computing digest of cached chart")
+
+	_, closer, err := client.ExtractChart(t.Context(), chartName, chartVersion, false, math.MaxInt64, false)
+	require.NoError(t, err)
+	utilio.Close(closer)
+
+	prov, err := client.GetProvenance(t.Context(), chartName, chartVersion, false)
+	require.NoError(t, err)
+	assert.Equal(t, fmt.Sprintf("sha256:%x", sha256.Sum256(chartArchive)), prov.ChartDigest)
+	assert.Equal(t, provenance, string(prov.Data))
+	requireProvenanceRequests(1)
+
+	// The provenance is cached with the chart
+	_, err = client.GetProvenance(t.Context(), chartName, chartVersion, false)
+	require.NoError(t, err)
+	requireProvenanceRequests(1)
+
+	// And it is cleaned with the chart
+	require.NoError(t, client.CleanChartCache(chartName, chartVersion))
+	_, closer, err = client.ExtractChart(t.Context(), chartName, chartVersion, false, math.MaxInt64, false)
+	require.NoError(t, err)
+	utilio.Close(closer)
+	_, err = client.GetProvenance(t.Context(), chartName, chartVersion, false)
+	require.NoError(t, err)
+	requireProvenanceRequests(2)
+}
+
 func testHelmChartArchive(t *testing.T, chartName, chartVersion, values string) []byte {
 	t.Helper()
 
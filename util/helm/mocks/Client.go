@@ -268,6 +268,86 @@ func (_c *Client_GetIndex_Call) RunAndReturn(run func(ctx context.Context, noCac
 	return _c
 }
 
+// GetProvenance provides a mock function for the type Client
+func (_mock *Client) GetProvenance(ctx context.Context, chart string, version string, passCredentials bool) (*helm.Provenance, error) {
+	ret := _mock.Called(ctx, chart, version, passCredentials)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProvenance")
+	}
+
+	var r0 *helm.Provenance
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, bool) (*helm.Provenance, error)); ok {
+		return returnFunc(ctx, chart, version, passCredentials)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string, string, bool) *helm.Provenance); ok {
+		r0 = returnFunc(ctx, chart, version, passCredentials)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*helm.Provenance)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string, string, bool) error); ok {
+		r1 = returnFunc(ctx, chart, version, passCredentials)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Client_GetProvenance_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProvenance'
+type Client_GetProvenance_Call struct {
+	*mock.Call
+}
+
+// GetProvenance is a helper method to define mock.On call
+//   - ctx context.Context
+//   - chart string
+//   - version string
+//   - passCredentials bool
+func (_e *Client_Expecter) GetProvenance(ctx any, chart any, version any, passCredentials any) *Client_GetProvenance_Call {
+	return &Client_GetProvenance_Call{Call: _e.mock.On("GetProvenance", ctx, chart, version, passCredentials)}
+}
+
+func (_c *Client_GetProvenance_Call) Run(run func(ctx context.Context, chart string, version string, passCredentials bool)) *Client_GetProvenance_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		var arg3 bool
+		if args[3] != nil {
+			arg3 = args[3].(bool)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return _c
+}
+
+func (_c *Client_GetProvenance_Call) Return(provenance *helm.Provenance, err error) *Client_GetProvenance_Call {
+	_c.Call.Return(provenance, err)
+	return _c
+}
+
+func (_c *Client_GetProvenance_Call) RunAndReturn(run func(ctx context.Context, chart string, version string, passCredentials bool) (*helm.Provenance, error)) *Client_GetProvenance_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetTags provides a mock function for the type Client
 func (_mock *Client) GetTags(ctx context.Context, chart string, noCache bool) ([]string, error) {
 	ret := _mock.Called(ctx, chart, noCache)
@@ -24,6 +24,10 @@ type HydratorCommitMetadata struct {
 	// Known Argocd- trailers with valid values are removed, but all other trailers are kept.
 	Body       string                    `json:"body,omitempty"`
 	References []appv1.RevisionReference `json:"references,omitempty"`
+	// Manifests are the names of the manifest files written to the hydrated path. Only set for the hydrated paths.
+	Manifests []string `json:"manifests,omitempty"`
+	// SigningKey is the fingerprint of the key the hydrated commit is signed with. Empty if the commit is not signed.
+	SigningKey string `json:"signingKey,omitempty"`
 }
 
 // GetCommitMetadata takes repo, drySha and commitMetadata and returns a HydratorCommitMetadata which is a
@@ -28,6 +28,13 @@ func NewKubectl() kube.Kubectl {
 	return &kube.KubectlCmd{Tracer: tracer, Log: logger}
 }
 
+// NewNativeKubectl returns a Kubectl whose resource operations send the server-side applies, creates and replaces
+// directly to the Kubernetes API with the dynamic client instead of running them with kubectl. The requests are sent
+// with the given field manager, or with the field managers of kubectl if it is empty.
+func NewNativeKubectl(fieldManager string) kube.Kubectl {
+	return &kube.KubectlCmd{Tracer: tracer, Log: logger, NativeResourceOperations: true, FieldManager: fieldManager}
+}
+
 func ManageServerSideDiffDryRuns(config *rest.Config, onKubectlRun kube.OnKubectlRunFunc) (diff.KubeApplier, func(), error) {
 	k := &kube.KubectlCmd{
 		Log:          logger,
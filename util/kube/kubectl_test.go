@@ -0,0 +1,82 @@
+package kube
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/rest"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+
+	"github.com/argoproj/argo-cd/v3/common"
+)
+
+// newFakeAPIServer returns an API server which serves ConfigMaps and records the field managers of the creates
+func newFakeAPIServer(t *testing.T) (*rest.Config, func() []string) {
+	t.Helper()
+	var lock sync.Mutex
+	var fieldManagers []string
+	writeJSON := func(w http.ResponseWriter, status int, v any) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_ = json.NewEncoder(w).Encode(v)
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, metav1.APIVersions{Versions: []string{"v1"}})
+	})
+	mux.HandleFunc("/apis", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, metav1.APIGroupList{})
+	})
+	mux.HandleFunc("/api/v1", func(w http.ResponseWriter, _ *http.Request) {
+		writeJSON(w, http.StatusOK, metav1.APIResourceList{GroupVersion: "v1", APIResources: []metav1.APIResource{
+			{Name: "configmaps", Namespaced: true, Kind: "ConfigMap", Verbs: metav1.Verbs{"create", "get", "patch", "update"}},
+		}})
+	})
+	mux.HandleFunc("/api/v1/namespaces/default/configmaps", func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		fieldManagers = append(fieldManagers, r.URL.Query().Get("fieldManager"))
+		lock.Unlock()
+		obj := map[string]any{}
+		_ = json.NewDecoder(r.Body).Decode(&obj)
+		writeJSON(w, http.StatusCreated, obj)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return &rest.Config{Host: server.URL}, func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+		return fieldManagers
+	}
+}
+
+func TestNewNativeKubectl_FieldManager(t *testing.T) {
+	obj := &unstructured.Unstructured{Object: map[string]any{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]any{"name": "my-map", "namespace": "default"},
+	}}
+
+	for _, tc := range []struct {
+		fieldManager string
+		expected     string
+	}{
+		{fieldManager: common.ArgoCDSSAManager, expected: common.ArgoCDSSAManager},
+		// the field manager of kubectl is used if none is configured
+		{fieldManager: "", expected: "kubectl-create"},
+	} {
+		config, getFieldManagers := newFakeAPIServer(t)
+		resourceOps, cleanup, err := NewNativeKubectl(tc.fieldManager).ManageResources(config)
+		require.NoError(t, err)
+		_, err = resourceOps.CreateResource(t.Context(), obj, cmdutil.DryRunNone, false)
+		cleanup()
+		require.NoError(t, err)
+		assert.Equal(t, []string{tc.expected}, getFieldManagers())
+	}
+}
@@ -26,6 +26,7 @@ import (
 	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
 	appv1 "github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/resource_customizations"
+	argocel "github.com/argoproj/argo-cd/v3/util/cel"
 	argoglob "github.com/argoproj/argo-cd/v3/util/glob"
 )
 
@@ -99,6 +100,9 @@ func (c *compiledScriptCache) add(key string, proto *lua.FunctionProto) {
 type ResourceHealthOverrides map[string]appv1.ResourceOverride
 
 func (overrides ResourceHealthOverrides) GetResourceHealth(obj *unstructured.Unstructured) (*health.HealthStatus, error) {
+	if expression := getHealthOverrideCEL(overrides, obj.GroupVersionKind()); expression != "" {
+		return argocel.ExecuteHealth(obj, expression)
+	}
 	luaVM := VM{
 		ResourceOverrides: overrides,
 	}
@@ -118,6 +122,20 @@ func (overrides ResourceHealthOverrides) GetResourceHealth(obj *unstructured.Uns
 	return result, nil
 }
 
+// HasHealthCheck returns true if the resources of the given group kind have a CEL or Lua health check, either
+// configured in the overrides or built in.
+func (overrides ResourceHealthOverrides) HasHealthCheck(gk schema.GroupKind) bool {
+	gvk := gk.WithVersion("")
+	if getHealthOverrideCEL(overrides, gvk) != "" {
+		return true
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	script, _, err := VM{ResourceOverrides: overrides}.GetHealthScript(obj)
+	// the resources are assumed to have a health check if the scripts cannot be read
+	return err != nil || script != ""
+}
+
 // VM Defines a struct that implements the luaVM
 type VM struct {
 	ResourceOverrides map[string]appv1.ResourceOverride
@@ -504,11 +522,19 @@ func (vm VM) GetResourceActionDiscovery(obj *unstructured.Unstructured) ([]strin
 		if err != nil {
 			return nil, err
 		}
-		// Append the action discovery Lua script if built-in actions are to be included
-		if !actions.MergeBuiltinActions {
-			return []string{actions.ActionDiscoveryLua}, nil
+		// The action discovery CEL expression takes precedence over the Lua script, and is evaluated by
+		// DiscoverResourceActions
+		if actions.ActionDiscoveryCEL != "" {
+			if !actions.MergeBuiltinActions {
+				return nil, nil
+			}
+		} else {
+			// Append the action discovery Lua script if built-in actions are to be included
+			if !actions.MergeBuiltinActions {
+				return []string{actions.ActionDiscoveryLua}, nil
+			}
+			discoveryScripts = append(discoveryScripts, actions.ActionDiscoveryLua)
 		}
-		discoveryScripts = append(discoveryScripts, actions.ActionDiscoveryLua)
 	}
 
 	// Fetch predefined Lua scripts
@@ -527,6 +553,57 @@ func (vm VM) GetResourceActionDiscovery(obj *unstructured.Unstructured) ([]strin
 	return discoveryScripts, nil
 }
 
+// DiscoverResourceActions returns the actions available for the resource, discovered by the CEL expression and the Lua
+// scripts configured for it. The actions discovered by the CEL expression take precedence over the ones discovered by
+// the Lua scripts.
+func (vm VM) DiscoverResourceActions(obj *unstructured.Unstructured) ([]appv1.ResourceAction, error) {
+	availableActions := []appv1.ResourceAction{}
+	override, ok := vm.ResourceOverrides[GetConfigMapKey(obj.GroupVersionKind())]
+	if ok && override.Actions != "" {
+		actions, err := override.GetActions()
+		if err != nil {
+			return nil, err
+		}
+		if actions.ActionDiscoveryCEL != "" {
+			availableActions, err = argocel.ExecuteResourceActionDiscovery(obj, actions.ActionDiscoveryCEL)
+			if err != nil {
+				return nil, fmt.Errorf("error executing CEL discovery expression: %w", err)
+			}
+		}
+	}
+
+	discoveryScripts, err := vm.GetResourceActionDiscovery(obj)
+	if err != nil {
+		return nil, fmt.Errorf("error getting Lua discovery script: %w", err)
+	}
+	if len(discoveryScripts) == 0 {
+		return availableActions, nil
+	}
+	luaActions, err := vm.ExecuteResourceActionDiscovery(obj, discoveryScripts)
+	if err != nil {
+		return nil, fmt.Errorf("error executing Lua discovery script: %w", err)
+	}
+	for _, action := range luaActions {
+		if !slices.ContainsFunc(availableActions, func(a appv1.ResourceAction) bool { return a.Name == action.Name }) {
+			availableActions = append(availableActions, action)
+		}
+	}
+	return availableActions, nil
+}
+
+// ExecuteResourceActionDefinition runs the action on the resource, with its CEL expression if it has one or with its
+// Lua script otherwise
+func (vm VM) ExecuteResourceActionDefinition(obj *unstructured.Unstructured, action appv1.ResourceActionDefinition, resourceActionParameters []*applicationpkg.ResourceActionParameters) ([]ImpactedResource, error) {
+	if action.ActionCEL == "" {
+		return vm.ExecuteResourceAction(obj, action.ActionLua, resourceActionParameters)
+	}
+	newObj, err := argocel.ExecuteResourceAction(obj, action.ActionCEL, resourceActionParameters)
+	if err != nil {
+		return nil, err
+	}
+	return []ImpactedResource{{UnstructuredObj: newObj, K8SOperation: PatchOperation}}, nil
+}
+
 // GetResourceAction attempts to read lua script from config and then filesystem for that resource
 func (vm VM) GetResourceAction(obj *unstructured.Unstructured, actionName string) (appv1.ResourceActionDefinition, error) {
 	key := GetConfigMapKey(obj.GroupVersionKind())
@@ -576,6 +653,22 @@ func getWildcardHealthOverrideLua(overrides map[string]appv1.ResourceOverride, g
 	return "", false
 }
 
+// getHealthOverrideCEL returns the health CEL expression configured for the GVK, either for the GVK itself or for the
+// first encountered wildcard which matches it. A health Lua script configured for the GVK itself takes precedence over
+// the wildcard expressions.
+func getHealthOverrideCEL(overrides map[string]appv1.ResourceOverride, gvk schema.GroupVersionKind) string {
+	key := GetConfigMapKey(gvk)
+	if override, ok := overrides[key]; ok && (override.HealthCEL != "" || override.HealthLua != "") {
+		return override.HealthCEL
+	}
+	for wildcard, override := range overrides {
+		if override.HealthCEL != "" && argoglob.Match(wildcard, key) {
+			return override.HealthCEL
+		}
+	}
+	return ""
+}
+
 func (vm VM) getPredefinedLuaScripts(objKey string, scriptFile string) (string, error) {
 	data, err := resource_customizations.Embedded.ReadFile(filepath.Join(objKey, scriptFile))
 	if err != nil {
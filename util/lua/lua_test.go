@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 	lua "github.com/yuin/gopher-lua"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/yaml"
 
 	applicationpkg "github.com/argoproj/argo-cd/v3/pkg/apiclient/application"
@@ -288,6 +289,20 @@ func TestGetHealthScriptNoPredefined(t *testing.T) {
 	assert.Empty(t, script)
 }
 
+func TestResourceHealthOverrides_HasHealthCheck(t *testing.T) {
+	t.Parallel()
+	overrides := ResourceHealthOverrides{
+		"example.com/Lua":   {HealthLua: "return {}"},
+		"example.com/CEL":   {HealthCEL: "{'status': 'Healthy'}"},
+		"example.com/Other": {IgnoreDifferences: appv1.OverrideIgnoreDiff{JSONPointers: []string{"/spec"}}},
+	}
+	assert.True(t, overrides.HasHealthCheck(schema.GroupKind{Group: "example.com", Kind: "Lua"}))
+	assert.True(t, overrides.HasHealthCheck(schema.GroupKind{Group: "example.com", Kind: "CEL"}))
+	assert.True(t, overrides.HasHealthCheck(schema.GroupKind{Group: "argoproj.io", Kind: "Rollout"}))
+	assert.False(t, overrides.HasHealthCheck(schema.GroupKind{Group: "example.com", Kind: "Other"}))
+	assert.False(t, overrides.HasHealthCheck(schema.GroupKind{Group: "example.com", Kind: "Unknown"}))
+}
+
 func TestGetResourceActionPredefined(t *testing.T) {
 	t.Parallel()
 	testObj := StrToUnstructured(objJSON)
@@ -1032,6 +1047,50 @@ return hs`
 		assert.Nil(t, status)
 	})
 
+	t.Run("Get resource health with CEL expression", func(t *testing.T) {
+		t.Parallel()
+		testObj := StrToUnstructured(testSA)
+		overrides := ResourceHealthOverrides{
+			"ServiceAccount": appv1.ResourceOverride{
+				HealthLua: script,
+				HealthCEL: `{"status": "Degraded", "message": "service account " + obj.metadata.name}`,
+			},
+		}
+		status, err := overrides.GetResourceHealth(testObj)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusDegraded, Message: "service account test"}, status)
+	})
+
+	t.Run("Get resource health for wildcard override with CEL expression", func(t *testing.T) {
+		t.Parallel()
+		testObj := StrToUnstructured(ec2AWSCrossplaneObjJSON)
+		overrides := ResourceHealthOverrides{
+			"*.aws.crossplane.io/*": appv1.ResourceOverride{
+				HealthCEL: `{"status": "Healthy", "message": obj.spec.forProvider.region}`,
+			},
+		}
+		status, err := overrides.GetResourceHealth(testObj)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusHealthy, Message: "us-west-2"}, status)
+	})
+
+	t.Run("Lua script of the GVK takes precedence over wildcard CEL expression", func(t *testing.T) {
+		t.Parallel()
+		testObj := StrToUnstructured(testSA)
+		overrides := ResourceHealthOverrides{
+			"ServiceAccount": appv1.ResourceOverride{
+				HealthLua:   script,
+				UseOpenLibs: true,
+			},
+			"*": appv1.ResourceOverride{
+				HealthCEL: `{"status": "Degraded"}`,
+			},
+		}
+		status, err := overrides.GetResourceHealth(testObj)
+		require.NoError(t, err)
+		assert.Equal(t, &health.HealthStatus{Status: health.HealthStatusHealthy, Message: "Standard lib was used"}, status)
+	})
+
 	t.Run("Resource health for wildcard override not found", func(t *testing.T) {
 		t.Parallel()
 		testObj := StrToUnstructured(testSA)
@@ -1096,6 +1155,89 @@ func TestExecuteResourceActionWithParams(t *testing.T) {
 	})
 }
 
+func TestDiscoverResourceActions(t *testing.T) {
+	t.Parallel()
+	testObj := createMockResource("Deployment", "test-deployment", 1)
+	getVM := func(mergeBuiltinActions bool) VM {
+		return VM{
+			ResourceOverrides: map[string]appv1.ResourceOverride{
+				"apps/Deployment": {
+					Actions: string(grpc.MustMarshal(appv1.ResourceActions{
+						ActionDiscoveryCEL:  `{"scale": {"params": [{"name": "replicas"}]}, "restart": {"disabled": true}}`,
+						MergeBuiltinActions: mergeBuiltinActions,
+					})),
+				},
+			},
+		}
+	}
+
+	t.Run("CEL actions only", func(t *testing.T) {
+		t.Parallel()
+		actions, err := getVM(false).DiscoverResourceActions(testObj)
+		require.NoError(t, err)
+		assert.Equal(t, []appv1.ResourceAction{
+			{Name: "restart", Disabled: true},
+			{Name: "scale", Params: []appv1.ResourceActionParam{{Name: "replicas"}}},
+		}, actions)
+	})
+
+	t.Run("CEL actions merged with built-in actions", func(t *testing.T) {
+		t.Parallel()
+		actions, err := getVM(true).DiscoverResourceActions(testObj)
+		require.NoError(t, err)
+		names := make([]string, 0, len(actions))
+		for _, action := range actions {
+			names = append(names, action.Name)
+			if action.Name == "restart" {
+				assert.True(t, action.Disabled, "CEL action should take precedence over the built-in action")
+			}
+		}
+		assert.Subset(t, names, []string{"restart", "scale", "pause", "resume"})
+	})
+
+	t.Run("No actions", func(t *testing.T) {
+		t.Parallel()
+		actions, err := VM{}.DiscoverResourceActions(StrToUnstructured(objWithNoScriptJSON))
+		require.NoError(t, err)
+		assert.Empty(t, actions)
+	})
+}
+
+func TestExecuteResourceActionDefinition(t *testing.T) {
+	t.Parallel()
+	deploymentObj := createMockResource("Deployment", "test-deployment", 1)
+	params := []*applicationpkg.ResourceActionParameters{
+		{
+			Name:  new("replicas"),
+			Value: new("3"),
+		},
+	}
+
+	vm := VM{
+		ResourceOverrides: map[string]appv1.ResourceOverride{
+			"apps/Deployment": {
+				Actions: string(grpc.MustMarshal(appv1.ResourceActions{
+					Definitions: []appv1.ResourceActionDefinition{{
+						Name:      "scale",
+						ActionCEL: `{"spec": {"replicas": int(actionParams.replicas)}}`,
+					}},
+				})),
+			},
+		},
+	}
+	action, err := vm.GetResourceAction(deploymentObj, "scale")
+	require.NoError(t, err)
+
+	impactedResources, err := vm.ExecuteResourceActionDefinition(deploymentObj, action, params)
+	require.NoError(t, err)
+	require.Len(t, impactedResources, 1)
+	assert.Equal(t, PatchOperation, impactedResources[0].K8SOperation)
+	actualReplicas, found, err := unstructured.NestedInt64(impactedResources[0].UnstructuredObj.Object, "spec", "replicas")
+	require.NoError(t, err)
+	assert.True(t, found, "spec.replicas should be found in the modified object")
+	assert.Equal(t, int64(3), actualReplicas, "replicas should be updated to 3")
+}
+
 func createMockResource(kind string, name string, replicas int) *unstructured.Unstructured {
 	return StrToUnstructured(fmt.Sprintf(`
     apiVersion: apps/v1
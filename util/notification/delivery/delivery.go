@@ -0,0 +1,96 @@
+package delivery
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	applicationsv1alpha1 "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/typed/application/v1alpha1"
+)
+
+// HistoryLimit is the number of notification deliveries kept in the status of an application
+const HistoryLimit = 20
+
+var (
+	// ErrNotFound is returned when redelivering a delivery which is not in the history of the application
+	ErrNotFound = errors.New("notification delivery not found")
+	// ErrPending is returned when redelivering a delivery which is still pending
+	ErrPending = errors.New("notification delivery is still pending")
+)
+
+// Add adds the delivery to the history, assigning it the next ID, and truncates the history to HistoryLimit
+func Add(deliveries v1alpha1.NotificationDeliveries, delivery v1alpha1.NotificationDelivery) (v1alpha1.NotificationDeliveries, v1alpha1.NotificationDelivery) {
+	delivery.ID = deliveries.NextID()
+	return append(deliveries, delivery).Trunc(HistoryLimit), delivery
+}
+
+// Redeliver adds a pending delivery of the notification sent by the delivery with the given ID. The notification
+// controller picks up the pending delivery and sends the notification again.
+func Redeliver(deliveries v1alpha1.NotificationDeliveries, id int64, now time.Time) (v1alpha1.NotificationDeliveries, v1alpha1.NotificationDelivery, error) {
+	original := deliveries.Find(id)
+	if original == nil {
+		return nil, v1alpha1.NotificationDelivery{}, fmt.Errorf("%w: %d", ErrNotFound, id)
+	}
+	if original.Phase == v1alpha1.NotificationDeliveryPhasePending {
+		return nil, v1alpha1.NotificationDelivery{}, fmt.Errorf("%w: %d", ErrPending, id)
+	}
+	deliveries, redelivery := Add(deliveries, v1alpha1.NotificationDelivery{
+		Trigger:      original.Trigger,
+		Templates:    slices.Clone(original.Templates),
+		Service:      original.Service,
+		Recipient:    original.Recipient,
+		Phase:        v1alpha1.NotificationDeliveryPhasePending,
+		CreatedAt:    metav1.NewTime(now),
+		RedeliveryOf: id,
+	})
+	return deliveries, redelivery, nil
+}
+
+// NewPatch returns a JSON merge patch replacing the notification deliveries of an application. The patch includes the
+// resource version of the application, so that it fails with a conflict if the application was modified in between.
+func NewPatch(resourceVersion string, deliveries v1alpha1.NotificationDeliveries) ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"metadata": map[string]any{"resourceVersion": resourceVersion},
+		"status":   map[string]any{"notificationDeliveries": deliveries},
+	})
+}
+
+// RedeliverApplication requests the redelivery of a notification sent about the given application
+func RedeliverApplication(ctx context.Context, appIf applicationsv1alpha1.ApplicationInterface, name string, id int64) (*v1alpha1.NotificationDelivery, error) {
+	var redelivery v1alpha1.NotificationDelivery
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		app, err := appIf.Get(ctx, name, metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		var deliveries v1alpha1.NotificationDeliveries
+		deliveries, redelivery, err = Redeliver(app.Status.NotificationDeliveries, id, time.Now())
+		if err != nil {
+			return err
+		}
+		patch, err := NewPatch(app.ResourceVersion, deliveries)
+		if err != nil {
+			return fmt.Errorf("error marshaling notification deliveries patch: %w", err)
+		}
+		app, err = appIf.Patch(ctx, name, types.MergePatchType, patch, metav1.PatchOptions{})
+		if err != nil {
+			return err
+		}
+		if patched := app.Status.NotificationDeliveries.Find(redelivery.ID); patched != nil {
+			redelivery = *patched
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &redelivery, nil
+}
@@ -0,0 +1,96 @@
+package delivery
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	appsfake "github.com/argoproj/argo-cd/v3/pkg/client/clientset/versioned/fake"
+)
+
+func TestAdd(t *testing.T) {
+	var deliveries v1alpha1.NotificationDeliveries
+	for range HistoryLimit + 5 {
+		deliveries, _ = Add(deliveries, v1alpha1.NotificationDelivery{Phase: v1alpha1.NotificationDeliveryPhaseSucceeded})
+	}
+	deliveries, added := Add(deliveries, v1alpha1.NotificationDelivery{Service: "slack"})
+	assert.Equal(t, int64(HistoryLimit+5), added.ID)
+	assert.Len(t, deliveries, HistoryLimit)
+	assert.Equal(t, added, deliveries[HistoryLimit-1])
+}
+
+func TestRedeliver(t *testing.T) {
+	now := time.Now()
+	deliveries := v1alpha1.NotificationDeliveries{{
+		ID:        3,
+		Trigger:   "on-sync-failed",
+		Templates: []string{"app-sync-failed"},
+		Service:   "slack",
+		Recipient: "alerts",
+		Phase:     v1alpha1.NotificationDeliveryPhaseFailed,
+		Message:   "slack is down",
+		Attempts:  5,
+	}, {
+		ID:    4,
+		Phase: v1alpha1.NotificationDeliveryPhasePending,
+	}}
+
+	t.Run("Failed", func(t *testing.T) {
+		updated, redelivery, err := Redeliver(deliveries, 3, now)
+		require.NoError(t, err)
+		assert.Equal(t, v1alpha1.NotificationDelivery{
+			ID:           5,
+			Trigger:      "on-sync-failed",
+			Templates:    []string{"app-sync-failed"},
+			Service:      "slack",
+			Recipient:    "alerts",
+			Phase:        v1alpha1.NotificationDeliveryPhasePending,
+			CreatedAt:    metav1.NewTime(now),
+			RedeliveryOf: 3,
+		}, redelivery)
+		assert.Len(t, updated, 3)
+		// the original delivery is kept as is
+		assert.Equal(t, deliveries[0], updated[0])
+	})
+
+	t.Run("Pending", func(t *testing.T) {
+		_, _, err := Redeliver(deliveries, 4, now)
+		require.ErrorIs(t, err, ErrPending)
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		_, _, err := Redeliver(deliveries, 1, now)
+		require.ErrorIs(t, err, ErrNotFound)
+	})
+}
+
+func TestNewPatch(t *testing.T) {
+	patch, err := NewPatch("123", v1alpha1.NotificationDeliveries{{ID: 1, Service: "slack", Phase: v1alpha1.NotificationDeliveryPhaseSucceeded}})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{
+		"metadata": {"resourceVersion": "123"},
+		"status": {"notificationDeliveries": [{"id": 1, "service": "slack", "phase": "Succeeded", "createdAt": null}]}
+	}`, string(patch))
+}
+
+func TestRedeliverApplication(t *testing.T) {
+	app := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+		Status: v1alpha1.ApplicationStatus{
+			NotificationDeliveries: v1alpha1.NotificationDeliveries{{ID: 0, Service: "webhook", Phase: v1alpha1.NotificationDeliveryPhaseSucceeded}},
+		},
+	}
+	appIf := appsfake.NewSimpleClientset(app).ArgoprojV1alpha1().Applications("argocd")
+
+	redelivery, err := RedeliverApplication(t.Context(), appIf, "guestbook", 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), redelivery.ID)
+
+	updated, err := appIf.Get(t.Context(), "guestbook", metav1.GetOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, v1alpha1.NotificationDeliveries{app.Status.NotificationDeliveries[0], *redelivery}, updated.Status.NotificationDeliveries)
+}
@@ -34,7 +34,9 @@ import (
 	"github.com/argoproj/argo-cd/v3/util/proxy"
 
 	"oras.land/oras-go/v2"
+	"oras.land/oras-go/v2/content"
 	"oras.land/oras-go/v2/content/file"
+	"oras.land/oras-go/v2/errdef"
 	"oras.land/oras-go/v2/registry/remote"
 	"oras.land/oras-go/v2/registry/remote/auth"
 )
@@ -47,6 +49,11 @@ var (
 const (
 	helmOCIConfigType = "application/vnd.cncf.helm.config.v1+json"
 	helmOCILayerType  = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+
+	cosignSimpleSigningMediaType = "application/vnd.dev.cosign.simplesigning.v1+json"
+	cosignSignatureAnnotation    = "dev.cosignproject.cosign/signature"
+	// cosignMaxPayloadSize guards against reading unreasonably large signature payloads
+	cosignMaxPayloadSize = 1024 * 1024
 )
 
 var _ Client = &nativeOCIClient{}
@@ -79,6 +86,18 @@ type Client interface {
 
 	// GetTags retrieves the list of tags for the repository.
 	GetTags(ctx context.Context, noCache bool) ([]string, error)
+
+	// Signatures retrieves the cosign signatures attached to the given digest, following the cosign convention of
+	// storing them under the sha256-<hash>.sig tag. An empty list is returned when the digest has no signatures.
+	Signatures(ctx context.Context, digest string) ([]Signature, error)
+}
+
+// Signature is a cosign signature attached to an OCI artifact.
+type Signature struct {
+	// Payload is the signed simple signing JSON document, which references the signed digest
+	Payload []byte
+	// Signature is the base64 encoded signature of the Payload
+	Signature string
 }
 
 type Creds struct {
@@ -459,6 +478,52 @@ func (c *nativeOCIClient) getTags(ctx context.Context, noCache bool) ([]string,
 	return tags, nil
 }
 
+// Signatures retrieves the cosign signatures attached to the given digest.
+func (c *nativeOCIClient) Signatures(ctx context.Context, digest string) ([]Signature, error) {
+	tag, err := cosignSignatureTag(digest)
+	if err != nil {
+		return nil, err
+	}
+
+	manifest, err := getOCIManifest(ctx, tag, c.repo)
+	if err != nil {
+		if errors.Is(err, errdef.ErrNotFound) {
+			// The artifact is not signed
+			return []Signature{}, nil
+		}
+		return nil, fmt.Errorf("error getting signatures for digest %s: %w", digest, err)
+	}
+
+	signatures := make([]Signature, 0, len(manifest.Layers))
+	for _, layer := range manifest.Layers {
+		if layer.MediaType != cosignSimpleSigningMediaType {
+			continue
+		}
+		if layer.Size > cosignMaxPayloadSize {
+			return nil, fmt.Errorf("signature payload of digest %s exceeds maximum size of %d bytes", digest, cosignMaxPayloadSize)
+		}
+		payload, err := content.FetchAll(ctx, c.repo, layer)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching signature payload for digest %s: %w", digest, err)
+		}
+		signatures = append(signatures, Signature{
+			Payload:   payload,
+			Signature: layer.Annotations[cosignSignatureAnnotation],
+		})
+	}
+
+	return signatures, nil
+}
+
+// cosignSignatureTag computes the tag under which cosign stores signatures for the given digest.
+func cosignSignatureTag(digest string) (string, error) {
+	algorithm, hash, ok := strings.Cut(digest, ":")
+	if !ok || algorithm == "" || hash == "" {
+		return "", fmt.Errorf("invalid digest %q", digest)
+	}
+	return algorithm + "-" + hash + ".sig", nil
+}
+
 // resolveDigest resolves a digest from a tag.
 func (c *nativeOCIClient) resolveDigest(ctx context.Context, revision string) (string, error) {
 	descriptor, err := c.repo.Resolve(ctx, revision)
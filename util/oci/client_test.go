@@ -817,6 +817,73 @@ func Test_nativeOCIClient_DigestMetadata(t *testing.T) {
 	})
 }
 
+func Test_nativeOCIClient_Signatures(t *testing.T) {
+	pushSignatures := func(t *testing.T, store *memory.Store, digest string, layers ...layerConf) {
+		t.Helper()
+		configBlob := []byte("{}")
+		configDesc := content.NewDescriptorFromBytes(imagev1.MediaTypeImageConfig, configBlob)
+		descs := make([]imagev1.Descriptor, 0, len(layers))
+		for _, layer := range layers {
+			descs = append(descs, layer.desc)
+			require.NoError(t, store.Push(t.Context(), layer.desc, bytes.NewReader(layer.bytes)))
+		}
+		manifestBlob, err := json.Marshal(imagev1.Manifest{
+			Versioned: specs.Versioned{SchemaVersion: 2},
+			Config:    configDesc,
+			Layers:    descs,
+		})
+		require.NoError(t, err)
+		manifestDesc := content.NewDescriptorFromBytes(imagev1.MediaTypeImageManifest, manifestBlob)
+		require.NoError(t, store.Push(t.Context(), configDesc, bytes.NewReader(configBlob)))
+		require.NoError(t, store.Push(t.Context(), manifestDesc, bytes.NewReader(manifestBlob)))
+		tag, err := cosignSignatureTag(digest)
+		require.NoError(t, err)
+		require.NoError(t, store.Tag(t.Context(), manifestDesc, tag))
+	}
+
+	signatureLayer := func(payload, signature string) layerConf {
+		desc := content.NewDescriptorFromBytes(cosignSimpleSigningMediaType, []byte(payload))
+		desc.Annotations = map[string]string{cosignSignatureAnnotation: signature}
+		return layerConf{desc, []byte(payload)}
+	}
+
+	newClient := func(store *memory.Store) Client {
+		return newClientWithLock("repo", globalLock, store, nil, func(_ context.Context) error { return nil }, nil,
+			WithEventHandlers(fakeEventHandlers(t, "repo")))
+	}
+
+	t.Run("returns signature layers only", func(t *testing.T) {
+		store := memory.New()
+		digest := generateManifest(t, store)
+		pushSignatures(t, store, digest,
+			signatureLayer(`{"critical":{}}`, "c2lnMQ=="),
+			layerConf{content.NewDescriptorFromBytes(imagev1.MediaTypeImageLayerGzip, []byte("foo")), []byte("foo")},
+			signatureLayer(`{"optional":{}}`, "c2lnMg=="),
+		)
+
+		signatures, err := newClient(store).Signatures(t.Context(), digest)
+		require.NoError(t, err)
+		assert.Equal(t, []Signature{
+			{Payload: []byte(`{"critical":{}}`), Signature: "c2lnMQ=="},
+			{Payload: []byte(`{"optional":{}}`), Signature: "c2lnMg=="},
+		}, signatures)
+	})
+
+	t.Run("returns no signatures for unsigned artifact", func(t *testing.T) {
+		store := memory.New()
+		digest := generateManifest(t, store)
+
+		signatures, err := newClient(store).Signatures(t.Context(), digest)
+		require.NoError(t, err)
+		assert.Empty(t, signatures)
+	})
+
+	t.Run("rejects invalid digest", func(t *testing.T) {
+		_, err := newClient(memory.New()).Signatures(t.Context(), "foo")
+		require.ErrorContains(t, err, `invalid digest "foo"`)
+	})
+}
+
 func TestNewClientUsesHTTP2(t *testing.T) {
 	t.Parallel()
 	t.Run("should negotiate HTTP/2 when TLS is configured", func(t *testing.T) {
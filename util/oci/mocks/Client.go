@@ -8,6 +8,7 @@ import (
 	"context"
 
 	"github.com/argoproj/argo-cd/v3/util/io"
+	"github.com/argoproj/argo-cd/v3/util/oci"
 	"github.com/opencontainers/image-spec/specs-go/v1"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -372,6 +373,74 @@ func (_c *Client_ResolveRevision_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// Signatures provides a mock function for the type Client
+func (_mock *Client) Signatures(ctx context.Context, digest string) ([]oci.Signature, error) {
+	ret := _mock.Called(ctx, digest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Signatures")
+	}
+
+	var r0 []oci.Signature
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) ([]oci.Signature, error)); ok {
+		return returnFunc(ctx, digest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, string) []oci.Signature); ok {
+		r0 = returnFunc(ctx, digest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]oci.Signature)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = returnFunc(ctx, digest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// Client_Signatures_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Signatures'
+type Client_Signatures_Call struct {
+	*mock.Call
+}
+
+// Signatures is a helper method to define mock.On call
+//   - ctx context.Context
+//   - digest string
+func (_e *Client_Expecter) Signatures(ctx any, digest any) *Client_Signatures_Call {
+	return &Client_Signatures_Call{Call: _e.mock.On("Signatures", ctx, digest)}
+}
+
+func (_c *Client_Signatures_Call) Run(run func(ctx context.Context, digest string)) *Client_Signatures_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 string
+		if args[1] != nil {
+			arg1 = args[1].(string)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *Client_Signatures_Call) Return(signatures []oci.Signature, err error) *Client_Signatures_Call {
+	_c.Call.Return(signatures, err)
+	return _c
+}
+
+func (_c *Client_Signatures_Call) RunAndReturn(run func(ctx context.Context, digest string) ([]oci.Signature, error)) *Client_Signatures_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // TestRepo provides a mock function for the type Client
 func (_mock *Client) TestRepo(ctx context.Context) (bool, error) {
 	ret := _mock.Called(ctx)
@@ -0,0 +1,176 @@
+package policy
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/glob"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const (
+	// objectVariable is the name of the CEL variable holding the evaluated resource
+	objectVariable = "object"
+	// appVariable is the name of the CEL variable holding the application of the evaluated resource
+	appVariable = "app"
+	// costLimit limits the cost of the evaluation of a single expression, so a policy cannot stall the reconciliation
+	costLimit = 1000000
+)
+
+// Violation is a violation of a manifest policy by a resource
+type Violation struct {
+	// Policy is the name of the violated policy
+	Policy string
+	// Action is the action of the violated policy
+	Action settings.ManifestPolicyAction
+	// Resource is the key of the resource violating the policy, or empty if the policy is invalid
+	Resource kube.ResourceKey
+	// Message describes the violation
+	Message string
+}
+
+// compiledExpression is the result of the compilation of a CEL expression
+type compiledExpression struct {
+	program cel.Program
+	err     error
+}
+
+// Evaluator evaluates the manifest policies against the target objects of the applications. The compiled
+// expressions are cached, so the evaluator should be reused across the reconciliations.
+type Evaluator struct {
+	lock     sync.Mutex
+	env      *cel.Env
+	envErr   error
+	programs map[string]compiledExpression
+}
+
+// NewEvaluator returns a new manifest policies evaluator
+func NewEvaluator() *Evaluator {
+	return &Evaluator{programs: make(map[string]compiledExpression)}
+}
+
+// Evaluate returns the violations of the given policies by the target objects of the application deployed to the
+// given cluster. Invalid policies and expressions which cannot be evaluated are reported as violations, so a
+// misconfigured policy is visible to the users of the applications it applies to.
+func (e *Evaluator) Evaluate(policies []settings.ManifestPolicy, app *v1alpha1.Application, cluster string, targetObjs []*unstructured.Unstructured) []Violation {
+	var violations []Violation
+	var appObj map[string]any
+	for _, policy := range policies {
+		if len(policy.Projects) > 0 && !glob.MatchStringInList(policy.Projects, app.Spec.GetProject(), glob.GLOB) {
+			continue
+		}
+		program, err := e.compile(policy.Expression, cel.BoolType)
+		if err != nil {
+			violations = append(violations, Violation{Policy: policy.Name, Action: policy.Action, Message: fmt.Sprintf("invalid expression: %v", err)})
+			continue
+		}
+		var messageProgram cel.Program
+		if policy.MessageExpression != "" {
+			if messageProgram, err = e.compile(policy.MessageExpression, cel.StringType); err != nil {
+				violations = append(violations, Violation{Policy: policy.Name, Action: policy.Action, Message: fmt.Sprintf("invalid message expression: %v", err)})
+				continue
+			}
+		}
+		if appObj == nil {
+			if appObj, err = runtime.DefaultUnstructuredConverter.ToUnstructured(app); err != nil {
+				violations = append(violations, Violation{Policy: policy.Name, Action: policy.Action, Message: fmt.Sprintf("failed to convert application: %v", err)})
+				continue
+			}
+		}
+
+		for _, obj := range targetObjs {
+			if obj == nil {
+				continue
+			}
+			if gvk := obj.GroupVersionKind(); !policy.Match.Match(gvk.Group, gvk.Kind, cluster) {
+				continue
+			}
+			vars := map[string]any{objectVariable: obj.Object, appVariable: appObj}
+			out, _, err := program.Eval(vars)
+			if err != nil {
+				violations = append(violations, Violation{Policy: policy.Name, Action: policy.Action, Resource: kube.GetResourceKey(obj), Message: fmt.Sprintf("failed to evaluate expression: %v", err)})
+				continue
+			}
+			if compliant, ok := out.Value().(bool); ok && compliant {
+				continue
+			}
+			violations = append(violations, Violation{Policy: policy.Name, Action: policy.Action, Resource: kube.GetResourceKey(obj), Message: violationMessage(policy, messageProgram, vars)})
+		}
+	}
+	return violations
+}
+
+// violationMessage returns the message of the violation of the policy, falling back to the static message if the
+// message expression cannot be evaluated
+func violationMessage(policy settings.ManifestPolicy, messageProgram cel.Program, vars map[string]any) string {
+	if messageProgram != nil {
+		if out, _, err := messageProgram.Eval(vars); err == nil {
+			if message, ok := out.Value().(string); ok && message != "" {
+				return message
+			}
+		}
+	}
+	if policy.Message != "" {
+		return policy.Message
+	}
+	return "failed expression: " + policy.Expression
+}
+
+// compile compiles the given CEL expression, which must evaluate to the given type
+func (e *Evaluator) compile(expression string, outputType *cel.Type) (cel.Program, error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	key := outputType.String() + ":" + expression
+	if compiled, ok := e.programs[key]; ok {
+		return compiled.program, compiled.err
+	}
+	if e.env == nil && e.envErr == nil {
+		e.env, e.envErr = cel.NewEnv(
+			cel.Variable(objectVariable, cel.DynType),
+			cel.Variable(appVariable, cel.DynType),
+			ext.Strings(),
+		)
+	}
+	if e.envErr != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", e.envErr)
+	}
+
+	program, err := func() (cel.Program, error) {
+		ast, issues := e.env.Compile(expression)
+		if issues.Err() != nil {
+			return nil, issues.Err()
+		}
+		if !ast.OutputType().IsExactType(outputType) && !ast.OutputType().IsExactType(cel.DynType) {
+			return nil, fmt.Errorf("expression must evaluate to %s, not %s", outputType, ast.OutputType())
+		}
+		return e.env.Program(ast, cel.CostLimit(costLimit))
+	}()
+	e.programs[key] = compiledExpression{program: program, err: err}
+	return program, err
+}
+
+// ViolationConditions returns the application conditions reporting the given violations. The violations of the
+// policies which deny the sync are reported as errors, and the others as warnings.
+func ViolationConditions(violations []Violation, now *metav1.Time) []v1alpha1.ApplicationCondition {
+	conditions := make([]v1alpha1.ApplicationCondition, 0, len(violations))
+	for _, violation := range violations {
+		conditionType := v1alpha1.ApplicationConditionPolicyViolationWarning
+		if violation.Action == settings.ManifestPolicyActionDeny {
+			conditionType = v1alpha1.ApplicationConditionPolicyViolationError
+		}
+		message := fmt.Sprintf("Policy %s is invalid: %s", violation.Policy, violation.Message)
+		if violation.Resource.Kind != "" {
+			message = fmt.Sprintf("Resource %s/%s %s violates policy %s: %s", violation.Resource.Group, violation.Resource.Kind, violation.Resource.Name, violation.Policy, violation.Message)
+		}
+		conditions = append(conditions, v1alpha1.ApplicationCondition{Type: conditionType, Message: message, LastTransitionTime: now})
+	}
+	return conditions
+}
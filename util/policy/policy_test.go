@@ -0,0 +1,165 @@
+package policy
+
+import (
+	"os"
+	"testing"
+
+	"github.com/argoproj/argo-cd/gitops-engine/v3/pkg/utils/kube"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/test"
+	"github.com/argoproj/argo-cd/v3/util/settings"
+)
+
+const policyDeployment = `
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: guestbook
+  namespace: default
+  labels:
+    team: frontend
+spec:
+  template:
+    spec:
+      containers:
+      - name: guestbook
+        image: guestbook:latest
+      - name: sidecar
+        image: sidecar:v1
+`
+
+const policyConfigMap = `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: guestbook
+  namespace: default
+`
+
+var noLatestTagPolicy = settings.ManifestPolicy{
+	Name:              "no-latest-tag",
+	Match:             settings.FilteredResource{APIGroups: []string{"apps"}, Kinds: []string{"Deployment"}},
+	Expression:        `object.spec.template.spec.containers.all(c, !c.image.endsWith(':latest'))`,
+	MessageExpression: `'images must not use the latest tag: ' + object.spec.template.spec.containers.filter(c, c.image.endsWith(':latest')).map(c, c.name).join(', ')`,
+	Action:            settings.ManifestPolicyActionDeny,
+}
+
+var requiredLabelsPolicy = settings.ManifestPolicy{
+	Name:       "required-labels",
+	Expression: `has(object.metadata.labels) && 'team' in object.metadata.labels`,
+	Message:    "the team label is required",
+	Action:     settings.ManifestPolicyActionWarn,
+}
+
+func newPolicyApp(project string) *v1alpha1.Application {
+	return &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: "guestbook", Namespace: "argocd"},
+		Spec:       v1alpha1.ApplicationSpec{Project: project},
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	deployment := test.YamlToUnstructured(policyDeployment)
+	configMap := test.YamlToUnstructured(policyConfigMap)
+	targetObjs := []*unstructured.Unstructured{deployment, configMap}
+
+	t.Run("violations are reported for the matching resources", func(t *testing.T) {
+		violations := NewEvaluator().Evaluate([]settings.ManifestPolicy{noLatestTagPolicy, requiredLabelsPolicy}, newPolicyApp("default"), "https://kubernetes.default.svc", targetObjs)
+		assert.Equal(t, []Violation{{
+			Policy:   "no-latest-tag",
+			Action:   settings.ManifestPolicyActionDeny,
+			Resource: kube.GetResourceKey(deployment),
+			Message:  "images must not use the latest tag: guestbook",
+		}, {
+			Policy:   "required-labels",
+			Action:   settings.ManifestPolicyActionWarn,
+			Resource: kube.GetResourceKey(configMap),
+			Message:  "the team label is required",
+		}}, violations)
+	})
+
+	t.Run("policies only apply to the matching projects", func(t *testing.T) {
+		policy := noLatestTagPolicy
+		policy.Projects = []string{"prod-*"}
+		evaluator := NewEvaluator()
+		assert.Empty(t, evaluator.Evaluate([]settings.ManifestPolicy{policy}, newPolicyApp("default"), "", targetObjs))
+		assert.Len(t, evaluator.Evaluate([]settings.ManifestPolicy{policy}, newPolicyApp("prod-frontend"), "", targetObjs), 1)
+	})
+
+	t.Run("the application is available to the expressions", func(t *testing.T) {
+		policy := settings.ManifestPolicy{Name: "same-namespace", Expression: `object.metadata.namespace == app.spec.destination.namespace`}
+		app := newPolicyApp("default")
+		app.Spec.Destination.Namespace = "default"
+		assert.Empty(t, NewEvaluator().Evaluate([]settings.ManifestPolicy{policy}, app, "", targetObjs))
+		app.Spec.Destination.Namespace = "other"
+		violations := NewEvaluator().Evaluate([]settings.ManifestPolicy{policy}, app, "", targetObjs)
+		require.Len(t, violations, 2)
+		assert.Equal(t, "failed expression: "+policy.Expression, violations[0].Message)
+	})
+
+	t.Run("invalid policies are reported as violations", func(t *testing.T) {
+		violations := NewEvaluator().Evaluate([]settings.ManifestPolicy{
+			{Name: "syntax", Expression: `object.metadata.name ==`, Action: settings.ManifestPolicyActionWarn},
+			{Name: "type", Expression: `'not a bool'`, Action: settings.ManifestPolicyActionDeny},
+		}, newPolicyApp("default"), "", targetObjs)
+		require.Len(t, violations, 2)
+		assert.Equal(t, "syntax", violations[0].Policy)
+		assert.Contains(t, violations[0].Message, "invalid expression")
+		assert.Empty(t, violations[0].Resource)
+		assert.Equal(t, "type", violations[1].Policy)
+		assert.Contains(t, violations[1].Message, "expression must evaluate to bool")
+	})
+
+	t.Run("expressions which cannot be evaluated are reported as violations", func(t *testing.T) {
+		policy := settings.ManifestPolicy{Name: "replicas", Expression: `object.spec.replicas > 1`, Action: settings.ManifestPolicyActionDeny}
+		violations := NewEvaluator().Evaluate([]settings.ManifestPolicy{policy}, newPolicyApp("default"), "", []*unstructured.Unstructured{deployment})
+		require.Len(t, violations, 1)
+		assert.Equal(t, kube.GetResourceKey(deployment), violations[0].Resource)
+		assert.Contains(t, violations[0].Message, "failed to evaluate expression: no such key: replicas")
+	})
+}
+
+func TestViolationConditions(t *testing.T) {
+	now := metav1.Now()
+	conditions := ViolationConditions([]Violation{{
+		Policy:   "no-latest-tag",
+		Action:   settings.ManifestPolicyActionDeny,
+		Resource: kube.ResourceKey{Group: "apps", Kind: "Deployment", Namespace: "default", Name: "guestbook"},
+		Message:  "images must not use the latest tag",
+	}, {
+		Policy:  "required-labels",
+		Action:  settings.ManifestPolicyActionWarn,
+		Message: "invalid expression: syntax error",
+	}}, &now)
+	assert.Equal(t, []v1alpha1.ApplicationCondition{{
+		Type:               v1alpha1.ApplicationConditionPolicyViolationError,
+		Message:            "Resource apps/Deployment guestbook violates policy no-latest-tag: images must not use the latest tag",
+		LastTransitionTime: &now,
+	}, {
+		Type:               v1alpha1.ApplicationConditionPolicyViolationWarning,
+		Message:            "Policy required-labels is invalid: invalid expression: syntax error",
+		LastTransitionTime: &now,
+	}}, conditions)
+}
+
+func TestDocumentedPoliciesAreValid(t *testing.T) {
+	var policyCM corev1.ConfigMap
+	data, err := os.ReadFile("../../docs/operator-manual/argocd-policy-cm.yaml")
+	require.NoError(t, err)
+	require.NoError(t, yaml.Unmarshal(data, &policyCM))
+	var policies []settings.ManifestPolicy
+	require.NoError(t, yaml.Unmarshal([]byte(policyCM.Data["policies"]), &policies))
+
+	violations := NewEvaluator().Evaluate(policies, newPolicyApp("prod-frontend"), "", []*unstructured.Unstructured{test.YamlToUnstructured(policyDeployment)})
+	for _, violation := range violations {
+		assert.NotEmpty(t, violation.Resource, violation.Message)
+	}
+	assert.Len(t, violations, 2)
+}
@@ -0,0 +1,85 @@
+package settings
+
+import (
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/common"
+)
+
+const (
+	// manifestPoliciesKey is the key of the manifest policies in the argocd-policy-cm ConfigMap
+	manifestPoliciesKey = "policies"
+)
+
+// ManifestPolicyAction is the action taken when a manifest violates a policy
+type ManifestPolicyAction string
+
+const (
+	// ManifestPolicyActionWarn reports the violations as warnings
+	ManifestPolicyActionWarn ManifestPolicyAction = "warn"
+	// ManifestPolicyActionDeny reports the violations as errors, which prevent the application from being synced
+	ManifestPolicyActionDeny ManifestPolicyAction = "deny"
+)
+
+// ManifestPolicy is a CEL policy which the generated manifests of the applications must comply with
+type ManifestPolicy struct {
+	// Name is the unique name of the policy
+	Name string `json:"name"`
+	// Match selects the resources the policy applies to. The policy applies to all resources if empty.
+	Match FilteredResource `json:"match,omitempty"`
+	// Projects are the glob patterns of the projects the policy applies to. The policy applies to all projects if empty.
+	Projects []string `json:"projects,omitempty"`
+	// Expression is the CEL expression which must evaluate to true for the resources complying with the policy. The
+	// resource is available as the 'object' variable, and the application as the 'app' variable.
+	Expression string `json:"expression"`
+	// Message is the message reported for the resources violating the policy
+	Message string `json:"message,omitempty"`
+	// MessageExpression is a CEL expression evaluating to the message reported for the resources violating the policy.
+	// It takes precedence over Message.
+	MessageExpression string `json:"messageExpression,omitempty"`
+	// Action is the action taken when a resource violates the policy, either warn (default) or deny
+	Action ManifestPolicyAction `json:"action,omitempty"`
+}
+
+// GetManifestPolicies returns the manifest policies configured in the argocd-policy-cm ConfigMap, or no policies if the
+// ConfigMap does not exist
+func (mgr *SettingsManager) GetManifestPolicies() ([]ManifestPolicy, error) {
+	policyCM, err := mgr.GetConfigMapByName(common.ArgoCDPolicyConfigMapName)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error retrieving %s: %w", common.ArgoCDPolicyConfigMapName, err)
+	}
+	value, ok := policyCM.Data[manifestPoliciesKey]
+	if !ok {
+		return nil, nil
+	}
+	var policies []ManifestPolicy
+	if err := yaml.Unmarshal([]byte(value), &policies); err != nil {
+		return nil, fmt.Errorf("error unmarshalling manifest policies: %w", err)
+	}
+	names := make(map[string]bool, len(policies))
+	for i, policy := range policies {
+		switch {
+		case policy.Name == "":
+			return nil, fmt.Errorf("manifest policy %d has no name", i)
+		case names[policy.Name]:
+			return nil, fmt.Errorf("manifest policy %s is defined more than once", policy.Name)
+		case policy.Expression == "":
+			return nil, fmt.Errorf("manifest policy %s has no expression", policy.Name)
+		}
+		switch policy.Action {
+		case "":
+			policies[i].Action = ManifestPolicyActionWarn
+		case ManifestPolicyActionWarn, ManifestPolicyActionDeny:
+		default:
+			return nil, fmt.Errorf("manifest policy %s has an unknown action %s", policy.Name, policy.Action)
+		}
+		names[policy.Name] = true
+	}
+	return policies, nil
+}
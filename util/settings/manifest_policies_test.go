@@ -0,0 +1,104 @@
+package settings
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/common"
+)
+
+func policyConfigMap(data map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ArgoCDPolicyConfigMapName,
+			Namespace: "default",
+			Labels: map[string]string{
+				"app.kubernetes.io/part-of": "argocd",
+			},
+		},
+		Data: data,
+	}
+}
+
+func TestGetManifestPolicies(t *testing.T) {
+	t.Run("no policy ConfigMap", func(t *testing.T) {
+		_, settingsManager := fixtures(t.Context(), nil)
+		policies, err := settingsManager.GetManifestPolicies()
+		require.NoError(t, err)
+		assert.Empty(t, policies)
+	})
+
+	t.Run("policies", func(t *testing.T) {
+		kubeClient, settingsManager := fixtures(t.Context(), nil)
+		_, err := kubeClient.CoreV1().ConfigMaps("default").Create(t.Context(), policyConfigMap(map[string]string{
+			"policies": `
+- name: no-latest-tag
+  match:
+    apiGroups: [apps]
+    kinds: [Deployment]
+  projects: [prod-*]
+  expression: object.spec.template.spec.containers.all(c, !c.image.endsWith(':latest'))
+  action: deny
+- name: required-labels
+  expression: has(object.metadata.labels)
+`,
+		}), metav1.CreateOptions{})
+		require.NoError(t, err)
+		require.NoError(t, settingsManager.ResyncInformers())
+
+		policies, err := settingsManager.GetManifestPolicies()
+		require.NoError(t, err)
+		assert.Equal(t, []ManifestPolicy{{
+			Name:       "no-latest-tag",
+			Match:      FilteredResource{APIGroups: []string{"apps"}, Kinds: []string{"Deployment"}},
+			Projects:   []string{"prod-*"},
+			Expression: `object.spec.template.spec.containers.all(c, !c.image.endsWith(':latest'))`,
+			Action:     ManifestPolicyActionDeny,
+		}, {
+			Name:       "required-labels",
+			Expression: "has(object.metadata.labels)",
+			Action:     ManifestPolicyActionWarn,
+		}}, policies)
+	})
+
+	for name, policies := range map[string]string{
+		"policy without name":           `[{expression: "true"}]`,
+		"policy without expression":     `[{name: foo}]`,
+		"duplicate policy":              `[{name: foo, expression: "true"}, {name: foo, expression: "false"}]`,
+		"policy with an unknown action": `[{name: foo, expression: "true", action: block}]`,
+		"invalid policies":              `foo`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			kubeClient, settingsManager := fixtures(t.Context(), nil)
+			_, err := kubeClient.CoreV1().ConfigMaps("default").Create(t.Context(), policyConfigMap(map[string]string{"policies": policies}), metav1.CreateOptions{})
+			require.NoError(t, err)
+			require.NoError(t, settingsManager.ResyncInformers())
+
+			_, err = settingsManager.GetManifestPolicies()
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestDocumentedPolicyConfigMapIsValid(t *testing.T) {
+	var policyCM *corev1.ConfigMap
+	data, err := os.ReadFile("../../docs/operator-manual/argocd-policy-cm.yaml")
+	require.NoError(t, err)
+	require.NoError(t, yaml.Unmarshal(data, &policyCM))
+	policyCM.Namespace = "default"
+
+	kubeClient, settingsManager := fixtures(t.Context(), nil)
+	_, err = kubeClient.CoreV1().ConfigMaps("default").Create(t.Context(), policyCM, metav1.CreateOptions{})
+	require.NoError(t, err)
+	require.NoError(t, settingsManager.ResyncInformers())
+
+	policies, err := settingsManager.GetManifestPolicies()
+	require.NoError(t, err)
+	assert.Len(t, policies, 3)
+}
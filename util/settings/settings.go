@@ -485,6 +485,8 @@ const (
 	resourceInclusionsKey = "resource.inclusions"
 	// resourceIgnoreResourceUpdatesEnabledKey is the key to a boolean determining whether the resourceIgnoreUpdates feature is enabled
 	resourceIgnoreResourceUpdatesEnabledKey = "resource.ignoreResourceUpdatesEnabled"
+	// resourceRelationalHealthEnabledKey is the key to a boolean determining whether the health of resources considers their related resources
+	resourceRelationalHealthEnabledKey = "resource.relationalHealthEnabled"
 	// resourceSensitiveAnnotationsKey is the key to list of annotations to mask in secret resource
 	resourceSensitiveAnnotationsKey = "resource.sensitive.mask.annotations"
 	// resourceCustomLabelKey is the key to a custom label to show in node info, if present
@@ -1045,6 +1047,21 @@ func (mgr *SettingsManager) GetIsIgnoreResourceUpdatesEnabled() (bool, error) {
 	return strconv.ParseBool(argoCDCM.Data[resourceIgnoreResourceUpdatesEnabledKey])
 }
 
+// GetIsRelationalHealthEnabled returns true if the health of the resources of the applications considers the health of
+// the resources they reference, such as the endpoints of a Service or the backend Services of an Ingress
+func (mgr *SettingsManager) GetIsRelationalHealthEnabled() (bool, error) {
+	argoCDCM, err := mgr.getConfigMap()
+	if err != nil {
+		return false, fmt.Errorf("error retrieving config map: %w", err)
+	}
+
+	if argoCDCM.Data[resourceRelationalHealthEnabledKey] == "" {
+		return false, nil
+	}
+
+	return strconv.ParseBool(argoCDCM.Data[resourceRelationalHealthEnabledKey])
+}
+
 // GetResourceOverrides loads Resource Overrides from argocd-cm ConfigMap
 func (mgr *SettingsManager) GetResourceOverrides() (map[string]v1alpha1.ResourceOverride, error) {
 	argoCDCM, err := mgr.getConfigMap()
@@ -1177,6 +1194,13 @@ func (mgr *SettingsManager) appendResourceOverridesFromSplitKeys(cmData map[stri
 				return err
 			}
 			overrideVal.UseOpenLibs = useOpenLibs
+		case "healthPlugin":
+			healthPlugin := &v1alpha1.HealthPlugin{}
+			err := yaml.Unmarshal([]byte(v), healthPlugin)
+			if err != nil {
+				return err
+			}
+			overrideVal.HealthPlugin = healthPlugin
 		case "actions":
 			overrideVal.Actions = v
 		case "ignoreDifferences":
@@ -1926,6 +1950,36 @@ func (mgr *SettingsManager) SaveGPGPublicKeyData(ctx context.Context, gpgPublicK
 	return mgr.ResyncInformers()
 }
 
+func (mgr *SettingsManager) SaveSSHSignerData(ctx context.Context, sshSigners map[string]string) error {
+	signersCM, err := mgr.GetConfigMapByName(common.ArgoCDSSHSignersConfigMapName)
+	if err != nil {
+		return err
+	}
+
+	signersCM.Data = sshSigners
+	_, err = mgr.clientset.CoreV1().ConfigMaps(mgr.namespace).Update(ctx, signersCM, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	return mgr.ResyncInformers()
+}
+
+func (mgr *SettingsManager) SaveCosignPublicKeyData(ctx context.Context, cosignPublicKeys map[string]string) error {
+	keysCM, err := mgr.GetConfigMapByName(common.ArgoCDCosignKeysConfigMapName)
+	if err != nil {
+		return err
+	}
+
+	keysCM.Data = cosignPublicKeys
+	_, err = mgr.clientset.CoreV1().ConfigMaps(mgr.namespace).Update(ctx, keysCM, metav1.UpdateOptions{})
+	if err != nil {
+		return err
+	}
+
+	return mgr.ResyncInformers()
+}
+
 type SettingsManagerOpts func(mgs *SettingsManager)
 
 func WithRepoOrClusterChangedHandler(handler func()) SettingsManagerOpts {
@@ -302,6 +302,20 @@ func TestGetIsIgnoreResourceUpdatesEnabledFalse(t *testing.T) {
 	assert.False(t, ignoreResourceUpdatesEnabled)
 }
 
+func TestGetIsRelationalHealthEnabled(t *testing.T) {
+	_, settingsManager := fixtures(t.Context(), nil)
+	relationalHealthEnabled, err := settingsManager.GetIsRelationalHealthEnabled()
+	require.NoError(t, err)
+	assert.False(t, relationalHealthEnabled)
+
+	_, settingsManager = fixtures(t.Context(), map[string]string{
+		"resource.relationalHealthEnabled": "true",
+	})
+	relationalHealthEnabled, err = settingsManager.GetIsRelationalHealthEnabled()
+	require.NoError(t, err)
+	assert.True(t, relationalHealthEnabled)
+}
+
 func TestGetResourceOverrides(t *testing.T) {
 	ignoreStatus := v1alpha1.ResourceOverride{IgnoreDifferences: v1alpha1.OverrideIgnoreDiff{
 		JSONPointers: []string{"/status"},
@@ -466,6 +480,8 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
     cert-manager.io/Certificate:
       health.lua: |
         foo
+      health.cel: |
+        bar
     apps/Deployment:
       actions: |
         foo`,
@@ -485,6 +501,7 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
 		assert.True(t, overrides["certmanager.k8s.io/Certificate"].UseOpenLibs)
 		assert.Equal(t, "foo\n", overrides["cert-manager.io/Certificate"].HealthLua)
 		assert.False(t, overrides["cert-manager.io/Certificate"].UseOpenLibs)
+		assert.Equal(t, "bar\n", overrides["cert-manager.io/Certificate"].HealthCEL)
 		assert.Equal(t, "foo", overrides["apps/Deployment"].Actions)
 	})
 
@@ -508,6 +525,8 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
 			"resource.customizations.actions.Deployment":                         "bar",
 			"resource.customizations.health.iam-manager.k8s.io_Iamrole":          "bar",
 			"resource.customizations.health.Iamrole":                             "bar",
+			"resource.customizations.healthPlugin.iam-manager.k8s.io_Iamrole": `name: iam-health
+timeout: 10s`,
 			"resource.customizations.ignoreDifferences.iam-manager.k8s.io_Iamrole": `jsonPointers:
         - bar`,
 			"resource.customizations.ignoreDifferences.apps_Deployment": `jqPathExpressions:
@@ -541,6 +560,9 @@ func TestGetResourceOverrides_with_splitted_keys(t *testing.T) {
 		assert.Equal(t, "bar", overrides["Deployment"].Actions)
 		assert.Equal(t, "bar", overrides["iam-manager.k8s.io/Iamrole"].HealthLua)
 		assert.Equal(t, "bar", overrides["Iamrole"].HealthLua)
+		require.NotNil(t, overrides["iam-manager.k8s.io/Iamrole"].HealthPlugin)
+		assert.Equal(t, "iam-health", overrides["iam-manager.k8s.io/Iamrole"].HealthPlugin.Name)
+		assert.Equal(t, 10*time.Second, overrides["iam-manager.k8s.io/Iamrole"].HealthPlugin.Timeout.Duration)
 		assert.Len(t, overrides["iam-manager.k8s.io/Iamrole"].IgnoreDifferences.JSONPointers, 1)
 		assert.Len(t, overrides["apps/Deployment"].IgnoreDifferences.JQPathExpressions, 1)
 		assert.Equal(t, "bar", overrides["apps/Deployment"].IgnoreDifferences.JQPathExpressions[0])
@@ -0,0 +1,191 @@
+package sourceintegrity
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/oci"
+)
+
+const cosignCheckName = "OCI/COSIGN"
+
+// Names of the keys are the keys of the argocd-cosign-keys-cm ConfigMap
+var cosignKeyNameRegexp = regexp.MustCompile(`^[-._a-zA-Z0-9]+$`)
+
+// simpleSigningPayload is the subset of the cosign simple signing format needed to tie a signature to a digest
+type simpleSigningPayload struct {
+	Critical struct {
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+	} `json:"critical"`
+}
+
+func verifyCosign(ctx context.Context, c *v1alpha1.SourceIntegrityOCIPolicyCosign, ociClient oci.Client, digest string) (*v1alpha1.SourceIntegrityCheckResult, error) {
+	signatures, err := ociClient.Signatures(ctx, digest)
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching cosign signatures: %w", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(c.Keys))
+	for _, name := range c.Keys {
+		key, err := loadCosignPublicKey(name)
+		if err != nil {
+			// Not fatal, the other keys can still produce a valid signature
+			log.Warnf("Failed loading cosign key %q: %v", name, err)
+			continue
+		}
+		keys[name] = key
+	}
+
+	return &v1alpha1.SourceIntegrityCheckResult{Checks: []v1alpha1.SourceIntegrityCheckResultItem{{
+		Name:     cosignCheckName,
+		Problems: describeCosignProblems(c, keys, signatures, digest),
+	}}}, nil
+}
+
+// describeCosignProblems reports why none of the signatures verifies the digest. It returns no problems when at least
+// one signature of the digest is made by one of the allowed keys.
+func describeCosignProblems(c *v1alpha1.SourceIntegrityOCIPolicyCosign, keys map[string]crypto.PublicKey, signatures []oci.Signature, digest string) []string {
+	if len(c.Keys) == 0 {
+		return []string{"no cosign keys configured in the policy"}
+	}
+	if len(signatures) == 0 {
+		return []string{fmt.Sprintf("Failed verifying digest %s: no cosign signatures found", digest)}
+	}
+
+	for _, signature := range signatures {
+		payload := simpleSigningPayload{}
+		if err := json.Unmarshal(signature.Payload, &payload); err != nil {
+			log.Warnf("Failed parsing cosign signature payload of digest %s: %v", digest, err)
+			continue
+		}
+		if payload.Critical.Image.DockerManifestDigest != digest {
+			// Signature moved from a different artifact
+			continue
+		}
+		rawSignature, err := base64.StdEncoding.DecodeString(signature.Signature)
+		if err != nil {
+			log.Warnf("Failed decoding cosign signature of digest %s: %v", digest, err)
+			continue
+		}
+		for _, key := range keys {
+			if verifyCosignSignature(key, signature.Payload, rawSignature) == nil {
+				return nil
+			}
+		}
+	}
+
+	var problems []string
+	for _, name := range c.Keys {
+		if _, ok := keys[name]; !ok {
+			problems = append(problems, fmt.Sprintf("cosign key %q is not configured in the repo-server", name))
+		}
+	}
+	problems = append(problems, fmt.Sprintf(
+		"Failed verifying digest %s: none of %d signature(s) is made by an allowed key (keys=%s)",
+		digest, len(signatures), strings.Join(c.Keys, ", "),
+	))
+	return problems
+}
+
+// verifyCosignSignature checks the signature the same way cosign does for the default signers of the given key type.
+func verifyCosignSignature(key crypto.PublicKey, payload []byte, signature []byte) error {
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		var hash crypto.Hash
+		switch k.Curve {
+		case elliptic.P256():
+			hash = crypto.SHA256
+		case elliptic.P384():
+			hash = crypto.SHA384
+		case elliptic.P521():
+			hash = crypto.SHA512
+		default:
+			return fmt.Errorf("unsupported elliptic curve %s", k.Curve.Params().Name)
+		}
+		h := hash.New()
+		h.Write(payload)
+		if !ecdsa.VerifyASN1(k, h.Sum(nil), signature) {
+			return errors.New("invalid ECDSA signature")
+		}
+		return nil
+	case *rsa.PublicKey:
+		h := crypto.SHA256.New()
+		h.Write(payload)
+		return rsa.VerifyPKCS1v15(k, crypto.SHA256, h.Sum(nil), signature)
+	case ed25519.PublicKey:
+		if !ed25519.Verify(k, payload, signature) {
+			return errors.New("invalid ED25519 signature")
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported public key type %T", key)
+	}
+}
+
+// loadCosignPublicKey reads the PEM encoded cosign public key of given name from the repo-server cosign key directory.
+func loadCosignPublicKey(name string) (crypto.PublicKey, error) {
+	if err := ValidateCosignKeyName(name); err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filepath.Join(common.GetCosignKeysPath(), name))
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseCosignPublicKey(data)
+}
+
+// ValidateCosignKeyName returns an error if the name cannot identify a key in the argocd-cosign-keys-cm ConfigMap
+func ValidateCosignKeyName(name string) error {
+	if !cosignKeyNameRegexp.MatchString(name) || strings.HasPrefix(name, ".") {
+		return fmt.Errorf("invalid cosign key name %q", name)
+	}
+	return nil
+}
+
+// ParseCosignPublicKey parses a PEM encoded PKIX public key as produced by `cosign generate-key-pair`.
+func ParseCosignPublicKey(data []byte) (crypto.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+	if block.Type != "PUBLIC KEY" {
+		return nil, fmt.Errorf("unexpected PEM block type %q", block.Type)
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
+
+// CosignKeyType describes the algorithm of a cosign public key, e.g. ECDSA-P-256 or RSA-2048
+func CosignKeyType(key crypto.PublicKey) string {
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		return "ECDSA-" + k.Curve.Params().Name
+	case *rsa.PublicKey:
+		return fmt.Sprintf("RSA-%d", k.N.BitLen())
+	case ed25519.PublicKey:
+		return "ED25519"
+	default:
+		return fmt.Sprintf("%T", key)
+	}
+}
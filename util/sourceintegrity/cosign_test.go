@@ -0,0 +1,152 @@
+package sourceintegrity
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/oci"
+	ocimocks "github.com/argoproj/argo-cd/v3/util/oci/mocks"
+)
+
+const (
+	cosignRepoURL = "oci://registry.example.com/my-group/my-app"
+	cosignDigest  = "sha256:3b4c5f6a7d8e9f00112233445566778899aabbccddeeff00112233445566778899"
+)
+
+// writeCosignKey generates an ECDSA key pair the same way `cosign generate-key-pair` does, and stores the public key
+// under given name in the cosign key directory.
+func writeCosignKey(t *testing.T, dir string, name string) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	der, err := x509.MarshalPKIXPublicKey(key.Public())
+	require.NoError(t, err)
+	data := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), data, 0o600))
+	return key
+}
+
+func cosignSign(t *testing.T, key *ecdsa.PrivateKey, digest string) oci.Signature {
+	t.Helper()
+	payload := fmt.Appendf(nil, `{"critical":{"identity":{"docker-reference":"registry.example.com/my-group/my-app"},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`, digest)
+	hash := sha256.Sum256(payload)
+	signature, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	require.NoError(t, err)
+	return oci.Signature{Payload: payload, Signature: base64.StdEncoding.EncodeToString(signature)}
+}
+
+func cosignSourceIntegrity(keys ...string) *v1alpha1.SourceIntegrity {
+	return &v1alpha1.SourceIntegrity{OCI: &v1alpha1.SourceIntegrityOCI{Policies: []*v1alpha1.SourceIntegrityOCIPolicy{{
+		Repos:  []v1alpha1.SourceIntegrityOCIPolicyRepo{{URL: "oci://registry.example.com/my-group/*"}},
+		Cosign: &v1alpha1.SourceIntegrityOCIPolicyCosign{Keys: keys},
+	}}}}
+}
+
+func TestVerifyOCICosign(t *testing.T) {
+	keysDir := t.TempDir()
+	t.Setenv(common.EnvCosignDataPath, keysDir)
+	trusted := writeCosignKey(t, keysDir, "trusted")
+	untrusted := writeCosignKey(t, keysDir, "untrusted")
+
+	verifyWith := func(t *testing.T, si *v1alpha1.SourceIntegrity, signatures ...oci.Signature) *v1alpha1.SourceIntegrityCheckResult {
+		t.Helper()
+		ociClient := &ocimocks.Client{}
+		ociClient.EXPECT().Signatures(mock.Anything, cosignDigest).Return(signatures, nil).Maybe()
+		result, err := VerifyOCI(t.Context(), si, ociClient, cosignRepoURL, cosignDigest)
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("valid signature", func(t *testing.T) {
+		result := verifyWith(t, cosignSourceIntegrity("trusted"), cosignSign(t, untrusted, cosignDigest), cosignSign(t, trusted, cosignDigest))
+		require.NoError(t, result.AsError())
+		assert.Equal(t, []string{cosignCheckName}, result.PassedChecks())
+	})
+
+	t.Run("unsigned", func(t *testing.T) {
+		result := verifyWith(t, cosignSourceIntegrity("trusted"))
+		assert.Equal(t, []v1alpha1.SourceIntegrityCheckResultItem{{
+			Name:     cosignCheckName,
+			Problems: []string{"Failed verifying digest " + cosignDigest + ": no cosign signatures found"},
+		}}, result.Checks)
+	})
+
+	t.Run("signed by untrusted key", func(t *testing.T) {
+		result := verifyWith(t, cosignSourceIntegrity("trusted", "missing"), cosignSign(t, untrusted, cosignDigest))
+		assert.Equal(t, []v1alpha1.SourceIntegrityCheckResultItem{{
+			Name: cosignCheckName,
+			Problems: []string{
+				`cosign key "missing" is not configured in the repo-server`,
+				"Failed verifying digest " + cosignDigest + ": none of 1 signature(s) is made by an allowed key (keys=trusted, missing)",
+			},
+		}}, result.Checks)
+	})
+
+	t.Run("signature of a different digest", func(t *testing.T) {
+		other := "sha256:0000000000000000000000000000000000000000000000000000000000000000"
+		signature := cosignSign(t, trusted, other)
+		result := verifyWith(t, cosignSourceIntegrity("trusted"), signature)
+		assert.False(t, result.IsValid())
+	})
+
+	t.Run("no keys", func(t *testing.T) {
+		result := verifyWith(t, cosignSourceIntegrity(), cosignSign(t, trusted, cosignDigest))
+		assert.Equal(t, []v1alpha1.SourceIntegrityCheckResultItem{{
+			Name:     cosignCheckName,
+			Problems: []string{"no cosign keys configured in the policy"},
+		}}, result.Checks)
+	})
+
+	t.Run("repository not matched", func(t *testing.T) {
+		si := cosignSourceIntegrity("trusted")
+		si.OCI.Policies[0].Repos = []v1alpha1.SourceIntegrityOCIPolicyRepo{{URL: "oci://registry.example.com/other-group/*"}}
+		assert.Nil(t, verifyWith(t, si))
+	})
+
+	t.Run("multiple policies matched", func(t *testing.T) {
+		si := cosignSourceIntegrity("trusted")
+		si.OCI.Policies = append(si.OCI.Policies, si.OCI.Policies[0])
+		result, err := VerifyOCI(t.Context(), si, &ocimocks.Client{}, cosignRepoURL, cosignDigest)
+		require.EqualError(t, err, "multiple (2) OCI source integrity policies found for repo URL: "+cosignRepoURL)
+		assert.Nil(t, result)
+	})
+}
+
+func TestLoadCosignPublicKey(t *testing.T) {
+	keysDir := t.TempDir()
+	t.Setenv(common.EnvCosignDataPath, keysDir)
+	key := writeCosignKey(t, keysDir, "my-key")
+
+	loaded, err := loadCosignPublicKey("my-key")
+	require.NoError(t, err)
+	assert.True(t, key.PublicKey.Equal(loaded))
+
+	_, err = loadCosignPublicKey("../my-key")
+	require.EqualError(t, err, `invalid cosign key name "../my-key"`)
+
+	require.NoError(t, os.WriteFile(filepath.Join(keysDir, "garbage"), []byte("foo"), 0o600))
+	_, err = loadCosignPublicKey("garbage")
+	require.EqualError(t, err, "no PEM data found")
+}
+
+func TestHasCriteriaOCI(t *testing.T) {
+	source := v1alpha1.ApplicationSource{RepoURL: cosignRepoURL}
+	assert.True(t, HasCriteria(cosignSourceIntegrity("trusted"), source))
+	assert.False(t, HasCriteria(&v1alpha1.SourceIntegrity{}, source))
+	assert.False(t, HasCriteria(cosignSourceIntegrity("trusted"), v1alpha1.ApplicationSource{RepoURL: "oci://registry.example.com/other-group/my-app"}))
+}
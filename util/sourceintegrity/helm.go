@@ -0,0 +1,205 @@
+package sourceintegrity
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"sigs.k8s.io/yaml"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	executil "github.com/argoproj/argo-cd/v3/util/exec"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	"github.com/argoproj/argo-cd/v3/util/helm"
+)
+
+const helmProvenanceCheckName = "HELM/PROVENANCE"
+
+// Matches the gpg --status-fd lines describing the outcome of a signature verification
+var provenanceStatusRegexp = regexp.MustCompile(`^\[GNUPG:\] (GOODSIG|BADSIG|EXPSIG|EXPKEYSIG|REVKEYSIG|ERRSIG|VALIDSIG|NO_PUBKEY) ([0-9A-Fa-f]+)(.*)$`)
+
+// provenanceFiles is the part of the provenance file body listing the digests of the chart archives it signs
+type provenanceFiles struct {
+	Files map[string]string `json:"files"`
+}
+
+// provenanceSignature is the outcome of verifying the provenance file signature
+type provenanceSignature struct {
+	KeyID  string
+	Result git.GPGVerificationResult
+	// Body is the signed content of the provenance file, only set for good signatures
+	Body []byte
+}
+
+func verifyHelmProvenance(ctx context.Context, p *v1alpha1.SourceIntegrityHelmPolicyProvenance, helmClient helm.Client, chart string, version string, passCredentials bool) (*v1alpha1.SourceIntegrityCheckResult, error) {
+	provenance, err := helmClient.GetProvenance(ctx, chart, version, passCredentials)
+	if err != nil {
+		return nil, fmt.Errorf("failed fetching chart provenance: %w", err)
+	}
+
+	signature, err := verifyClearSignature(ctx, provenance.Data)
+	if err != nil {
+		return nil, fmt.Errorf("failed verifying chart provenance signature: %w", err)
+	}
+
+	return &v1alpha1.SourceIntegrityCheckResult{Checks: []v1alpha1.SourceIntegrityCheckResultItem{{
+		Name:     helmProvenanceCheckName,
+		Problems: describeProvenanceProblems(p, signature, provenance.ChartDigest, chart, version),
+	}}}, nil
+}
+
+// describeProvenanceProblems reports why the provenance does not prove the integrity of the chart archive. It returns
+// no problems when the provenance file is signed by one of the allowed keys, and it lists the digest of the archive.
+func describeProvenanceProblems(p *v1alpha1.SourceIntegrityHelmPolicyProvenance, signature *provenanceSignature, chartDigest string, chart string, version string) []string {
+	if len(p.Keys) == 0 {
+		return []string{"no GnuPG keys configured in the policy"}
+	}
+
+	if signature.Result != git.GPGVerificationResultGood {
+		return []string{fmt.Sprintf(
+			"Failed verifying provenance of chart %s %s: %s (key_id=%s)",
+			chart, version, signature.Result, signature.KeyID,
+		)}
+	}
+
+	allowed := false
+	for _, allowedKey := range p.Keys {
+		allowedKey, err := KeyID(allowedKey)
+		if err != nil {
+			log.Error(err.Error())
+			continue
+		}
+		if allowedKey == signature.KeyID {
+			allowed = true
+			break
+		}
+	}
+	if !allowed {
+		return []string{fmt.Sprintf(
+			"Failed verifying provenance of chart %s %s: signed with unallowed key (key_id=%s)",
+			chart, version, signature.KeyID,
+		)}
+	}
+
+	digests, err := parseProvenanceDigests(signature.Body)
+	if err != nil {
+		return []string{fmt.Sprintf("Failed verifying provenance of chart %s %s: %v", chart, version, err)}
+	}
+	for _, digest := range digests {
+		if digest == chartDigest {
+			return nil
+		}
+	}
+	return []string{fmt.Sprintf(
+		"Failed verifying provenance of chart %s %s: chart archive digest %s is not listed in the provenance file",
+		chart, version, chartDigest,
+	)}
+}
+
+// parseProvenanceDigests returns the archive digests listed in the signed body of a provenance file. The body consists
+// of the chart metadata, and the digests of the files, separated by a YAML document end marker.
+func parseProvenanceDigests(body []byte) ([]string, error) {
+	parts := bytes.SplitN(body, []byte("\n...\n"), 2)
+	if len(parts) != 2 {
+		return nil, errors.New("malformed provenance file, no files section found")
+	}
+	files := provenanceFiles{}
+	if err := yaml.Unmarshal(parts[1], &files); err != nil {
+		return nil, fmt.Errorf("malformed provenance file files section: %w", err)
+	}
+	digests := make([]string, 0, len(files.Files))
+	for _, digest := range files.Files {
+		digests = append(digests, digest)
+	}
+	return digests, nil
+}
+
+// verifyClearSignature verifies the PGP clear-signed document against the keys in the repo-server keyring, and
+// extracts its signed content.
+func verifyClearSignature(ctx context.Context, data []byte) (*provenanceSignature, error) {
+	dir, err := os.MkdirTemp("", "argocd-provenance")
+	if err != nil {
+		return nil, fmt.Errorf("error creating temporary directory: %w", err)
+	}
+	defer func() { _ = os.RemoveAll(dir) }()
+
+	signedPath := filepath.Join(dir, "chart.prov")
+	bodyPath := filepath.Join(dir, "chart.body")
+	if err := os.WriteFile(signedPath, data, 0o600); err != nil {
+		return nil, fmt.Errorf("error writing provenance file: %w", err)
+	}
+
+	cmd := exec.CommandContext(ctx, "gpg", "--no-permission-warning", "--batch", "--status-fd", "1", "--output", bodyPath, "--decrypt", signedPath)
+	cmd.Env = getGPGEnviron()
+	// gpg exits with non-zero code whenever the signature is not good, the status output tells the reason
+	out, _ := executil.Run(cmd)
+
+	signature := parseProvenanceStatus(out)
+	if signature.Result == git.GPGVerificationResultGood {
+		signature.Body, err = os.ReadFile(bodyPath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading signed content of provenance file: %w", err)
+		}
+	}
+	return signature, nil
+}
+
+// parseProvenanceStatus interprets the machine-readable status output of gpg verifying a single signature.
+func parseProvenanceStatus(status string) *provenanceSignature {
+	signature := &provenanceSignature{Result: git.GPGVerificationResultUnsigned}
+	primaryKeyID := ""
+	missingKey := false
+
+	scanner := bufio.NewScanner(strings.NewReader(status))
+	for scanner.Scan() {
+		match := provenanceStatusRegexp.FindStringSubmatch(scanner.Text())
+		if match == nil {
+			continue
+		}
+		keyID := match[2]
+		switch match[1] {
+		case "GOODSIG":
+			signature.Result = git.GPGVerificationResultGood
+		case "BADSIG":
+			signature.Result = git.GPGVerificationResultBad
+		case "EXPSIG":
+			signature.Result = git.GPGVerificationResultExpiredSignature
+		case "EXPKEYSIG":
+			signature.Result = git.GPGVerificationResultExpiredKey
+		case "REVKEYSIG":
+			signature.Result = git.GPGVerificationResultRevokedKey
+		case "ERRSIG":
+			signature.Result = git.GPGVerificationResultBad
+		case "NO_PUBKEY":
+			missingKey = true
+			continue
+		case "VALIDSIG":
+			// The last field is the fingerprint of the primary key, the signature can be made by its subkey
+			fields := strings.Fields(match[3])
+			if len(fields) > 0 {
+				primaryKeyID = fields[len(fields)-1]
+			}
+			continue
+		}
+		signature.KeyID = keyID
+	}
+
+	if missingKey && signature.Result == git.GPGVerificationResultBad {
+		signature.Result = git.GPGVerificationResultMissingKey
+	}
+	if primaryKeyID != "" {
+		signature.KeyID = primaryKeyID
+	}
+	if keyID, err := KeyID(signature.KeyID); err == nil {
+		signature.KeyID = keyID
+	}
+	return signature
+}
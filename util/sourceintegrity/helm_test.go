@@ -0,0 +1,203 @@
+package sourceintegrity
+
+import (
+	"bytes"
+	"os/exec"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	"github.com/argoproj/argo-cd/v3/util/helm"
+	helmmocks "github.com/argoproj/argo-cd/v3/util/helm/mocks"
+)
+
+const (
+	helmRepoURL     = "https://charts.example.com/stable"
+	helmChart       = "my-chart"
+	helmVersion     = "1.2.3"
+	helmChartDigest = "sha256:3b4c5f6a7d8e9f00112233445566778899aabbccddeeff0011223344556677"
+	helmProvenance  = `apiVersion: v2
+description: A Helm chart for Kubernetes
+name: my-chart
+version: 1.2.3
+
+...
+files:
+  my-chart-1.2.3.tgz: ` + helmChartDigest + `
+`
+)
+
+// runGPG runs gpg with the GNUPGHOME of the test
+func runGPG(t *testing.T, stdin string, args ...string) string {
+	t.Helper()
+	cmd := exec.CommandContext(t.Context(), "gpg", append([]string{"--no-permission-warning", "--batch"}, args...)...)
+	cmd.Env = getGPGEnviron()
+	cmd.Stdin = strings.NewReader(stdin)
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	require.NoError(t, err, stderr.String())
+	return string(out)
+}
+
+// generateProvenanceKey generates a signing key without a passphrase in the test keyring, and returns its key ID
+func generateProvenanceKey(t *testing.T, uid string) string {
+	t.Helper()
+	runGPG(t, "", "--passphrase", "", "--quick-gen-key", uid, "ed25519", "sign", "never")
+	for line := range strings.SplitSeq(runGPG(t, "", "--with-colons", "--list-keys", uid), "\n") {
+		fields := strings.Split(line, ":")
+		if fields[0] == "pub" {
+			return fields[4]
+		}
+	}
+	require.FailNow(t, "generated key not found", uid)
+	return ""
+}
+
+func helmSourceIntegrity(keys ...string) *v1alpha1.SourceIntegrity {
+	return &v1alpha1.SourceIntegrity{Helm: &v1alpha1.SourceIntegrityHelm{Policies: []*v1alpha1.SourceIntegrityHelmPolicy{{
+		Repos:      []v1alpha1.SourceIntegrityHelmPolicyRepo{{URL: "https://charts.example.com/*"}},
+		Provenance: &v1alpha1.SourceIntegrityHelmPolicyProvenance{Keys: keys},
+	}}}}
+}
+
+func TestVerifyHelmProvenance(t *testing.T) {
+	initTempDir(t)
+	trusted := generateProvenanceKey(t, "Trusted <trusted@example.com>")
+	untrusted := generateProvenanceKey(t, "Untrusted <untrusted@example.com>")
+	signed := runGPG(t, helmProvenance, "--local-user", trusted, "--clearsign")
+
+	verifyWith := func(t *testing.T, si *v1alpha1.SourceIntegrity, provenance string, chartDigest string) *v1alpha1.SourceIntegrityCheckResult {
+		t.Helper()
+		helmClient := &helmmocks.Client{}
+		helmClient.EXPECT().GetProvenance(mock.Anything, helmChart, helmVersion, false).Return(&helm.Provenance{
+			ChartDigest: chartDigest,
+			Data:        []byte(provenance),
+		}, nil).Maybe()
+		result, err := VerifyHelm(t.Context(), si, helmClient, helmRepoURL, helmChart, helmVersion, false)
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("valid provenance", func(t *testing.T) {
+		result := verifyWith(t, helmSourceIntegrity(trusted), signed, helmChartDigest)
+		require.NoError(t, result.AsError())
+		assert.Equal(t, []string{helmProvenanceCheckName}, result.PassedChecks())
+	})
+
+	t.Run("signed with unallowed key", func(t *testing.T) {
+		result := verifyWith(t, helmSourceIntegrity(untrusted), signed, helmChartDigest)
+		require.EqualError(t, result.AsError(), "HELM/PROVENANCE: Failed verifying provenance of chart my-chart 1.2.3: signed with unallowed key (key_id="+trusted+")")
+	})
+
+	t.Run("tampered chart archive", func(t *testing.T) {
+		tamperedDigest := "sha256:" + strings.Repeat("0", 64)
+		result := verifyWith(t, helmSourceIntegrity(trusted), signed, tamperedDigest)
+		require.EqualError(t, result.AsError(), "HELM/PROVENANCE: Failed verifying provenance of chart my-chart 1.2.3: chart archive digest "+tamperedDigest+" is not listed in the provenance file")
+	})
+
+	t.Run("tampered provenance", func(t *testing.T) {
+		tampered := strings.Replace(signed, helmChartDigest, "sha256:"+strings.Repeat("0", 64), 1)
+		result := verifyWith(t, helmSourceIntegrity(trusted), tampered, helmChartDigest)
+		require.EqualError(t, result.AsError(), "HELM/PROVENANCE: Failed verifying provenance of chart my-chart 1.2.3: bad signature (key_id="+trusted+")")
+	})
+
+	t.Run("unsigned provenance", func(t *testing.T) {
+		result := verifyWith(t, helmSourceIntegrity(trusted), helmProvenance, helmChartDigest)
+		require.EqualError(t, result.AsError(), "HELM/PROVENANCE: Failed verifying provenance of chart my-chart 1.2.3: unsigned (key_id=)")
+	})
+
+	t.Run("no keys in policy", func(t *testing.T) {
+		result := verifyWith(t, helmSourceIntegrity(), signed, helmChartDigest)
+		require.EqualError(t, result.AsError(), "HELM/PROVENANCE: no GnuPG keys configured in the policy")
+	})
+
+	t.Run("repo not matched", func(t *testing.T) {
+		si := helmSourceIntegrity(trusted)
+		si.Helm.Policies[0].Repos = []v1alpha1.SourceIntegrityHelmPolicyRepo{{URL: "https://other.example.com/*"}}
+		assert.Nil(t, verifyWith(t, si, signed, helmChartDigest))
+	})
+
+	t.Run("multiple policies", func(t *testing.T) {
+		si := helmSourceIntegrity(trusted)
+		si.Helm.Policies = append(si.Helm.Policies, si.Helm.Policies[0])
+		_, err := VerifyHelm(t.Context(), si, &helmmocks.Client{}, helmRepoURL, helmChart, helmVersion, false)
+		require.EqualError(t, err, "multiple (2) Helm source integrity policies found for repo URL: "+helmRepoURL)
+	})
+
+	t.Run("gpg disabled", func(t *testing.T) {
+		t.Setenv("ARGOCD_GPG_ENABLED", "false")
+		assert.Nil(t, verifyWith(t, helmSourceIntegrity(trusted), helmProvenance, helmChartDigest))
+	})
+}
+
+func TestParseProvenanceStatus(t *testing.T) {
+	tests := []struct {
+		name   string
+		status string
+		keyID  string
+		result git.GPGVerificationResult
+	}{{
+		name: "signed by subkey",
+		status: `[GNUPG:] NEWSIG
+[GNUPG:] GOODSIG 0123456789ABCDEF Trusted <trusted@example.com>
+[GNUPG:] VALIDSIG 11111111111111111111111111111111111111FF 2026-10-17 1792202102 0 4 0 22 8 01 5F6F4BB456B6170780A4038ECEB26F4DC8A6DDFF
+[GNUPG:] TRUST_ULTIMATE 0 pgp`,
+		keyID:  "CEB26F4DC8A6DDFF",
+		result: git.GPGVerificationResultGood,
+	}, {
+		name: "missing key",
+		status: `[GNUPG:] NEWSIG
+[GNUPG:] ERRSIG CEB26F4DC8A6DDFF 22 8 01 1792202102 9 5F6F4BB456B6170780A4038ECEB26F4DC8A6DDFF
+[GNUPG:] NO_PUBKEY CEB26F4DC8A6DDFF`,
+		keyID:  "CEB26F4DC8A6DDFF",
+		result: git.GPGVerificationResultMissingKey,
+	}, {
+		name:   "expired key",
+		status: `[GNUPG:] EXPKEYSIG CEB26F4DC8A6DDFF Trusted <trusted@example.com>`,
+		keyID:  "CEB26F4DC8A6DDFF",
+		result: git.GPGVerificationResultExpiredKey,
+	}, {
+		name:   "revoked key",
+		status: `[GNUPG:] REVKEYSIG CEB26F4DC8A6DDFF Trusted <trusted@example.com>`,
+		keyID:  "CEB26F4DC8A6DDFF",
+		result: git.GPGVerificationResultRevokedKey,
+	}, {
+		name:   "no signature",
+		status: `[GNUPG:] NODATA 1`,
+		result: git.GPGVerificationResultUnsigned,
+	}}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			signature := parseProvenanceStatus(tt.status)
+			assert.Equal(t, tt.result, signature.Result)
+			assert.Equal(t, tt.keyID, signature.KeyID)
+		})
+	}
+}
+
+func TestParseProvenanceDigests(t *testing.T) {
+	digests, err := parseProvenanceDigests([]byte(helmProvenance))
+	require.NoError(t, err)
+	assert.Equal(t, []string{helmChartDigest}, digests)
+
+	_, err = parseProvenanceDigests([]byte("name: my-chart\nversion: 1.2.3\n"))
+	require.EqualError(t, err, "malformed provenance file, no files section found")
+}
+
+func TestHasCriteriaHelm(t *testing.T) {
+	si := helmSourceIntegrity("CEB26F4DC8A6DDFF")
+	assert.True(t, HasCriteria(si, v1alpha1.ApplicationSource{RepoURL: helmRepoURL, Chart: helmChart}))
+	assert.False(t, HasCriteria(si, v1alpha1.ApplicationSource{RepoURL: "https://other.example.com/charts", Chart: helmChart}))
+	// Git policies do not apply to charts
+	si = &v1alpha1.SourceIntegrity{Git: &v1alpha1.SourceIntegrityGit{Policies: []*v1alpha1.SourceIntegrityGitPolicy{{
+		Repos: []v1alpha1.SourceIntegrityGitPolicyRepo{{URL: "*"}},
+		GPG:   &v1alpha1.SourceIntegrityGitPolicyGPG{Mode: v1alpha1.SourceIntegrityGitPolicyGPGModeHead},
+	}}}}
+	assert.False(t, HasCriteria(si, v1alpha1.ApplicationSource{RepoURL: helmRepoURL, Chart: helmChart}))
+}
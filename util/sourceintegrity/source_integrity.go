@@ -12,21 +12,41 @@ import (
 	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
 	"github.com/argoproj/argo-cd/v3/util/git"
 	"github.com/argoproj/argo-cd/v3/util/glob"
+	"github.com/argoproj/argo-cd/v3/util/helm"
+	"github.com/argoproj/argo-cd/v3/util/oci"
 )
 
 type gitFunc func(ctx context.Context, gitClient git.Client, verifiedRevision string) (result *v1alpha1.SourceIntegrityCheckResult, legacyDescription string, err error)
 
-var _gpgDisabledLoggedAlready bool
+type ociFunc func(ctx context.Context, ociClient oci.Client, digest string) (result *v1alpha1.SourceIntegrityCheckResult, err error)
+
+type helmFunc func(ctx context.Context, helmClient helm.Client, chart string, version string, passCredentials bool) (result *v1alpha1.SourceIntegrityCheckResult, err error)
+
+var (
+	_gpgDisabledLoggedAlready     bool
+	_helmGPGDisabledLoggedAlready bool
+)
 
 // HasCriteria determines if any of the sources have some criteria declared
 func HasCriteria(si *v1alpha1.SourceIntegrity, sources ...v1alpha1.ApplicationSource) bool {
-	if si == nil || si.Git == nil {
+	if si == nil {
 		return false
 	}
 
 	for _, source := range sources {
-		if !source.IsZero() && !source.IsOCI() && !source.IsHelm() {
-			if lookupGit(si, source.RepoURL) != nil {
+		switch {
+		case source.IsZero():
+			continue
+		case source.IsHelm():
+			if si.Helm != nil && lookupHelm(si, source.RepoURL) != nil {
+				return true
+			}
+		case source.IsOCI():
+			if si.OCI != nil && lookupOCI(si, source.RepoURL) != nil {
+				return true
+			}
+		default:
+			if si.Git != nil && lookupGit(si, source.RepoURL) != nil {
 				return true
 			}
 		}
@@ -68,20 +88,88 @@ func lookupGit(si *v1alpha1.SourceIntegrity, repoURL string) gitFunc {
 	}
 
 	policy := policies[0]
-	if policy.GPG != nil {
-		if policy.GPG.Mode == v1alpha1.SourceIntegrityGitPolicyGPGModeNone {
+	if policy.GPG == nil && policy.SSH == nil {
+		log.Warnf("No verification configured for SourceIntegrity policy for %+v", policy.Repos)
+		return nil
+	}
+
+	gpg := policy.GPG
+	if gpg != nil {
+		if gpg.Mode == v1alpha1.SourceIntegrityGitPolicyGPGModeNone {
 			// Declare missing check because there is no verification performed
-			return nil
+			gpg = nil
+		} else if !IsGPGEnabled() {
+			if !_gpgDisabledLoggedAlready {
+				log.Warnf("SourceIntegrity criteria for git+gpg declared, but it is turned off by ARGOCD_GPG_ENABLED")
+				_gpgDisabledLoggedAlready = true
+			}
+			gpg = nil
 		}
+	}
 
-		if !_gpgDisabledLoggedAlready && !IsGPGEnabled() {
-			log.Warnf("SourceIntegrity criteria for git+gpg declared, but it is turned off by ARGOCD_GPG_ENABLED")
-			_gpgDisabledLoggedAlready = true
-			return nil
+	ssh := policy.SSH
+	if ssh != nil && ssh.Mode == v1alpha1.SourceIntegrityGitPolicySSHModeNone {
+		// Declare missing check because there is no verification performed
+		ssh = nil
+	}
+
+	if gpg == nil && ssh == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, gitClient git.Client, verifiedRevision string) (*v1alpha1.SourceIntegrityCheckResult, string, error) {
+		return verifyPolicy(ctx, gpg, ssh, gitClient, verifiedRevision)
+	}
+}
+
+func findMatchingGitPolicies(si *v1alpha1.SourceIntegrityGit, repoURL string) (policies []*v1alpha1.SourceIntegrityGitPolicy) {
+	for _, p := range si.Policies {
+		urlGlobs := make([]string, 0, len(p.Repos))
+		for _, r := range p.Repos {
+			urlGlobs = append(urlGlobs, r.URL)
+		}
+		if reposInclude(urlGlobs, repoURL) {
+			policies = append(policies, p)
+		}
+	}
+	return policies
+}
+
+// VerifyOCI makes sure the OCI artifact digest satisfies the criteria declared.
+// It returns nil in case there were no relevant criteria, a check result if there were.
+// The digest is expected to be already resolved from a tag or a version constraint.
+func VerifyOCI(ctx context.Context, si *v1alpha1.SourceIntegrity, ociClient oci.Client, repoURL string, digest string) (*v1alpha1.SourceIntegrityCheckResult, error) {
+	if si == nil || si.OCI == nil {
+		return nil, nil
+	}
+
+	check := lookupOCI(si, repoURL)
+	if check != nil {
+		return check(ctx, ociClient, digest)
+	}
+	return nil, nil
+}
+
+func lookupOCI(si *v1alpha1.SourceIntegrity, repoURL string) ociFunc {
+	policies := findMatchingOCIPolicies(si.OCI, repoURL)
+	nPolicies := len(policies)
+	if nPolicies == 0 {
+		log.Infof("No OCI source integrity policies found for repo URL: %s", repoURL)
+		return nil
+	}
+	if nPolicies > 1 {
+		// Same as with git, a misconfiguration must not disable verification until fixed.
+		msg := fmt.Sprintf("multiple (%d) OCI source integrity policies found for repo URL: %s", nPolicies, repoURL)
+		log.Warn(msg)
+		return func(_ context.Context, _ oci.Client, _ string) (*v1alpha1.SourceIntegrityCheckResult, error) {
+			return nil, errors.New(msg)
 		}
+	}
 
-		return func(ctx context.Context, gitClient git.Client, verifiedRevision string) (*v1alpha1.SourceIntegrityCheckResult, string, error) {
-			return verify(ctx, policy.GPG, gitClient, verifiedRevision)
+	policy := policies[0]
+	if policy.Cosign != nil {
+		return func(ctx context.Context, ociClient oci.Client, digest string) (*v1alpha1.SourceIntegrityCheckResult, error) {
+			return verifyCosign(ctx, policy.Cosign, ociClient, digest)
 		}
 	}
 
@@ -89,25 +177,95 @@ func lookupGit(si *v1alpha1.SourceIntegrity, repoURL string) gitFunc {
 	return nil
 }
 
-func findMatchingGitPolicies(si *v1alpha1.SourceIntegrityGit, repoURL string) (policies []*v1alpha1.SourceIntegrityGitPolicy) {
+func findMatchingOCIPolicies(si *v1alpha1.SourceIntegrityOCI, repoURL string) (policies []*v1alpha1.SourceIntegrityOCIPolicy) {
 	for _, p := range si.Policies {
-		include := false
+		urlGlobs := make([]string, 0, len(p.Repos))
 		for _, r := range p.Repos {
-			m := repoMatches(r.URL, repoURL)
-			if m == -1 {
-				include = false
-				break
-			} else if m == 1 {
-				include = true
+			urlGlobs = append(urlGlobs, r.URL)
+		}
+		if reposInclude(urlGlobs, repoURL) {
+			policies = append(policies, p)
+		}
+	}
+	return policies
+}
+
+// VerifyHelm makes sure the chart from a Helm repository satisfies the criteria declared.
+// It returns nil in case there were no relevant criteria, a check result if there were.
+// The chart is expected to be already extracted by the helm client, so the verified archive is the one in use.
+func VerifyHelm(ctx context.Context, si *v1alpha1.SourceIntegrity, helmClient helm.Client, repoURL string, chart string, version string, passCredentials bool) (*v1alpha1.SourceIntegrityCheckResult, error) {
+	if si == nil || si.Helm == nil {
+		return nil, nil
+	}
+
+	check := lookupHelm(si, repoURL)
+	if check != nil {
+		return check(ctx, helmClient, chart, version, passCredentials)
+	}
+	return nil, nil
+}
+
+func lookupHelm(si *v1alpha1.SourceIntegrity, repoURL string) helmFunc {
+	policies := findMatchingHelmPolicies(si.Helm, repoURL)
+	nPolicies := len(policies)
+	if nPolicies == 0 {
+		log.Infof("No Helm source integrity policies found for repo URL: %s", repoURL)
+		return nil
+	}
+	if nPolicies > 1 {
+		// Same as with git, a misconfiguration must not disable verification until fixed.
+		msg := fmt.Sprintf("multiple (%d) Helm source integrity policies found for repo URL: %s", nPolicies, repoURL)
+		log.Warn(msg)
+		return func(_ context.Context, _ helm.Client, _ string, _ string, _ bool) (*v1alpha1.SourceIntegrityCheckResult, error) {
+			return nil, errors.New(msg)
+		}
+	}
+
+	policy := policies[0]
+	if policy.Provenance != nil {
+		if !IsGPGEnabled() {
+			if !_helmGPGDisabledLoggedAlready {
+				log.Warnf("SourceIntegrity criteria for helm+provenance declared, but it is turned off by ARGOCD_GPG_ENABLED")
+				_helmGPGDisabledLoggedAlready = true
 			}
+			return nil
+		}
+		return func(ctx context.Context, helmClient helm.Client, chart string, version string, passCredentials bool) (*v1alpha1.SourceIntegrityCheckResult, error) {
+			return verifyHelmProvenance(ctx, policy.Provenance, helmClient, chart, version, passCredentials)
+		}
+	}
+
+	log.Warnf("No verification configured for SourceIntegrity policy for %+v", policy.Repos)
+	return nil
+}
+
+func findMatchingHelmPolicies(si *v1alpha1.SourceIntegrityHelm, repoURL string) (policies []*v1alpha1.SourceIntegrityHelmPolicy) {
+	for _, p := range si.Policies {
+		urlGlobs := make([]string, 0, len(p.Repos))
+		for _, r := range p.Repos {
+			urlGlobs = append(urlGlobs, r.URL)
 		}
-		if include {
+		if reposInclude(urlGlobs, repoURL) {
 			policies = append(policies, p)
 		}
 	}
 	return policies
 }
 
+// reposInclude reports whether the repoURL is matched by some of the positive globs, and none of the negative ones.
+func reposInclude(urlGlobs []string, repoURL string) bool {
+	include := false
+	for _, urlGlob := range urlGlobs {
+		m := repoMatches(urlGlob, repoURL)
+		if m == -1 {
+			return false
+		} else if m == 1 {
+			include = true
+		}
+	}
+	return include
+}
+
 func repoMatches(urlGlob string, repoURL string) int {
 	if strings.HasPrefix(urlGlob, "!") {
 		if glob.Match(urlGlob[1:], repoURL) {
@@ -122,30 +280,53 @@ func repoMatches(urlGlob string, repoURL string) int {
 	return 0
 }
 
-func verify(ctx context.Context, g *v1alpha1.SourceIntegrityGitPolicyGPG, gitClient git.Client, verifiedRevision string) (*v1alpha1.SourceIntegrityCheckResult, string, error) {
-	const checkName = "GIT/GPG"
-
+// verifyPolicy verifies the signatures of the revision with GPG, SSH, or both. When both are given, each signature
+// needs to satisfy one of them.
+func verifyPolicy(ctx context.Context, g *v1alpha1.SourceIntegrityGitPolicyGPG, s *v1alpha1.SourceIntegrityGitPolicySSH, gitClient git.Client, verifiedRevision string) (*v1alpha1.SourceIntegrityCheckResult, string, error) {
+	var methods []string
 	var deep bool
-	switch g.Mode {
-	// verify tag if on tag, latest revision otherwise
-	case v1alpha1.SourceIntegrityGitPolicyGPGModeHead:
-		deep = false
-	// verify history from the current commit
-	case v1alpha1.SourceIntegrityGitPolicyGPGModeStrict:
-		deep = true
-	default:
-		return nil, "", fmt.Errorf("unknown GPG mode %q configured for GIT source integrity", g.Mode)
+	if g != nil {
+		switch g.Mode {
+		// verify tag if on tag, latest revision otherwise
+		case v1alpha1.SourceIntegrityGitPolicyGPGModeHead:
+		// verify history from the current commit
+		case v1alpha1.SourceIntegrityGitPolicyGPGModeStrict:
+			deep = true
+		default:
+			return nil, "", fmt.Errorf("unknown GPG mode %q configured for GIT source integrity", g.Mode)
+		}
+		methods = append(methods, "GPG")
 	}
+	var sshFingerprints map[string]string
+	if s != nil {
+		switch s.Mode {
+		case v1alpha1.SourceIntegrityGitPolicySSHModeHead:
+		case v1alpha1.SourceIntegrityGitPolicySSHModeStrict:
+			deep = true
+		default:
+			return nil, "", fmt.Errorf("unknown SSH mode %q configured for GIT source integrity", s.Mode)
+		}
+		methods = append(methods, "SSH")
+
+		var err error
+		sshFingerprints, err = sshAllowedFingerprints(s)
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	checkName := "GIT/" + strings.Join(methods, "+")
 
 	// TODO: Remove deprecated https://github.com/argoproj/argo-cd/issues/27695
 	signatures, legacyVerification, err := gitClient.LsSignatures(ctx, verifiedRevision, deep)
 	if err != nil {
 		return nil, "", err
 	}
-	legacyDescriptionCondition := VerifyGnuPGSignature(verifiedRevision, g.Keys, legacyVerification)
 	legacyDescription := ""
-	if legacyDescriptionCondition != nil {
-		legacyDescription = legacyDescriptionCondition.Message
+	if g != nil {
+		legacyDescriptionCondition := VerifyGnuPGSignature(verifiedRevision, g.Keys, legacyVerification)
+		if legacyDescriptionCondition != nil {
+			legacyDescription = legacyDescriptionCondition.Message
+		}
 	}
 
 	if len(signatures) == 0 {
@@ -153,7 +334,7 @@ func verify(ctx context.Context, g *v1alpha1.SourceIntegrityGitPolicyGPG, gitCli
 		return nil, "", errors.New("git LsSignatures found no signatures for " + verifiedRevision)
 	}
 
-	problems, legacyGood := describeProblems(g, signatures)
+	problems, legacyGood := describeProblems(g, sshFingerprints, signatures)
 	result := &v1alpha1.SourceIntegrityCheckResult{Checks: []v1alpha1.SourceIntegrityCheckResultItem{{
 		Name:     checkName,
 		Problems: problems,
@@ -164,9 +345,10 @@ func verify(ctx context.Context, g *v1alpha1.SourceIntegrityGitPolicyGPG, gitCli
 	return result, legacyDescription, nil
 }
 
-// describeProblems reports 10 most recent problematic signatures or unsigned commits.
-// The number is limited not to flood the UI and logs with too many problems. Problems related to the same signing key are squashed.
-func describeProblems(g *v1alpha1.SourceIntegrityGitPolicyGPG, signatureInfos []git.RevisionSignatureInfo) (problems []string, legacyDescription string) {
+// describeProblems reports 10 most recent problematic signatures or unsigned commits, verified by GPG keys, SSH key
+// fingerprints, or both. The number is limited not to flood the UI and logs with too many problems. Problems related
+// to the same signing key are squashed.
+func describeProblems(g *v1alpha1.SourceIntegrityGitPolicyGPG, sshFingerprints map[string]string, signatureInfos []git.RevisionSignatureInfo) (problems []string, legacyDescription string) {
 	reportedKeys := make(map[string]any)
 	for _, signatureInfo := range signatureInfos {
 		// TODO: Remove deprecated https://github.com/argoproj/argo-cd/issues/27695
@@ -187,7 +369,7 @@ func describeProblems(g *v1alpha1.SourceIntegrityGitPolicyGPG, signatureInfos []
 			}
 		}
 
-		problem := gpgProblemMessage(g, signatureInfo)
+		problem := signatureProblemMessage(g, sshFingerprints, signatureInfo)
 		if problem != "" {
 			// Do not report the same key twice unless:
 			// - the revision is unsigned (unsigned commits can have different authors, so they are all worth reporting)
@@ -210,9 +392,9 @@ func describeProblems(g *v1alpha1.SourceIntegrityGitPolicyGPG, signatureInfos []
 	return problems, legacyDescription
 }
 
-// gpgProblemMessage generates a message describing GPG verification issues for a specific revision signature and the configured policy.
-// When an empty string is returned, it means there is no problem - the validation has passed.
-func gpgProblemMessage(g *v1alpha1.SourceIntegrityGitPolicyGPG, signatureInfo git.RevisionSignatureInfo) string {
+// signatureProblemMessage generates a message describing verification issues for a specific revision signature made
+// either by a GPG key, or an SSH key. When an empty string is returned, it means there is no problem - the validation has passed.
+func signatureProblemMessage(g *v1alpha1.SourceIntegrityGitPolicyGPG, sshFingerprints map[string]string, signatureInfo git.RevisionSignatureInfo) string {
 	if signatureInfo.VerificationResult != git.GPGVerificationResultGood {
 		return fmt.Sprintf(
 			"Failed verifying revision %s by '%s': %s (key_id=%s)",
@@ -220,15 +402,21 @@ func gpgProblemMessage(g *v1alpha1.SourceIntegrityGitPolicyGPG, signatureInfo gi
 		)
 	}
 
-	for _, allowedKey := range g.Keys {
-		allowedKey, err := KeyID(allowedKey)
-		if err != nil {
-			log.Error(err.Error())
-			continue
-		}
-		if allowedKey == signatureInfo.SignatureKeyID {
+	if IsSSHFingerprint(signatureInfo.SignatureKeyID) {
+		if _, ok := sshFingerprints[signatureInfo.SignatureKeyID]; ok {
 			return ""
 		}
+	} else if g != nil {
+		for _, allowedKey := range g.Keys {
+			allowedKey, err := KeyID(allowedKey)
+			if err != nil {
+				log.Error(err.Error())
+				continue
+			}
+			if allowedKey == signatureInfo.SignatureKeyID {
+				return ""
+			}
+		}
 	}
 
 	return fmt.Sprintf(
@@ -59,13 +59,24 @@ func Test_GPGDisabledLogging(t *testing.T) {
 	assert.Nil(t, fun)
 }
 
+// verifyGPG verifies the revision against a source integrity policy of the repository of the client with the given GPG criteria
+func verifyGPG(ctx context.Context, g *v1alpha1.SourceIntegrityGitPolicyGPG, gitClient *gitmocks.Client, verifiedRevision string) (*v1alpha1.SourceIntegrityCheckResult, string, error) {
+	const repoURL = "https://github.com/argoproj/argo-cd.git"
+	gitClient.EXPECT().RepoURL().Return(repoURL).Maybe()
+	si := &v1alpha1.SourceIntegrity{Git: &v1alpha1.SourceIntegrityGit{Policies: []*v1alpha1.SourceIntegrityGitPolicy{{
+		Repos: []v1alpha1.SourceIntegrityGitPolicyRepo{{URL: repoURL}},
+		GPG:   g,
+	}}}}
+	return VerifyGit(ctx, si, gitClient, verifiedRevision)
+}
+
 func TestGPGUnknownMode(t *testing.T) {
 	gitClient := &gitmocks.Client{}
 	gitClient.EXPECT().IsAnnotatedTag(mock.Anything, mock.Anything).Return(false)
 	gitClient.EXPECT().CommitSHA(mock.Anything).Return("DEADBEEF", nil)
 
 	s := &v1alpha1.SourceIntegrityGitPolicyGPG{Mode: "foobar", Keys: []string{}}
-	result, _, err := verify(t.Context(), s, gitClient, "https://github.com/argoproj/argo-cd.git")
+	result, _, err := verifyGPG(t.Context(), s, gitClient, "https://github.com/argoproj/argo-cd.git")
 	require.ErrorContains(t, err, `unknown GPG mode "foobar" configured for GIT source integrity`)
 	assert.Nil(t, result)
 }
@@ -236,7 +247,7 @@ gpg: Good signature from "test user <testuser@example.com>" [ultimate]`, fingerp
 
 	gpgWithTag := &v1alpha1.SourceIntegrityGitPolicyGPG{Mode: v1alpha1.SourceIntegrityGitPolicyGPGModeHead, Keys: []string{fingerprint}}
 	// And verifying a given revision
-	result, legacy, err := verify(t.Context(), gpgWithTag, gitClient, "1.0")
+	result, legacy, err := verifyGPG(t.Context(), gpgWithTag, gitClient, "1.0")
 	require.NoError(t, err)
 
 	assert.True(t, result.IsValid())
@@ -292,7 +303,7 @@ gpg: Good signature from "test user <testuser@example.com>" [ultimate]`, keyId)
 				Keys: []string{keyId, "0000000000000000"},
 			}
 			// And verifying a given revision
-			result, legacy, err := verify(t.Context(), gpgWithTag, gitClient, test.revision)
+			result, legacy, err := verifyGPG(t.Context(), gpgWithTag, gitClient, test.revision)
 			require.NoError(t, err)
 			// Then it is checked and valid
 			assert.True(t, result.IsValid())
@@ -309,7 +320,7 @@ func TestDescribeProblems(t *testing.T) {
 	const a = "Commit Author <nereply@acme.com>"
 	const kGood = "AAAAAAAAAAAAAAAA"
 	const kOk = "BBBBBBBBBBBBBBB"
-	policy := v1alpha1.SourceIntegrityGitPolicyGPG{Keys: []string{kGood, kOk}}
+	policy := v1alpha1.SourceIntegrityGitPolicyGPG{Mode: v1alpha1.SourceIntegrityGitPolicyGPGModeHead, Keys: []string{kGood, kOk}}
 
 	sig := func(key string, result git.GPGVerificationResult) git.RevisionSignatureInfo {
 		return git.RevisionSignatureInfo{
@@ -433,8 +444,16 @@ func TestDescribeProblems(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			problems, legacy := describeProblems(tt.gpg, tt.sigs)
-			assert.Equal(t, tt.expected, problems)
+			gitClient := &gitmocks.Client{}
+			// a good legacy verification leaves the legacy description to the signatures
+			gitClient.EXPECT().LsSignatures(mock.Anything, r, false).Return(tt.sigs, fmt.Sprintf(`gpg: Signature made Wed Feb 26 23:22:34 2020 CET
+gpg:                using RSA key %s
+gpg: Good signature from "%s" [ultimate]`, kGood, a), nil)
+
+			result, legacy, err := verifyGPG(t.Context(), tt.gpg, gitClient, r)
+			require.NoError(t, err)
+			require.Len(t, result.Checks, 1)
+			assert.Equal(t, tt.expected, result.Checks[0].Problems)
 			assert.Equal(t, tt.legacy, legacy)
 		})
 	}
@@ -558,7 +577,7 @@ gpg: Good signature from "%s" [ultimate]`, "Wed Feb 26 23:22:34 2020 CET", ret[0
 				Keys: []string{keyOfFirst, keyOfSecond},
 			}
 			// And verifying a given revision
-			result, legacy, err := verify(t.Context(), gpgWithTag, gitClient, test.revision)
+			result, legacy, err := verifyGPG(t.Context(), gpgWithTag, gitClient, test.revision)
 			require.NoError(t, err)
 
 			// Then it is checked and valid
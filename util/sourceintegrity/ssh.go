@@ -0,0 +1,165 @@
+package sourceintegrity
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+)
+
+// Names of the signers are the keys of the argocd-ssh-signers-cm ConfigMap
+var sshSignerNameRegexp = regexp.MustCompile(`^[-._a-zA-Z0-9]+$`)
+
+// IsSSHFingerprint returns true if the key ID reported for a signature is an SSH key fingerprint, as opposed to a GnuPG key ID
+func IsSSHFingerprint(keyID string) bool {
+	return strings.HasPrefix(keyID, "SHA256:")
+}
+
+// ValidateSSHSignerName returns an error if the name cannot be used to identify an SSH signer
+func ValidateSSHSignerName(name string) error {
+	if !sshSignerNameRegexp.MatchString(name) || strings.HasPrefix(name, ".") {
+		return fmt.Errorf("'%s' is not a valid SSH signer name", name)
+	}
+	return nil
+}
+
+// ParseSSHPublicKeys parses the public keys in the authorized_keys format, one per line. Empty lines and comments are ignored.
+func ParseSSHPublicKeys(keyData string) ([]ssh.PublicKey, error) {
+	var keys []ssh.PublicKey
+	scanner := bufio.NewScanner(strings.NewReader(keyData))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(line))
+		if err != nil {
+			return nil, fmt.Errorf("invalid SSH public key %q: %w", line, err)
+		}
+		keys = append(keys, key)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("no SSH public keys found in input data")
+	}
+	return keys, nil
+}
+
+// SSHFingerprints returns the SHA256 fingerprints of the keys, in the same format git reports them for SSH signatures
+func SSHFingerprints(keys []ssh.PublicKey) []string {
+	fingerprints := make([]string, 0, len(keys))
+	for _, key := range keys {
+		fingerprints = append(fingerprints, ssh.FingerprintSHA256(key))
+	}
+	return fingerprints
+}
+
+// loadSSHSigners reads the public keys of all the signers configured in the repo-server, indexed by signer name.
+// Signers whose keys cannot be parsed are skipped.
+func loadSSHSigners() (map[string][]ssh.PublicKey, error) {
+	signers := make(map[string][]ssh.PublicKey)
+	basePath := common.GetSSHSignersPath()
+	entries, err := os.ReadDir(basePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return signers, nil
+		}
+		return nil, fmt.Errorf("error reading SSH signers from %s: %w", basePath, err)
+	}
+
+	for _, entry := range entries {
+		// Skip the ConfigMap volume internals like ..data
+		if ValidateSSHSignerName(entry.Name()) != nil {
+			continue
+		}
+		path := filepath.Join(basePath, entry.Name())
+		// Follow the symlinks of ConfigMap volume
+		st, err := os.Stat(path)
+		if err != nil || st.IsDir() {
+			continue
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("error reading SSH signer %q: %w", entry.Name(), err)
+		}
+		keys, err := ParseSSHPublicKeys(string(data))
+		if err != nil {
+			log.Warnf("Failed parsing public keys of SSH signer %q: %v", entry.Name(), err)
+			continue
+		}
+		signers[entry.Name()] = keys
+	}
+	return signers, nil
+}
+
+// writeSSHAllowedSigners writes the allowed signers file git uses to verify SSH signatures. All configured signers are
+// written, it is up to the source integrity policies to decide which of them to trust.
+func writeSSHAllowedSigners(signers map[string][]ssh.PublicKey) error {
+	names := make([]string, 0, len(signers))
+	for name := range signers {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var sb strings.Builder
+	for _, name := range names {
+		for _, key := range signers[name] {
+			_, _ = fmt.Fprintf(&sb, "%s namespaces=\"git\" %s\n", name, strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key))))
+		}
+	}
+
+	// Write the file atomically, as there can be concurrent verifications reading it
+	path := common.GetSSHAllowedSignersFilePath()
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+"-*")
+	if err != nil {
+		return fmt.Errorf("error creating SSH allowed signers file: %w", err)
+	}
+	defer os.Remove(f.Name())
+	if _, err = f.WriteString(sb.String()); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("error writing SSH allowed signers file: %w", err)
+	}
+	if err = f.Close(); err != nil {
+		return fmt.Errorf("error writing SSH allowed signers file: %w", err)
+	}
+	if err = os.Rename(f.Name(), path); err != nil {
+		return fmt.Errorf("error writing SSH allowed signers file: %w", err)
+	}
+	return nil
+}
+
+// sshAllowedFingerprints refreshes the SSH allowed signers file, and returns the fingerprints of the keys of the given signers.
+func sshAllowedFingerprints(s *v1alpha1.SourceIntegrityGitPolicySSH) (map[string]string, error) {
+	signers, err := loadSSHSigners()
+	if err != nil {
+		return nil, err
+	}
+	if err := writeSSHAllowedSigners(signers); err != nil {
+		return nil, err
+	}
+
+	allowed := make(map[string]string)
+	for _, name := range s.Signers {
+		keys, ok := signers[name]
+		if !ok {
+			log.Warnf("SSH signer %q is not configured in the repo-server", name)
+			continue
+		}
+		for _, fingerprint := range SSHFingerprints(keys) {
+			allowed[fingerprint] = name
+		}
+	}
+	return allowed, nil
+}
@@ -0,0 +1,167 @@
+package sourceintegrity
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/argoproj/argo-cd/v3/common"
+	"github.com/argoproj/argo-cd/v3/pkg/apis/application/v1alpha1"
+	"github.com/argoproj/argo-cd/v3/util/git"
+	gitmocks "github.com/argoproj/argo-cd/v3/util/git/mocks"
+	utilTest "github.com/argoproj/argo-cd/v3/util/test"
+)
+
+// writeSSHSigner generates an ED25519 key and stores its public key under given signer name in the SSH signers directory.
+func writeSSHSigner(t *testing.T, dir string, name string) ssh.PublicKey {
+	t.Helper()
+	pub, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	key, err := ssh.NewPublicKey(pub)
+	require.NoError(t, err)
+	data := ssh.MarshalAuthorizedKey(key)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name), data, 0o600))
+	return key
+}
+
+func sshSignedBy(revision string, result git.GPGVerificationResult, keyID string) git.RevisionSignatureInfo {
+	return git.RevisionSignatureInfo{Revision: revision, VerificationResult: result, SignatureKeyID: keyID, Date: "ignored", AuthorIdentity: "ignored"}
+}
+
+func TestVerifySSH(t *testing.T) {
+	const sha = "0c7a9c3f939c1f19b518bcdd11e2fce9703c4901"
+	const gpgKeyID = "4cfe068f80b1681b"
+
+	signersDir := t.TempDir()
+	t.Setenv(common.EnvSSHSignersDataPath, signersDir)
+	t.Setenv("TMPDIR", t.TempDir())
+	alice := ssh.FingerprintSHA256(writeSSHSigner(t, signersDir, "alice"))
+	bob := ssh.FingerprintSHA256(writeSSHSigner(t, signersDir, "bob"))
+
+	verifyWith := func(t *testing.T, g *v1alpha1.SourceIntegrityGitPolicyGPG, s *v1alpha1.SourceIntegrityGitPolicySSH, deep bool, signatures ...git.RevisionSignatureInfo) *v1alpha1.SourceIntegrityCheckResult {
+		t.Helper()
+		gitClient := &gitmocks.Client{}
+		gitClient.EXPECT().LsSignatures(mock.Anything, sha, deep).Return(signatures, "", nil)
+		result, _, err := verifyPolicy(t.Context(), g, s, gitClient, sha)
+		require.NoError(t, err)
+		return result
+	}
+
+	t.Run("head signed by allowed signer", func(t *testing.T) {
+		s := &v1alpha1.SourceIntegrityGitPolicySSH{Mode: v1alpha1.SourceIntegrityGitPolicySSHModeHead, Signers: []string{"alice"}}
+		result := verifyWith(t, nil, s, false, sshSignedBy(sha, git.GPGVerificationResultGood, alice))
+		require.NoError(t, result.AsError())
+		assert.Equal(t, []string{"GIT/SSH"}, result.PassedChecks())
+	})
+
+	t.Run("strict signed by other signer", func(t *testing.T) {
+		s := &v1alpha1.SourceIntegrityGitPolicySSH{Mode: v1alpha1.SourceIntegrityGitPolicySSHModeStrict, Signers: []string{"alice"}}
+		result := verifyWith(t, nil, s, true,
+			sshSignedBy(sha, git.GPGVerificationResultGood, alice),
+			sshSignedBy("b2f7e6d1f2c5a1d4e3f6a7b8c9d0e1f2a3b4c5d6", git.GPGVerificationResultGood, bob),
+		)
+		assert.Equal(t, []v1alpha1.SourceIntegrityCheckResultItem{{
+			Name:     "GIT/SSH",
+			Problems: []string{"Failed verifying revision b2f7e6d1f2c5a1d4e3f6a7b8c9d0e1f2a3b4c5d6 by 'ignored': signed with unallowed key (key_id=" + bob + ")"},
+		}}, result.Checks)
+	})
+
+	t.Run("unknown key", func(t *testing.T) {
+		s := &v1alpha1.SourceIntegrityGitPolicySSH{Mode: v1alpha1.SourceIntegrityGitPolicySSHModeHead, Signers: []string{"alice"}}
+		const unknown = "SHA256:c/h3eTc3LSB4uJzYPmjOfuhumRx90FiSlPyXVBfapfo"
+		result := verifyWith(t, nil, s, false, sshSignedBy(sha, git.GPGVerificationResultUntrusted, unknown))
+		assert.Equal(t, []v1alpha1.SourceIntegrityCheckResultItem{{
+			Name:     "GIT/SSH",
+			Problems: []string{"Failed verifying revision " + sha + " by 'ignored': signed with untrusted key (key_id=" + unknown + ")"},
+		}}, result.Checks)
+	})
+
+	t.Run("signer not configured", func(t *testing.T) {
+		logger := utilTest.LogHook{}
+		logrus.AddHook(&logger)
+		t.Cleanup(logger.CleanupHook)
+
+		s := &v1alpha1.SourceIntegrityGitPolicySSH{Mode: v1alpha1.SourceIntegrityGitPolicySSHModeHead, Signers: []string{"carol"}}
+		result := verifyWith(t, nil, s, false, sshSignedBy(sha, git.GPGVerificationResultGood, alice))
+		assert.False(t, result.IsValid())
+		assert.Equal(t, []string{`SSH signer "carol" is not configured in the repo-server`}, logger.GetEntries())
+	})
+
+	t.Run("either GPG or SSH", func(t *testing.T) {
+		g := &v1alpha1.SourceIntegrityGitPolicyGPG{Mode: v1alpha1.SourceIntegrityGitPolicyGPGModeHead, Keys: []string{gpgKeyID}}
+		s := &v1alpha1.SourceIntegrityGitPolicySSH{Mode: v1alpha1.SourceIntegrityGitPolicySSHModeStrict, Signers: []string{"bob"}}
+		result := verifyWith(t, g, s, true,
+			sshSignedBy(sha, git.GPGVerificationResultGood, gpgKeyID),
+			sshSignedBy("b2f7e6d1f2c5a1d4e3f6a7b8c9d0e1f2a3b4c5d6", git.GPGVerificationResultGood, bob),
+		)
+		require.NoError(t, result.AsError())
+		assert.Equal(t, []string{"GIT/GPG+SSH"}, result.PassedChecks())
+	})
+
+	t.Run("allowed signers file", func(t *testing.T) {
+		s := &v1alpha1.SourceIntegrityGitPolicySSH{Mode: v1alpha1.SourceIntegrityGitPolicySSHModeHead, Signers: []string{"alice"}}
+		verifyWith(t, nil, s, false, sshSignedBy(sha, git.GPGVerificationResultGood, alice))
+
+		data, err := os.ReadFile(common.GetSSHAllowedSignersFilePath())
+		require.NoError(t, err)
+		lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+		require.Len(t, lines, 2)
+		assert.True(t, strings.HasPrefix(lines[0], `alice namespaces="git" ssh-ed25519 `))
+		assert.True(t, strings.HasPrefix(lines[1], `bob namespaces="git" ssh-ed25519 `))
+	})
+}
+
+func TestSSHUnknownMode(t *testing.T) {
+	gitClient := &gitmocks.Client{}
+	s := &v1alpha1.SourceIntegrityGitPolicySSH{Mode: "foobar", Signers: []string{}}
+	result, _, err := verifyPolicy(t.Context(), nil, s, gitClient, "https://github.com/argoproj/argo-cd.git")
+	require.ErrorContains(t, err, `unknown SSH mode "foobar" configured for GIT source integrity`)
+	assert.Nil(t, result)
+}
+
+func TestLookupGitSSH(t *testing.T) {
+	t.Setenv("ARGOCD_GPG_ENABLED", "false")
+	si := &v1alpha1.SourceIntegrity{Git: &v1alpha1.SourceIntegrityGit{Policies: []*v1alpha1.SourceIntegrityGitPolicy{{
+		Repos: []v1alpha1.SourceIntegrityGitPolicyRepo{{URL: "*"}},
+		GPG:   &v1alpha1.SourceIntegrityGitPolicyGPG{Mode: v1alpha1.SourceIntegrityGitPolicyGPGModeStrict, Keys: []string{"SOME_KEY_ID"}},
+		SSH:   &v1alpha1.SourceIntegrityGitPolicySSH{Mode: v1alpha1.SourceIntegrityGitPolicySSHModeHead, Signers: []string{"alice"}},
+	}}}}
+
+	// Disabling GPG does not turn off SSH verification
+	assert.NotNil(t, lookupGit(si, "https://github.com/argoproj/argo-cd.git"))
+
+	si.Git.Policies[0].SSH.Mode = v1alpha1.SourceIntegrityGitPolicySSHModeNone
+	assert.Nil(t, lookupGit(si, "https://github.com/argoproj/argo-cd.git"))
+}
+
+func TestParseSSHPublicKeys(t *testing.T) {
+	dir := t.TempDir()
+	key := writeSSHSigner(t, dir, "alice")
+	data, err := os.ReadFile(filepath.Join(dir, "alice"))
+	require.NoError(t, err)
+
+	keys, err := ParseSSHPublicKeys("# alice's key\n\n" + string(data))
+	require.NoError(t, err)
+	assert.Equal(t, []string{ssh.FingerprintSHA256(key)}, SSHFingerprints(keys))
+
+	_, err = ParseSSHPublicKeys("# nothing here\n")
+	require.EqualError(t, err, "no SSH public keys found in input data")
+
+	_, err = ParseSSHPublicKeys("ssh-ed25519 garbage")
+	require.ErrorContains(t, err, `invalid SSH public key "ssh-ed25519 garbage"`)
+}
+
+func TestValidateSSHSignerName(t *testing.T) {
+	require.NoError(t, ValidateSSHSignerName("alice.example-1"))
+	require.EqualError(t, ValidateSSHSignerName("..data"), "'..data' is not a valid SSH signer name")
+	require.EqualError(t, ValidateSSHSignerName("alice/bob"), "'alice/bob' is not a valid SSH signer name")
+}